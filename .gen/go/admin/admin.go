@@ -269,12 +269,20 @@ func (v *AddSearchAttributeRequest) IsSetSearchAttribute() bool {
 	return v != nil && v.SearchAttribute != nil
 }
 
-type DescribeWorkflowExecutionRequest struct {
-	Domain    *string                   `json:"domain,omitempty"`
-	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
-}
-
-// ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
+type AuditRecord struct {
+	ID             *string `json:"id,omitempty"`
+	Timestamp      *int64  `json:"timestamp,omitempty"`
+	Operation      *string `json:"operation,omitempty"`
+	Domain         *string `json:"domain,omitempty"`
+	WorkflowId     *string `json:"workflowId,omitempty"`
+	RunId          *string `json:"runId,omitempty"`
+	Identity       *string `json:"identity,omitempty"`
+	Reason         *string `json:"reason,omitempty"`
+	RequestSummary *string `json:"requestSummary,omitempty"`
+	Result         *string `json:"result,omitempty"`
+}
+
+// ToWire translates a AuditRecord struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -289,45 +297,103 @@ type DescribeWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *AuditRecord) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [10]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.ID != nil {
+		w, err = wire.NewValueString(*(v.ID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.Timestamp != nil {
+		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Operation != nil {
+		w, err = wire.NewValueString(*(v.Operation)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.RequestSummary != nil {
+		w, err = wire.NewValueString(*(v.RequestSummary)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.Result != nil {
+		w, err = wire.NewValueString(*(v.Result)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
-	var v shared.WorkflowExecution
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a DescribeWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a AuditRecord struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a AuditRecord struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -335,12 +401,12 @@ func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
 //     return nil, err
 //   }
 //
-//   var v DescribeWorkflowExecutionRequest
+//   var v AuditRecord
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *AuditRecord) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -349,15 +415,97 @@ func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.ID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Timestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Operation = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestSummary = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Result = &x
 				if err != nil {
 					return err
 				}
@@ -369,25 +517,57 @@ func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeWorkflowExecutionRequest
+// String returns a readable string representation of a AuditRecord
 // struct.
-func (v *DescribeWorkflowExecutionRequest) String() string {
+func (v *AuditRecord) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [10]string
 	i := 0
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+	if v.Timestamp != nil {
+		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
+		i++
+	}
+	if v.Operation != nil {
+		fields[i] = fmt.Sprintf("Operation: %v", *(v.Operation))
+		i++
+	}
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.RequestSummary != nil {
+		fields[i] = fmt.Sprintf("RequestSummary: %v", *(v.RequestSummary))
+		i++
+	}
+	if v.Result != nil {
+		fields[i] = fmt.Sprintf("Result: %v", *(v.Result))
 		i++
 	}
 
-	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AuditRecord{%v}", strings.Join(fields[:i], ", "))
 }
 
 func _String_EqualsPtr(lhs, rhs *string) bool {
@@ -400,20 +580,54 @@ func _String_EqualsPtr(lhs, rhs *string) bool {
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
-// provided DescribeWorkflowExecutionRequest.
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this AuditRecord match the
+// provided AuditRecord.
 //
 // This function performs a deep comparison.
-func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
+func (v *AuditRecord) Equals(rhs *AuditRecord) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !_String_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Operation, rhs.Operation) {
+		return false
+	}
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RequestSummary, rhs.RequestSummary) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Result, rhs.Result) {
 		return false
 	}
 
@@ -421,23 +635,92 @@ func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecution
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DescribeWorkflowExecutionRequest.
-func (v *DescribeWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AuditRecord.
+func (v *AuditRecord) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.ID != nil {
+		enc.AddString("id", *v.ID)
+	}
+	if v.Timestamp != nil {
+		enc.AddInt64("timestamp", *v.Timestamp)
+	}
+	if v.Operation != nil {
+		enc.AddString("operation", *v.Operation)
+	}
 	if v.Domain != nil {
 		enc.AddString("domain", *v.Domain)
 	}
-	if v.Execution != nil {
-		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.RequestSummary != nil {
+		enc.AddString("requestSummary", *v.RequestSummary)
+	}
+	if v.Result != nil {
+		enc.AddString("result", *v.Result)
 	}
 	return err
 }
 
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *AuditRecord) GetID() (o string) {
+	if v != nil && v.ID != nil {
+		return *v.ID
+	}
+
+	return
+}
+
+// IsSetID returns true if ID is not nil.
+func (v *AuditRecord) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
+
+// GetTimestamp returns the value of Timestamp if it is set or its
+// zero value if it is unset.
+func (v *AuditRecord) GetTimestamp() (o int64) {
+	if v != nil && v.Timestamp != nil {
+		return *v.Timestamp
+	}
+
+	return
+}
+
+// IsSetTimestamp returns true if Timestamp is not nil.
+func (v *AuditRecord) IsSetTimestamp() bool {
+	return v != nil && v.Timestamp != nil
+}
+
+// GetOperation returns the value of Operation if it is set or its
+// zero value if it is unset.
+func (v *AuditRecord) GetOperation() (o string) {
+	if v != nil && v.Operation != nil {
+		return *v.Operation
+	}
+
+	return
+}
+
+// IsSetOperation returns true if Operation is not nil.
+func (v *AuditRecord) IsSetOperation() bool {
+	return v != nil && v.Operation != nil
+}
+
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionRequest) GetDomain() (o string) {
+func (v *AuditRecord) GetDomain() (o string) {
 	if v != nil && v.Domain != nil {
 		return *v.Domain
 	}
@@ -446,39 +729,300 @@ func (v *DescribeWorkflowExecutionRequest) GetDomain() (o string) {
 }
 
 // IsSetDomain returns true if Domain is not nil.
-func (v *DescribeWorkflowExecutionRequest) IsSetDomain() bool {
+func (v *AuditRecord) IsSetDomain() bool {
 	return v != nil && v.Domain != nil
 }
 
-// GetExecution returns the value of Execution if it is set or its
+// GetWorkflowId returns the value of WorkflowId if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
+func (v *AuditRecord) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
 	}
 
 	return
 }
 
-// IsSetExecution returns true if Execution is not nil.
-func (v *DescribeWorkflowExecutionRequest) IsSetExecution() bool {
-	return v != nil && v.Execution != nil
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *AuditRecord) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
 }
 
-type DescribeWorkflowExecutionResponse struct {
-	ShardId                *string `json:"shardId,omitempty"`
-	HistoryAddr            *string `json:"historyAddr,omitempty"`
-	MutableStateInCache    *string `json:"mutableStateInCache,omitempty"`
-	MutableStateInDatabase *string `json:"mutableStateInDatabase,omitempty"`
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *AuditRecord) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
 }
 
-// ToWire translates a DescribeWorkflowExecutionResponse struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
+// IsSetRunId returns true if RunId is not nil.
+func (v *AuditRecord) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *AuditRecord) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *AuditRecord) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *AuditRecord) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *AuditRecord) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetRequestSummary returns the value of RequestSummary if it is set or its
+// zero value if it is unset.
+func (v *AuditRecord) GetRequestSummary() (o string) {
+	if v != nil && v.RequestSummary != nil {
+		return *v.RequestSummary
+	}
+
+	return
+}
+
+// IsSetRequestSummary returns true if RequestSummary is not nil.
+func (v *AuditRecord) IsSetRequestSummary() bool {
+	return v != nil && v.RequestSummary != nil
+}
+
+// GetResult returns the value of Result if it is set or its
+// zero value if it is unset.
+func (v *AuditRecord) GetResult() (o string) {
+	if v != nil && v.Result != nil {
+		return *v.Result
+	}
+
+	return
+}
+
+// IsSetResult returns true if Result is not nil.
+func (v *AuditRecord) IsSetResult() bool {
+	return v != nil && v.Result != nil
+}
+
+type DescribeWorkflowExecutionRequest struct {
+	Domain    *string                   `json:"domain,omitempty"`
+	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
+}
+
+// ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
+	var v shared.WorkflowExecution
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a DescribeWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeWorkflowExecutionRequest
+// struct.
+func (v *DescribeWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+
+	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
+// provided DescribeWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeWorkflowExecutionRequest.
+func (v *DescribeWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *DescribeWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *DescribeWorkflowExecutionRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+type DescribeWorkflowExecutionResponse struct {
+	ShardId                *string `json:"shardId,omitempty"`
+	HistoryAddr            *string `json:"historyAddr,omitempty"`
+	MutableStateInCache    *string `json:"mutableStateInCache,omitempty"`
+	MutableStateInDatabase *string `json:"mutableStateInDatabase,omitempty"`
+}
+
+// ToWire translates a DescribeWorkflowExecutionResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
 //   x, err := v.ToWire()
 //   if err != nil {
 //     return err
@@ -940,16 +1484,6 @@ func (v *GetWorkflowExecutionRawHistoryRequest) String() string {
 	return fmt.Sprintf("GetWorkflowExecutionRawHistoryRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 func _I32_EqualsPtr(lhs, rhs *int32) bool {
 	if lhs != nil && rhs != nil {
 
@@ -1427,153 +1961,6139 @@ func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationI
 // provided GetWorkflowExecutionRawHistoryResponse.
 //
 // This function performs a deep comparison.
-func (v *GetWorkflowExecutionRawHistoryResponse) Equals(rhs *GetWorkflowExecutionRawHistoryResponse) bool {
+func (v *GetWorkflowExecutionRawHistoryResponse) Equals(rhs *GetWorkflowExecutionRawHistoryResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	if !((v.HistoryBatches == nil && rhs.HistoryBatches == nil) || (v.HistoryBatches != nil && rhs.HistoryBatches != nil && _List_DataBlob_Equals(v.HistoryBatches, rhs.HistoryBatches))) {
+		return false
+	}
+	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+		return false
+	}
+
+	return true
+}
+
+type _List_DataBlob_Zapper []*shared.DataBlob
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DataBlob_Zapper.
+func (l _List_DataBlob_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_ReplicationInfo_Zapper.
+func (m _Map_String_ReplicationInfo_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetWorkflowExecutionRawHistoryResponse.
+func (v *GetWorkflowExecutionRawHistoryResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	if v.HistoryBatches != nil {
+		err = multierr.Append(err, enc.AddArray("historyBatches", (_List_DataBlob_Zapper)(v.HistoryBatches)))
+	}
+	if v.ReplicationInfo != nil {
+		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	}
+	if v.EventStoreVersion != nil {
+		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	}
+	return err
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *GetWorkflowExecutionRawHistoryResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+// GetHistoryBatches returns the value of HistoryBatches if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetHistoryBatches() (o []*shared.DataBlob) {
+	if v != nil && v.HistoryBatches != nil {
+		return v.HistoryBatches
+	}
+
+	return
+}
+
+// IsSetHistoryBatches returns true if HistoryBatches is not nil.
+func (v *GetWorkflowExecutionRawHistoryResponse) IsSetHistoryBatches() bool {
+	return v != nil && v.HistoryBatches != nil
+}
+
+// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
+	if v != nil && v.ReplicationInfo != nil {
+		return v.ReplicationInfo
+	}
+
+	return
+}
+
+// IsSetReplicationInfo returns true if ReplicationInfo is not nil.
+func (v *GetWorkflowExecutionRawHistoryResponse) IsSetReplicationInfo() bool {
+	return v != nil && v.ReplicationInfo != nil
+}
+
+// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetEventStoreVersion() (o int32) {
+	if v != nil && v.EventStoreVersion != nil {
+		return *v.EventStoreVersion
+	}
+
+	return
+}
+
+// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
+func (v *GetWorkflowExecutionRawHistoryResponse) IsSetEventStoreVersion() bool {
+	return v != nil && v.EventStoreVersion != nil
+}
+
+type ListAuditRecordsRequest struct {
+	Domain          *string `json:"domain,omitempty"`
+	EarliestTime    *int64  `json:"earliestTime,omitempty"`
+	LatestTime      *int64  `json:"latestTime,omitempty"`
+	MaximumPageSize *int32  `json:"maximumPageSize,omitempty"`
+	NextPageToken   []byte  `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a ListAuditRecordsRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ListAuditRecordsRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.EarliestTime != nil {
+		w, err = wire.NewValueI64(*(v.EarliestTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.LatestTime != nil {
+		w, err = wire.NewValueI64(*(v.LatestTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ListAuditRecordsRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListAuditRecordsRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListAuditRecordsRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListAuditRecordsRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.EarliestTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LatestTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListAuditRecordsRequest
+// struct.
+func (v *ListAuditRecordsRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.EarliestTime != nil {
+		fields[i] = fmt.Sprintf("EarliestTime: %v", *(v.EarliestTime))
+		i++
+	}
+	if v.LatestTime != nil {
+		fields[i] = fmt.Sprintf("LatestTime: %v", *(v.LatestTime))
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("ListAuditRecordsRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ListAuditRecordsRequest match the
+// provided ListAuditRecordsRequest.
+//
+// This function performs a deep comparison.
+func (v *ListAuditRecordsRequest) Equals(rhs *ListAuditRecordsRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.EarliestTime, rhs.EarliestTime) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LatestTime, rhs.LatestTime) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListAuditRecordsRequest.
+func (v *ListAuditRecordsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.EarliestTime != nil {
+		enc.AddInt64("earliestTime", *v.EarliestTime)
+	}
+	if v.LatestTime != nil {
+		enc.AddInt64("latestTime", *v.LatestTime)
+	}
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ListAuditRecordsRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *ListAuditRecordsRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetEarliestTime returns the value of EarliestTime if it is set or its
+// zero value if it is unset.
+func (v *ListAuditRecordsRequest) GetEarliestTime() (o int64) {
+	if v != nil && v.EarliestTime != nil {
+		return *v.EarliestTime
+	}
+
+	return
+}
+
+// IsSetEarliestTime returns true if EarliestTime is not nil.
+func (v *ListAuditRecordsRequest) IsSetEarliestTime() bool {
+	return v != nil && v.EarliestTime != nil
+}
+
+// GetLatestTime returns the value of LatestTime if it is set or its
+// zero value if it is unset.
+func (v *ListAuditRecordsRequest) GetLatestTime() (o int64) {
+	if v != nil && v.LatestTime != nil {
+		return *v.LatestTime
+	}
+
+	return
+}
+
+// IsSetLatestTime returns true if LatestTime is not nil.
+func (v *ListAuditRecordsRequest) IsSetLatestTime() bool {
+	return v != nil && v.LatestTime != nil
+}
+
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *ListAuditRecordsRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
+}
+
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *ListAuditRecordsRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ListAuditRecordsRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ListAuditRecordsRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type ListAuditRecordsResponse struct {
+	Records       []*AuditRecord `json:"records,omitempty"`
+	NextPageToken []byte         `json:"nextPageToken,omitempty"`
+}
+
+type _List_AuditRecord_ValueList []*AuditRecord
+
+func (v _List_AuditRecord_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_AuditRecord_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_AuditRecord_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_AuditRecord_ValueList) Close() {}
+
+// ToWire translates a ListAuditRecordsResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ListAuditRecordsResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Records != nil {
+		w, err = wire.NewValueList(_List_AuditRecord_ValueList(v.Records)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AuditRecord_Read(w wire.Value) (*AuditRecord, error) {
+	var v AuditRecord
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_AuditRecord_Read(l wire.ValueList) ([]*AuditRecord, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*AuditRecord, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _AuditRecord_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ListAuditRecordsResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListAuditRecordsResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListAuditRecordsResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListAuditRecordsResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Records, err = _List_AuditRecord_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListAuditRecordsResponse
+// struct.
+func (v *ListAuditRecordsResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Records != nil {
+		fields[i] = fmt.Sprintf("Records: %v", v.Records)
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("ListAuditRecordsResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_AuditRecord_Equals(lhs, rhs []*AuditRecord) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ListAuditRecordsResponse match the
+// provided ListAuditRecordsResponse.
+//
+// This function performs a deep comparison.
+func (v *ListAuditRecordsResponse) Equals(rhs *ListAuditRecordsResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Records == nil && rhs.Records == nil) || (v.Records != nil && rhs.Records != nil && _List_AuditRecord_Equals(v.Records, rhs.Records))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_AuditRecord_Zapper []*AuditRecord
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_AuditRecord_Zapper.
+func (l _List_AuditRecord_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListAuditRecordsResponse.
+func (v *ListAuditRecordsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Records != nil {
+		err = multierr.Append(err, enc.AddArray("records", (_List_AuditRecord_Zapper)(v.Records)))
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetRecords returns the value of Records if it is set or its
+// zero value if it is unset.
+func (v *ListAuditRecordsResponse) GetRecords() (o []*AuditRecord) {
+	if v != nil && v.Records != nil {
+		return v.Records
+	}
+
+	return
+}
+
+// IsSetRecords returns true if Records is not nil.
+func (v *ListAuditRecordsResponse) IsSetRecords() bool {
+	return v != nil && v.Records != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ListAuditRecordsResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ListAuditRecordsResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "admin",
+	Package:  "github.com/uber/cadence/.gen/go/admin",
+	FilePath: "admin.thrift",
+	SHA1:     "22638bc6b1c72dbb4c46905f7f9565682654bb6c",
+	Includes: []*thriftreflect.ThriftModule{
+		shared.ThriftModule,
+	},
+	Raw: rawIDL,
+}
+
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privillege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DescribeHistoryHost returns information about the internal states of a history host\n  **/\n  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  **/\n  GetWorkflowExecutionRawHistoryResponse GetWorkflowExecutionRawHistory(1: GetWorkflowExecutionRawHistoryRequest getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddSearchAttribute whitelist search attribute in request.\n  **/\n  void AddSearchAttribute(1: AddSearchAttributeRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ListDLQTasks lists the transfer or timer tasks of a shard which were moved to the dead letter queue\n  * after they kept failing.\n  **/\n  shared.ListDLQTasksResponse ListDLQTasks(1: shared.ListDLQTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.AccessDeniedError accessDeniedError,\n    )\n\n  /**\n  * DescribeDLQTask returns a single task of the dead letter queue of a shard.\n  **/\n  shared.DescribeDLQTaskResponse DescribeDLQTask(1: shared.DescribeDLQTaskRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.AccessDeniedError accessDeniedError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * RequeueDLQTask processes a task of the dead letter queue of a shard again, the task is removed from the\n  * dead letter queue once it is processed successfully.\n  **/\n  void RequeueDLQTask(1: shared.RequeueDLQTaskRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.AccessDeniedError accessDeniedError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * PurgeDLQTasks removes tasks from the dead letter queue of a shard without processing them.\n  **/\n  void PurgeDLQTasks(1: shared.PurgeDLQTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.AccessDeniedError accessDeniedError,\n    )\n\n  /**\n  * DescribeShardQueues returns the ack levels and read levels of the transfer, timer and replication queues\n  * of a shard.\n  **/\n  shared.DescribeShardQueuesResponse DescribeShardQueues(1: shared.DescribeShardQueuesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.AccessDeniedError accessDeniedError,\n    )\n\n  /**\n  * ListShardQueueTasks lists the pending tasks of the transfer, timer or replication queue of a shard.\n  **/\n  shared.ListShardQueueTasksResponse ListShardQueueTasks(1: shared.ListShardQueueTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.AccessDeniedError accessDeniedError,\n    )\n\n  /**\n  * DeleteShardQueueTask removes a task from the transfer, timer or replication queue of a shard without\n  * processing it.\n  **/\n  void DeleteShardQueueTask(1: shared.DeleteShardQueueTaskRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.AccessDeniedError accessDeniedError,\n    )\n\n  /**\n  * RefireShardQueueTask processes a task of the transfer, timer or replication queue of a shard immediately,\n  * the task stays in the queue and is acked by the queue processor as usual.\n  **/\n  void RefireShardQueueTask(1: shared.RefireShardQueueTaskRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.AccessDeniedError accessDeniedError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * ListAuditRecords lists the audit log of a domain newest first, the audit log records the mutating control\n  * plane operations issued against the cluster. Cluster level operations are listed when domain is not set.\n  **/\n  ListAuditRecordsResponse ListAuditRecords(1: ListAuditRecordsRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.AccessDeniedError accessDeniedError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}\n\nstruct GetWorkflowExecutionRawHistoryRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\") firstEventId\n  40: optional i64 (js.type = \"Long\") nextEventId\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct GetWorkflowExecutionRawHistoryResponse {\n  10: optional binary nextPageToken\n  20: optional list<shared.DataBlob> historyBatches\n  30: optional map<string, shared.ReplicationInfo> replicationInfo\n  40: optional i32 eventStoreVersion\n}\n\nstruct AddSearchAttributeRequest {\n  10: optional map<string, shared.IndexedValueType> searchAttribute\n}\n\nstruct AuditRecord {\n  10: optional string id\n  20: optional i64 (js.type = \"Long\") timestamp\n  30: optional string operation\n  40: optional string domain\n  50: optional string workflowId\n  60: optional string runId\n  70: optional string identity\n  80: optional string reason\n  90: optional string requestSummary\n  100: optional string result\n}\n\nstruct ListAuditRecordsRequest {\n  10: optional string domain\n  20: optional i64 (js.type = \"Long\") earliestTime\n  30: optional i64 (js.type = \"Long\") latestTime\n  40: optional i32 maximumPageSize\n  50: optional binary nextPageToken\n}\n\nstruct ListAuditRecordsResponse {\n  10: optional list<AuditRecord> records\n  20: optional binary nextPageToken\n}\n"
+
+// AdminService_AddSearchAttribute_Args represents the arguments for the AdminService.AddSearchAttribute function.
+//
+// The arguments for AddSearchAttribute are sent and received over the wire as this struct.
+type AdminService_AddSearchAttribute_Args struct {
+	Request *AddSearchAttributeRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_AddSearchAttribute_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest, error) {
+	var v AddSearchAttributeRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_AddSearchAttribute_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_AddSearchAttribute_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _AddSearchAttributeRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_AddSearchAttribute_Args
+// struct.
+func (v *AdminService_AddSearchAttribute_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_AddSearchAttribute_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Args match the
+// provided AdminService_AddSearchAttribute_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearchAttribute_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_AddSearchAttribute_Args.
+func (v *AdminService_AddSearchAttribute_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Args) GetRequest() (o *AddSearchAttributeRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_AddSearchAttribute_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "AddSearchAttribute" for this struct.
+func (v *AdminService_AddSearchAttribute_Args) MethodName() string {
+	return "AddSearchAttribute"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_AddSearchAttribute_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_AddSearchAttribute_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.AddSearchAttribute
+// function.
+var AdminService_AddSearchAttribute_Helper = struct {
+	// Args accepts the parameters of AddSearchAttribute in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *AddSearchAttributeRequest,
+	) *AdminService_AddSearchAttribute_Args
+
+	// IsException returns true if the given error can be thrown
+	// by AddSearchAttribute.
+	//
+	// An error can be thrown by AddSearchAttribute only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for AddSearchAttribute
+	// given the error returned by it. The provided error may
+	// be nil if AddSearchAttribute did not fail.
+	//
+	// This allows mapping errors returned by AddSearchAttribute into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// AddSearchAttribute
+	//
+	//   err := AddSearchAttribute(args)
+	//   result, err := AdminService_AddSearchAttribute_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from AddSearchAttribute: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_AddSearchAttribute_Result, error)
+
+	// UnwrapResponse takes the result struct for AddSearchAttribute
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if AddSearchAttribute threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_AddSearchAttribute_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_AddSearchAttribute_Result) error
+}{}
+
+func init() {
+	AdminService_AddSearchAttribute_Helper.Args = func(
+		request *AddSearchAttributeRequest,
+	) *AdminService_AddSearchAttribute_Args {
+		return &AdminService_AddSearchAttribute_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_AddSearchAttribute_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_AddSearchAttribute_Helper.WrapResponse = func(err error) (*AdminService_AddSearchAttribute_Result, error) {
+		if err == nil {
+			return &AdminService_AddSearchAttribute_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.BadRequestError")
+			}
+			return &AdminService_AddSearchAttribute_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.InternalServiceError")
+			}
+			return &AdminService_AddSearchAttribute_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.ServiceBusyError")
+			}
+			return &AdminService_AddSearchAttribute_Result{ServiceBusyError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_AddSearchAttribute_Helper.UnwrapResponse = func(result *AdminService_AddSearchAttribute_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_AddSearchAttribute_Result represents the result of a AdminService.AddSearchAttribute function call.
+//
+// The result of a AddSearchAttribute execution is sent and received over the wire as this struct.
+type AdminService_AddSearchAttribute_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+}
+
+// ToWire translates a AdminService_AddSearchAttribute_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
+	var v shared.BadRequestError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
+	var v shared.InternalServiceError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
+	var v shared.ServiceBusyError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_AddSearchAttribute_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_AddSearchAttribute_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_AddSearchAttribute_Result
+// struct.
+func (v *AdminService_AddSearchAttribute_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_AddSearchAttribute_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Result match the
+// provided AdminService_AddSearchAttribute_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSearchAttribute_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_AddSearchAttribute_Result.
+func (v *AdminService_AddSearchAttribute_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "AddSearchAttribute" for this struct.
+func (v *AdminService_AddSearchAttribute_Result) MethodName() string {
+	return "AddSearchAttribute"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_AddSearchAttribute_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DeleteShardQueueTask_Args represents the arguments for the AdminService.DeleteShardQueueTask function.
+//
+// The arguments for DeleteShardQueueTask are sent and received over the wire as this struct.
+type AdminService_DeleteShardQueueTask_Args struct {
+	Request *shared.DeleteShardQueueTaskRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DeleteShardQueueTask_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DeleteShardQueueTask_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DeleteShardQueueTaskRequest_Read(w wire.Value) (*shared.DeleteShardQueueTaskRequest, error) {
+	var v shared.DeleteShardQueueTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DeleteShardQueueTask_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DeleteShardQueueTask_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DeleteShardQueueTask_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DeleteShardQueueTask_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DeleteShardQueueTaskRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DeleteShardQueueTask_Args
+// struct.
+func (v *AdminService_DeleteShardQueueTask_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DeleteShardQueueTask_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DeleteShardQueueTask_Args match the
+// provided AdminService_DeleteShardQueueTask_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DeleteShardQueueTask_Args) Equals(rhs *AdminService_DeleteShardQueueTask_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DeleteShardQueueTask_Args.
+func (v *AdminService_DeleteShardQueueTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DeleteShardQueueTask_Args) GetRequest() (o *shared.DeleteShardQueueTaskRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DeleteShardQueueTask_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DeleteShardQueueTask" for this struct.
+func (v *AdminService_DeleteShardQueueTask_Args) MethodName() string {
+	return "DeleteShardQueueTask"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DeleteShardQueueTask_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DeleteShardQueueTask_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DeleteShardQueueTask
+// function.
+var AdminService_DeleteShardQueueTask_Helper = struct {
+	// Args accepts the parameters of DeleteShardQueueTask in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.DeleteShardQueueTaskRequest,
+	) *AdminService_DeleteShardQueueTask_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DeleteShardQueueTask.
+	//
+	// An error can be thrown by DeleteShardQueueTask only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DeleteShardQueueTask
+	// given the error returned by it. The provided error may
+	// be nil if DeleteShardQueueTask did not fail.
+	//
+	// This allows mapping errors returned by DeleteShardQueueTask into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// DeleteShardQueueTask
+	//
+	//   err := DeleteShardQueueTask(args)
+	//   result, err := AdminService_DeleteShardQueueTask_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DeleteShardQueueTask: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_DeleteShardQueueTask_Result, error)
+
+	// UnwrapResponse takes the result struct for DeleteShardQueueTask
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if DeleteShardQueueTask threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_DeleteShardQueueTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DeleteShardQueueTask_Result) error
+}{}
+
+func init() {
+	AdminService_DeleteShardQueueTask_Helper.Args = func(
+		request *shared.DeleteShardQueueTaskRequest,
+	) *AdminService_DeleteShardQueueTask_Args {
+		return &AdminService_DeleteShardQueueTask_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DeleteShardQueueTask_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DeleteShardQueueTask_Helper.WrapResponse = func(err error) (*AdminService_DeleteShardQueueTask_Result, error) {
+		if err == nil {
+			return &AdminService_DeleteShardQueueTask_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DeleteShardQueueTask_Result.BadRequestError")
+			}
+			return &AdminService_DeleteShardQueueTask_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DeleteShardQueueTask_Result.InternalServiceError")
+			}
+			return &AdminService_DeleteShardQueueTask_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DeleteShardQueueTask_Result.AccessDeniedError")
+			}
+			return &AdminService_DeleteShardQueueTask_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DeleteShardQueueTask_Helper.UnwrapResponse = func(result *AdminService_DeleteShardQueueTask_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_DeleteShardQueueTask_Result represents the result of a AdminService.DeleteShardQueueTask function call.
+//
+// The result of a DeleteShardQueueTask execution is sent and received over the wire as this struct.
+type AdminService_DeleteShardQueueTask_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_DeleteShardQueueTask_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DeleteShardQueueTask_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DeleteShardQueueTask_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
+	var v shared.AccessDeniedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DeleteShardQueueTask_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DeleteShardQueueTask_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DeleteShardQueueTask_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DeleteShardQueueTask_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_DeleteShardQueueTask_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DeleteShardQueueTask_Result
+// struct.
+func (v *AdminService_DeleteShardQueueTask_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DeleteShardQueueTask_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DeleteShardQueueTask_Result match the
+// provided AdminService_DeleteShardQueueTask_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DeleteShardQueueTask_Result) Equals(rhs *AdminService_DeleteShardQueueTask_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DeleteShardQueueTask_Result.
+func (v *AdminService_DeleteShardQueueTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DeleteShardQueueTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DeleteShardQueueTask_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DeleteShardQueueTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DeleteShardQueueTask_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DeleteShardQueueTask_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DeleteShardQueueTask_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DeleteShardQueueTask" for this struct.
+func (v *AdminService_DeleteShardQueueTask_Result) MethodName() string {
+	return "DeleteShardQueueTask"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DeleteShardQueueTask_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeDLQTask_Args represents the arguments for the AdminService.DescribeDLQTask function.
+//
+// The arguments for DescribeDLQTask are sent and received over the wire as this struct.
+type AdminService_DescribeDLQTask_Args struct {
+	Request *shared.DescribeDLQTaskRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeDLQTask_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeDLQTask_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeDLQTaskRequest_Read(w wire.Value) (*shared.DescribeDLQTaskRequest, error) {
+	var v shared.DescribeDLQTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeDLQTask_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeDLQTask_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeDLQTask_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeDLQTask_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeDLQTaskRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeDLQTask_Args
+// struct.
+func (v *AdminService_DescribeDLQTask_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeDLQTask_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeDLQTask_Args match the
+// provided AdminService_DescribeDLQTask_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeDLQTask_Args) Equals(rhs *AdminService_DescribeDLQTask_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeDLQTask_Args.
+func (v *AdminService_DescribeDLQTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeDLQTask_Args) GetRequest() (o *shared.DescribeDLQTaskRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeDLQTask_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeDLQTask" for this struct.
+func (v *AdminService_DescribeDLQTask_Args) MethodName() string {
+	return "DescribeDLQTask"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeDLQTask_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DescribeDLQTask_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeDLQTask
+// function.
+var AdminService_DescribeDLQTask_Helper = struct {
+	// Args accepts the parameters of DescribeDLQTask in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.DescribeDLQTaskRequest,
+	) *AdminService_DescribeDLQTask_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DescribeDLQTask.
+	//
+	// An error can be thrown by DescribeDLQTask only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DescribeDLQTask
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeDLQTask into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeDLQTask
+	//
+	//   value, err := DescribeDLQTask(args)
+	//   result, err := AdminService_DescribeDLQTask_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeDLQTask: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*shared.DescribeDLQTaskResponse, error) (*AdminService_DescribeDLQTask_Result, error)
+
+	// UnwrapResponse takes the result struct for DescribeDLQTask
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeDLQTask threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeDLQTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeDLQTask_Result) (*shared.DescribeDLQTaskResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeDLQTask_Helper.Args = func(
+		request *shared.DescribeDLQTaskRequest,
+	) *AdminService_DescribeDLQTask_Args {
+		return &AdminService_DescribeDLQTask_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DescribeDLQTask_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DescribeDLQTask_Helper.WrapResponse = func(success *shared.DescribeDLQTaskResponse, err error) (*AdminService_DescribeDLQTask_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeDLQTask_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeDLQTask_Result.BadRequestError")
+			}
+			return &AdminService_DescribeDLQTask_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeDLQTask_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeDLQTask_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeDLQTask_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeDLQTask_Result{AccessDeniedError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeDLQTask_Result.EntityNotExistError")
+			}
+			return &AdminService_DescribeDLQTask_Result{EntityNotExistError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DescribeDLQTask_Helper.UnwrapResponse = func(result *AdminService_DescribeDLQTask_Result) (success *shared.DescribeDLQTaskResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_DescribeDLQTask_Result represents the result of a AdminService.DescribeDLQTask function call.
+//
+// The result of a DescribeDLQTask execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeDLQTask_Result struct {
+	// Value returned by DescribeDLQTask after a successful execution.
+	Success              *shared.DescribeDLQTaskResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError         `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError    `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError       `json:"accessDeniedError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError    `json:"entityNotExistError,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeDLQTask_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeDLQTask_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeDLQTask_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeDLQTaskResponse_Read(w wire.Value) (*shared.DescribeDLQTaskResponse, error) {
+	var v shared.DescribeDLQTaskResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
+	var v shared.EntityNotExistsError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeDLQTask_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeDLQTask_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeDLQTask_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeDLQTask_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeDLQTaskResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeDLQTask_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeDLQTask_Result
+// struct.
+func (v *AdminService_DescribeDLQTask_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeDLQTask_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeDLQTask_Result match the
+// provided AdminService_DescribeDLQTask_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeDLQTask_Result) Equals(rhs *AdminService_DescribeDLQTask_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeDLQTask_Result.
+func (v *AdminService_DescribeDLQTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeDLQTask_Result) GetSuccess() (o *shared.DescribeDLQTaskResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeDLQTask_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeDLQTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeDLQTask_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeDLQTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeDLQTask_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeDLQTask_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeDLQTask_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeDLQTask_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_DescribeDLQTask_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeDLQTask" for this struct.
+func (v *AdminService_DescribeDLQTask_Result) MethodName() string {
+	return "DescribeDLQTask"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeDLQTask_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeHistoryHost_Args represents the arguments for the AdminService.DescribeHistoryHost function.
+//
+// The arguments for DescribeHistoryHost are sent and received over the wire as this struct.
+type AdminService_DescribeHistoryHost_Args struct {
+	Request *shared.DescribeHistoryHostRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeHistoryHost_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHostRequest, error) {
+	var v shared.DescribeHistoryHostRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeHistoryHost_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeHistoryHost_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeHistoryHostRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeHistoryHost_Args
+// struct.
+func (v *AdminService_DescribeHistoryHost_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeHistoryHost_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Args match the
+// provided AdminService_DescribeHistoryHost_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_DescribeHistoryHost_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeHistoryHost_Args.
+func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.DescribeHistoryHostRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeHistoryHost_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeHistoryHost" for this struct.
+func (v *AdminService_DescribeHistoryHost_Args) MethodName() string {
+	return "DescribeHistoryHost"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeHistoryHost_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DescribeHistoryHost_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeHistoryHost
+// function.
+var AdminService_DescribeHistoryHost_Helper = struct {
+	// Args accepts the parameters of DescribeHistoryHost in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.DescribeHistoryHostRequest,
+	) *AdminService_DescribeHistoryHost_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DescribeHistoryHost.
+	//
+	// An error can be thrown by DescribeHistoryHost only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DescribeHistoryHost
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeHistoryHost into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeHistoryHost
+	//
+	//   value, err := DescribeHistoryHost(args)
+	//   result, err := AdminService_DescribeHistoryHost_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeHistoryHost: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*shared.DescribeHistoryHostResponse, error) (*AdminService_DescribeHistoryHost_Result, error)
+
+	// UnwrapResponse takes the result struct for DescribeHistoryHost
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeHistoryHost threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeHistoryHost_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeHistoryHost_Result) (*shared.DescribeHistoryHostResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeHistoryHost_Helper.Args = func(
+		request *shared.DescribeHistoryHostRequest,
+	) *AdminService_DescribeHistoryHost_Args {
+		return &AdminService_DescribeHistoryHost_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DescribeHistoryHost_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DescribeHistoryHost_Helper.WrapResponse = func(success *shared.DescribeHistoryHostResponse, err error) (*AdminService_DescribeHistoryHost_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeHistoryHost_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.BadRequestError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DescribeHistoryHost_Helper.UnwrapResponse = func(result *AdminService_DescribeHistoryHost_Result) (success *shared.DescribeHistoryHostResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_DescribeHistoryHost_Result represents the result of a AdminService.DescribeHistoryHost function call.
+//
+// The result of a DescribeHistoryHost execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeHistoryHost_Result struct {
+	// Value returned by DescribeHistoryHost after a successful execution.
+	Success              *shared.DescribeHistoryHostResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError           `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeHistoryHost_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeHistoryHostResponse_Read(w wire.Value) (*shared.DescribeHistoryHostResponse, error) {
+	var v shared.DescribeHistoryHostResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeHistoryHost_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeHistoryHost_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeHistoryHostResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeHistoryHost_Result
+// struct.
+func (v *AdminService_DescribeHistoryHost_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeHistoryHost_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Result match the
+// provided AdminService_DescribeHistoryHost_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_DescribeHistoryHost_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeHistoryHost_Result.
+func (v *AdminService_DescribeHistoryHost_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetSuccess() (o *shared.DescribeHistoryHostResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeHistoryHost" for this struct.
+func (v *AdminService_DescribeHistoryHost_Result) MethodName() string {
+	return "DescribeHistoryHost"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeHistoryHost_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeShardQueues_Args represents the arguments for the AdminService.DescribeShardQueues function.
+//
+// The arguments for DescribeShardQueues are sent and received over the wire as this struct.
+type AdminService_DescribeShardQueues_Args struct {
+	Request *shared.DescribeShardQueuesRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeShardQueues_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeShardQueues_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeShardQueuesRequest_Read(w wire.Value) (*shared.DescribeShardQueuesRequest, error) {
+	var v shared.DescribeShardQueuesRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeShardQueues_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeShardQueues_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeShardQueues_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeShardQueues_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeShardQueuesRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeShardQueues_Args
+// struct.
+func (v *AdminService_DescribeShardQueues_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeShardQueues_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeShardQueues_Args match the
+// provided AdminService_DescribeShardQueues_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeShardQueues_Args) Equals(rhs *AdminService_DescribeShardQueues_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeShardQueues_Args.
+func (v *AdminService_DescribeShardQueues_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeShardQueues_Args) GetRequest() (o *shared.DescribeShardQueuesRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeShardQueues_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeShardQueues" for this struct.
+func (v *AdminService_DescribeShardQueues_Args) MethodName() string {
+	return "DescribeShardQueues"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeShardQueues_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DescribeShardQueues_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeShardQueues
+// function.
+var AdminService_DescribeShardQueues_Helper = struct {
+	// Args accepts the parameters of DescribeShardQueues in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.DescribeShardQueuesRequest,
+	) *AdminService_DescribeShardQueues_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DescribeShardQueues.
+	//
+	// An error can be thrown by DescribeShardQueues only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DescribeShardQueues
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeShardQueues into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeShardQueues
+	//
+	//   value, err := DescribeShardQueues(args)
+	//   result, err := AdminService_DescribeShardQueues_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeShardQueues: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*shared.DescribeShardQueuesResponse, error) (*AdminService_DescribeShardQueues_Result, error)
+
+	// UnwrapResponse takes the result struct for DescribeShardQueues
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeShardQueues threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeShardQueues_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeShardQueues_Result) (*shared.DescribeShardQueuesResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeShardQueues_Helper.Args = func(
+		request *shared.DescribeShardQueuesRequest,
+	) *AdminService_DescribeShardQueues_Args {
+		return &AdminService_DescribeShardQueues_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DescribeShardQueues_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DescribeShardQueues_Helper.WrapResponse = func(success *shared.DescribeShardQueuesResponse, err error) (*AdminService_DescribeShardQueues_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeShardQueues_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeShardQueues_Result.BadRequestError")
+			}
+			return &AdminService_DescribeShardQueues_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeShardQueues_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeShardQueues_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeShardQueues_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeShardQueues_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DescribeShardQueues_Helper.UnwrapResponse = func(result *AdminService_DescribeShardQueues_Result) (success *shared.DescribeShardQueuesResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_DescribeShardQueues_Result represents the result of a AdminService.DescribeShardQueues function call.
+//
+// The result of a DescribeShardQueues execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeShardQueues_Result struct {
+	// Value returned by DescribeShardQueues after a successful execution.
+	Success              *shared.DescribeShardQueuesResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError           `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeShardQueues_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeShardQueues_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeShardQueues_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeShardQueuesResponse_Read(w wire.Value) (*shared.DescribeShardQueuesResponse, error) {
+	var v shared.DescribeShardQueuesResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeShardQueues_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeShardQueues_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeShardQueues_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeShardQueues_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeShardQueuesResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeShardQueues_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeShardQueues_Result
+// struct.
+func (v *AdminService_DescribeShardQueues_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeShardQueues_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeShardQueues_Result match the
+// provided AdminService_DescribeShardQueues_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeShardQueues_Result) Equals(rhs *AdminService_DescribeShardQueues_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeShardQueues_Result.
+func (v *AdminService_DescribeShardQueues_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeShardQueues_Result) GetSuccess() (o *shared.DescribeShardQueuesResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeShardQueues_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeShardQueues_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeShardQueues_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeShardQueues_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeShardQueues_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeShardQueues_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeShardQueues_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeShardQueues" for this struct.
+func (v *AdminService_DescribeShardQueues_Result) MethodName() string {
+	return "DescribeShardQueues"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeShardQueues_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeWorkflowExecution_Args represents the arguments for the AdminService.DescribeWorkflowExecution function.
+//
+// The arguments for DescribeWorkflowExecution are sent and received over the wire as this struct.
+type AdminService_DescribeWorkflowExecution_Args struct {
+	Request *DescribeWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeWorkflowExecution_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExecutionRequest, error) {
+	var v DescribeWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeWorkflowExecution_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeWorkflowExecution_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Args
+// struct.
+func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Args match the
+// provided AdminService_DescribeWorkflowExecution_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_DescribeWorkflowExecution_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeWorkflowExecution_Args.
+func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeWorkflowExecution" for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Args) MethodName() string {
+	return "DescribeWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DescribeWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeWorkflowExecution
+// function.
+var AdminService_DescribeWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of DescribeWorkflowExecution in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *DescribeWorkflowExecutionRequest,
+	) *AdminService_DescribeWorkflowExecution_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DescribeWorkflowExecution.
+	//
+	// An error can be thrown by DescribeWorkflowExecution only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DescribeWorkflowExecution
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeWorkflowExecution into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeWorkflowExecution
+	//
+	//   value, err := DescribeWorkflowExecution(args)
+	//   result, err := AdminService_DescribeWorkflowExecution_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeWorkflowExecution: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*DescribeWorkflowExecutionResponse, error) (*AdminService_DescribeWorkflowExecution_Result, error)
+
+	// UnwrapResponse takes the result struct for DescribeWorkflowExecution
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeWorkflowExecution threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeWorkflowExecution_Result) (*DescribeWorkflowExecutionResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeWorkflowExecution_Helper.Args = func(
+		request *DescribeWorkflowExecutionRequest,
+	) *AdminService_DescribeWorkflowExecution_Args {
+		return &AdminService_DescribeWorkflowExecution_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DescribeWorkflowExecution_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DescribeWorkflowExecution_Helper.WrapResponse = func(success *DescribeWorkflowExecutionResponse, err error) (*AdminService_DescribeWorkflowExecution_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeWorkflowExecution_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.BadRequestError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.EntityNotExistError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{EntityNotExistError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_DescribeWorkflowExecution_Result) (success *DescribeWorkflowExecutionResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_DescribeWorkflowExecution_Result represents the result of a AdminService.DescribeWorkflowExecution function call.
+//
+// The result of a DescribeWorkflowExecution execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeWorkflowExecution_Result struct {
+	// Value returned by DescribeWorkflowExecution after a successful execution.
+	Success              *DescribeWorkflowExecutionResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError            `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError       `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError       `json:"entityNotExistError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError          `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeWorkflowExecution_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeWorkflowExecutionResponse_Read(w wire.Value) (*DescribeWorkflowExecutionResponse, error) {
+	var v DescribeWorkflowExecutionResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeWorkflowExecution_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeWorkflowExecution_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeWorkflowExecutionResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Result
+// struct.
+func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Result match the
+// provided AdminService_DescribeWorkflowExecution_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService_DescribeWorkflowExecution_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeWorkflowExecution_Result.
+func (v *AdminService_DescribeWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetSuccess() (o *DescribeWorkflowExecutionResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeWorkflowExecution" for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Result) MethodName() string {
+	return "DescribeWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_GetWorkflowExecutionRawHistory_Args represents the arguments for the AdminService.GetWorkflowExecutionRawHistory function.
+//
+// The arguments for GetWorkflowExecutionRawHistory are sent and received over the wire as this struct.
+type AdminService_GetWorkflowExecutionRawHistory_Args struct {
+	GetRequest *GetWorkflowExecutionRawHistoryRequest `json:"getRequest,omitempty"`
+}
+
+// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.GetRequest != nil {
+		w, err = v.GetRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _GetWorkflowExecutionRawHistoryRequest_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryRequest, error) {
+	var v GetWorkflowExecutionRawHistoryRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_GetWorkflowExecutionRawHistory_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.GetRequest, err = _GetWorkflowExecutionRawHistoryRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Args
+// struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.GetRequest != nil {
+		fields[i] = fmt.Sprintf("GetRequest: %v", v.GetRequest)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Args match the
+// provided AdminService_GetWorkflowExecutionRawHistory_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.GetRequest == nil && rhs.GetRequest == nil) || (v.GetRequest != nil && rhs.GetRequest != nil && v.GetRequest.Equals(rhs.GetRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_GetWorkflowExecutionRawHistory_Args.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.GetRequest != nil {
+		err = multierr.Append(err, enc.AddObject("getRequest", v.GetRequest))
+	}
+	return err
+}
+
+// GetGetRequest returns the value of GetRequest if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) GetGetRequest() (o *GetWorkflowExecutionRawHistoryRequest) {
+	if v != nil && v.GetRequest != nil {
+		return v.GetRequest
+	}
+
+	return
+}
+
+// IsSetGetRequest returns true if GetRequest is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) IsSetGetRequest() bool {
+	return v != nil && v.GetRequest != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "GetWorkflowExecutionRawHistory" for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MethodName() string {
+	return "GetWorkflowExecutionRawHistory"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_GetWorkflowExecutionRawHistory_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetWorkflowExecutionRawHistory
+// function.
+var AdminService_GetWorkflowExecutionRawHistory_Helper = struct {
+	// Args accepts the parameters of GetWorkflowExecutionRawHistory in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		getRequest *GetWorkflowExecutionRawHistoryRequest,
+	) *AdminService_GetWorkflowExecutionRawHistory_Args
+
+	// IsException returns true if the given error can be thrown
+	// by GetWorkflowExecutionRawHistory.
+	//
+	// An error can be thrown by GetWorkflowExecutionRawHistory only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for GetWorkflowExecutionRawHistory
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// GetWorkflowExecutionRawHistory into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by GetWorkflowExecutionRawHistory
+	//
+	//   value, err := GetWorkflowExecutionRawHistory(args)
+	//   result, err := AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from GetWorkflowExecutionRawHistory: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*GetWorkflowExecutionRawHistoryResponse, error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error)
+
+	// UnwrapResponse takes the result struct for GetWorkflowExecutionRawHistory
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if GetWorkflowExecutionRawHistory threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetWorkflowExecutionRawHistory_Result) (*GetWorkflowExecutionRawHistoryResponse, error)
+}{}
+
+func init() {
+	AdminService_GetWorkflowExecutionRawHistory_Helper.Args = func(
+		getRequest *GetWorkflowExecutionRawHistoryRequest,
+	) *AdminService_GetWorkflowExecutionRawHistory_Args {
+		return &AdminService_GetWorkflowExecutionRawHistory_Args{
+			GetRequest: getRequest,
+		}
+	}
+
+	AdminService_GetWorkflowExecutionRawHistory_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse = func(success *GetWorkflowExecutionRawHistoryResponse, err error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error) {
+		if err == nil {
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.BadRequestError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.InternalServiceError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.EntityNotExistError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.ServiceBusyError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{ServiceBusyError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse = func(result *AdminService_GetWorkflowExecutionRawHistory_Result) (success *GetWorkflowExecutionRawHistoryResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_GetWorkflowExecutionRawHistory_Result represents the result of a AdminService.GetWorkflowExecutionRawHistory function call.
+//
+// The result of a GetWorkflowExecutionRawHistory execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_GetWorkflowExecutionRawHistory_Result struct {
+	// Value returned by GetWorkflowExecutionRawHistory after a successful execution.
+	Success              *GetWorkflowExecutionRawHistoryResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError                 `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError            `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError            `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError                `json:"serviceBusyError,omitempty"`
+}
+
+// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _GetWorkflowExecutionRawHistoryResponse_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryResponse, error) {
+	var v GetWorkflowExecutionRawHistoryResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_GetWorkflowExecutionRawHistory_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _GetWorkflowExecutionRawHistoryResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Result
+// struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Result match the
+// provided AdminService_GetWorkflowExecutionRawHistory_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_GetWorkflowExecutionRawHistory_Result.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetSuccess() (o *GetWorkflowExecutionRawHistoryResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "GetWorkflowExecutionRawHistory" for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MethodName() string {
+	return "GetWorkflowExecutionRawHistory"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_ListAuditRecords_Args represents the arguments for the AdminService.ListAuditRecords function.
+//
+// The arguments for ListAuditRecords are sent and received over the wire as this struct.
+type AdminService_ListAuditRecords_Args struct {
+	Request *ListAuditRecordsRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_ListAuditRecords_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_ListAuditRecords_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ListAuditRecordsRequest_Read(w wire.Value) (*ListAuditRecordsRequest, error) {
+	var v ListAuditRecordsRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ListAuditRecords_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ListAuditRecords_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_ListAuditRecords_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_ListAuditRecords_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _ListAuditRecordsRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ListAuditRecords_Args
+// struct.
+func (v *AdminService_ListAuditRecords_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ListAuditRecords_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ListAuditRecords_Args match the
+// provided AdminService_ListAuditRecords_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ListAuditRecords_Args) Equals(rhs *AdminService_ListAuditRecords_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_ListAuditRecords_Args.
+func (v *AdminService_ListAuditRecords_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListAuditRecords_Args) GetRequest() (o *ListAuditRecordsRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_ListAuditRecords_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "ListAuditRecords" for this struct.
+func (v *AdminService_ListAuditRecords_Args) MethodName() string {
+	return "ListAuditRecords"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_ListAuditRecords_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_ListAuditRecords_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ListAuditRecords
+// function.
+var AdminService_ListAuditRecords_Helper = struct {
+	// Args accepts the parameters of ListAuditRecords in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *ListAuditRecordsRequest,
+	) *AdminService_ListAuditRecords_Args
+
+	// IsException returns true if the given error can be thrown
+	// by ListAuditRecords.
+	//
+	// An error can be thrown by ListAuditRecords only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for ListAuditRecords
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// ListAuditRecords into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by ListAuditRecords
+	//
+	//   value, err := ListAuditRecords(args)
+	//   result, err := AdminService_ListAuditRecords_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from ListAuditRecords: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*ListAuditRecordsResponse, error) (*AdminService_ListAuditRecords_Result, error)
+
+	// UnwrapResponse takes the result struct for ListAuditRecords
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if ListAuditRecords threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_ListAuditRecords_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ListAuditRecords_Result) (*ListAuditRecordsResponse, error)
+}{}
+
+func init() {
+	AdminService_ListAuditRecords_Helper.Args = func(
+		request *ListAuditRecordsRequest,
+	) *AdminService_ListAuditRecords_Args {
+		return &AdminService_ListAuditRecords_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_ListAuditRecords_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_ListAuditRecords_Helper.WrapResponse = func(success *ListAuditRecordsResponse, err error) (*AdminService_ListAuditRecords_Result, error) {
+		if err == nil {
+			return &AdminService_ListAuditRecords_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListAuditRecords_Result.BadRequestError")
+			}
+			return &AdminService_ListAuditRecords_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListAuditRecords_Result.InternalServiceError")
+			}
+			return &AdminService_ListAuditRecords_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListAuditRecords_Result.AccessDeniedError")
+			}
+			return &AdminService_ListAuditRecords_Result{AccessDeniedError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListAuditRecords_Result.ServiceBusyError")
+			}
+			return &AdminService_ListAuditRecords_Result{ServiceBusyError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_ListAuditRecords_Helper.UnwrapResponse = func(result *AdminService_ListAuditRecords_Result) (success *ListAuditRecordsResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_ListAuditRecords_Result represents the result of a AdminService.ListAuditRecords function call.
+//
+// The result of a ListAuditRecords execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_ListAuditRecords_Result struct {
+	// Value returned by ListAuditRecords after a successful execution.
+	Success              *ListAuditRecordsResponse    `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+}
+
+// ToWire translates a AdminService_ListAuditRecords_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_ListAuditRecords_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_ListAuditRecords_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ListAuditRecordsResponse_Read(w wire.Value) (*ListAuditRecordsResponse, error) {
+	var v ListAuditRecordsResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ListAuditRecords_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ListAuditRecords_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_ListAuditRecords_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_ListAuditRecords_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _ListAuditRecordsResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_ListAuditRecords_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ListAuditRecords_Result
+// struct.
+func (v *AdminService_ListAuditRecords_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ListAuditRecords_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ListAuditRecords_Result match the
+// provided AdminService_ListAuditRecords_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ListAuditRecords_Result) Equals(rhs *AdminService_ListAuditRecords_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_ListAuditRecords_Result.
+func (v *AdminService_ListAuditRecords_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListAuditRecords_Result) GetSuccess() (o *ListAuditRecordsResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_ListAuditRecords_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListAuditRecords_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_ListAuditRecords_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListAuditRecords_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_ListAuditRecords_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListAuditRecords_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_ListAuditRecords_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListAuditRecords_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_ListAuditRecords_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "ListAuditRecords" for this struct.
+func (v *AdminService_ListAuditRecords_Result) MethodName() string {
+	return "ListAuditRecords"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_ListAuditRecords_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_ListDLQTasks_Args represents the arguments for the AdminService.ListDLQTasks function.
+//
+// The arguments for ListDLQTasks are sent and received over the wire as this struct.
+type AdminService_ListDLQTasks_Args struct {
+	Request *shared.ListDLQTasksRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_ListDLQTasks_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_ListDLQTasks_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ListDLQTasksRequest_Read(w wire.Value) (*shared.ListDLQTasksRequest, error) {
+	var v shared.ListDLQTasksRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ListDLQTasks_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ListDLQTasks_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_ListDLQTasks_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_ListDLQTasks_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _ListDLQTasksRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ListDLQTasks_Args
+// struct.
+func (v *AdminService_ListDLQTasks_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ListDLQTasks_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ListDLQTasks_Args match the
+// provided AdminService_ListDLQTasks_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ListDLQTasks_Args) Equals(rhs *AdminService_ListDLQTasks_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_ListDLQTasks_Args.
+func (v *AdminService_ListDLQTasks_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListDLQTasks_Args) GetRequest() (o *shared.ListDLQTasksRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_ListDLQTasks_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "ListDLQTasks" for this struct.
+func (v *AdminService_ListDLQTasks_Args) MethodName() string {
+	return "ListDLQTasks"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_ListDLQTasks_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_ListDLQTasks_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ListDLQTasks
+// function.
+var AdminService_ListDLQTasks_Helper = struct {
+	// Args accepts the parameters of ListDLQTasks in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.ListDLQTasksRequest,
+	) *AdminService_ListDLQTasks_Args
+
+	// IsException returns true if the given error can be thrown
+	// by ListDLQTasks.
+	//
+	// An error can be thrown by ListDLQTasks only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for ListDLQTasks
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// ListDLQTasks into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by ListDLQTasks
+	//
+	//   value, err := ListDLQTasks(args)
+	//   result, err := AdminService_ListDLQTasks_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from ListDLQTasks: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*shared.ListDLQTasksResponse, error) (*AdminService_ListDLQTasks_Result, error)
+
+	// UnwrapResponse takes the result struct for ListDLQTasks
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if ListDLQTasks threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_ListDLQTasks_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ListDLQTasks_Result) (*shared.ListDLQTasksResponse, error)
+}{}
+
+func init() {
+	AdminService_ListDLQTasks_Helper.Args = func(
+		request *shared.ListDLQTasksRequest,
+	) *AdminService_ListDLQTasks_Args {
+		return &AdminService_ListDLQTasks_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_ListDLQTasks_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_ListDLQTasks_Helper.WrapResponse = func(success *shared.ListDLQTasksResponse, err error) (*AdminService_ListDLQTasks_Result, error) {
+		if err == nil {
+			return &AdminService_ListDLQTasks_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListDLQTasks_Result.BadRequestError")
+			}
+			return &AdminService_ListDLQTasks_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListDLQTasks_Result.InternalServiceError")
+			}
+			return &AdminService_ListDLQTasks_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListDLQTasks_Result.AccessDeniedError")
+			}
+			return &AdminService_ListDLQTasks_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_ListDLQTasks_Helper.UnwrapResponse = func(result *AdminService_ListDLQTasks_Result) (success *shared.ListDLQTasksResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_ListDLQTasks_Result represents the result of a AdminService.ListDLQTasks function call.
+//
+// The result of a ListDLQTasks execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_ListDLQTasks_Result struct {
+	// Value returned by ListDLQTasks after a successful execution.
+	Success              *shared.ListDLQTasksResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_ListDLQTasks_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_ListDLQTasks_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_ListDLQTasks_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ListDLQTasksResponse_Read(w wire.Value) (*shared.ListDLQTasksResponse, error) {
+	var v shared.ListDLQTasksResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ListDLQTasks_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ListDLQTasks_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_ListDLQTasks_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_ListDLQTasks_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _ListDLQTasksResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_ListDLQTasks_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ListDLQTasks_Result
+// struct.
+func (v *AdminService_ListDLQTasks_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ListDLQTasks_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ListDLQTasks_Result match the
+// provided AdminService_ListDLQTasks_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ListDLQTasks_Result) Equals(rhs *AdminService_ListDLQTasks_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
-	if !((v.HistoryBatches == nil && rhs.HistoryBatches == nil) || (v.HistoryBatches != nil && rhs.HistoryBatches != nil && _List_DataBlob_Equals(v.HistoryBatches, rhs.HistoryBatches))) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
 
 	return true
 }
 
-type _List_DataBlob_Zapper []*shared.DataBlob
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_DataBlob_Zapper.
-func (l _List_DataBlob_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
-type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_ReplicationInfo_Zapper.
-func (m _Map_String_ReplicationInfo_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AddObject((string)(k), v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetWorkflowExecutionRawHistoryResponse.
-func (v *GetWorkflowExecutionRawHistoryResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ListDLQTasks_Result.
+func (v *AdminService_ListDLQTasks_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.NextPageToken != nil {
-		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
 	}
-	if v.HistoryBatches != nil {
-		err = multierr.Append(err, enc.AddArray("historyBatches", (_List_DataBlob_Zapper)(v.HistoryBatches)))
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.ReplicationInfo != nil {
-		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EventStoreVersion != nil {
-		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
 	return err
 }
 
-// GetNextPageToken returns the value of NextPageToken if it is set or its
+// GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
+func (v *AdminService_ListDLQTasks_Result) GetSuccess() (o *shared.ListDLQTasksResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
 	}
 
 	return
 }
 
-// IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *GetWorkflowExecutionRawHistoryResponse) IsSetNextPageToken() bool {
-	return v != nil && v.NextPageToken != nil
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_ListDLQTasks_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
 }
 
-// GetHistoryBatches returns the value of HistoryBatches if it is set or its
+// GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetHistoryBatches() (o []*shared.DataBlob) {
-	if v != nil && v.HistoryBatches != nil {
-		return v.HistoryBatches
+func (v *AdminService_ListDLQTasks_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
 	}
 
 	return
 }
 
-// IsSetHistoryBatches returns true if HistoryBatches is not nil.
-func (v *GetWorkflowExecutionRawHistoryResponse) IsSetHistoryBatches() bool {
-	return v != nil && v.HistoryBatches != nil
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_ListDLQTasks_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
 }
 
-// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
-	if v != nil && v.ReplicationInfo != nil {
-		return v.ReplicationInfo
+func (v *AdminService_ListDLQTasks_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
 	}
 
 	return
 }
 
-// IsSetReplicationInfo returns true if ReplicationInfo is not nil.
-func (v *GetWorkflowExecutionRawHistoryResponse) IsSetReplicationInfo() bool {
-	return v != nil && v.ReplicationInfo != nil
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_ListDLQTasks_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetEventStoreVersion() (o int32) {
-	if v != nil && v.EventStoreVersion != nil {
-		return *v.EventStoreVersion
+func (v *AdminService_ListDLQTasks_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
 	}
 
 	return
 }
 
-// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
-func (v *GetWorkflowExecutionRawHistoryResponse) IsSetEventStoreVersion() bool {
-	return v != nil && v.EventStoreVersion != nil
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_ListDLQTasks_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
 }
 
-// ThriftModule represents the IDL file used to generate this package.
-var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "admin",
-	Package:  "github.com/uber/cadence/.gen/go/admin",
-	FilePath: "admin.thrift",
-	SHA1:     "e0204db2c0f09897b1f0efaf590a7f5e1b961d1a",
-	Includes: []*thriftreflect.ThriftModule{
-		shared.ThriftModule,
-	},
-	Raw: rawIDL,
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "ListDLQTasks" for this struct.
+func (v *AdminService_ListDLQTasks_Result) MethodName() string {
+	return "ListDLQTasks"
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privillege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DescribeHistoryHost returns information about the internal states of a history host\n  **/\n  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  **/\n  GetWorkflowExecutionRawHistoryResponse GetWorkflowExecutionRawHistory(1: GetWorkflowExecutionRawHistoryRequest getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddSearchAttribute whitelist search attribute in request.\n  **/\n  void AddSearchAttribute(1: AddSearchAttributeRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}\n\nstruct GetWorkflowExecutionRawHistoryRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\") firstEventId\n  40: optional i64 (js.type = \"Long\") nextEventId\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct GetWorkflowExecutionRawHistoryResponse {\n  10: optional binary nextPageToken\n  20: optional list<shared.DataBlob> historyBatches\n  30: optional map<string, shared.ReplicationInfo> replicationInfo\n  40: optional i32 eventStoreVersion\n}\n\nstruct AddSearchAttributeRequest {\n  10: optional map<string, shared.IndexedValueType> searchAttribute\n}\n"
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_ListDLQTasks_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
 
-// AdminService_AddSearchAttribute_Args represents the arguments for the AdminService.AddSearchAttribute function.
+// AdminService_ListShardQueueTasks_Args represents the arguments for the AdminService.ListShardQueueTasks function.
 //
-// The arguments for AddSearchAttribute are sent and received over the wire as this struct.
-type AdminService_AddSearchAttribute_Args struct {
-	Request *AddSearchAttributeRequest `json:"request,omitempty"`
+// The arguments for ListShardQueueTasks are sent and received over the wire as this struct.
+type AdminService_ListShardQueueTasks_Args struct {
+	Request *shared.ListShardQueueTasksRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_AddSearchAttribute_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ListShardQueueTasks_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1588,7 +8108,7 @@ type AdminService_AddSearchAttribute_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_ListShardQueueTasks_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -1608,17 +8128,17 @@ func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest, error) {
-	var v AddSearchAttributeRequest
+func _ListShardQueueTasksRequest_Read(w wire.Value) (*shared.ListShardQueueTasksRequest, error) {
+	var v shared.ListShardQueueTasksRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_AddSearchAttribute_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_ListShardQueueTasks_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Args struct
+// An error is returned if we were unable to build a AdminService_ListShardQueueTasks_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1626,19 +8146,19 @@ func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest,
 //     return nil, err
 //   }
 //
-//   var v AdminService_AddSearchAttribute_Args
+//   var v AdminService_ListShardQueueTasks_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
+func (v *AdminService_ListShardQueueTasks_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _AddSearchAttributeRequest_Read(field.Value)
+				v.Request, err = _ListShardQueueTasksRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -1650,9 +8170,9 @@ func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_AddSearchAttribute_Args
+// String returns a readable string representation of a AdminService_ListShardQueueTasks_Args
 // struct.
-func (v *AdminService_AddSearchAttribute_Args) String() string {
+func (v *AdminService_ListShardQueueTasks_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -1664,14 +8184,14 @@ func (v *AdminService_AddSearchAttribute_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_AddSearchAttribute_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ListShardQueueTasks_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Args match the
-// provided AdminService_AddSearchAttribute_Args.
+// Equals returns true if all the fields of this AdminService_ListShardQueueTasks_Args match the
+// provided AdminService_ListShardQueueTasks_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearchAttribute_Args) bool {
+func (v *AdminService_ListShardQueueTasks_Args) Equals(rhs *AdminService_ListShardQueueTasks_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -1685,8 +8205,8 @@ func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_AddSearchAttribute_Args.
-func (v *AdminService_AddSearchAttribute_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ListShardQueueTasks_Args.
+func (v *AdminService_ListShardQueueTasks_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -1698,7 +8218,7 @@ func (v *AdminService_AddSearchAttribute_Args) MarshalLogObject(enc zapcore.Obje
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Args) GetRequest() (o *AddSearchAttributeRequest) {
+func (v *AdminService_ListShardQueueTasks_Args) GetRequest() (o *shared.ListShardQueueTasksRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -1707,119 +8227,118 @@ func (v *AdminService_AddSearchAttribute_Args) GetRequest() (o *AddSearchAttribu
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_AddSearchAttribute_Args) IsSetRequest() bool {
+func (v *AdminService_ListShardQueueTasks_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "AddSearchAttribute" for this struct.
-func (v *AdminService_AddSearchAttribute_Args) MethodName() string {
-	return "AddSearchAttribute"
+// This will always be "ListShardQueueTasks" for this struct.
+func (v *AdminService_ListShardQueueTasks_Args) MethodName() string {
+	return "ListShardQueueTasks"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_AddSearchAttribute_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ListShardQueueTasks_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_AddSearchAttribute_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.AddSearchAttribute
+// AdminService_ListShardQueueTasks_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ListShardQueueTasks
 // function.
-var AdminService_AddSearchAttribute_Helper = struct {
-	// Args accepts the parameters of AddSearchAttribute in-order and returns
+var AdminService_ListShardQueueTasks_Helper = struct {
+	// Args accepts the parameters of ListShardQueueTasks in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *AddSearchAttributeRequest,
-	) *AdminService_AddSearchAttribute_Args
+		request *shared.ListShardQueueTasksRequest,
+	) *AdminService_ListShardQueueTasks_Args
 
 	// IsException returns true if the given error can be thrown
-	// by AddSearchAttribute.
+	// by ListShardQueueTasks.
 	//
-	// An error can be thrown by AddSearchAttribute only if the
+	// An error can be thrown by ListShardQueueTasks only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for AddSearchAttribute
-	// given the error returned by it. The provided error may
-	// be nil if AddSearchAttribute did not fail.
+	// WrapResponse returns the result struct for ListShardQueueTasks
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by AddSearchAttribute into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// AddSearchAttribute
+	// This allows mapping values and errors returned by
+	// ListShardQueueTasks into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by ListShardQueueTasks
 	//
-	//   err := AddSearchAttribute(args)
-	//   result, err := AdminService_AddSearchAttribute_Helper.WrapResponse(err)
+	//   value, err := ListShardQueueTasks(args)
+	//   result, err := AdminService_ListShardQueueTasks_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from AddSearchAttribute: %v", err)
+	//     return fmt.Errorf("unexpected error from ListShardQueueTasks: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_AddSearchAttribute_Result, error)
+	WrapResponse func(*shared.ListShardQueueTasksResponse, error) (*AdminService_ListShardQueueTasks_Result, error)
 
-	// UnwrapResponse takes the result struct for AddSearchAttribute
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for ListShardQueueTasks
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if AddSearchAttribute threw an
+	// The error is non-nil only if ListShardQueueTasks threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_AddSearchAttribute_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_AddSearchAttribute_Result) error
+	//   value, err := AdminService_ListShardQueueTasks_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ListShardQueueTasks_Result) (*shared.ListShardQueueTasksResponse, error)
 }{}
 
 func init() {
-	AdminService_AddSearchAttribute_Helper.Args = func(
-		request *AddSearchAttributeRequest,
-	) *AdminService_AddSearchAttribute_Args {
-		return &AdminService_AddSearchAttribute_Args{
+	AdminService_ListShardQueueTasks_Helper.Args = func(
+		request *shared.ListShardQueueTasksRequest,
+	) *AdminService_ListShardQueueTasks_Args {
+		return &AdminService_ListShardQueueTasks_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_AddSearchAttribute_Helper.IsException = func(err error) bool {
+	AdminService_ListShardQueueTasks_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.ServiceBusyError:
+		case *shared.AccessDeniedError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_AddSearchAttribute_Helper.WrapResponse = func(err error) (*AdminService_AddSearchAttribute_Result, error) {
+	AdminService_ListShardQueueTasks_Helper.WrapResponse = func(success *shared.ListShardQueueTasksResponse, err error) (*AdminService_ListShardQueueTasks_Result, error) {
 		if err == nil {
-			return &AdminService_AddSearchAttribute_Result{}, nil
+			return &AdminService_ListShardQueueTasks_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListShardQueueTasks_Result.BadRequestError")
 			}
-			return &AdminService_AddSearchAttribute_Result{BadRequestError: e}, nil
+			return &AdminService_ListShardQueueTasks_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListShardQueueTasks_Result.InternalServiceError")
 			}
-			return &AdminService_AddSearchAttribute_Result{InternalServiceError: e}, nil
-		case *shared.ServiceBusyError:
+			return &AdminService_ListShardQueueTasks_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListShardQueueTasks_Result.AccessDeniedError")
 			}
-			return &AdminService_AddSearchAttribute_Result{ServiceBusyError: e}, nil
+			return &AdminService_ListShardQueueTasks_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_AddSearchAttribute_Helper.UnwrapResponse = func(result *AdminService_AddSearchAttribute_Result) (err error) {
+	AdminService_ListShardQueueTasks_Helper.UnwrapResponse = func(result *AdminService_ListShardQueueTasks_Result) (success *shared.ListShardQueueTasksResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -1828,25 +8347,36 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.ServiceBusyError != nil {
-			err = result.ServiceBusyError
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
 			return
 		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_AddSearchAttribute_Result represents the result of a AdminService.AddSearchAttribute function call.
+// AdminService_ListShardQueueTasks_Result represents the result of a AdminService.ListShardQueueTasks function call.
 //
-// The result of a AddSearchAttribute execution is sent and received over the wire as this struct.
-type AdminService_AddSearchAttribute_Result struct {
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+// The result of a ListShardQueueTasks execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_ListShardQueueTasks_Result struct {
+	// Value returned by ListShardQueueTasks after a successful execution.
+	Success              *shared.ListShardQueueTasksResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError           `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_AddSearchAttribute_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ListShardQueueTasks_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1861,14 +8391,22 @@ type AdminService_AddSearchAttribute_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_ListShardQueueTasks_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -1885,8 +8423,8 @@ func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -1894,36 +8432,24 @@ func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
 		i++
 	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_ListShardQueueTasks_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
-	var v shared.BadRequestError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
-	var v shared.InternalServiceError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
-	var v shared.ServiceBusyError
+func _ListShardQueueTasksResponse_Read(w wire.Value) (*shared.ListShardQueueTasksResponse, error) {
+	var v shared.ListShardQueueTasksResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_AddSearchAttribute_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_ListShardQueueTasks_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Result struct
+// An error is returned if we were unable to build a AdminService_ListShardQueueTasks_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1931,16 +8457,24 @@ func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_AddSearchAttribute_Result
+//   var v AdminService_ListShardQueueTasks_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
+func (v *AdminService_ListShardQueueTasks_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _ListShardQueueTasksResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -1959,7 +8493,7 @@ func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -1969,31 +8503,38 @@ func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.AccessDeniedError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("AdminService_ListShardQueueTasks_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_AddSearchAttribute_Result
+// String returns a readable string representation of a AdminService_ListShardQueueTasks_Result
 // struct.
-func (v *AdminService_AddSearchAttribute_Result) String() string {
+func (v *AdminService_ListShardQueueTasks_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -2002,31 +8543,34 @@ func (v *AdminService_AddSearchAttribute_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_AddSearchAttribute_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ListShardQueueTasks_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Result match the
-// provided AdminService_AddSearchAttribute_Result.
+// Equals returns true if all the fields of this AdminService_ListShardQueueTasks_Result match the
+// provided AdminService_ListShardQueueTasks_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSearchAttribute_Result) bool {
+func (v *AdminService_ListShardQueueTasks_Result) Equals(rhs *AdminService_ListShardQueueTasks_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
 
@@ -2034,26 +8578,44 @@ func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSea
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_AddSearchAttribute_Result.
-func (v *AdminService_AddSearchAttribute_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ListShardQueueTasks_Result.
+func (v *AdminService_ListShardQueueTasks_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.ServiceBusyError != nil {
-		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListShardQueueTasks_Result) GetSuccess() (o *shared.ListShardQueueTasksResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_ListShardQueueTasks_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_ListShardQueueTasks_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -2062,13 +8624,13 @@ func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetBadRequestError() bool {
+func (v *AdminService_ListShardQueueTasks_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_ListShardQueueTasks_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -2077,48 +8639,48 @@ func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *s
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_ListShardQueueTasks_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
-	if v != nil && v.ServiceBusyError != nil {
-		return v.ServiceBusyError
+func (v *AdminService_ListShardQueueTasks_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
 	}
 
 	return
 }
 
-// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetServiceBusyError() bool {
-	return v != nil && v.ServiceBusyError != nil
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_ListShardQueueTasks_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "AddSearchAttribute" for this struct.
-func (v *AdminService_AddSearchAttribute_Result) MethodName() string {
-	return "AddSearchAttribute"
+// This will always be "ListShardQueueTasks" for this struct.
+func (v *AdminService_ListShardQueueTasks_Result) MethodName() string {
+	return "ListShardQueueTasks"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_AddSearchAttribute_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ListShardQueueTasks_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_DescribeHistoryHost_Args represents the arguments for the AdminService.DescribeHistoryHost function.
+// AdminService_PurgeDLQTasks_Args represents the arguments for the AdminService.PurgeDLQTasks function.
 //
-// The arguments for DescribeHistoryHost are sent and received over the wire as this struct.
-type AdminService_DescribeHistoryHost_Args struct {
-	Request *shared.DescribeHistoryHostRequest `json:"request,omitempty"`
+// The arguments for PurgeDLQTasks are sent and received over the wire as this struct.
+type AdminService_PurgeDLQTasks_Args struct {
+	Request *shared.PurgeDLQTasksRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeHistoryHost_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_PurgeDLQTasks_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2133,7 +8695,7 @@ type AdminService_DescribeHistoryHost_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_PurgeDLQTasks_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -2153,17 +8715,17 @@ func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHostRequest, error) {
-	var v shared.DescribeHistoryHostRequest
+func _PurgeDLQTasksRequest_Read(w wire.Value) (*shared.PurgeDLQTasksRequest, error) {
+	var v shared.PurgeDLQTasksRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeHistoryHost_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_PurgeDLQTasks_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Args struct
+// An error is returned if we were unable to build a AdminService_PurgeDLQTasks_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2171,19 +8733,19 @@ func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHost
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeHistoryHost_Args
+//   var v AdminService_PurgeDLQTasks_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
+func (v *AdminService_PurgeDLQTasks_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeHistoryHostRequest_Read(field.Value)
+				v.Request, err = _PurgeDLQTasksRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2195,9 +8757,9 @@ func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeHistoryHost_Args
+// String returns a readable string representation of a AdminService_PurgeDLQTasks_Args
 // struct.
-func (v *AdminService_DescribeHistoryHost_Args) String() string {
+func (v *AdminService_PurgeDLQTasks_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -2209,14 +8771,14 @@ func (v *AdminService_DescribeHistoryHost_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeHistoryHost_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_PurgeDLQTasks_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Args match the
-// provided AdminService_DescribeHistoryHost_Args.
+// Equals returns true if all the fields of this AdminService_PurgeDLQTasks_Args match the
+// provided AdminService_PurgeDLQTasks_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_DescribeHistoryHost_Args) bool {
+func (v *AdminService_PurgeDLQTasks_Args) Equals(rhs *AdminService_PurgeDLQTasks_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2230,8 +8792,8 @@ func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_Describ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeHistoryHost_Args.
-func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_PurgeDLQTasks_Args.
+func (v *AdminService_PurgeDLQTasks_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -2243,7 +8805,7 @@ func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.Obj
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.DescribeHistoryHostRequest) {
+func (v *AdminService_PurgeDLQTasks_Args) GetRequest() (o *shared.PurgeDLQTasksRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -2252,80 +8814,81 @@ func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.Describe
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_DescribeHistoryHost_Args) IsSetRequest() bool {
+func (v *AdminService_PurgeDLQTasks_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeHistoryHost" for this struct.
-func (v *AdminService_DescribeHistoryHost_Args) MethodName() string {
-	return "DescribeHistoryHost"
+// This will always be "PurgeDLQTasks" for this struct.
+func (v *AdminService_PurgeDLQTasks_Args) MethodName() string {
+	return "PurgeDLQTasks"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_DescribeHistoryHost_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_PurgeDLQTasks_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_DescribeHistoryHost_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.DescribeHistoryHost
+// AdminService_PurgeDLQTasks_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.PurgeDLQTasks
 // function.
-var AdminService_DescribeHistoryHost_Helper = struct {
-	// Args accepts the parameters of DescribeHistoryHost in-order and returns
+var AdminService_PurgeDLQTasks_Helper = struct {
+	// Args accepts the parameters of PurgeDLQTasks in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.DescribeHistoryHostRequest,
-	) *AdminService_DescribeHistoryHost_Args
+		request *shared.PurgeDLQTasksRequest,
+	) *AdminService_PurgeDLQTasks_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeHistoryHost.
+	// by PurgeDLQTasks.
 	//
-	// An error can be thrown by DescribeHistoryHost only if the
+	// An error can be thrown by PurgeDLQTasks only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeHistoryHost
-	// given its return value and error.
+	// WrapResponse returns the result struct for PurgeDLQTasks
+	// given the error returned by it. The provided error may
+	// be nil if PurgeDLQTasks did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// DescribeHistoryHost into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeHistoryHost
+	// This allows mapping errors returned by PurgeDLQTasks into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// PurgeDLQTasks
 	//
-	//   value, err := DescribeHistoryHost(args)
-	//   result, err := AdminService_DescribeHistoryHost_Helper.WrapResponse(value, err)
+	//   err := PurgeDLQTasks(args)
+	//   result, err := AdminService_PurgeDLQTasks_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeHistoryHost: %v", err)
+	//     return fmt.Errorf("unexpected error from PurgeDLQTasks: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.DescribeHistoryHostResponse, error) (*AdminService_DescribeHistoryHost_Result, error)
+	WrapResponse func(error) (*AdminService_PurgeDLQTasks_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeHistoryHost
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for PurgeDLQTasks
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if DescribeHistoryHost threw an
+	// The error is non-nil only if PurgeDLQTasks threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_DescribeHistoryHost_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_DescribeHistoryHost_Result) (*shared.DescribeHistoryHostResponse, error)
+	//   err := AdminService_PurgeDLQTasks_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_PurgeDLQTasks_Result) error
 }{}
 
 func init() {
-	AdminService_DescribeHistoryHost_Helper.Args = func(
-		request *shared.DescribeHistoryHostRequest,
-	) *AdminService_DescribeHistoryHost_Args {
-		return &AdminService_DescribeHistoryHost_Args{
+	AdminService_PurgeDLQTasks_Helper.Args = func(
+		request *shared.PurgeDLQTasksRequest,
+	) *AdminService_PurgeDLQTasks_Args {
+		return &AdminService_PurgeDLQTasks_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_DescribeHistoryHost_Helper.IsException = func(err error) bool {
+	AdminService_PurgeDLQTasks_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -2338,32 +8901,32 @@ func init() {
 		}
 	}
 
-	AdminService_DescribeHistoryHost_Helper.WrapResponse = func(success *shared.DescribeHistoryHostResponse, err error) (*AdminService_DescribeHistoryHost_Result, error) {
+	AdminService_PurgeDLQTasks_Helper.WrapResponse = func(err error) (*AdminService_PurgeDLQTasks_Result, error) {
 		if err == nil {
-			return &AdminService_DescribeHistoryHost_Result{Success: success}, nil
+			return &AdminService_PurgeDLQTasks_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PurgeDLQTasks_Result.BadRequestError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{BadRequestError: e}, nil
+			return &AdminService_PurgeDLQTasks_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PurgeDLQTasks_Result.InternalServiceError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{InternalServiceError: e}, nil
+			return &AdminService_PurgeDLQTasks_Result{InternalServiceError: e}, nil
 		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PurgeDLQTasks_Result.AccessDeniedError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{AccessDeniedError: e}, nil
+			return &AdminService_PurgeDLQTasks_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_DescribeHistoryHost_Helper.UnwrapResponse = func(result *AdminService_DescribeHistoryHost_Result) (success *shared.DescribeHistoryHostResponse, err error) {
+	AdminService_PurgeDLQTasks_Helper.UnwrapResponse = func(result *AdminService_PurgeDLQTasks_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -2376,32 +8939,21 @@ func init() {
 			err = result.AccessDeniedError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
-			return
-		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_DescribeHistoryHost_Result represents the result of a AdminService.DescribeHistoryHost function call.
-//
-// The result of a DescribeHistoryHost execution is sent and received over the wire as this struct.
+// AdminService_PurgeDLQTasks_Result represents the result of a AdminService.PurgeDLQTasks function call.
 //
-// Success is set only if the function did not throw an exception.
-type AdminService_DescribeHistoryHost_Result struct {
-	// Value returned by DescribeHistoryHost after a successful execution.
-	Success              *shared.DescribeHistoryHostResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError           `json:"accessDeniedError,omitempty"`
+// The result of a PurgeDLQTasks execution is sent and received over the wire as this struct.
+type AdminService_PurgeDLQTasks_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeHistoryHost_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_PurgeDLQTasks_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2416,22 +8968,14 @@ type AdminService_DescribeHistoryHost_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_PurgeDLQTasks_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -2457,30 +9001,18 @@ func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_PurgeDLQTasks_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeHistoryHostResponse_Read(w wire.Value) (*shared.DescribeHistoryHostResponse, error) {
-	var v shared.DescribeHistoryHostResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
-	var v shared.AccessDeniedError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_DescribeHistoryHost_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_PurgeDLQTasks_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Result struct
+// An error is returned if we were unable to build a AdminService_PurgeDLQTasks_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2488,24 +9020,16 @@ func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeHistoryHost_Result
+//   var v AdminService_PurgeDLQTasks_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
+func (v *AdminService_PurgeDLQTasks_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeHistoryHostResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -2534,9 +9058,6 @@ func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
@@ -2546,26 +9067,22 @@ func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
 	if v.AccessDeniedError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_PurgeDLQTasks_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeHistoryHost_Result
+// String returns a readable string representation of a AdminService_PurgeDLQTasks_Result
 // struct.
-func (v *AdminService_DescribeHistoryHost_Result) String() string {
+func (v *AdminService_PurgeDLQTasks_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [3]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -2579,22 +9096,19 @@ func (v *AdminService_DescribeHistoryHost_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeHistoryHost_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_PurgeDLQTasks_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Result match the
-// provided AdminService_DescribeHistoryHost_Result.
+// Equals returns true if all the fields of this AdminService_PurgeDLQTasks_Result match the
+// provided AdminService_PurgeDLQTasks_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_DescribeHistoryHost_Result) bool {
+func (v *AdminService_PurgeDLQTasks_Result) Equals(rhs *AdminService_PurgeDLQTasks_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
@@ -2609,14 +9123,11 @@ func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_Descr
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeHistoryHost_Result.
-func (v *AdminService_DescribeHistoryHost_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_PurgeDLQTasks_Result.
+func (v *AdminService_PurgeDLQTasks_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
@@ -2629,24 +9140,9 @@ func (v *AdminService_DescribeHistoryHost_Result) MarshalLogObject(enc zapcore.O
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetSuccess() (o *shared.DescribeHistoryHostResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
-}
-
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_PurgeDLQTasks_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -2655,13 +9151,13 @@ func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *share
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetBadRequestError() bool {
+func (v *AdminService_PurgeDLQTasks_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_PurgeDLQTasks_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -2670,13 +9166,13 @@ func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_PurgeDLQTasks_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+func (v *AdminService_PurgeDLQTasks_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
 	if v != nil && v.AccessDeniedError != nil {
 		return v.AccessDeniedError
 	}
@@ -2685,33 +9181,33 @@ func (v *AdminService_DescribeHistoryHost_Result) GetAccessDeniedError() (o *sha
 }
 
 // IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetAccessDeniedError() bool {
+func (v *AdminService_PurgeDLQTasks_Result) IsSetAccessDeniedError() bool {
 	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeHistoryHost" for this struct.
-func (v *AdminService_DescribeHistoryHost_Result) MethodName() string {
-	return "DescribeHistoryHost"
+// This will always be "PurgeDLQTasks" for this struct.
+func (v *AdminService_PurgeDLQTasks_Result) MethodName() string {
+	return "PurgeDLQTasks"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_DescribeHistoryHost_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_PurgeDLQTasks_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_DescribeWorkflowExecution_Args represents the arguments for the AdminService.DescribeWorkflowExecution function.
+// AdminService_RefireShardQueueTask_Args represents the arguments for the AdminService.RefireShardQueueTask function.
 //
-// The arguments for DescribeWorkflowExecution are sent and received over the wire as this struct.
-type AdminService_DescribeWorkflowExecution_Args struct {
-	Request *DescribeWorkflowExecutionRequest `json:"request,omitempty"`
+// The arguments for RefireShardQueueTask are sent and received over the wire as this struct.
+type AdminService_RefireShardQueueTask_Args struct {
+	Request *shared.RefireShardQueueTaskRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeWorkflowExecution_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RefireShardQueueTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2726,7 +9222,7 @@ type AdminService_DescribeWorkflowExecution_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_RefireShardQueueTask_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -2746,17 +9242,17 @@ func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, erro
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExecutionRequest, error) {
-	var v DescribeWorkflowExecutionRequest
+func _RefireShardQueueTaskRequest_Read(w wire.Value) (*shared.RefireShardQueueTaskRequest, error) {
+	var v shared.RefireShardQueueTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeWorkflowExecution_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_RefireShardQueueTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Args struct
+// An error is returned if we were unable to build a AdminService_RefireShardQueueTask_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2764,19 +9260,19 @@ func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExec
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeWorkflowExecution_Args
+//   var v AdminService_RefireShardQueueTask_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) error {
+func (v *AdminService_RefireShardQueueTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeWorkflowExecutionRequest_Read(field.Value)
+				v.Request, err = _RefireShardQueueTaskRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2788,9 +9284,9 @@ func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) err
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Args
+// String returns a readable string representation of a AdminService_RefireShardQueueTask_Args
 // struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
+func (v *AdminService_RefireShardQueueTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -2802,14 +9298,14 @@ func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RefireShardQueueTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Args match the
-// provided AdminService_DescribeWorkflowExecution_Args.
+// Equals returns true if all the fields of this AdminService_RefireShardQueueTask_Args match the
+// provided AdminService_RefireShardQueueTask_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_DescribeWorkflowExecution_Args) bool {
+func (v *AdminService_RefireShardQueueTask_Args) Equals(rhs *AdminService_RefireShardQueueTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2823,8 +9319,8 @@ func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_D
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeWorkflowExecution_Args.
-func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RefireShardQueueTask_Args.
+func (v *AdminService_RefireShardQueueTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -2836,7 +9332,7 @@ func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapco
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeWorkflowExecutionRequest) {
+func (v *AdminService_RefireShardQueueTask_Args) GetRequest() (o *shared.RefireShardQueueTaskRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -2845,125 +9341,126 @@ func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeW
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Args) IsSetRequest() bool {
+func (v *AdminService_RefireShardQueueTask_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeWorkflowExecution" for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) MethodName() string {
-	return "DescribeWorkflowExecution"
+// This will always be "RefireShardQueueTask" for this struct.
+func (v *AdminService_RefireShardQueueTask_Args) MethodName() string {
+	return "RefireShardQueueTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RefireShardQueueTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_DescribeWorkflowExecution_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.DescribeWorkflowExecution
+// AdminService_RefireShardQueueTask_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.RefireShardQueueTask
 // function.
-var AdminService_DescribeWorkflowExecution_Helper = struct {
-	// Args accepts the parameters of DescribeWorkflowExecution in-order and returns
+var AdminService_RefireShardQueueTask_Helper = struct {
+	// Args accepts the parameters of RefireShardQueueTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *DescribeWorkflowExecutionRequest,
-	) *AdminService_DescribeWorkflowExecution_Args
+		request *shared.RefireShardQueueTaskRequest,
+	) *AdminService_RefireShardQueueTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeWorkflowExecution.
+	// by RefireShardQueueTask.
 	//
-	// An error can be thrown by DescribeWorkflowExecution only if the
+	// An error can be thrown by RefireShardQueueTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeWorkflowExecution
-	// given its return value and error.
+	// WrapResponse returns the result struct for RefireShardQueueTask
+	// given the error returned by it. The provided error may
+	// be nil if RefireShardQueueTask did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// DescribeWorkflowExecution into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeWorkflowExecution
+	// This allows mapping errors returned by RefireShardQueueTask into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// RefireShardQueueTask
 	//
-	//   value, err := DescribeWorkflowExecution(args)
-	//   result, err := AdminService_DescribeWorkflowExecution_Helper.WrapResponse(value, err)
+	//   err := RefireShardQueueTask(args)
+	//   result, err := AdminService_RefireShardQueueTask_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeWorkflowExecution: %v", err)
+	//     return fmt.Errorf("unexpected error from RefireShardQueueTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*DescribeWorkflowExecutionResponse, error) (*AdminService_DescribeWorkflowExecution_Result, error)
+	WrapResponse func(error) (*AdminService_RefireShardQueueTask_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeWorkflowExecution
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for RefireShardQueueTask
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if DescribeWorkflowExecution threw an
+	// The error is non-nil only if RefireShardQueueTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_DescribeWorkflowExecution_Result) (*DescribeWorkflowExecutionResponse, error)
+	//   err := AdminService_RefireShardQueueTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_RefireShardQueueTask_Result) error
 }{}
 
 func init() {
-	AdminService_DescribeWorkflowExecution_Helper.Args = func(
-		request *DescribeWorkflowExecutionRequest,
-	) *AdminService_DescribeWorkflowExecution_Args {
-		return &AdminService_DescribeWorkflowExecution_Args{
+	AdminService_RefireShardQueueTask_Helper.Args = func(
+		request *shared.RefireShardQueueTaskRequest,
+	) *AdminService_RefireShardQueueTask_Args {
+		return &AdminService_RefireShardQueueTask_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_DescribeWorkflowExecution_Helper.IsException = func(err error) bool {
+	AdminService_RefireShardQueueTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.EntityNotExistsError:
-			return true
 		case *shared.AccessDeniedError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_DescribeWorkflowExecution_Helper.WrapResponse = func(success *DescribeWorkflowExecutionResponse, err error) (*AdminService_DescribeWorkflowExecution_Result, error) {
+	AdminService_RefireShardQueueTask_Helper.WrapResponse = func(err error) (*AdminService_RefireShardQueueTask_Result, error) {
 		if err == nil {
-			return &AdminService_DescribeWorkflowExecution_Result{Success: success}, nil
+			return &AdminService_RefireShardQueueTask_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefireShardQueueTask_Result.BadRequestError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{BadRequestError: e}, nil
+			return &AdminService_RefireShardQueueTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefireShardQueueTask_Result.InternalServiceError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{InternalServiceError: e}, nil
-		case *shared.EntityNotExistsError:
+			return &AdminService_RefireShardQueueTask_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefireShardQueueTask_Result.AccessDeniedError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{EntityNotExistError: e}, nil
-		case *shared.AccessDeniedError:
+			return &AdminService_RefireShardQueueTask_Result{AccessDeniedError: e}, nil
+		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefireShardQueueTask_Result.EntityNotExistError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{AccessDeniedError: e}, nil
+			return &AdminService_RefireShardQueueTask_Result{EntityNotExistError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_DescribeWorkflowExecution_Result) (success *DescribeWorkflowExecutionResponse, err error) {
+	AdminService_RefireShardQueueTask_Helper.UnwrapResponse = func(result *AdminService_RefireShardQueueTask_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -2972,41 +9469,30 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
 		if result.AccessDeniedError != nil {
 			err = result.AccessDeniedError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
 			return
 		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_DescribeWorkflowExecution_Result represents the result of a AdminService.DescribeWorkflowExecution function call.
-//
-// The result of a DescribeWorkflowExecution execution is sent and received over the wire as this struct.
+// AdminService_RefireShardQueueTask_Result represents the result of a AdminService.RefireShardQueueTask function call.
 //
-// Success is set only if the function did not throw an exception.
-type AdminService_DescribeWorkflowExecution_Result struct {
-	// Value returned by DescribeWorkflowExecution after a successful execution.
-	Success              *DescribeWorkflowExecutionResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError            `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError       `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError       `json:"entityNotExistError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError          `json:"accessDeniedError,omitempty"`
+// The result of a RefireShardQueueTask execution is sent and received over the wire as this struct.
+type AdminService_RefireShardQueueTask_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeWorkflowExecution_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RefireShardQueueTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3021,22 +9507,14 @@ type AdminService_DescribeWorkflowExecution_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_RefireShardQueueTask_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -3053,16 +9531,16 @@ func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, er
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.AccessDeniedError != nil {
-		w, err = v.AccessDeniedError.ToWire()
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -3070,30 +9548,18 @@ func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, er
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_RefireShardQueueTask_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeWorkflowExecutionResponse_Read(w wire.Value) (*DescribeWorkflowExecutionResponse, error) {
-	var v DescribeWorkflowExecutionResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
-	var v shared.EntityNotExistsError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_DescribeWorkflowExecution_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_RefireShardQueueTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Result struct
+// An error is returned if we were unable to build a AdminService_RefireShardQueueTask_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3101,24 +9567,16 @@ func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, err
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeWorkflowExecution_Result
+//   var v AdminService_RefireShardQueueTask_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) error {
+func (v *AdminService_RefireShardQueueTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeWorkflowExecutionResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -3137,7 +9595,7 @@ func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) e
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3145,7 +9603,7 @@ func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) e
 			}
 		case 4:
 			if field.Value.Type() == wire.TStruct {
-				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3155,41 +9613,34 @@ func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) e
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.AccessDeniedError != nil {
 		count++
 	}
-	if v.AccessDeniedError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_RefireShardQueueTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Result
+// String returns a readable string representation of a AdminService_RefireShardQueueTask_Result
 // struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
+func (v *AdminService_RefireShardQueueTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [4]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -3198,41 +9649,38 @@ func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
-		i++
-	}
 	if v.AccessDeniedError != nil {
 		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RefireShardQueueTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Result match the
-// provided AdminService_DescribeWorkflowExecution_Result.
+// Equals returns true if all the fields of this AdminService_RefireShardQueueTask_Result match the
+// provided AdminService_RefireShardQueueTask_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService_DescribeWorkflowExecution_Result) bool {
+func (v *AdminService_RefireShardQueueTask_Result) Equals(rhs *AdminService_RefireShardQueueTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
-	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
 
@@ -3240,47 +9688,29 @@ func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeWorkflowExecution_Result.
-func (v *AdminService_DescribeWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RefireShardQueueTask_Result.
+func (v *AdminService_RefireShardQueueTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
 	if v.AccessDeniedError != nil {
 		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
-	return err
-}
-
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetSuccess() (o *DescribeWorkflowExecutionResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
 	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
+	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_RefireShardQueueTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -3289,13 +9719,13 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetBadRequestError() bool {
+func (v *AdminService_RefireShardQueueTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_RefireShardQueueTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -3304,63 +9734,63 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError(
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_RefireShardQueueTask_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
+func (v *AdminService_RefireShardQueueTask_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
 	}
 
 	return
 }
 
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_RefireShardQueueTask_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
 }
 
-// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
-	if v != nil && v.AccessDeniedError != nil {
-		return v.AccessDeniedError
+func (v *AdminService_RefireShardQueueTask_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetAccessDeniedError() bool {
-	return v != nil && v.AccessDeniedError != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_RefireShardQueueTask_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeWorkflowExecution" for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) MethodName() string {
-	return "DescribeWorkflowExecution"
+// This will always be "RefireShardQueueTask" for this struct.
+func (v *AdminService_RefireShardQueueTask_Result) MethodName() string {
+	return "RefireShardQueueTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RefireShardQueueTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_GetWorkflowExecutionRawHistory_Args represents the arguments for the AdminService.GetWorkflowExecutionRawHistory function.
+// AdminService_RequeueDLQTask_Args represents the arguments for the AdminService.RequeueDLQTask function.
 //
-// The arguments for GetWorkflowExecutionRawHistory are sent and received over the wire as this struct.
-type AdminService_GetWorkflowExecutionRawHistory_Args struct {
-	GetRequest *GetWorkflowExecutionRawHistoryRequest `json:"getRequest,omitempty"`
+// The arguments for RequeueDLQTask are sent and received over the wire as this struct.
+type AdminService_RequeueDLQTask_Args struct {
+	Request *shared.RequeueDLQTaskRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RequeueDLQTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3375,7 +9805,7 @@ type AdminService_GetWorkflowExecutionRawHistory_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_RequeueDLQTask_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -3383,8 +9813,8 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value,
 		err    error
 	)
 
-	if v.GetRequest != nil {
-		w, err = v.GetRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -3395,17 +9825,17 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value,
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetWorkflowExecutionRawHistoryRequest_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryRequest, error) {
-	var v GetWorkflowExecutionRawHistoryRequest
+func _RequeueDLQTaskRequest_Read(w wire.Value) (*shared.RequeueDLQTaskRequest, error) {
+	var v shared.RequeueDLQTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_RequeueDLQTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Args struct
+// An error is returned if we were unable to build a AdminService_RequeueDLQTask_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3413,19 +9843,19 @@ func _GetWorkflowExecutionRawHistoryRequest_Read(w wire.Value) (*GetWorkflowExec
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetWorkflowExecutionRawHistory_Args
+//   var v AdminService_RequeueDLQTask_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) FromWire(w wire.Value) error {
+func (v *AdminService_RequeueDLQTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.GetRequest, err = _GetWorkflowExecutionRawHistoryRequest_Read(field.Value)
+				v.Request, err = _RequeueDLQTaskRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3437,34 +9867,34 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) FromWire(w wire.Value
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Args
+// String returns a readable string representation of a AdminService_RequeueDLQTask_Args
 // struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) String() string {
+func (v *AdminService_RequeueDLQTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.GetRequest != nil {
-		fields[i] = fmt.Sprintf("GetRequest: %v", v.GetRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RequeueDLQTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Args match the
-// provided AdminService_GetWorkflowExecutionRawHistory_Args.
+// Equals returns true if all the fields of this AdminService_RequeueDLQTask_Args match the
+// provided AdminService_RequeueDLQTask_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Args) bool {
+func (v *AdminService_RequeueDLQTask_Args) Equals(rhs *AdminService_RequeueDLQTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.GetRequest == nil && rhs.GetRequest == nil) || (v.GetRequest != nil && rhs.GetRequest != nil && v.GetRequest.Equals(rhs.GetRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -3472,147 +9902,148 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) Equals(rhs *AdminServ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetWorkflowExecutionRawHistory_Args.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RequeueDLQTask_Args.
+func (v *AdminService_RequeueDLQTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.GetRequest != nil {
-		err = multierr.Append(err, enc.AddObject("getRequest", v.GetRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetGetRequest returns the value of GetRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) GetGetRequest() (o *GetWorkflowExecutionRawHistoryRequest) {
-	if v != nil && v.GetRequest != nil {
-		return v.GetRequest
+func (v *AdminService_RequeueDLQTask_Args) GetRequest() (o *shared.RequeueDLQTaskRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetGetRequest returns true if GetRequest is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) IsSetGetRequest() bool {
-	return v != nil && v.GetRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_RequeueDLQTask_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetWorkflowExecutionRawHistory" for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MethodName() string {
-	return "GetWorkflowExecutionRawHistory"
+// This will always be "RequeueDLQTask" for this struct.
+func (v *AdminService_RequeueDLQTask_Args) MethodName() string {
+	return "RequeueDLQTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RequeueDLQTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_GetWorkflowExecutionRawHistory_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.GetWorkflowExecutionRawHistory
+// AdminService_RequeueDLQTask_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.RequeueDLQTask
 // function.
-var AdminService_GetWorkflowExecutionRawHistory_Helper = struct {
-	// Args accepts the parameters of GetWorkflowExecutionRawHistory in-order and returns
+var AdminService_RequeueDLQTask_Helper = struct {
+	// Args accepts the parameters of RequeueDLQTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		getRequest *GetWorkflowExecutionRawHistoryRequest,
-	) *AdminService_GetWorkflowExecutionRawHistory_Args
+		request *shared.RequeueDLQTaskRequest,
+	) *AdminService_RequeueDLQTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetWorkflowExecutionRawHistory.
+	// by RequeueDLQTask.
 	//
-	// An error can be thrown by GetWorkflowExecutionRawHistory only if the
+	// An error can be thrown by RequeueDLQTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetWorkflowExecutionRawHistory
-	// given its return value and error.
+	// WrapResponse returns the result struct for RequeueDLQTask
+	// given the error returned by it. The provided error may
+	// be nil if RequeueDLQTask did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// GetWorkflowExecutionRawHistory into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetWorkflowExecutionRawHistory
+	// This allows mapping errors returned by RequeueDLQTask into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// RequeueDLQTask
 	//
-	//   value, err := GetWorkflowExecutionRawHistory(args)
-	//   result, err := AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse(value, err)
+	//   err := RequeueDLQTask(args)
+	//   result, err := AdminService_RequeueDLQTask_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetWorkflowExecutionRawHistory: %v", err)
+	//     return fmt.Errorf("unexpected error from RequeueDLQTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*GetWorkflowExecutionRawHistoryResponse, error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error)
+	WrapResponse func(error) (*AdminService_RequeueDLQTask_Result, error)
 
-	// UnwrapResponse takes the result struct for GetWorkflowExecutionRawHistory
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for RequeueDLQTask
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if GetWorkflowExecutionRawHistory threw an
+	// The error is non-nil only if RequeueDLQTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_GetWorkflowExecutionRawHistory_Result) (*GetWorkflowExecutionRawHistoryResponse, error)
+	//   err := AdminService_RequeueDLQTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_RequeueDLQTask_Result) error
 }{}
 
 func init() {
-	AdminService_GetWorkflowExecutionRawHistory_Helper.Args = func(
-		getRequest *GetWorkflowExecutionRawHistoryRequest,
-	) *AdminService_GetWorkflowExecutionRawHistory_Args {
-		return &AdminService_GetWorkflowExecutionRawHistory_Args{
-			GetRequest: getRequest,
+	AdminService_RequeueDLQTask_Helper.Args = func(
+		request *shared.RequeueDLQTaskRequest,
+	) *AdminService_RequeueDLQTask_Args {
+		return &AdminService_RequeueDLQTask_Args{
+			Request: request,
 		}
 	}
 
-	AdminService_GetWorkflowExecutionRawHistory_Helper.IsException = func(err error) bool {
+	AdminService_RequeueDLQTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.EntityNotExistsError:
+		case *shared.AccessDeniedError:
 			return true
-		case *shared.ServiceBusyError:
+		case *shared.EntityNotExistsError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse = func(success *GetWorkflowExecutionRawHistoryResponse, err error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error) {
+	AdminService_RequeueDLQTask_Helper.WrapResponse = func(err error) (*AdminService_RequeueDLQTask_Result, error) {
 		if err == nil {
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{Success: success}, nil
+			return &AdminService_RequeueDLQTask_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RequeueDLQTask_Result.BadRequestError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{BadRequestError: e}, nil
+			return &AdminService_RequeueDLQTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RequeueDLQTask_Result.InternalServiceError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{InternalServiceError: e}, nil
-		case *shared.EntityNotExistsError:
+			return &AdminService_RequeueDLQTask_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RequeueDLQTask_Result.AccessDeniedError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{EntityNotExistError: e}, nil
-		case *shared.ServiceBusyError:
+			return &AdminService_RequeueDLQTask_Result{AccessDeniedError: e}, nil
+		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RequeueDLQTask_Result.EntityNotExistError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{ServiceBusyError: e}, nil
+			return &AdminService_RequeueDLQTask_Result{EntityNotExistError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse = func(result *AdminService_GetWorkflowExecutionRawHistory_Result) (success *GetWorkflowExecutionRawHistoryResponse, err error) {
+	AdminService_RequeueDLQTask_Helper.UnwrapResponse = func(result *AdminService_RequeueDLQTask_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -3621,41 +10052,30 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
-		if result.ServiceBusyError != nil {
-			err = result.ServiceBusyError
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
 			return
 		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_GetWorkflowExecutionRawHistory_Result represents the result of a AdminService.GetWorkflowExecutionRawHistory function call.
+// AdminService_RequeueDLQTask_Result represents the result of a AdminService.RequeueDLQTask function call.
 //
-// The result of a GetWorkflowExecutionRawHistory execution is sent and received over the wire as this struct.
-//
-// Success is set only if the function did not throw an exception.
-type AdminService_GetWorkflowExecutionRawHistory_Result struct {
-	// Value returned by GetWorkflowExecutionRawHistory after a successful execution.
-	Success              *GetWorkflowExecutionRawHistoryResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError                 `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError            `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError            `json:"entityNotExistError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError                `json:"serviceBusyError,omitempty"`
+// The result of a RequeueDLQTask execution is sent and received over the wire as this struct.
+type AdminService_RequeueDLQTask_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
 }
 
-// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RequeueDLQTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3670,22 +10090,14 @@ type AdminService_GetWorkflowExecutionRawHistory_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_RequeueDLQTask_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -3702,16 +10114,16 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) ToWire() (wire.Valu
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -3719,24 +10131,18 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) ToWire() (wire.Valu
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_RequeueDLQTask_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetWorkflowExecutionRawHistoryResponse_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryResponse, error) {
-	var v GetWorkflowExecutionRawHistoryResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_RequeueDLQTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Result struct
+// An error is returned if we were unable to build a AdminService_RequeueDLQTask_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3744,24 +10150,16 @@ func _GetWorkflowExecutionRawHistoryResponse_Read(w wire.Value) (*GetWorkflowExe
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetWorkflowExecutionRawHistory_Result
+//   var v AdminService_RequeueDLQTask_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Value) error {
+func (v *AdminService_RequeueDLQTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetWorkflowExecutionRawHistoryResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -3780,7 +10178,7 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Val
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3788,7 +10186,7 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Val
 			}
 		case 4:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3798,41 +10196,34 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Val
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.AccessDeniedError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_RequeueDLQTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Result
+// String returns a readable string representation of a AdminService_RequeueDLQTask_Result
 // struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) String() string {
+func (v *AdminService_RequeueDLQTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [4]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -3841,41 +10232,38 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RequeueDLQTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Result match the
-// provided AdminService_GetWorkflowExecutionRawHistory_Result.
+// Equals returns true if all the fields of this AdminService_RequeueDLQTask_Result match the
+// provided AdminService_RequeueDLQTask_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Result) bool {
+func (v *AdminService_RequeueDLQTask_Result) Equals(rhs *AdminService_RequeueDLQTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
-	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
 
@@ -3883,47 +10271,29 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) Equals(rhs *AdminSe
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetWorkflowExecutionRawHistory_Result.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RequeueDLQTask_Result.
+func (v *AdminService_RequeueDLQTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
 	if v.EntityNotExistError != nil {
 		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
 	}
-	if v.ServiceBusyError != nil {
-		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
-	}
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetSuccess() (o *GetWorkflowExecutionRawHistoryResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
-}
-
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_RequeueDLQTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -3932,13 +10302,13 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetBadRequestError(
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetBadRequestError() bool {
+func (v *AdminService_RequeueDLQTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_RequeueDLQTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -3947,51 +10317,51 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetInternalServiceE
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_RequeueDLQTask_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
+func (v *AdminService_RequeueDLQTask_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
 	}
 
 	return
 }
 
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_RequeueDLQTask_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
 }
 
-// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
-	if v != nil && v.ServiceBusyError != nil {
-		return v.ServiceBusyError
+func (v *AdminService_RequeueDLQTask_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetServiceBusyError() bool {
-	return v != nil && v.ServiceBusyError != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_RequeueDLQTask_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetWorkflowExecutionRawHistory" for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MethodName() string {
-	return "GetWorkflowExecutionRawHistory"
+// This will always be "RequeueDLQTask" for this struct.
+func (v *AdminService_RequeueDLQTask_Result) MethodName() string {
+	return "RequeueDLQTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RequeueDLQTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
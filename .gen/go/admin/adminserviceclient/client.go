@@ -42,12 +42,30 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	DeleteShardQueueTask(
+		ctx context.Context,
+		Request *shared.DeleteShardQueueTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	DescribeDLQTask(
+		ctx context.Context,
+		Request *shared.DescribeDLQTaskRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeDLQTaskResponse, error)
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeHistoryHostResponse, error)
 
+	DescribeShardQueues(
+		ctx context.Context,
+		Request *shared.DescribeShardQueuesRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeShardQueuesResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
@@ -59,6 +77,42 @@ type Interface interface {
 		GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
+
+	ListAuditRecords(
+		ctx context.Context,
+		Request *admin.ListAuditRecordsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListAuditRecordsResponse, error)
+
+	ListDLQTasks(
+		ctx context.Context,
+		Request *shared.ListDLQTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListDLQTasksResponse, error)
+
+	ListShardQueueTasks(
+		ctx context.Context,
+		Request *shared.ListShardQueueTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListShardQueueTasksResponse, error)
+
+	PurgeDLQTasks(
+		ctx context.Context,
+		Request *shared.PurgeDLQTasksRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RefireShardQueueTask(
+		ctx context.Context,
+		Request *shared.RefireShardQueueTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RequeueDLQTask(
+		ctx context.Context,
+		Request *shared.RequeueDLQTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the AdminService service.
@@ -108,6 +162,52 @@ func (c client) AddSearchAttribute(
 	return
 }
 
+func (c client) DeleteShardQueueTask(
+	ctx context.Context,
+	_Request *shared.DeleteShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_DeleteShardQueueTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DeleteShardQueueTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_DeleteShardQueueTask_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DescribeDLQTask(
+	ctx context.Context,
+	_Request *shared.DescribeDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeDLQTaskResponse, err error) {
+
+	args := admin.AdminService_DescribeDLQTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeDLQTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeDLQTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -131,6 +231,29 @@ func (c client) DescribeHistoryHost(
 	return
 }
 
+func (c client) DescribeShardQueues(
+	ctx context.Context,
+	_Request *shared.DescribeShardQueuesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeShardQueuesResponse, err error) {
+
+	args := admin.AdminService_DescribeShardQueues_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeShardQueues_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeShardQueues_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_Request *admin.DescribeWorkflowExecutionRequest,
@@ -176,3 +299,141 @@ func (c client) GetWorkflowExecutionRawHistory(
 	success, err = admin.AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) ListAuditRecords(
+	ctx context.Context,
+	_Request *admin.ListAuditRecordsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListAuditRecordsResponse, err error) {
+
+	args := admin.AdminService_ListAuditRecords_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListAuditRecords_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListAuditRecords_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListDLQTasks(
+	ctx context.Context,
+	_Request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListDLQTasksResponse, err error) {
+
+	args := admin.AdminService_ListDLQTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListDLQTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListDLQTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListShardQueueTasks(
+	ctx context.Context,
+	_Request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListShardQueueTasksResponse, err error) {
+
+	args := admin.AdminService_ListShardQueueTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListShardQueueTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListShardQueueTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PurgeDLQTasks(
+	ctx context.Context,
+	_Request *shared.PurgeDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_PurgeDLQTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_PurgeDLQTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_PurgeDLQTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RefireShardQueueTask(
+	ctx context.Context,
+	_Request *shared.RefireShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RefireShardQueueTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RefireShardQueueTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RefireShardQueueTask_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RequeueDLQTask(
+	ctx context.Context,
+	_Request *shared.RequeueDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RequeueDLQTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RequeueDLQTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RequeueDLQTask_Helper.UnwrapResponse(&result)
+	return
+}
@@ -39,11 +39,26 @@ type Interface interface {
 		Request *admin.AddSearchAttributeRequest,
 	) error
 
+	DeleteShardQueueTask(
+		ctx context.Context,
+		Request *shared.DeleteShardQueueTaskRequest,
+	) error
+
+	DescribeDLQTask(
+		ctx context.Context,
+		Request *shared.DescribeDLQTaskRequest,
+	) (*shared.DescribeDLQTaskResponse, error)
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
 	) (*shared.DescribeHistoryHostResponse, error)
 
+	DescribeShardQueues(
+		ctx context.Context,
+		Request *shared.DescribeShardQueuesRequest,
+	) (*shared.DescribeShardQueuesResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
@@ -53,6 +68,36 @@ type Interface interface {
 		ctx context.Context,
 		GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
+
+	ListAuditRecords(
+		ctx context.Context,
+		Request *admin.ListAuditRecordsRequest,
+	) (*admin.ListAuditRecordsResponse, error)
+
+	ListDLQTasks(
+		ctx context.Context,
+		Request *shared.ListDLQTasksRequest,
+	) (*shared.ListDLQTasksResponse, error)
+
+	ListShardQueueTasks(
+		ctx context.Context,
+		Request *shared.ListShardQueueTasksRequest,
+	) (*shared.ListShardQueueTasksResponse, error)
+
+	PurgeDLQTasks(
+		ctx context.Context,
+		Request *shared.PurgeDLQTasksRequest,
+	) error
+
+	RefireShardQueueTask(
+		ctx context.Context,
+		Request *shared.RefireShardQueueTaskRequest,
+	) error
+
+	RequeueDLQTask(
+		ctx context.Context,
+		Request *shared.RequeueDLQTaskRequest,
+	) error
 }
 
 // New prepares an implementation of the AdminService service for
@@ -77,6 +122,28 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DeleteShardQueueTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DeleteShardQueueTask),
+				},
+				Signature:    "DeleteShardQueueTask(Request *shared.DeleteShardQueueTaskRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "DescribeDLQTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeDLQTask),
+				},
+				Signature:    "DescribeDLQTask(Request *shared.DescribeDLQTaskRequest) (*shared.DescribeDLQTaskResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeHistoryHost",
 				HandlerSpec: thrift.HandlerSpec{
@@ -88,6 +155,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeShardQueues",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeShardQueues),
+				},
+				Signature:    "DescribeShardQueues(Request *shared.DescribeShardQueuesRequest) (*shared.DescribeShardQueuesResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -109,10 +187,76 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "GetWorkflowExecutionRawHistory(GetRequest *admin.GetWorkflowExecutionRawHistoryRequest) (*admin.GetWorkflowExecutionRawHistoryResponse)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "ListAuditRecords",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListAuditRecords),
+				},
+				Signature:    "ListAuditRecords(Request *admin.ListAuditRecordsRequest) (*admin.ListAuditRecordsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListDLQTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListDLQTasks),
+				},
+				Signature:    "ListDLQTasks(Request *shared.ListDLQTasksRequest) (*shared.ListDLQTasksResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListShardQueueTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListShardQueueTasks),
+				},
+				Signature:    "ListShardQueueTasks(Request *shared.ListShardQueueTasksRequest) (*shared.ListShardQueueTasksResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PurgeDLQTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PurgeDLQTasks),
+				},
+				Signature:    "PurgeDLQTasks(Request *shared.PurgeDLQTasksRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RefireShardQueueTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RefireShardQueueTask),
+				},
+				Signature:    "RefireShardQueueTask(Request *shared.RefireShardQueueTaskRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RequeueDLQTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RequeueDLQTask),
+				},
+				Signature:    "RequeueDLQTask(Request *shared.RequeueDLQTaskRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 4)
+	procedures := make([]transport.Procedure, 0, 13)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -138,6 +282,44 @@ func (h handler) AddSearchAttribute(ctx context.Context, body wire.Value) (thrif
 	return response, err
 }
 
+func (h handler) DeleteShardQueueTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DeleteShardQueueTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.DeleteShardQueueTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DeleteShardQueueTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) DescribeDLQTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeDLQTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeDLQTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DescribeDLQTask_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeHistoryHost_Args
 	if err := args.FromWire(body); err != nil {
@@ -157,6 +339,25 @@ func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
+func (h handler) DescribeShardQueues(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeShardQueues_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeShardQueues(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DescribeShardQueues_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
@@ -194,3 +395,117 @@ func (h handler) GetWorkflowExecutionRawHistory(ctx context.Context, body wire.V
 	}
 	return response, err
 }
+
+func (h handler) ListAuditRecords(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListAuditRecords_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListAuditRecords(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ListAuditRecords_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ListDLQTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListDLQTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListDLQTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ListDLQTasks_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ListShardQueueTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListShardQueueTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListShardQueueTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ListShardQueueTasks_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PurgeDLQTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PurgeDLQTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PurgeDLQTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_PurgeDLQTasks_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RefireShardQueueTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RefireShardQueueTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RefireShardQueueTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RefireShardQueueTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RequeueDLQTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RequeueDLQTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RequeueDLQTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RequeueDLQTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
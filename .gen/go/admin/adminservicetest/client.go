@@ -94,6 +94,70 @@ func (mr *_MockClientRecorder) AddSearchAttribute(
 	return mr.mock.ctrl.RecordCall(mr.mock, "AddSearchAttribute", args...)
 }
 
+// DeleteShardQueueTask responds to a DeleteShardQueueTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DeleteShardQueueTask(gomock.Any(), ...).Return(...)
+// 	... := client.DeleteShardQueueTask(...)
+func (m *MockClient) DeleteShardQueueTask(
+	ctx context.Context,
+	_Request *shared.DeleteShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DeleteShardQueueTask", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DeleteShardQueueTask(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteShardQueueTask", args...)
+}
+
+// DescribeDLQTask responds to a DescribeDLQTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DescribeDLQTask(gomock.Any(), ...).Return(...)
+// 	... := client.DescribeDLQTask(...)
+func (m *MockClient) DescribeDLQTask(
+	ctx context.Context,
+	_Request *shared.DescribeDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeDLQTaskResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeDLQTask", args...)
+	success, _ = ret[i].(*shared.DescribeDLQTaskResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeDLQTask(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeDLQTask", args...)
+}
+
 // DescribeHistoryHost responds to a DescribeHistoryHost call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -127,6 +191,39 @@ func (mr *_MockClientRecorder) DescribeHistoryHost(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeHistoryHost", args...)
 }
 
+// DescribeShardQueues responds to a DescribeShardQueues call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DescribeShardQueues(gomock.Any(), ...).Return(...)
+// 	... := client.DescribeShardQueues(...)
+func (m *MockClient) DescribeShardQueues(
+	ctx context.Context,
+	_Request *shared.DescribeShardQueuesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeShardQueuesResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeShardQueues", args...)
+	success, _ = ret[i].(*shared.DescribeShardQueuesResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeShardQueues(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeShardQueues", args...)
+}
+
 // DescribeWorkflowExecution responds to a DescribeWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -192,3 +289,195 @@ func (mr *_MockClientRecorder) GetWorkflowExecutionRawHistory(
 	args := append([]interface{}{ctx, _GetRequest}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkflowExecutionRawHistory", args...)
 }
+
+// ListAuditRecords responds to a ListAuditRecords call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ListAuditRecords(gomock.Any(), ...).Return(...)
+// 	... := client.ListAuditRecords(...)
+func (m *MockClient) ListAuditRecords(
+	ctx context.Context,
+	_Request *admin.ListAuditRecordsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListAuditRecordsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListAuditRecords", args...)
+	success, _ = ret[i].(*admin.ListAuditRecordsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListAuditRecords(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListAuditRecords", args...)
+}
+
+// ListDLQTasks responds to a ListDLQTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ListDLQTasks(gomock.Any(), ...).Return(...)
+// 	... := client.ListDLQTasks(...)
+func (m *MockClient) ListDLQTasks(
+	ctx context.Context,
+	_Request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListDLQTasksResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListDLQTasks", args...)
+	success, _ = ret[i].(*shared.ListDLQTasksResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListDLQTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListDLQTasks", args...)
+}
+
+// ListShardQueueTasks responds to a ListShardQueueTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ListShardQueueTasks(gomock.Any(), ...).Return(...)
+// 	... := client.ListShardQueueTasks(...)
+func (m *MockClient) ListShardQueueTasks(
+	ctx context.Context,
+	_Request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListShardQueueTasksResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListShardQueueTasks", args...)
+	success, _ = ret[i].(*shared.ListShardQueueTasksResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListShardQueueTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListShardQueueTasks", args...)
+}
+
+// PurgeDLQTasks responds to a PurgeDLQTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().PurgeDLQTasks(gomock.Any(), ...).Return(...)
+// 	... := client.PurgeDLQTasks(...)
+func (m *MockClient) PurgeDLQTasks(
+	ctx context.Context,
+	_Request *shared.PurgeDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PurgeDLQTasks", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PurgeDLQTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PurgeDLQTasks", args...)
+}
+
+// RefireShardQueueTask responds to a RefireShardQueueTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().RefireShardQueueTask(gomock.Any(), ...).Return(...)
+// 	... := client.RefireShardQueueTask(...)
+func (m *MockClient) RefireShardQueueTask(
+	ctx context.Context,
+	_Request *shared.RefireShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RefireShardQueueTask", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RefireShardQueueTask(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RefireShardQueueTask", args...)
+}
+
+// RequeueDLQTask responds to a RequeueDLQTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().RequeueDLQTask(gomock.Any(), ...).Return(...)
+// 	... := client.RequeueDLQTask(...)
+func (m *MockClient) RequeueDLQTask(
+	ctx context.Context,
+	_Request *shared.RequeueDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RequeueDLQTask", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RequeueDLQTask(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RequeueDLQTask", args...)
+}
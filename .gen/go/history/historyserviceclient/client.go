@@ -36,6 +36,18 @@ import (
 
 // Interface is a client for the HistoryService service.
 type Interface interface {
+	DeleteShardQueueTask(
+		ctx context.Context,
+		Request *shared.DeleteShardQueueTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	DescribeDLQTask(
+		ctx context.Context,
+		Request *shared.DescribeDLQTaskRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeDLQTaskResponse, error)
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -48,6 +60,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.DescribeMutableStateResponse, error)
 
+	DescribeShardQueues(
+		ctx context.Context,
+		Request *shared.DescribeShardQueuesRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeShardQueuesResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		DescribeRequest *history.DescribeWorkflowExecutionRequest,
@@ -60,6 +78,42 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.GetMutableStateResponse, error)
 
+	HandoffShard(
+		ctx context.Context,
+		Request *history.HandoffShardRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ListDLQTasks(
+		ctx context.Context,
+		Request *shared.ListDLQTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListDLQTasksResponse, error)
+
+	ListShardQueueTasks(
+		ctx context.Context,
+		Request *shared.ListShardQueueTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListShardQueueTasksResponse, error)
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *history.PauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	PurgeDLQTasks(
+		ctx context.Context,
+		Request *shared.PurgeDLQTasksRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	QueryWorkflow(
+		ctx context.Context,
+		QueryRequest *history.QueryWorkflowRequest,
+		opts ...yarpc.CallOption,
+	) (*history.QueryWorkflowResponse, error)
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -84,6 +138,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.RecordDecisionTaskStartedResponse, error)
 
+	RefireShardQueueTask(
+		ctx context.Context,
+		Request *shared.RefireShardQueueTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RemoveSignalMutableState(
 		ctx context.Context,
 		RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -108,6 +168,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RequeueDLQTask(
+		ctx context.Context,
+		Request *shared.RequeueDLQTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *history.ResetStickyTaskListRequest,
@@ -150,6 +216,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResumeWorkflowExecution(
+		ctx context.Context,
+		ResumeRequest *history.ResumeWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -191,6 +263,12 @@ type Interface interface {
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*history.UpdateWorkflowExecutionResponse, error)
 }
 
 // New builds a new client for the HistoryService service.
@@ -217,6 +295,52 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) DeleteShardQueueTask(
+	ctx context.Context,
+	_Request *shared.DeleteShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_DeleteShardQueueTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DeleteShardQueueTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_DeleteShardQueueTask_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DescribeDLQTask(
+	ctx context.Context,
+	_Request *shared.DescribeDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeDLQTaskResponse, err error) {
+
+	args := history.HistoryService_DescribeDLQTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DescribeDLQTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_DescribeDLQTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -263,6 +387,29 @@ func (c client) DescribeMutableState(
 	return
 }
 
+func (c client) DescribeShardQueues(
+	ctx context.Context,
+	_Request *shared.DescribeShardQueuesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeShardQueuesResponse, err error) {
+
+	args := history.HistoryService_DescribeShardQueues_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DescribeShardQueues_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_DescribeShardQueues_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_DescribeRequest *history.DescribeWorkflowExecutionRequest,
@@ -309,6 +456,144 @@ func (c client) GetMutableState(
 	return
 }
 
+func (c client) HandoffShard(
+	ctx context.Context,
+	_Request *history.HandoffShardRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_HandoffShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_HandoffShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_HandoffShard_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListDLQTasks(
+	ctx context.Context,
+	_Request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListDLQTasksResponse, err error) {
+
+	args := history.HistoryService_ListDLQTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ListDLQTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_ListDLQTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListShardQueueTasks(
+	ctx context.Context,
+	_Request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListShardQueueTasksResponse, err error) {
+
+	args := history.HistoryService_ListShardQueueTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ListShardQueueTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_ListShardQueueTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *history.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_PauseWorkflowExecution_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_PauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_PauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PurgeDLQTasks(
+	ctx context.Context,
+	_Request *shared.PurgeDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_PurgeDLQTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_PurgeDLQTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_PurgeDLQTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) QueryWorkflow(
+	ctx context.Context,
+	_QueryRequest *history.QueryWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (success *history.QueryWorkflowResponse, err error) {
+
+	args := history.HistoryService_QueryWorkflow_Helper.Args(_QueryRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_QueryWorkflow_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_QueryWorkflow_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	_HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -401,6 +686,29 @@ func (c client) RecordDecisionTaskStarted(
 	return
 }
 
+func (c client) RefireShardQueueTask(
+	ctx context.Context,
+	_Request *shared.RefireShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_RefireShardQueueTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RefireShardQueueTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_RefireShardQueueTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RemoveSignalMutableState(
 	ctx context.Context,
 	_RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -493,6 +801,29 @@ func (c client) RequestCancelWorkflowExecution(
 	return
 }
 
+func (c client) RequeueDLQTask(
+	ctx context.Context,
+	_Request *shared.RequeueDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_RequeueDLQTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RequeueDLQTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_RequeueDLQTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResetStickyTaskList(
 	ctx context.Context,
 	_ResetRequest *history.ResetStickyTaskListRequest,
@@ -654,6 +985,29 @@ func (c client) RespondDecisionTaskFailed(
 	return
 }
 
+func (c client) ResumeWorkflowExecution(
+	ctx context.Context,
+	_ResumeRequest *history.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_ResumeWorkflowExecution_Helper.Args(_ResumeRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ResumeWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_ResumeWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ScheduleDecisionTask(
 	ctx context.Context,
 	_ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -814,3 +1168,26 @@ func (c client) TerminateWorkflowExecution(
 	err = history.HistoryService_TerminateWorkflowExecution_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *history.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *history.UpdateWorkflowExecutionResponse, err error) {
+
+	args := history.HistoryService_UpdateWorkflowExecution_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_UpdateWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_UpdateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
@@ -34,6 +34,16 @@ import (
 
 // Interface is the server-side interface for the HistoryService service.
 type Interface interface {
+	DeleteShardQueueTask(
+		ctx context.Context,
+		Request *shared.DeleteShardQueueTaskRequest,
+	) error
+
+	DescribeDLQTask(
+		ctx context.Context,
+		Request *shared.DescribeDLQTaskRequest,
+	) (*shared.DescribeDLQTaskResponse, error)
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -44,6 +54,11 @@ type Interface interface {
 		Request *history.DescribeMutableStateRequest,
 	) (*history.DescribeMutableStateResponse, error)
 
+	DescribeShardQueues(
+		ctx context.Context,
+		Request *shared.DescribeShardQueuesRequest,
+	) (*shared.DescribeShardQueuesResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		DescribeRequest *history.DescribeWorkflowExecutionRequest,
@@ -54,6 +69,36 @@ type Interface interface {
 		GetRequest *history.GetMutableStateRequest,
 	) (*history.GetMutableStateResponse, error)
 
+	HandoffShard(
+		ctx context.Context,
+		Request *history.HandoffShardRequest,
+	) error
+
+	ListDLQTasks(
+		ctx context.Context,
+		Request *shared.ListDLQTasksRequest,
+	) (*shared.ListDLQTasksResponse, error)
+
+	ListShardQueueTasks(
+		ctx context.Context,
+		Request *shared.ListShardQueueTasksRequest,
+	) (*shared.ListShardQueueTasksResponse, error)
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *history.PauseWorkflowExecutionRequest,
+	) error
+
+	PurgeDLQTasks(
+		ctx context.Context,
+		Request *shared.PurgeDLQTasksRequest,
+	) error
+
+	QueryWorkflow(
+		ctx context.Context,
+		QueryRequest *history.QueryWorkflowRequest,
+	) (*history.QueryWorkflowResponse, error)
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -74,6 +119,11 @@ type Interface interface {
 		AddRequest *history.RecordDecisionTaskStartedRequest,
 	) (*history.RecordDecisionTaskStartedResponse, error)
 
+	RefireShardQueueTask(
+		ctx context.Context,
+		Request *shared.RefireShardQueueTaskRequest,
+	) error
+
 	RemoveSignalMutableState(
 		ctx context.Context,
 		RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -94,6 +144,11 @@ type Interface interface {
 		CancelRequest *history.RequestCancelWorkflowExecutionRequest,
 	) error
 
+	RequeueDLQTask(
+		ctx context.Context,
+		Request *shared.RequeueDLQTaskRequest,
+	) error
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *history.ResetStickyTaskListRequest,
@@ -129,6 +184,11 @@ type Interface interface {
 		FailedRequest *history.RespondDecisionTaskFailedRequest,
 	) error
 
+	ResumeWorkflowExecution(
+		ctx context.Context,
+		ResumeRequest *history.ResumeWorkflowExecutionRequest,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -163,6 +223,11 @@ type Interface interface {
 		ctx context.Context,
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowExecutionRequest,
+	) (*history.UpdateWorkflowExecutionResponse, error)
 }
 
 // New prepares an implementation of the HistoryService service for
@@ -176,6 +241,28 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "HistoryService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "DeleteShardQueueTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DeleteShardQueueTask),
+				},
+				Signature:    "DeleteShardQueueTask(Request *shared.DeleteShardQueueTaskRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "DescribeDLQTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeDLQTask),
+				},
+				Signature:    "DescribeDLQTask(Request *shared.DescribeDLQTaskRequest) (*shared.DescribeDLQTaskResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeHistoryHost",
 				HandlerSpec: thrift.HandlerSpec{
@@ -198,6 +285,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeShardQueues",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeShardQueues),
+				},
+				Signature:    "DescribeShardQueues(Request *shared.DescribeShardQueuesRequest) (*shared.DescribeShardQueuesResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -220,6 +318,72 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "HandoffShard",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.HandoffShard),
+				},
+				Signature:    "HandoffShard(Request *history.HandoffShardRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListDLQTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListDLQTasks),
+				},
+				Signature:    "ListDLQTasks(Request *shared.ListDLQTasksRequest) (*shared.ListDLQTasksResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListShardQueueTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListShardQueueTasks),
+				},
+				Signature:    "ListShardQueueTasks(Request *shared.ListShardQueueTasksRequest) (*shared.ListShardQueueTasksResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseWorkflowExecution),
+				},
+				Signature:    "PauseWorkflowExecution(PauseRequest *history.PauseWorkflowExecutionRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PurgeDLQTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PurgeDLQTasks),
+				},
+				Signature:    "PurgeDLQTasks(Request *shared.PurgeDLQTasksRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "QueryWorkflow",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.QueryWorkflow),
+				},
+				Signature:    "QueryWorkflow(QueryRequest *history.QueryWorkflowRequest) (*history.QueryWorkflowResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RecordActivityTaskHeartbeat",
 				HandlerSpec: thrift.HandlerSpec{
@@ -264,6 +428,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RefireShardQueueTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RefireShardQueueTask),
+				},
+				Signature:    "RefireShardQueueTask(Request *shared.RefireShardQueueTaskRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RemoveSignalMutableState",
 				HandlerSpec: thrift.HandlerSpec{
@@ -308,6 +483,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RequeueDLQTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RequeueDLQTask),
+				},
+				Signature:    "RequeueDLQTask(Request *shared.RequeueDLQTaskRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResetStickyTaskList",
 				HandlerSpec: thrift.HandlerSpec{
@@ -385,6 +571,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ResumeWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResumeWorkflowExecution),
+				},
+				Signature:    "ResumeWorkflowExecution(ResumeRequest *history.ResumeWorkflowExecutionRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ScheduleDecisionTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -461,16 +658,65 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "TerminateWorkflowExecution(TerminateRequest *history.TerminateWorkflowExecutionRequest)",
 				ThriftModule: history.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateWorkflowExecution),
+				},
+				Signature:    "UpdateWorkflowExecution(UpdateRequest *history.UpdateWorkflowExecutionRequest) (*history.UpdateWorkflowExecutionResponse)",
+				ThriftModule: history.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 26)
+	procedures := make([]transport.Procedure, 0, 39)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
 
 type handler struct{ impl Interface }
 
+func (h handler) DeleteShardQueueTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_DeleteShardQueueTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.DeleteShardQueueTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_DeleteShardQueueTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) DescribeDLQTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_DescribeDLQTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeDLQTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_DescribeDLQTask_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_DescribeHistoryHost_Args
 	if err := args.FromWire(body); err != nil {
@@ -509,6 +755,25 @@ func (h handler) DescribeMutableState(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
+func (h handler) DescribeShardQueues(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_DescribeShardQueues_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeShardQueues(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_DescribeShardQueues_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
@@ -547,6 +812,120 @@ func (h handler) GetMutableState(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
+func (h handler) HandoffShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_HandoffShard_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.HandoffShard(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_HandoffShard_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ListDLQTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ListDLQTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListDLQTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_ListDLQTasks_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ListShardQueueTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ListShardQueueTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListShardQueueTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_ListShardQueueTasks_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PauseWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_PauseWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PauseWorkflowExecution(ctx, args.PauseRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_PauseWorkflowExecution_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PurgeDLQTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_PurgeDLQTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PurgeDLQTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_PurgeDLQTasks_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_QueryWorkflow_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.QueryWorkflow(ctx, args.QueryRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_QueryWorkflow_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) RecordActivityTaskHeartbeat(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_RecordActivityTaskHeartbeat_Args
 	if err := args.FromWire(body); err != nil {
@@ -623,6 +1002,25 @@ func (h handler) RecordDecisionTaskStarted(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) RefireShardQueueTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RefireShardQueueTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RefireShardQueueTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_RefireShardQueueTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) RemoveSignalMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_RemoveSignalMutableState_Args
 	if err := args.FromWire(body); err != nil {
@@ -699,6 +1097,25 @@ func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.V
 	return response, err
 }
 
+func (h handler) RequeueDLQTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RequeueDLQTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RequeueDLQTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_RequeueDLQTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_ResetStickyTaskList_Args
 	if err := args.FromWire(body); err != nil {
@@ -832,6 +1249,25 @@ func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) ResumeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ResumeWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ResumeWorkflowExecution(ctx, args.ResumeRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_ResumeWorkflowExecution_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) ScheduleDecisionTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_ScheduleDecisionTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -964,3 +1400,22 @@ func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value
 	}
 	return response, err
 }
+
+func (h handler) UpdateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_UpdateWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.UpdateWorkflowExecution(ctx, args.UpdateRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_UpdateWorkflowExecution_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
@@ -71,6 +71,118 @@ func (c *clientImpl) AddSearchAttribute(
 	return client.AddSearchAttribute(ctx, request, opts...)
 }
 
+func (c *clientImpl) RequeueDLQTask(
+	ctx context.Context,
+	request *shared.RequeueDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RequeueDLQTask(ctx, request, opts...)
+}
+
+func (c *clientImpl) PurgeDLQTasks(
+	ctx context.Context,
+	request *shared.PurgeDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PurgeDLQTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListShardQueueTasks(
+	ctx context.Context,
+	request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListShardQueueTasksResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListShardQueueTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeShardQueues(
+	ctx context.Context,
+	request *shared.DescribeShardQueuesRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeShardQueuesResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeShardQueues(ctx, request, opts...)
+}
+
+func (c *clientImpl) RefireShardQueueTask(
+	ctx context.Context,
+	request *shared.RefireShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RefireShardQueueTask(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListAuditRecords(
+	ctx context.Context,
+	request *admin.ListAuditRecordsRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ListAuditRecordsResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListAuditRecords(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteShardQueueTask(
+	ctx context.Context,
+	request *shared.DeleteShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteShardQueueTask(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeHistoryHost(
 	ctx context.Context,
 	request *shared.DescribeHistoryHostRequest,
@@ -87,6 +199,38 @@ func (c *clientImpl) DescribeHistoryHost(
 	return client.DescribeHistoryHost(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListDLQTasks(
+	ctx context.Context,
+	request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListDLQTasksResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListDLQTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeDLQTask(
+	ctx context.Context,
+	request *shared.DescribeDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeDLQTaskResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeDLQTask(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
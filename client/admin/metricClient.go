@@ -61,6 +61,132 @@ func (c *metricClient) AddSearchAttribute(
 	return err
 }
 
+func (c *metricClient) RequeueDLQTask(
+	ctx context.Context,
+	request *shared.RequeueDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRequeueDLQTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRequeueDLQTaskScope, metrics.CadenceClientLatency)
+	err := c.client.RequeueDLQTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRequeueDLQTaskScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) PurgeDLQTasks(
+	ctx context.Context,
+	request *shared.PurgeDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPurgeDLQTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPurgeDLQTasksScope, metrics.CadenceClientLatency)
+	err := c.client.PurgeDLQTasks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPurgeDLQTasksScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ListShardQueueTasks(
+	ctx context.Context,
+	request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListShardQueueTasksResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListShardQueueTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListShardQueueTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListShardQueueTasks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListShardQueueTasksScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeShardQueues(
+	ctx context.Context,
+	request *shared.DescribeShardQueuesRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeShardQueuesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeShardQueuesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeShardQueuesScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeShardQueues(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeShardQueuesScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RefireShardQueueTask(
+	ctx context.Context,
+	request *shared.RefireShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRefireShardQueueTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRefireShardQueueTaskScope, metrics.CadenceClientLatency)
+	err := c.client.RefireShardQueueTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRefireShardQueueTaskScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ListAuditRecords(
+	ctx context.Context,
+	request *admin.ListAuditRecordsRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ListAuditRecordsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListAuditRecordsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListAuditRecordsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListAuditRecords(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListAuditRecordsScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteShardQueueTask(
+	ctx context.Context,
+	request *shared.DeleteShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteShardQueueTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteShardQueueTaskScope, metrics.CadenceClientLatency)
+	err := c.client.DeleteShardQueueTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteShardQueueTaskScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *shared.DescribeHistoryHostRequest,
@@ -79,6 +205,42 @@ func (c *metricClient) DescribeHistoryHost(
 	return resp, err
 }
 
+func (c *metricClient) ListDLQTasks(
+	ctx context.Context,
+	request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListDLQTasksResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListDLQTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListDLQTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListDLQTasks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListDLQTasksScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeDLQTask(
+	ctx context.Context,
+	request *shared.DescribeDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeDLQTaskResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeDLQTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeDLQTaskScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeDLQTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeDLQTaskScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
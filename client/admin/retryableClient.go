@@ -58,6 +58,102 @@ func (c *retryableClient) AddSearchAttribute(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) RequeueDLQTask(
+	ctx context.Context,
+	request *shared.RequeueDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RequeueDLQTask(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) PurgeDLQTasks(
+	ctx context.Context,
+	request *shared.PurgeDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PurgeDLQTasks(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ListShardQueueTasks(
+	ctx context.Context,
+	request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListShardQueueTasksResponse, error) {
+
+	var resp *shared.ListShardQueueTasksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListShardQueueTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeShardQueues(
+	ctx context.Context,
+	request *shared.DescribeShardQueuesRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeShardQueuesResponse, error) {
+
+	var resp *shared.DescribeShardQueuesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeShardQueues(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RefireShardQueueTask(
+	ctx context.Context,
+	request *shared.RefireShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RefireShardQueueTask(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ListAuditRecords(
+	ctx context.Context,
+	request *admin.ListAuditRecordsRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ListAuditRecordsResponse, error) {
+
+	var resp *admin.ListAuditRecordsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListAuditRecords(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteShardQueueTask(
+	ctx context.Context,
+	request *shared.DeleteShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.DeleteShardQueueTask(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *shared.DescribeHistoryHostRequest,
@@ -74,6 +170,38 @@ func (c *retryableClient) DescribeHistoryHost(
 	return resp, err
 }
 
+func (c *retryableClient) ListDLQTasks(
+	ctx context.Context,
+	request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListDLQTasksResponse, error) {
+
+	var resp *shared.ListDLQTasksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListDLQTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeDLQTask(
+	ctx context.Context,
+	request *shared.DescribeDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeDLQTaskResponse, error) {
+
+	var resp *shared.DescribeDLQTaskResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeDLQTask(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
@@ -22,6 +22,7 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"strings"
@@ -38,7 +39,9 @@ import (
 	"go.uber.org/yarpc/api/peer"
 	"go.uber.org/yarpc/api/transport"
 	"go.uber.org/yarpc/peer/roundrobin"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
+	"google.golang.org/grpc/credentials"
 )
 
 const (
@@ -69,8 +72,9 @@ type (
 	}
 
 	dnsDispatcherProvider struct {
-		interval time.Duration
-		logger   log.Logger
+		interval  time.Duration
+		tlsConfig *tls.Config
+		logger    log.Logger
 	}
 	dnsUpdater struct {
 		interval     time.Duration
@@ -184,34 +188,44 @@ func (h *clientBeanImpl) GetRemoteFrontendClient(cluster string) frontend.Client
 	return client
 }
 
-// NewDNSYarpcDispatcherProvider create a dispatcher provider which handles with IP address
-func NewDNSYarpcDispatcherProvider(logger log.Logger, interval time.Duration) DispatcherProvider {
+// NewDNSYarpcDispatcherProvider create a dispatcher provider which handles with IP address,
+// the dispatchers call over gRPC with tlsConfig if it is not nil, and over tchannel otherwise
+func NewDNSYarpcDispatcherProvider(logger log.Logger, interval time.Duration, tlsConfig *tls.Config) DispatcherProvider {
 	if interval <= 0 {
 		interval = defaultRefreshInterval
 	}
 	return &dnsDispatcherProvider{
-		interval: interval,
-		logger:   logger,
+		interval:  interval,
+		tlsConfig: tlsConfig,
+		logger:    logger,
 	}
 }
 
 func (p *dnsDispatcherProvider) Get(serviceName string, address string) (*yarpc.Dispatcher, error) {
-	tchanTransport, err := tchannel.NewTransport(
-		tchannel.ServiceName(serviceName),
-		// this aim to get rid of the annoying popup about accepting incoming network connections
-		tchannel.ListenAddr("127.0.0.1:0"),
-	)
-	if err != nil {
-		return nil, err
+	var peerList *roundrobin.List
+	var outbound transport.UnaryOutbound
+	if p.tlsConfig != nil {
+		grpcTransport := grpc.NewTransport()
+		peerList = roundrobin.New(grpcTransport.NewDialer(grpc.DialerCredentials(credentials.NewTLS(p.tlsConfig))))
+		outbound = grpcTransport.NewOutbound(peerList)
+	} else {
+		tchanTransport, err := tchannel.NewTransport(
+			tchannel.ServiceName(serviceName),
+			// this aim to get rid of the annoying popup about accepting incoming network connections
+			tchannel.ListenAddr("127.0.0.1:0"),
+		)
+		if err != nil {
+			return nil, err
+		}
+		peerList = roundrobin.New(tchanTransport)
+		outbound = tchanTransport.NewOutbound(peerList)
 	}
 
-	peerList := roundrobin.New(tchanTransport)
 	peerListUpdater, err := newDNSUpdater(peerList, address, p.interval, p.logger)
 	if err != nil {
 		return nil, err
 	}
 	peerListUpdater.Start()
-	outbound := tchanTransport.NewOutbound(peerList)
 
 	p.logger.Info("Creating RPC dispatcher outbound", tag.Service(serviceName), tag.Address(address))
 
@@ -107,6 +107,54 @@ func (c *clientImpl) GetMutableState(
 	return response, nil
 }
 
+func (c *clientImpl) QueryWorkflow(
+	ctx context.Context,
+	request *h.QueryWorkflowRequest,
+	opts ...yarpc.CallOption) (*h.QueryWorkflowResponse, error) {
+	client, err := c.getClientForWorkflowID(request.GetRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.QueryWorkflowResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.QueryWorkflow(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *h.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*h.UpdateWorkflowExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(request.GetUpdateRequest().GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.UpdateWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) DescribeHistoryHost(
 	ctx context.Context,
 	request *workflow.DescribeHistoryHostRequest,
@@ -146,6 +194,210 @@ func (c *clientImpl) DescribeHistoryHost(
 	return response, nil
 }
 
+func (c *clientImpl) ListDLQTasks(
+	ctx context.Context,
+	request *workflow.ListDLQTasksRequest,
+	opts ...yarpc.CallOption) (*workflow.ListDLQTasksResponse, error) {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return nil, err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *workflow.ListDLQTasksResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ListDLQTasks(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DescribeDLQTask(
+	ctx context.Context,
+	request *workflow.DescribeDLQTaskRequest,
+	opts ...yarpc.CallOption) (*workflow.DescribeDLQTaskResponse, error) {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return nil, err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *workflow.DescribeDLQTaskResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeDLQTask(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) RequeueDLQTask(
+	ctx context.Context,
+	request *workflow.RequeueDLQTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.RequeueDLQTask(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) PurgeDLQTasks(
+	ctx context.Context,
+	request *workflow.PurgeDLQTasksRequest,
+	opts ...yarpc.CallOption) error {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.PurgeDLQTasks(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) ListShardQueueTasks(
+	ctx context.Context,
+	request *workflow.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption) (*workflow.ListShardQueueTasksResponse, error) {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return nil, err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *workflow.ListShardQueueTasksResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ListShardQueueTasks(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DescribeShardQueues(
+	ctx context.Context,
+	request *workflow.DescribeShardQueuesRequest,
+	opts ...yarpc.CallOption) (*workflow.DescribeShardQueuesResponse, error) {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return nil, err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *workflow.DescribeShardQueuesResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeShardQueues(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) RefireShardQueueTask(
+	ctx context.Context,
+	request *workflow.RefireShardQueueTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.RefireShardQueueTask(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) DeleteShardQueueTask(
+	ctx context.Context,
+	request *workflow.DeleteShardQueueTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.DeleteShardQueueTask(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) HandoffShard(
+	ctx context.Context,
+	request *h.HandoffShardRequest,
+	opts ...yarpc.CallOption) error {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.HandoffShard(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
 func (c *clientImpl) DescribeMutableState(
 	ctx context.Context,
 	request *h.DescribeMutableStateRequest,
@@ -503,6 +755,42 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(*request.PauseRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *h.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(*request.ResumeRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.ResumeWorkflowExecution(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
 func (c *clientImpl) ResetWorkflowExecution(
 	ctx context.Context,
 	request *h.ResetWorkflowExecutionRequest,
@@ -70,6 +70,159 @@ func (c *metricClient) DescribeHistoryHost(
 	return resp, err
 }
 
+func (c *metricClient) ListDLQTasks(
+	context context.Context,
+	request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption) (*shared.ListDLQTasksResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientListDLQTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientListDLQTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListDLQTasks(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientListDLQTasksScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DescribeDLQTask(
+	context context.Context,
+	request *shared.DescribeDLQTaskRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeDLQTaskResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeDLQTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeDLQTaskScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeDLQTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeDLQTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) RequeueDLQTask(
+	context context.Context,
+	request *shared.RequeueDLQTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientRequeueDLQTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRequeueDLQTaskScope, metrics.CadenceClientLatency)
+	err := c.client.RequeueDLQTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRequeueDLQTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) PurgeDLQTasks(
+	context context.Context,
+	request *shared.PurgeDLQTasksRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientPurgeDLQTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPurgeDLQTasksScope, metrics.CadenceClientLatency)
+	err := c.client.PurgeDLQTasks(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPurgeDLQTasksScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ListShardQueueTasks(
+	context context.Context,
+	request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption) (*shared.ListShardQueueTasksResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientListShardQueueTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientListShardQueueTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListShardQueueTasks(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientListShardQueueTasksScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DescribeShardQueues(
+	context context.Context,
+	request *shared.DescribeShardQueuesRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeShardQueuesResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeShardQueuesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeShardQueuesScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeShardQueues(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeShardQueuesScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) RefireShardQueueTask(
+	context context.Context,
+	request *shared.RefireShardQueueTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientRefireShardQueueTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRefireShardQueueTaskScope, metrics.CadenceClientLatency)
+	err := c.client.RefireShardQueueTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRefireShardQueueTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) DeleteShardQueueTask(
+	context context.Context,
+	request *shared.DeleteShardQueueTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientDeleteShardQueueTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDeleteShardQueueTaskScope, metrics.CadenceClientLatency)
+	err := c.client.DeleteShardQueueTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDeleteShardQueueTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) HandoffShard(
+	context context.Context,
+	request *h.HandoffShardRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientHandoffShardScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientHandoffShardScope, metrics.CadenceClientLatency)
+	err := c.client.HandoffShard(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientHandoffShardScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) DescribeMutableState(
 	context context.Context,
 	request *h.DescribeMutableStateRequest,
@@ -96,6 +249,40 @@ func (c *metricClient) GetMutableState(
 	return resp, err
 }
 
+func (c *metricClient) QueryWorkflow(
+	context context.Context,
+	request *h.QueryWorkflowRequest,
+	opts ...yarpc.CallOption) (*h.QueryWorkflowResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientQueryWorkflowScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientQueryWorkflowScope, metrics.CadenceClientLatency)
+	resp, err := c.client.QueryWorkflow(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientQueryWorkflowScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) UpdateWorkflowExecution(
+	context context.Context,
+	request *h.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*h.UpdateWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) ResetStickyTaskList(
 	context context.Context,
 	request *h.ResetStickyTaskListRequest,
@@ -351,6 +538,40 @@ func (c *metricClient) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) PauseWorkflowExecution(
+	context context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.PauseWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ResumeWorkflowExecution(
+	context context.Context,
+	request *h.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.ResumeWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) ResetWorkflowExecution(
 	context context.Context,
 	request *h.ResetWorkflowExecutionRequest,
@@ -78,6 +78,130 @@ func (c *retryableClient) DescribeHistoryHost(
 	return resp, err
 }
 
+func (c *retryableClient) ListDLQTasks(
+	ctx context.Context,
+	request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption) (*shared.ListDLQTasksResponse, error) {
+
+	var resp *shared.ListDLQTasksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListDLQTasks(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeDLQTask(
+	ctx context.Context,
+	request *shared.DescribeDLQTaskRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeDLQTaskResponse, error) {
+
+	var resp *shared.DescribeDLQTaskResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeDLQTask(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RequeueDLQTask(
+	ctx context.Context,
+	request *shared.RequeueDLQTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.RequeueDLQTask(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) PurgeDLQTasks(
+	ctx context.Context,
+	request *shared.PurgeDLQTasksRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.PurgeDLQTasks(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ListShardQueueTasks(
+	ctx context.Context,
+	request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption) (*shared.ListShardQueueTasksResponse, error) {
+
+	var resp *shared.ListShardQueueTasksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListShardQueueTasks(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeShardQueues(
+	ctx context.Context,
+	request *shared.DescribeShardQueuesRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeShardQueuesResponse, error) {
+
+	var resp *shared.DescribeShardQueuesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeShardQueues(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RefireShardQueueTask(
+	ctx context.Context,
+	request *shared.RefireShardQueueTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.RefireShardQueueTask(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) DeleteShardQueueTask(
+	ctx context.Context,
+	request *shared.DeleteShardQueueTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.DeleteShardQueueTask(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) HandoffShard(
+	ctx context.Context,
+	request *h.HandoffShardRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.HandoffShard(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) DescribeMutableState(
 	ctx context.Context,
 	request *h.DescribeMutableStateRequest,
@@ -110,6 +234,38 @@ func (c *retryableClient) GetMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) QueryWorkflow(
+	ctx context.Context,
+	request *h.QueryWorkflowRequest,
+	opts ...yarpc.CallOption) (*h.QueryWorkflowResponse, error) {
+
+	var resp *h.QueryWorkflowResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.QueryWorkflow(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *h.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*h.UpdateWorkflowExecutionResponse, error) {
+
+	var resp *h.UpdateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ResetStickyTaskList(
 	ctx context.Context,
 	request *h.ResetStickyTaskListRequest,
@@ -318,6 +474,30 @@ func (c *retryableClient) TerminateWorkflowExecution(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *h.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.ResumeWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) ResetWorkflowExecution(
 	ctx context.Context,
 	request *h.ResetWorkflowExecutionRequest,
@@ -21,12 +21,14 @@
 package main
 
 import (
+	"crypto/tls"
 	"log"
 	"time"
 
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log/loggerimpl"
@@ -121,11 +123,24 @@ func (s *server) startService() common.Daemon {
 
 	svcCfg := s.cfg.Services[s.name]
 	params.MetricScope = svcCfg.Metrics.NewScope(params.Logger)
-	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger)
+	grpcPorts := make(map[string]int, len(s.cfg.Services))
+	for name, cfg := range s.cfg.Services {
+		grpcPorts["cadence-"+name] = cfg.RPC.GRPCPort
+	}
+	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger, grpcPorts)
 	params.PProfInitializer = svcCfg.PProf.NewInitializer(params.Logger)
 
 	params.DCRedirectionPolicy = s.cfg.DCRedirectionPolicy
 
+	params.Authenticator, err = authorization.NewAuthenticator(&s.cfg.Authorization)
+	if err != nil {
+		log.Fatalf("error creating authenticator: %v", err)
+	}
+	params.Authorizer, err = authorization.NewAuthorizer(&s.cfg.Authorization)
+	if err != nil {
+		log.Fatalf("error creating authorizer: %v", err)
+	}
+
 	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, params.Logger))
 
 	clusterMetadata := s.cfg.ClusterMetadata
@@ -142,7 +157,14 @@ func (s *server) startService() common.Daemon {
 	)
 
 	if s.cfg.PublicClient.HostPort != "" {
-		params.DispatcherProvider = client.NewDNSYarpcDispatcherProvider(params.Logger, s.cfg.PublicClient.RefreshInterval)
+		var tlsConfig *tls.Config
+		if svcCfg.RPC.TLS.Enabled {
+			tlsConfig, err = svcCfg.RPC.TLS.NewClientConfig()
+			if err != nil {
+				log.Fatalf("error creating TLS client config: %v", err)
+			}
+		}
+		params.DispatcherProvider = client.NewDNSYarpcDispatcherProvider(params.Logger, s.cfg.PublicClient.RefreshInterval, tlsConfig)
 	} else {
 		log.Fatalf("need to provide an endpoint config for PublicClient")
 	}
@@ -29,6 +29,8 @@ import (
 const (
 	// ArchiveNonRetriableErrorMsg is the log message when the Archive() method encounters a non-retriable error
 	ArchiveNonRetriableErrorMsg = "Archive method encountered an non-retriable error."
+	// ArchiveRetriableErrorMsg is the log message when the Archive() method encounters a retriable error
+	ArchiveRetriableErrorMsg = "Archive method encountered a retriable error."
 
 	// ErrInvalidURI is the error reason for invalid URI
 	ErrInvalidURI = "URI is invalid"
@@ -40,6 +42,8 @@ const (
 	ErrReadHistory = "failed to read history batches"
 	// ErrHistoryMutated is the error reason for mutated history
 	ErrHistoryMutated = "history was mutated"
+	// ErrWriteVisibilityRecord is the error reason for failing to write visibility record
+	ErrWriteVisibilityRecord = "failed to write visibility record"
 )
 
 var (
@@ -53,4 +57,8 @@ var (
 	ErrGetHistoryTokenCorrupted = &shared.BadRequestError{Message: "Next page token is corrupted."}
 	// ErrHistoryNotExist is the error for non-exist history
 	ErrHistoryNotExist = &shared.BadRequestError{Message: "Requested workflow history does not exist."}
+	// ErrInvalidGetVisibilityRequest is the error for invalid GetVisibility request
+	ErrInvalidGetVisibilityRequest = &shared.BadRequestError{Message: "Get archived visibility request is invalid"}
+	// ErrGetVisibilityTokenCorrupted is the error for corrupted GetVisibility token
+	ErrGetVisibilityTokenCorrupted = &shared.BadRequestError{Message: "Next page token is corrupted."}
 )
@@ -23,7 +23,10 @@
 
 // Each Archive() request results in a file named in the format of
 // hash(domainID, workflowID, runID)_version.history being created in the specified
-// directory. Workflow histories stored in that file are encoded in JSON format.
+// directory, followed by a hash(domainID, workflowID, runID)_version.manifest file.
+// Workflow histories stored in the history file are encoded in JSON format and compressed
+// with the configured compression. The manifest records the checksum of the history file and
+// the event ID range of every history batch, see historyFormat.go for details.
 
 // The Get() method retrieves the archived histories from the directory specified in the
 // URI. It optionally takes in a NextPageToken which specifies the workflow close failover
@@ -56,9 +59,11 @@ const (
 	// URIScheme is the scheme for the filestore implementation
 	URIScheme = "file"
 
-	errEncodeHistory = "failed to encode history batches"
-	errMakeDirectory = "failed to make directory"
-	errWriteFile     = "failed to write history to file"
+	errEncodeHistory  = "failed to encode history batches"
+	errEncodeManifest = "failed to encode history manifest"
+	errMakeDirectory  = "failed to make directory"
+	errWriteFile      = "failed to write history to file"
+	errWriteManifest  = "failed to write history manifest to file"
 
 	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
 )
@@ -66,13 +71,15 @@ const (
 var (
 	errInvalidFileMode = errors.New("invalid file mode")
 	errInvalidDirMode  = errors.New("invalid directory mode")
+	errInvalidCompress = errors.New("invalid compression")
 )
 
 type (
 	historyArchiver struct {
-		container archiver.HistoryBootstrapContainer
-		fileMode  os.FileMode
-		dirMode   os.FileMode
+		container   archiver.HistoryBootstrapContainer
+		fileMode    os.FileMode
+		dirMode     os.FileMode
+		compression string
 
 		// only set in test code
 		historyIterator archiver.HistoryIterator
@@ -105,10 +112,18 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	compression := config.Compression
+	if len(compression) == 0 {
+		compression = CompressionGzip
+	}
+	if err := validateCompression(compression); err != nil {
+		return nil, errInvalidCompress
+	}
 	return &historyArchiver{
 		container:       container,
 		fileMode:        os.FileMode(fileMode),
 		dirMode:         os.FileMode(dirMode),
+		compression:     compression,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -158,11 +173,16 @@ func (h *historyArchiver) Archive(
 		historyBatches = append(historyBatches, historyBlob.Body...)
 	}
 
-	encodedHistoryBatches, err := encodeHistoryBatches(historyBatches)
+	historyFile, manifest, err := encodeHistoryFile(historyBatches, h.compression)
 	if err != nil {
 		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 		return archiver.ErrArchiveNonRetriable
 	}
+	encodedManifest, err := encodeHistoryManifest(manifest)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeManifest), tag.Error(err))
+		return archiver.ErrArchiveNonRetriable
+	}
 
 	dirPath := getDirPathFromURI(URI)
 	if err = mkdirAll(dirPath, h.dirMode); err != nil {
@@ -171,11 +191,17 @@ func (h *historyArchiver) Archive(
 	}
 
 	filename := constructFilename(request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
-	if err := writeFile(path.Join(dirPath, filename), encodedHistoryBatches, h.fileMode); err != nil {
+	if err := writeFile(path.Join(dirPath, filename), historyFile, h.fileMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return archiver.ErrArchiveNonRetriable
 	}
 
+	manifestFilename := constructManifestFilename(request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	if err := writeFile(path.Join(dirPath, manifestFilename), encodedManifest, h.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errWriteManifest), tag.Error(err))
+		return archiver.ErrArchiveNonRetriable
+	}
+
 	return nil
 }
 
@@ -233,13 +259,27 @@ func (h *historyArchiver) Get(
 		return nil, archiver.ErrHistoryNotExist
 	}
 
-	encodedHistoryBatches, err := readFile(filepath)
+	historyFile, err := readFile(filepath)
 	if err != nil {
 		return nil, err
 	}
 
-	historyBatches, err := decodeHistoryBatches(encodedHistoryBatches)
+	manifestFilename := constructManifestFilename(request.DomainID, request.WorkflowID, request.RunID, token.CloseFailoverVersion)
+	var historyBatches []*shared.History
+	manifest, err := readHistoryManifest(path.Join(dirPath, manifestFilename))
+	if err == nil {
+		historyBatches, err = decodeHistoryFile(historyFile, manifest)
+	}
 	if err != nil {
+		if _, ok := err.(*historyCorruptedError); ok {
+			h.container.Logger.Error("Archived history failed verification.",
+				tag.ArchivalRequestDomainID(request.DomainID),
+				tag.ArchivalRequestWorkflowID(request.WorkflowID),
+				tag.ArchivalRequestRunID(request.RunID),
+				tag.ArchivalRequestCloseFailoverVersion(token.CloseFailoverVersion),
+				tag.ArchivalURI(URI),
+				tag.Error(err))
+		}
 		return nil, err
 	}
 	historyBatches = historyBatches[token.NextBatchIdx:]
@@ -294,6 +334,19 @@ func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIte
 	return historyBlob, nil
 }
 
+// readHistoryManifest returns a nil manifest if the history file was written without one
+func readHistoryManifest(filepath string) (*historyManifest, error) {
+	exists, err := fileExists(filepath)
+	if err != nil || !exists {
+		return nil, err
+	}
+	data, err := readFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeHistoryManifest(data)
+}
+
 func getHighestVersion(dirPath string, request *archiver.GetHistoryRequest) (*int64, error) {
 	filenames, err := listFilesByPrefix(dirPath, constructFilenamePrefix(request.DomainID, request.WorkflowID, request.RunID))
 	if err != nil {
@@ -297,6 +297,8 @@ func (s *historyArchiverSuite) TestArchive_Success() {
 
 	expectedFilename := constructFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion)
 	s.assertFileExists(path.Join(dir, expectedFilename))
+	expectedManifestFilename := constructManifestFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	s.assertFileExists(path.Join(dir, expectedManifestFilename))
 }
 
 func (s *historyArchiverSuite) TestGet_Fail_InvalidURI() {
@@ -426,6 +428,79 @@ func (s *historyArchiverSuite) TestGet_Success_SmallPageSize() {
 	s.Equal(s.historyBatchesV100, combinedHistory)
 }
 
+func (s *historyArchiverSuite) TestNewHistoryArchiver_InvalidCompression() {
+	config := &config.FilestoreHistoryArchiver{
+		FileMode:    testFileModeStr,
+		DirMode:     testDirModeStr,
+		Compression: "lz4",
+	}
+	historyArchiver, err := newHistoryArchiver(s.container, config, nil)
+	s.Nil(historyArchiver)
+	s.Equal(errInvalidCompress, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_Corrupted() {
+	dir, err := ioutil.TempDir("", "TestGetCorrupted")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	s.archiveHistoryBatches(dir, CompressionGzip)
+
+	filepath := path.Join(dir, constructFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion))
+	data, err := readFile(filepath)
+	s.NoError(err)
+	data[len(data)-1] ^= 0x1
+	s.NoError(writeFile(filepath, data, testFileMode))
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), "file://"+dir, request)
+	s.Nil(response)
+	s.IsType(&historyCorruptedError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_ManifestMissing() {
+	dir, err := ioutil.TempDir("", "TestGetManifestMissing")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	s.archiveHistoryBatches(dir, CompressionZstd)
+	s.NoError(os.Remove(path.Join(dir, constructManifestFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion))))
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), "file://"+dir, request)
+	s.Nil(response)
+	s.IsType(&historyCorruptedError{}, err)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet_Zstd() {
+	dir, err := ioutil.TempDir("", "TestArchiveAndGetZstd")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	s.archiveHistoryBatches(dir, CompressionZstd)
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), "file://"+dir, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+}
+
 func (s *historyArchiverSuite) TestArchiveAndGet() {
 	mockCtrl := gomock.NewController(s.T())
 	defer mockCtrl.Finish()
@@ -485,6 +560,41 @@ func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.H
 	return archiver
 }
 
+func (s *historyArchiverSuite) archiveHistoryBatches(dir string, compression string) {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(true),
+		},
+		Body: s.historyBatchesV100,
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	config := &config.FilestoreHistoryArchiver{
+		FileMode:    testFileModeStr,
+		DirMode:     testDirModeStr,
+		Compression: compression,
+	}
+	historyArchiver, err := newHistoryArchiver(s.container, config, historyIterator)
+	s.NoError(err)
+	request := &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	s.NoError(historyArchiver.Archive(context.Background(), "file://"+dir, request))
+}
+
 func (s *historyArchiverSuite) setupHistoryDirectory() {
 	s.historyBatchesV1 = []*shared.History{
 		&shared.History{
@@ -0,0 +1,279 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/DataDog/zstd"
+	"github.com/uber/cadence/.gen/go/shared"
+)
+
+// Archived history is stored as two files per close failover version:
+//   - hash_version.history holds a header followed by the compressed, JSON encoded history batches
+//   - hash_version.manifest holds the checksum of the history file and the event ID range of every batch
+// The manifest is written after the history file, Get() verifies the history file against the manifest
+// before returning any batches. History files written before the format was introduced have neither
+// a header nor a manifest and are read as plain JSON.
+
+const (
+	// CompressionGzip compresses archived history with gzip
+	CompressionGzip = "gzip"
+	// CompressionZstd compresses archived history with zstd
+	CompressionZstd = "zstd"
+
+	historyFormatVersion = 1
+	manifestFileSuffix   = ".manifest"
+)
+
+var (
+	historyFileMagic = []byte("CDNCHIST")
+
+	errUnknownCompression = errors.New("unknown compression")
+)
+
+type (
+	// historyFileHeader is the fixed size header at the start of every versioned history file
+	historyFileHeader struct {
+		FormatVersion byte
+		Compression   byte
+	}
+
+	// historyManifest describes the content of one history file
+	historyManifest struct {
+		FormatVersion int
+		Compression   string
+		// Checksum is the hex encoded sha256 of the whole history file
+		Checksum   string
+		Size       int64
+		EventCount int64
+		Batches    []historyBatchRange
+	}
+
+	// historyBatchRange is the inclusive event ID range of one history batch
+	historyBatchRange struct {
+		FirstEventID int64
+		LastEventID  int64
+	}
+
+	historyCorruptedError struct {
+		reason string
+	}
+)
+
+var compressionCodes = map[string]byte{
+	CompressionGzip: 1,
+	CompressionZstd: 2,
+}
+
+func (e *historyCorruptedError) Error() string {
+	return fmt.Sprintf("archived history is corrupted: %s", e.reason)
+}
+
+func newHistoryCorruptedError(format string, args ...interface{}) error {
+	return &historyCorruptedError{reason: fmt.Sprintf(format, args...)}
+}
+
+func validateCompression(compression string) error {
+	if _, ok := compressionCodes[compression]; !ok {
+		return errUnknownCompression
+	}
+	return nil
+}
+
+// encodeHistoryFile returns the content of the history file and its manifest for the given batches
+func encodeHistoryFile(historyBatches []*shared.History, compression string) ([]byte, *historyManifest, error) {
+	code, ok := compressionCodes[compression]
+	if !ok {
+		return nil, nil, errUnknownCompression
+	}
+	encodedHistoryBatches, err := encodeHistoryBatches(historyBatches)
+	if err != nil {
+		return nil, nil, err
+	}
+	compressed, err := compress(encodedHistoryBatches, compression)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := make([]byte, 0, len(historyFileMagic)+2+len(compressed))
+	data = append(data, historyFileMagic...)
+	data = append(data, historyFormatVersion, code)
+	data = append(data, compressed...)
+
+	manifest := &historyManifest{
+		FormatVersion: historyFormatVersion,
+		Compression:   compression,
+		Checksum:      checksum(data),
+		Size:          int64(len(data)),
+	}
+	for _, batch := range historyBatches {
+		events := batch.GetEvents()
+		if len(events) == 0 {
+			return nil, nil, errors.New("history batch is empty")
+		}
+		manifest.EventCount += int64(len(events))
+		manifest.Batches = append(manifest.Batches, historyBatchRange{
+			FirstEventID: events[0].GetEventId(),
+			LastEventID:  events[len(events)-1].GetEventId(),
+		})
+	}
+	return data, manifest, nil
+}
+
+// decodeHistoryFile verifies the history file against its manifest and returns the history batches,
+// the manifest is nil for history files written before the versioned format was introduced
+func decodeHistoryFile(data []byte, manifest *historyManifest) ([]*shared.History, error) {
+	header, isVersioned := readHistoryFileHeader(data)
+	if manifest == nil {
+		if isVersioned {
+			return nil, newHistoryCorruptedError("manifest is missing")
+		}
+		historyBatches, err := decodeHistoryBatches(data)
+		if err != nil {
+			return nil, newHistoryCorruptedError("failed to decode history batches: %v", err)
+		}
+		return historyBatches, nil
+	}
+
+	if !isVersioned {
+		return nil, newHistoryCorruptedError("history file header is missing")
+	}
+	if manifest.FormatVersion != historyFormatVersion || int(header.FormatVersion) != manifest.FormatVersion {
+		return nil, newHistoryCorruptedError("unsupported format version %v, file header %v", manifest.FormatVersion, header.FormatVersion)
+	}
+	if code, ok := compressionCodes[manifest.Compression]; !ok || code != header.Compression {
+		return nil, newHistoryCorruptedError("compression %q does not match file header %v", manifest.Compression, header.Compression)
+	}
+	if int64(len(data)) != manifest.Size {
+		return nil, newHistoryCorruptedError("file size %v does not match manifest size %v", len(data), manifest.Size)
+	}
+	if actual := checksum(data); actual != manifest.Checksum {
+		return nil, newHistoryCorruptedError("checksum %v does not match manifest checksum %v", actual, manifest.Checksum)
+	}
+
+	encodedHistoryBatches, err := decompress(data[len(historyFileMagic)+2:], manifest.Compression)
+	if err != nil {
+		return nil, newHistoryCorruptedError("failed to decompress history: %v", err)
+	}
+	historyBatches, err := decodeHistoryBatches(encodedHistoryBatches)
+	if err != nil {
+		return nil, newHistoryCorruptedError("failed to decode history batches: %v", err)
+	}
+	if err := verifyHistoryBatches(historyBatches, manifest); err != nil {
+		return nil, err
+	}
+	return historyBatches, nil
+}
+
+func verifyHistoryBatches(historyBatches []*shared.History, manifest *historyManifest) error {
+	if len(historyBatches) != len(manifest.Batches) {
+		return newHistoryCorruptedError("found %v batches, manifest has %v", len(historyBatches), len(manifest.Batches))
+	}
+	eventCount := int64(0)
+	for i, batch := range historyBatches {
+		events := batch.GetEvents()
+		if len(events) == 0 {
+			return newHistoryCorruptedError("batch %v is empty", i)
+		}
+		expected := manifest.Batches[i]
+		if events[0].GetEventId() != expected.FirstEventID || events[len(events)-1].GetEventId() != expected.LastEventID {
+			return newHistoryCorruptedError(
+				"batch %v has event IDs [%v, %v], manifest has [%v, %v]",
+				i, events[0].GetEventId(), events[len(events)-1].GetEventId(), expected.FirstEventID, expected.LastEventID,
+			)
+		}
+		eventCount += int64(len(events))
+	}
+	if eventCount != manifest.EventCount {
+		return newHistoryCorruptedError("found %v events, manifest has %v", eventCount, manifest.EventCount)
+	}
+	return nil
+}
+
+func readHistoryFileHeader(data []byte) (*historyFileHeader, bool) {
+	if len(data) < len(historyFileMagic)+2 || !bytes.Equal(data[:len(historyFileMagic)], historyFileMagic) {
+		return nil, false
+	}
+	return &historyFileHeader{
+		FormatVersion: data[len(historyFileMagic)],
+		Compression:   data[len(historyFileMagic)+1],
+	}, true
+}
+
+func encodeHistoryManifest(manifest *historyManifest) ([]byte, error) {
+	return json.Marshal(manifest)
+}
+
+func decodeHistoryManifest(data []byte) (*historyManifest, error) {
+	manifest := &historyManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, newHistoryCorruptedError("failed to decode manifest: %v", err)
+	}
+	return manifest, nil
+}
+
+func compress(data []byte, compression string) ([]byte, error) {
+	switch compression {
+	case CompressionGzip:
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CompressionZstd:
+		return zstd.Compress(nil, data)
+	default:
+		return nil, errUnknownCompression
+	}
+}
+
+func decompress(data []byte, compression string) ([]byte, error) {
+	switch compression {
+	case CompressionGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return ioutil.ReadAll(reader)
+	case CompressionZstd:
+		return zstd.Decompress(nil, data)
+	default:
+		return nil, errUnknownCompression
+	}
+}
+
+func checksum(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type historyFormatSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	historyBatches []*shared.History
+}
+
+func TestHistoryFormatSuite(t *testing.T) {
+	suite.Run(t, new(historyFormatSuite))
+}
+
+func (s *historyFormatSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.historyBatches = []*shared.History{
+		&shared.History{
+			Events: []*shared.HistoryEvent{
+				&shared.HistoryEvent{EventId: common.Int64Ptr(1), Version: common.Int64Ptr(testCloseFailoverVersion)},
+				&shared.HistoryEvent{EventId: common.Int64Ptr(2), Version: common.Int64Ptr(testCloseFailoverVersion)},
+			},
+		},
+		&shared.History{
+			Events: []*shared.HistoryEvent{
+				&shared.HistoryEvent{EventId: common.Int64Ptr(3), Version: common.Int64Ptr(testCloseFailoverVersion)},
+			},
+		},
+	}
+}
+
+func (s *historyFormatSuite) TestEncodeDecode() {
+	for _, compression := range []string{CompressionGzip, CompressionZstd} {
+		data, manifest, err := encodeHistoryFile(s.historyBatches, compression)
+		s.NoError(err)
+		s.Equal(historyFormatVersion, manifest.FormatVersion)
+		s.Equal(compression, manifest.Compression)
+		s.Equal(int64(len(data)), manifest.Size)
+		s.Equal(int64(3), manifest.EventCount)
+		s.Equal([]historyBatchRange{{FirstEventID: 1, LastEventID: 2}, {FirstEventID: 3, LastEventID: 3}}, manifest.Batches)
+
+		encodedManifest, err := encodeHistoryManifest(manifest)
+		s.NoError(err)
+		decodedManifest, err := decodeHistoryManifest(encodedManifest)
+		s.NoError(err)
+		s.Equal(manifest, decodedManifest)
+
+		historyBatches, err := decodeHistoryFile(data, decodedManifest)
+		s.NoError(err)
+		s.Equal(s.historyBatches, historyBatches)
+	}
+}
+
+func (s *historyFormatSuite) TestEncode_UnknownCompression() {
+	_, _, err := encodeHistoryFile(s.historyBatches, "lz4")
+	s.Equal(errUnknownCompression, err)
+}
+
+func (s *historyFormatSuite) TestDecode_LegacyFormat() {
+	data, err := encodeHistoryBatches(s.historyBatches)
+	s.NoError(err)
+	historyBatches, err := decodeHistoryFile(data, nil)
+	s.NoError(err)
+	s.Equal(s.historyBatches, historyBatches)
+
+	_, err = decodeHistoryFile(data[1:], nil)
+	s.IsType(&historyCorruptedError{}, err)
+}
+
+func (s *historyFormatSuite) TestDecode_Corrupted() {
+	data, manifest, err := encodeHistoryFile(s.historyBatches, CompressionGzip)
+	s.NoError(err)
+
+	testCases := []struct {
+		name     string
+		data     func() []byte
+		manifest func() *historyManifest
+	}{
+		{
+			name:     "missing manifest",
+			data:     func() []byte { return data },
+			manifest: func() *historyManifest { return nil },
+		},
+		{
+			name: "flipped bit",
+			data: func() []byte {
+				corrupted := append([]byte(nil), data...)
+				corrupted[len(corrupted)-5] ^= 0x1
+				return corrupted
+			},
+			manifest: func() *historyManifest { return manifest },
+		},
+		{
+			name:     "truncated file",
+			data:     func() []byte { return data[:len(data)-1] },
+			manifest: func() *historyManifest { return manifest },
+		},
+		{
+			name: "missing header",
+			data: func() []byte { return data[len(historyFileMagic):] },
+			manifest: func() *historyManifest {
+				return manifest
+			},
+		},
+		{
+			name: "unsupported version",
+			data: func() []byte { return data },
+			manifest: func() *historyManifest {
+				m := *manifest
+				m.FormatVersion = historyFormatVersion + 1
+				return &m
+			},
+		},
+		{
+			name: "compression mismatch",
+			data: func() []byte { return data },
+			manifest: func() *historyManifest {
+				m := *manifest
+				m.Compression = CompressionZstd
+				return &m
+			},
+		},
+		{
+			name: "event range mismatch",
+			data: func() []byte { return data },
+			manifest: func() *historyManifest {
+				m := *manifest
+				m.Batches = []historyBatchRange{{FirstEventID: 1, LastEventID: 2}, {FirstEventID: 4, LastEventID: 4}}
+				return &m
+			},
+		},
+		{
+			name: "event count mismatch",
+			data: func() []byte { return data },
+			manifest: func() *historyManifest {
+				m := *manifest
+				m.EventCount++
+				return &m
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := decodeHistoryFile(tc.data(), tc.manifest())
+		s.IsType(&historyCorruptedError{}, err, tc.name)
+	}
+}
+
+func (s *historyFormatSuite) TestDecodeManifest_Corrupted() {
+	_, err := decodeHistoryManifest([]byte("{not json"))
+	s.IsType(&historyCorruptedError{}, err)
+}
@@ -37,6 +37,10 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 )
 
+const (
+	visibilityFileSuffix = ".visibility"
+)
+
 var (
 	errDirectoryExpected  = errors.New("a path to a directory was expected")
 	errFileExpected       = errors.New("a path to a file was expected")
@@ -134,6 +138,23 @@ func decodeHistoryBatches(data []byte) ([]*shared.History, error) {
 	return historyBatches, nil
 }
 
+func encodeVisibilityRecord(record *archiver.ArchiveVisibilityRequest) ([]byte, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func decodeVisibilityRecord(data []byte) (*archiver.ArchiveVisibilityRequest, error) {
+	record := &archiver.ArchiveVisibilityRequest{}
+	err := json.Unmarshal(data, record)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
 func tagLoggerWithArchiveHistoryRequest(logger log.Logger, request *archiver.ArchiveHistoryRequest) log.Logger {
 	return logger.WithTags(
 		tag.ShardID(request.ShardID),
@@ -148,6 +169,15 @@ func tagLoggerWithArchiveHistoryRequest(logger log.Logger, request *archiver.Arc
 	)
 }
 
+func tagLoggerWithArchiveVisibilityRequest(logger log.Logger, request *archiver.ArchiveVisibilityRequest) log.Logger {
+	return logger.WithTags(
+		tag.ArchivalRequestDomainID(request.DomainID),
+		tag.ArchivalRequestDomainName(request.DomainName),
+		tag.ArchivalRequestWorkflowID(request.WorkflowID),
+		tag.ArchivalRequestRunID(request.RunID),
+	)
+}
+
 func contextExpired(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
@@ -183,6 +213,11 @@ func constructFilename(domainID, workflowID, runID string, version int64) string
 	return fmt.Sprintf("%s_%v.history", combinedHash, version)
 }
 
+func constructManifestFilename(domainID, workflowID, runID string, version int64) string {
+	combinedHash := constructFilenamePrefix(domainID, workflowID, runID)
+	return fmt.Sprintf("%s_%v%s", combinedHash, version, manifestFileSuffix)
+}
+
 func constructFilenamePrefix(domainID, workflowID, runID string) string {
 	domainIDHash := fmt.Sprintf("%v", farm.Fingerprint64([]byte(domainID)))
 	workflowIDHash := fmt.Sprintf("%v", farm.Fingerprint64([]byte(workflowID)))
@@ -200,6 +235,25 @@ func extractCloseFailoverVersion(filename string) (int64, error) {
 	return strconv.ParseInt(filenameParts[1], 10, 64)
 }
 
+func constructVisibilityFilename(closeTimestamp int64, workflowID, runID string) string {
+	combinedHash := strings.Join([]string{
+		fmt.Sprintf("%v", farm.Fingerprint64([]byte(workflowID))),
+		fmt.Sprintf("%v", farm.Fingerprint64([]byte(runID))),
+	}, "")
+	return fmt.Sprintf("%v_%s%s", closeTimestamp, combinedHash, visibilityFileSuffix)
+}
+
+func extractCloseTimestamp(filename string) (int64, error) {
+	if !strings.HasSuffix(filename, visibilityFileSuffix) {
+		return -1, errors.New("unknown file extension")
+	}
+	filenameParts := strings.Split(strings.TrimSuffix(filename, visibilityFileSuffix), "_")
+	if len(filenameParts) != 2 {
+		return -1, errors.New("unknown filename structure")
+	}
+	return strconv.ParseInt(filenameParts[0], 10, 64)
+}
+
 func historyMutated(request *archiver.ArchiveHistoryRequest, historyBatches []*shared.History, isLast bool) bool {
 	lastBatch := historyBatches[len(historyBatches)-1].Events
 	lastEvent := lastBatch[len(lastBatch)-1]
@@ -230,6 +284,21 @@ func serializeGetHistoryToken(token *getHistoryToken) ([]byte, error) {
 	return bytes, err
 }
 
+func deserializeGetVisibilityToken(bytes []byte) (*getVisibilityToken, error) {
+	token := &getVisibilityToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+func serializeGetVisibilityToken(token *getVisibilityToken) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+
+	bytes, err := json.Marshal(token)
+	return bytes, err
+}
+
 func validateArchiveRequest(request *archiver.ArchiveHistoryRequest) error {
 	if request.DomainID == "" {
 		return errors.New("DomainID is empty")
@@ -261,3 +330,33 @@ func validateGetRequest(request *archiver.GetHistoryRequest) error {
 	}
 	return nil
 }
+
+func validateArchiveVisibilityRequest(request *archiver.ArchiveVisibilityRequest) error {
+	if request.DomainID == "" {
+		return errors.New("DomainID is empty")
+	}
+	if request.WorkflowID == "" {
+		return errors.New("WorkflowID is empty")
+	}
+	if request.RunID == "" {
+		return errors.New("RunID is empty")
+	}
+	if request.CloseTimestamp == 0 {
+		return errors.New("CloseTimestamp is empty")
+	}
+	return nil
+}
+
+func validateGetVisibilityRequest(request *archiver.GetVisibilityRequest) error {
+	if request.DomainID == "" {
+		return errors.New("DomainID is empty")
+	}
+	if request.PageSize <= 0 {
+		return errors.New("PageSize should be greater than 0")
+	}
+	if request.EarliestCloseTime != nil && request.LatestCloseTime != nil &&
+		*request.EarliestCloseTime > *request.LatestCloseTime {
+		return errors.New("EarliestCloseTime is later than LatestCloseTime")
+	}
+	return nil
+}
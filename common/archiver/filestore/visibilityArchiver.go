@@ -18,26 +18,84 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
+// Filestore Visibility Archiver will archive closed workflow visibility records to local disk.
+// The location is specified by the URI which has the form file:///path/to/directory.
+
+// Each Archive() request results in a file named in the format of
+// closeTimestamp_hash(workflowID, runID).visibility being created in a sub-directory named
+// after the domainID under the specified directory. The visibility record stored in that file
+// is encoded in JSON format.
+
+// The Get() method lists all visibility records of a domain in descending order of close time,
+// and returns those matching the filters in the request. Close time range filters are applied
+// using only the filename, while other filters require reading the record. The NextPageToken
+// records the last returned file, so the next page starts right after that file.
+
 package filestore
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/service/config"
 )
 
+const (
+	errEncodeVisibilityRecord = "failed to encode visibility record"
+)
+
 type (
-	visibilityArchiver struct{}
+	visibilityArchiver struct {
+		container archiver.VisibilityBootstrapContainer
+		fileMode  os.FileMode
+		dirMode   os.FileMode
+	}
+
+	getVisibilityToken struct {
+		LastCloseTimestamp int64
+		LastFilename       string
+	}
+
+	visibilityFileInfo struct {
+		closeTimestamp int64
+		filename       string
+	}
 )
 
 // NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on filestore
 func NewVisibilityArchiver(
 	container archiver.VisibilityBootstrapContainer,
 	config *config.FilestoreVisibilityArchiver,
-) archiver.VisibilityArchiver {
-	return &visibilityArchiver{}
+) (archiver.VisibilityArchiver, error) {
+	return newVisibilityArchiver(container, config)
+}
+
+func newVisibilityArchiver(
+	container archiver.VisibilityBootstrapContainer,
+	config *config.FilestoreVisibilityArchiver,
+) (*visibilityArchiver, error) {
+	fileMode, err := strconv.ParseUint(config.FileMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidFileMode
+	}
+	dirMode, err := strconv.ParseUint(config.DirMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidDirMode
+	}
+	return &visibilityArchiver{
+		container: container,
+		fileMode:  os.FileMode(fileMode),
+		dirMode:   os.FileMode(dirMode),
+	}, nil
 }
 
 func (v *visibilityArchiver) Archive(
@@ -46,7 +104,38 @@ func (v *visibilityArchiver) Archive(
 	request *archiver.ArchiveVisibilityRequest,
 	opts ...archiver.ArchiveOption,
 ) error {
-	return errors.New("method Archive() is not implemented")
+	logger := tagLoggerWithArchiveVisibilityRequest(v.container.Logger, request)
+
+	if err := v.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrInvalidURI), tag.Error(err), tag.ArchivalURI(URI))
+		return archiver.ErrArchiveNonRetriable
+	}
+
+	if err := validateArchiveVisibilityRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrInvalidArchiveRequest), tag.Error(err))
+		return archiver.ErrArchiveNonRetriable
+	}
+
+	encodedRecord, err := encodeVisibilityRecord(request)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
+		return archiver.ErrArchiveNonRetriable
+	}
+
+	// failing to write the record may be transient, e.g. a full or unmounted disk, so the error is retriable
+	dirPath := path.Join(getDirPathFromURI(URI), request.DomainID)
+	if err = mkdirAll(dirPath, v.dirMode); err != nil {
+		logger.Error(archiver.ArchiveRetriableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
+		return err
+	}
+
+	filename := constructVisibilityFilename(request.CloseTimestamp, request.WorkflowID, request.RunID)
+	if err := writeFile(path.Join(dirPath, filename), encodedRecord, v.fileMode); err != nil {
+		logger.Error(archiver.ArchiveRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrWriteVisibilityRecord), tag.Error(err))
+		return err
+	}
+
+	return nil
 }
 
 func (v *visibilityArchiver) Get(
@@ -54,9 +143,157 @@ func (v *visibilityArchiver) Get(
 	URI string,
 	request *archiver.GetVisibilityRequest,
 ) (*archiver.GetVisibilityResponse, error) {
-	return nil, errors.New("method Get() is not implemented")
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, fmt.Errorf("%s: %v", archiver.ErrInvalidURI, err)
+	}
+
+	if err := validateGetVisibilityRequest(request); err != nil {
+		return nil, archiver.ErrInvalidGetVisibilityRequest
+	}
+
+	var token *getVisibilityToken
+	if request.NextPageToken != nil {
+		var err error
+		token, err = deserializeGetVisibilityToken(request.NextPageToken)
+		if err != nil {
+			return nil, archiver.ErrGetVisibilityTokenCorrupted
+		}
+	}
+
+	response := &archiver.GetVisibilityResponse{}
+	dirPath := path.Join(getDirPathFromURI(URI), request.DomainID)
+	exists, err := directoryExists(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return response, nil
+	}
+
+	filenames, err := listFilesByPrefix(dirPath, "")
+	if err != nil {
+		return nil, err
+	}
+	files := sortAndFilterVisibilityFiles(filenames, request)
+
+	startIdx := 0
+	if token != nil {
+		startIdx = sort.Search(len(files), func(i int) bool {
+			return visibilityFileAfterToken(files[i], token)
+		})
+	}
+
+	for idx := startIdx; idx < len(files); idx++ {
+		if contextExpired(ctx) {
+			return nil, archiver.ErrContextTimeout
+		}
+
+		encodedRecord, err := readFile(path.Join(dirPath, files[idx].filename))
+		if err != nil {
+			return nil, err
+		}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, err
+		}
+		if !matchVisibilityRecord(record, request) {
+			continue
+		}
+
+		response.Executions = append(response.Executions, convertToExecutionInfo(record))
+		if len(response.Executions) == request.PageSize {
+			if idx < len(files)-1 {
+				nextToken, err := serializeGetVisibilityToken(&getVisibilityToken{
+					LastCloseTimestamp: files[idx].closeTimestamp,
+					LastFilename:       files[idx].filename,
+				})
+				if err != nil {
+					return nil, err
+				}
+				response.NextPageToken = nextToken
+			}
+			break
+		}
+	}
+
+	return response, nil
 }
 
 func (v *visibilityArchiver) ValidateURI(URI string) error {
-	return errors.New("method ValidateURI() is not implemented")
+	if !strings.HasPrefix(URI, URIScheme+"://") {
+		return archiver.ErrInvalidURIScheme
+	}
+
+	return validateDirPath(getDirPathFromURI(URI))
+}
+
+func sortAndFilterVisibilityFiles(filenames []string, request *archiver.GetVisibilityRequest) []*visibilityFileInfo {
+	var files []*visibilityFileInfo
+	for _, filename := range filenames {
+		closeTimestamp, err := extractCloseTimestamp(filename)
+		if err != nil {
+			continue
+		}
+		if request.EarliestCloseTime != nil && closeTimestamp < *request.EarliestCloseTime {
+			continue
+		}
+		if request.LatestCloseTime != nil && closeTimestamp > *request.LatestCloseTime {
+			continue
+		}
+		files = append(files, &visibilityFileInfo{
+			closeTimestamp: closeTimestamp,
+			filename:       filename,
+		})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].closeTimestamp == files[j].closeTimestamp {
+			return files[i].filename > files[j].filename
+		}
+		return files[i].closeTimestamp > files[j].closeTimestamp
+	})
+	return files
+}
+
+func visibilityFileAfterToken(file *visibilityFileInfo, token *getVisibilityToken) bool {
+	if file.closeTimestamp == token.LastCloseTimestamp {
+		return file.filename < token.LastFilename
+	}
+	return file.closeTimestamp < token.LastCloseTimestamp
+}
+
+func matchVisibilityRecord(record *archiver.ArchiveVisibilityRequest, request *archiver.GetVisibilityRequest) bool {
+	if request.WorkflowIDPrefix != nil && !strings.HasPrefix(record.WorkflowID, *request.WorkflowIDPrefix) {
+		return false
+	}
+	if request.WorkflowTypeName != nil && record.WorkflowTypeName != *request.WorkflowTypeName {
+		return false
+	}
+	if request.CloseStatus != nil && record.CloseStatus != *request.CloseStatus {
+		return false
+	}
+	return true
+}
+
+func convertToExecutionInfo(record *archiver.ArchiveVisibilityRequest) *shared.WorkflowExecutionInfo {
+	executionInfo := &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(record.WorkflowID),
+			RunId:      common.StringPtr(record.RunID),
+		},
+		Type: &shared.WorkflowType{
+			Name: common.StringPtr(record.WorkflowTypeName),
+		},
+		StartTime:     common.Int64Ptr(record.StartTimestamp),
+		ExecutionTime: common.Int64Ptr(record.ExecutionTimestamp),
+		CloseTime:     common.Int64Ptr(record.CloseTimestamp),
+		CloseStatus:   record.CloseStatus.Ptr(),
+		HistoryLength: common.Int64Ptr(record.HistoryLength),
+		Memo:          record.Memo,
+	}
+	if len(record.SearchAttributes) != 0 {
+		executionInfo.SearchAttributes = &shared.SearchAttributes{
+			IndexedFields: record.SearchAttributes,
+		}
+	}
+	return executionInfo
 }
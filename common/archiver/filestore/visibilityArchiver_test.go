@@ -0,0 +1,344 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package filestore
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/zap"
+)
+
+const (
+	testWorkflowTypeName = "test-workflow-type"
+)
+
+type visibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	container         archiver.VisibilityBootstrapContainer
+	testGetDirectory  string
+	visibilityRecords []*archiver.ArchiveVisibilityRequest
+}
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupSuite() {
+	var err error
+	s.testGetDirectory, err = ioutil.TempDir("", "TestGetVisibility")
+	s.Require().NoError(err)
+	s.setupVisibilityDirectory()
+}
+
+func (s *visibilityArchiverSuite) TearDownSuite() {
+	os.RemoveAll(s.testGetDirectory)
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	zapLogger := zap.NewNop()
+	s.container = archiver.VisibilityBootstrapContainer{
+		Logger: loggerimpl.NewLogger(zapLogger),
+	}
+}
+
+func (s *visibilityArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme:///a/b/c",
+			expectedErr: archiver.ErrInvalidURIScheme,
+		},
+		{
+			URI:         "",
+			expectedErr: archiver.ErrInvalidURIScheme,
+		},
+		{
+			URI:         "file://",
+			expectedErr: errEmptyDirectoryPath,
+		},
+		{
+			URI:         "file:///a/b/c",
+			expectedErr: nil,
+		},
+	}
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, tc := range testCases {
+		s.Equal(tc.expectedErr, visibilityArchiver.ValidateURI(tc.URI))
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := s.newTestArchiveVisibilityRequest(testRunID, time.Now().UnixNano())
+	err := visibilityArchiver.Archive(context.Background(), "wrongscheme://", request)
+	s.Equal(archiver.ErrArchiveNonRetriable, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := s.newTestArchiveVisibilityRequest("", time.Now().UnixNano()) // an invalid request
+	err := visibilityArchiver.Archive(context.Background(), "file:///a/b/c", request)
+	s.Equal(archiver.ErrArchiveNonRetriable, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_WriteRecord() {
+	dir, err := ioutil.TempDir("", "TestArchiveVisibility")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	// a file in place of the domain directory makes creating the directory fail
+	s.NoError(ioutil.WriteFile(path.Join(dir, testDomainID), []byte{}, testFileMode))
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := s.newTestArchiveVisibilityRequest(testRunID, time.Now().UnixNano())
+	err = visibilityArchiver.Archive(context.Background(), "file://"+dir, request)
+	s.Error(err)
+	s.NotEqual(archiver.ErrArchiveNonRetriable, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Success() {
+	dir, err := ioutil.TempDir("", "TestArchiveVisibility")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	closeTimestamp := time.Now().UnixNano()
+	request := s.newTestArchiveVisibilityRequest(testRunID, closeTimestamp)
+	err = visibilityArchiver.Archive(context.Background(), "file://"+dir, request)
+	s.NoError(err)
+
+	expectedFilename := constructVisibilityFilename(closeTimestamp, testWorkflowID, testRunID)
+	filepath := path.Join(dir, testDomainID, expectedFilename)
+	exists, err := fileExists(filepath)
+	s.NoError(err)
+	s.True(exists)
+
+	data, err := readFile(filepath)
+	s.NoError(err)
+	record, err := decodeVisibilityRecord(data)
+	s.NoError(err)
+	s.Equal(request, record)
+}
+
+func (s *visibilityArchiverSuite) TestGet_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.GetVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: testPageSize,
+	}
+	response, err := visibilityArchiver.Get(context.Background(), "wrongscheme://", request)
+	s.Nil(response)
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestGet_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.GetVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 0, // pageSize should be greater than 0
+	}
+	response, err := visibilityArchiver.Get(context.Background(), "file:///a/b/c", request)
+	s.Nil(response)
+	s.Equal(archiver.ErrInvalidGetVisibilityRequest, err)
+}
+
+func (s *visibilityArchiverSuite) TestGet_Fail_InvalidToken() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.GetVisibilityRequest{
+		DomainID:      testDomainID,
+		PageSize:      testPageSize,
+		NextPageToken: []byte{'r', 'a', 'n', 'd', 'o', 'm'},
+	}
+	response, err := visibilityArchiver.Get(context.Background(), "file:///a/b/c", request)
+	s.Nil(response)
+	s.Equal(archiver.ErrGetVisibilityTokenCorrupted, err)
+}
+
+func (s *visibilityArchiverSuite) TestGet_Success_DirectoryNotExist() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.GetVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: testPageSize,
+	}
+	response, err := visibilityArchiver.Get(context.Background(), "file:///a/b/c", request)
+	s.NoError(err)
+	s.Empty(response.Executions)
+	s.Nil(response.NextPageToken)
+}
+
+func (s *visibilityArchiverSuite) TestGet_Success_NoFilter() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.GetVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: testPageSize,
+	}
+	response, err := visibilityArchiver.Get(context.Background(), "file://"+s.testGetDirectory, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, len(s.visibilityRecords))
+	// records are returned in descending order of close time
+	for i, execution := range response.Executions {
+		s.Equal(convertToExecutionInfo(s.visibilityRecords[len(s.visibilityRecords)-1-i]), execution)
+	}
+}
+
+func (s *visibilityArchiverSuite) TestGet_Success_Filters() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.GetVisibilityRequest{
+		DomainID:          testDomainID,
+		CloseStatus:       shared.WorkflowExecutionCloseStatusFailed.Ptr(),
+		EarliestCloseTime: common.Int64Ptr(s.visibilityRecords[1].CloseTimestamp),
+		LatestCloseTime:   common.Int64Ptr(s.visibilityRecords[3].CloseTimestamp),
+		PageSize:          testPageSize,
+	}
+	response, err := visibilityArchiver.Get(context.Background(), "file://"+s.testGetDirectory, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 2)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[3]), response.Executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), response.Executions[1])
+
+	request.CloseStatus = nil
+	request.WorkflowTypeName = common.StringPtr("some-other-workflow-type")
+	response, err = visibilityArchiver.Get(context.Background(), "file://"+s.testGetDirectory, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Empty(response.Executions)
+
+	request.WorkflowTypeName = nil
+	request.WorkflowIDPrefix = common.StringPtr(testWorkflowID[:len(testWorkflowID)-1])
+	response, err = visibilityArchiver.Get(context.Background(), "file://"+s.testGetDirectory, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 3)
+
+	request.WorkflowIDPrefix = common.StringPtr("some-other-workflow-id")
+	response, err = visibilityArchiver.Get(context.Background(), "file://"+s.testGetDirectory, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Empty(response.Executions)
+}
+
+func (s *visibilityArchiverSuite) TestGet_Success_SmallPageSize() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.GetVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 2,
+	}
+	var executions []*shared.WorkflowExecutionInfo
+	for {
+		response, err := visibilityArchiver.Get(context.Background(), "file://"+s.testGetDirectory, request)
+		s.NoError(err)
+		s.True(len(response.Executions) <= 2)
+		executions = append(executions, response.Executions...)
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Len(executions, len(s.visibilityRecords))
+	for i, execution := range executions {
+		s.Equal(convertToExecutionInfo(s.visibilityRecords[len(s.visibilityRecords)-1-i]), execution)
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchiveAndGet() {
+	dir, err := ioutil.TempDir("", "TestArchiveAndGetVisibility")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := s.newTestArchiveVisibilityRequest(testRunID, time.Now().UnixNano())
+	err = visibilityArchiver.Archive(context.Background(), "file://"+dir, request)
+	s.NoError(err)
+
+	response, err := visibilityArchiver.Get(context.Background(), "file://"+dir, &archiver.GetVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: testPageSize,
+	})
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.Equal(convertToExecutionInfo(request), response.Executions[0])
+}
+
+func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
+	config := &config.FilestoreVisibilityArchiver{
+		FileMode: "0600",
+		DirMode:  "0700",
+	}
+	archiver, err := newVisibilityArchiver(s.container, config)
+	s.NoError(err)
+	return archiver
+}
+
+func (s *visibilityArchiverSuite) newTestArchiveVisibilityRequest(runID string, closeTimestamp int64) *archiver.ArchiveVisibilityRequest {
+	return &archiver.ArchiveVisibilityRequest{
+		DomainID:           testDomainID,
+		DomainName:         testDomainName,
+		WorkflowID:         testWorkflowID,
+		RunID:              runID,
+		WorkflowTypeName:   testWorkflowTypeName,
+		StartTimestamp:     closeTimestamp - int64(time.Hour),
+		ExecutionTimestamp: closeTimestamp - int64(time.Hour),
+		CloseTimestamp:     closeTimestamp,
+		CloseStatus:        shared.WorkflowExecutionCloseStatusCompleted,
+		HistoryLength:      testNextEventID - 1,
+	}
+}
+
+func (s *visibilityArchiverSuite) setupVisibilityDirectory() {
+	closeTimestamp := time.Now().UnixNano()
+	for i := 0; i < 5; i++ {
+		record := s.newTestArchiveVisibilityRequest(testRunID+"-"+string('a'+rune(i)), closeTimestamp+int64(i))
+		if i%2 == 1 {
+			record.CloseStatus = shared.WorkflowExecutionCloseStatusFailed
+		}
+		s.visibilityRecords = append(s.visibilityRecords, record)
+		s.writeVisibilityRecordForGetTest(record)
+	}
+}
+
+func (s *visibilityArchiverSuite) writeVisibilityRecordForGetTest(record *archiver.ArchiveVisibilityRequest) {
+	data, err := encodeVisibilityRecord(record)
+	s.Require().NoError(err)
+	dirPath := path.Join(s.testGetDirectory, record.DomainID)
+	s.Require().NoError(mkdirAll(dirPath, testDirMode))
+	filename := constructVisibilityFilename(record.CloseTimestamp, record.WorkflowID, record.RunID)
+	err = writeFile(path.Join(dirPath, filename), data, testFileMode)
+	s.Require().NoError(err)
+}
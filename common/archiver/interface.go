@@ -86,19 +86,48 @@ type (
 		ValidateURI(URI string) error
 	}
 
-	// ycyang TODO: implement visibility archiver
-
-	// ArchiveVisibilityRequest is request to Archive
-	ArchiveVisibilityRequest struct{}
+	// ArchiveVisibilityRequest is request to Archive a single closed workflow visibility record
+	ArchiveVisibilityRequest struct {
+		DomainID           string
+		DomainName         string
+		WorkflowID         string
+		RunID              string
+		WorkflowTypeName   string
+		StartTimestamp     int64
+		ExecutionTimestamp int64
+		CloseTimestamp     int64
+		CloseStatus        shared.WorkflowExecutionCloseStatus
+		HistoryLength      int64
+		Memo               *shared.Memo
+		SearchAttributes   map[string][]byte
+	}
 
 	// GetVisibilityRequest is the request to Get archived visibility records
-	GetVisibilityRequest struct{}
+	// All filters are optional, and records are returned in descending order of close time
+	GetVisibilityRequest struct {
+		DomainID          string
+		WorkflowIDPrefix  *string
+		WorkflowTypeName  *string
+		CloseStatus       *shared.WorkflowExecutionCloseStatus
+		EarliestCloseTime *int64
+		LatestCloseTime   *int64
+		PageSize          int
+		NextPageToken     []byte
+	}
 
 	// GetVisibilityResponse is the response of Get archived visibility records
-	GetVisibilityResponse struct{}
+	GetVisibilityResponse struct {
+		Executions    []*shared.WorkflowExecutionInfo
+		NextPageToken []byte
+	}
 
 	// VisibilityBootstrapContainer contains components needed by all visibility Archiver implementations
-	VisibilityBootstrapContainer struct{}
+	VisibilityBootstrapContainer struct {
+		Logger          log.Logger
+		MetricsClient   metrics.Client
+		ClusterMetadata cluster.Metadata
+		DomainCache     cache.DomainCache
+	}
 
 	// VisibilityArchiver is used to archive visibility and read archived visibility
 	VisibilityArchiver interface {
@@ -25,6 +25,7 @@ import (
 
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/filestore"
+	"github.com/uber/cadence/common/archiver/s3store"
 	"github.com/uber/cadence/common/service/config"
 )
 
@@ -115,6 +116,16 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (archi
 		}
 		p.historyArchivers[archiverKey] = historyArchiver
 		return historyArchiver, nil
+	case s3store.URIScheme:
+		if p.historyArchiverConfigs.S3store == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		historyArchiver, err := s3store.NewHistoryArchiver(*container, p.historyArchiverConfigs.S3store)
+		if err != nil {
+			return nil, err
+		}
+		p.historyArchivers[archiverKey] = historyArchiver
+		return historyArchiver, nil
 	}
 	return nil, ErrUnknownScheme
 }
@@ -135,8 +146,12 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 		if p.visibilityArchiverConfigs.Filestore == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
-		p.visibilityArchivers[archiverKey] = filestore.NewVisibilityArchiver(*container, p.visibilityArchiverConfigs.Filestore)
-		return p.visibilityArchivers[archiverKey], nil
+		visibilityArchiver, err := filestore.NewVisibilityArchiver(*container, p.visibilityArchiverConfigs.Filestore)
+		if err != nil {
+			return nil, err
+		}
+		p.visibilityArchivers[archiverKey] = visibilityArchiver
+		return visibilityArchiver, nil
 	}
 	return nil, ErrUnknownScheme
 }
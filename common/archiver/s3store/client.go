@@ -0,0 +1,137 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/uber/cadence/common/service/config"
+)
+
+var (
+	errEmptyRegion    = errors.New("region is empty")
+	errObjectNotFound = errors.New("object does not exist")
+)
+
+type (
+	// objectStore is the subset of the S3 API used by the archiver
+	objectStore interface {
+		PutObject(ctx context.Context, bucket, key string, body []byte) error
+		GetObject(ctx context.Context, bucket, key string) ([]byte, error)
+		ListObjects(ctx context.Context, bucket, prefix string) ([]string, error)
+	}
+
+	// s3Client is the objectStore backed by the AWS SDK
+	s3Client struct {
+		s3 s3iface.S3API
+	}
+)
+
+// newS3Client creates a client of the s3 compatible service. Credentials are looked up by
+// the default credential chain of the AWS SDK: the environment variables, the shared
+// credentials file, then the IAM role of the EC2 instance or ECS task
+func newS3Client(config *config.S3HistoryArchiver) (*s3Client, error) {
+	if len(config.Region) == 0 {
+		return nil, errEmptyRegion
+	}
+
+	awsConfig := aws.NewConfig().
+		WithRegion(config.Region).
+		WithS3ForcePathStyle(config.S3ForcePathStyle)
+	if len(config.Endpoint) != 0 {
+		awsConfig = awsConfig.WithEndpoint(config.Endpoint)
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &s3Client{
+		s3: s3.New(sess),
+	}, nil
+}
+
+func (c *s3Client) PutObject(ctx context.Context, bucket, key string, body []byte) error {
+	_, err := c.s3.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(body),
+	})
+	return err
+}
+
+func (c *s3Client) GetObject(ctx context.Context, bucket, key string) ([]byte, error) {
+	resp, err := c.s3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, errObjectNotFound
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (c *s3Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	var keys []string
+	err := c.s3.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			keys = append(keys, aws.StringValue(object.Key))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func isNotFoundError(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == s3.ErrCodeNoSuchKey
+}
+
+// isRetryableError returns false for errors which will not go away by retrying the request,
+// e.g. access denied or a missing bucket. The SDK has already retried throttled requests and
+// server errors by the time they are returned
+func isRetryableError(err error) bool {
+	reqErr, ok := err.(awserr.RequestFailure)
+	if !ok {
+		return true
+	}
+	return reqErr.StatusCode() >= http.StatusInternalServerError ||
+		reqErr.StatusCode() == http.StatusRequestTimeout ||
+		reqErr.StatusCode() == http.StatusTooManyRequests
+}
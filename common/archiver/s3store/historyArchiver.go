@@ -0,0 +1,319 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// S3 History Archiver will archive workflow histories to an S3 compatible object store.
+// The location is specified by the URI which has the form s3://bucket/optional/key/prefix.
+
+// Each Archive() request uploads every history blob read from persistence as a separate object
+// named prefix/domainID/workflowID/runID/closeFailoverVersion/partIdx.history, followed by a
+// manifest object under the same closeFailoverVersion which records the number of parts. A
+// history is only visible to Get() once its manifest exists, so a partially archived history
+// is never returned. Workflow histories stored in the objects are encoded in JSON format.
+
+// The Get() method retrieves the archived histories from the bucket specified in the URI.
+// It optionally takes in a NextPageToken which specifies the workflow close failover version
+// and the position of the first history batch that should be returned. Instead of NextPageToken,
+// caller can also provide a close failover version, in which case, Get() method will return
+// history batches starting from the beginning of that history version. If neither of NextPageToken
+// or close failover version is specified, the highest archived close failover version will be picked.
+
+package s3store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// URIScheme is the scheme for the s3 implementation
+	URIScheme = "s3"
+
+	errEncodeHistory  = "failed to encode history batches"
+	errEncodeManifest = "failed to encode history manifest"
+	errWriteObject    = "failed to write history object"
+
+	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
+)
+
+type (
+	historyArchiver struct {
+		container archiver.HistoryBootstrapContainer
+		store     objectStore
+
+		// only set in test code
+		historyIterator archiver.HistoryIterator
+	}
+
+	getHistoryToken struct {
+		CloseFailoverVersion int64
+		NextPartIdx          int
+		NextBatchIdx         int
+	}
+)
+
+// NewHistoryArchiver creates a new archiver.HistoryArchiver based on an s3 compatible object store
+func NewHistoryArchiver(
+	container archiver.HistoryBootstrapContainer,
+	config *config.S3HistoryArchiver,
+) (archiver.HistoryArchiver, error) {
+	store, err := newS3Client(config)
+	if err != nil {
+		return nil, err
+	}
+	return newHistoryArchiver(container, store, nil), nil
+}
+
+func newHistoryArchiver(
+	container archiver.HistoryBootstrapContainer,
+	store objectStore,
+	historyIterator archiver.HistoryIterator,
+) *historyArchiver {
+	return &historyArchiver{
+		container:       container,
+		store:           store,
+		historyIterator: historyIterator,
+	}
+}
+
+func (h *historyArchiver) Archive(
+	ctx context.Context,
+	URI string,
+	request *archiver.ArchiveHistoryRequest,
+	opts ...archiver.ArchiveOption,
+) error {
+	logger := tagLoggerWithArchiveHistoryRequest(h.container.Logger, request)
+
+	bucket, prefix, err := parseURI(URI)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrInvalidURI), tag.Error(err), tag.ArchivalURI(URI))
+		return archiver.ErrArchiveNonRetriable
+	}
+
+	if err := validateArchiveRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrInvalidArchiveRequest), tag.Error(err))
+		return archiver.ErrArchiveNonRetriable
+	}
+
+	historyIterator := h.historyIterator
+	if historyIterator == nil { // will only be set by testing code
+		historyIterator, err = archiver.NewHistoryIterator(request, h.container.HistoryManager, h.container.HistoryV2Manager, targetHistoryBlobSize, nil, nil)
+		if err != nil {
+			// this should not happen
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrConstructHistoryIterator), tag.Error(err))
+			return archiver.ErrArchiveNonRetriable
+		}
+	}
+
+	partIdx := 0
+	for historyIterator.HasNext() {
+		historyBlob, err := getNextHistoryBlob(ctx, historyIterator)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReadHistory), tag.Error(err))
+			return archiver.ErrArchiveNonRetriable
+		}
+
+		if historyMutated(request, historyBlob.Body, *historyBlob.Header.IsLast) {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrHistoryMutated))
+			return archiver.ErrArchiveNonRetriable
+		}
+
+		encodedHistoryBatches, err := encodeHistoryBatches(historyBlob.Body)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return archiver.ErrArchiveNonRetriable
+		}
+
+		key := constructHistoryPartKey(prefix, request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, partIdx)
+		if err := h.putObject(ctx, logger, bucket, key, encodedHistoryBatches); err != nil {
+			return err
+		}
+		partIdx++
+	}
+
+	encodedManifest, err := encodeHistoryManifest(&historyManifest{PartCount: partIdx})
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeManifest), tag.Error(err))
+		return archiver.ErrArchiveNonRetriable
+	}
+	key := constructHistoryManifestKey(prefix, request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	return h.putObject(ctx, logger, bucket, key, encodedManifest)
+}
+
+func (h *historyArchiver) Get(
+	ctx context.Context,
+	URI string,
+	request *archiver.GetHistoryRequest,
+) (*archiver.GetHistoryResponse, error) {
+	bucket, prefix, err := parseURI(URI)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", archiver.ErrInvalidURI, err)
+	}
+
+	if err := validateGetRequest(request); err != nil {
+		return nil, archiver.ErrInvalidGetHistoryRequest
+	}
+
+	var token *getHistoryToken
+	if request.NextPageToken != nil {
+		token, err = deserializeGetHistoryToken(request.NextPageToken)
+		if err != nil {
+			return nil, archiver.ErrGetHistoryTokenCorrupted
+		}
+	} else if request.CloseFailoverVersion != nil {
+		token = &getHistoryToken{
+			CloseFailoverVersion: *request.CloseFailoverVersion,
+		}
+	} else {
+		highestVersion, err := h.getHighestVersion(ctx, bucket, prefix, request)
+		if err != nil {
+			return nil, err
+		}
+		token = &getHistoryToken{
+			CloseFailoverVersion: *highestVersion,
+		}
+	}
+
+	manifestKey := constructHistoryManifestKey(prefix, request.DomainID, request.WorkflowID, request.RunID, token.CloseFailoverVersion)
+	encodedManifest, err := h.store.GetObject(ctx, bucket, manifestKey)
+	if err != nil {
+		if err == errObjectNotFound {
+			return nil, archiver.ErrHistoryNotExist
+		}
+		return nil, err
+	}
+	manifest, err := decodeHistoryManifest(encodedManifest)
+	if err != nil {
+		return nil, err
+	}
+	if token.NextPartIdx < 0 || token.NextBatchIdx < 0 || (manifest.PartCount != 0 && token.NextPartIdx >= manifest.PartCount) {
+		return nil, archiver.ErrGetHistoryTokenCorrupted
+	}
+
+	response := &archiver.GetHistoryResponse{}
+	numOfEvents := 0
+	for token.NextPartIdx < manifest.PartCount && numOfEvents < request.PageSize {
+		partKey := constructHistoryPartKey(prefix, request.DomainID, request.WorkflowID, request.RunID, token.CloseFailoverVersion, token.NextPartIdx)
+		encodedHistoryBatches, err := h.store.GetObject(ctx, bucket, partKey)
+		if err != nil {
+			return nil, err
+		}
+		historyBatches, err := decodeHistoryBatches(encodedHistoryBatches)
+		if err != nil {
+			return nil, err
+		}
+		if token.NextBatchIdx > len(historyBatches) {
+			return nil, archiver.ErrGetHistoryTokenCorrupted
+		}
+
+		for _, batch := range historyBatches[token.NextBatchIdx:] {
+			response.HistoryBatches = append(response.HistoryBatches, batch)
+			token.NextBatchIdx++
+			numOfEvents += len(batch.Events)
+			if numOfEvents >= request.PageSize {
+				break
+			}
+		}
+		if token.NextBatchIdx == len(historyBatches) {
+			token.NextPartIdx++
+			token.NextBatchIdx = 0
+		}
+	}
+
+	if token.NextPartIdx < manifest.PartCount {
+		nextToken, err := serializeGetHistoryToken(token)
+		if err != nil {
+			return nil, err
+		}
+		response.NextPageToken = nextToken
+	}
+
+	return response, nil
+}
+
+func (h *historyArchiver) ValidateURI(URI string) error {
+	_, _, err := parseURI(URI)
+	return err
+}
+
+func (h *historyArchiver) putObject(ctx context.Context, logger log.Logger, bucket, key string, data []byte) error {
+	err := h.store.PutObject(ctx, bucket, key, data)
+	if err == nil {
+		return nil
+	}
+	if !isRetryableError(err) {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errWriteObject), tag.Error(err))
+		return archiver.ErrArchiveNonRetriable
+	}
+	logger.Warn(errWriteObject, tag.Error(err))
+	return err
+}
+
+func (h *historyArchiver) getHighestVersion(
+	ctx context.Context,
+	bucket string,
+	prefix string,
+	request *archiver.GetHistoryRequest,
+) (*int64, error) {
+	keyPrefix := constructHistoryKeyPrefix(prefix, request.DomainID, request.WorkflowID, request.RunID)
+	keys, err := h.store.ListObjects(ctx, bucket, keyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var highestVersion *int64
+	for _, key := range keys {
+		version, err := extractCloseFailoverVersion(keyPrefix, key)
+		if err != nil {
+			continue
+		}
+		if highestVersion == nil || version > *highestVersion {
+			highestVersion = common.Int64Ptr(version)
+		}
+	}
+	if highestVersion == nil {
+		return nil, archiver.ErrHistoryNotExist
+	}
+	return highestVersion, nil
+}
+
+func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIterator) (*archiver.HistoryBlob, error) {
+	historyBlob, err := historyIterator.Next()
+	op := func() error {
+		historyBlob, err = historyIterator.Next()
+		return err
+	}
+	for err != nil {
+		if !common.IsPersistenceTransientError(err) {
+			return nil, err
+		}
+		if contextExpired(ctx) {
+			return nil, archiver.ErrContextTimeout
+		}
+		err = backoff.Retry(op, common.CreatePersistanceRetryPolicy(), common.IsPersistenceTransientError)
+	}
+	return historyBlob, nil
+}
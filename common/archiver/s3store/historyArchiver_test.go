@@ -0,0 +1,552 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/zap"
+)
+
+const (
+	testDomainID             = "test-domain-id"
+	testDomainName           = "test-domain-name"
+	testWorkflowID           = "test/workflow id"
+	testRunID                = "test-run-id"
+	testNextEventID          = 1800
+	testCloseFailoverVersion = 100
+	testPageSize             = 100
+
+	testURI = "s3://" + testBucket + "/archival/history"
+)
+
+var (
+	testBranchToken = []byte{1, 2, 3}
+)
+
+type historyArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	container          archiver.HistoryBootstrapContainer
+	store              *fakeObjectStore
+	historyBatchesV1   []*shared.History
+	historyBatchesV100 []*shared.History
+}
+
+func TestHistoryArchiverSuite(t *testing.T) {
+	suite.Run(t, new(historyArchiverSuite))
+}
+
+func (s *historyArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	zapLogger := zap.NewNop()
+	s.container = archiver.HistoryBootstrapContainer{
+		Logger: loggerimpl.NewLogger(zapLogger),
+	}
+	s.store = newFakeObjectStore(testBucket)
+	s.setupHistoryBatches()
+}
+
+func (s *historyArchiverSuite) TestNewHistoryArchiver_InvalidConfig() {
+	historyArchiver, err := NewHistoryArchiver(s.container, &config.S3HistoryArchiver{})
+	s.Nil(historyArchiver)
+	s.Equal(errEmptyRegion, err)
+}
+
+func (s *historyArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme:///a/b/c",
+			expectedErr: archiver.ErrInvalidURIScheme,
+		},
+		{
+			URI:         "",
+			expectedErr: archiver.ErrInvalidURIScheme,
+		},
+		{
+			URI:         "s3://",
+			expectedErr: errEmptyBucket,
+		},
+		{
+			URI:         "s3:///a/b",
+			expectedErr: errEmptyBucket,
+		},
+		{
+			URI:         "s3://Invalid_Bucket",
+			expectedErr: errInvalidBucket,
+		},
+		{
+			URI:         "s3://a..b",
+			expectedErr: errInvalidBucket,
+		},
+		{
+			URI:         "s3://some-bucket",
+			expectedErr: nil,
+		},
+		{
+			URI:         "s3://some-bucket/a/b/c",
+			expectedErr: nil,
+		},
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	for _, tc := range testCases {
+		s.Equal(tc.expectedErr, historyArchiver.ValidateURI(tc.URI))
+	}
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_InvalidURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	err := historyArchiver.Archive(context.Background(), "wrongscheme://", s.newArchiveRequest())
+	s.Equal(archiver.ErrArchiveNonRetriable, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newArchiveRequest()
+	request.WorkflowID = "" // an invalid request
+	err := historyArchiver.Archive(context.Background(), testURI, request)
+	s.Equal(archiver.ErrArchiveNonRetriable, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_ErrorOnReadHistory() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(nil, errors.New("some random error")),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), testURI, s.newArchiveRequest())
+	s.Equal(archiver.ErrArchiveNonRetriable, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_TimeoutWhenReadingHistory() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(nil, &shared.ServiceBusyError{}),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(getCanceledContext(), testURI, s.newArchiveRequest())
+	s.Equal(archiver.ErrArchiveNonRetriable, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_HistoryMutated() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBatches := []*shared.History{
+		&shared.History{
+			Events: []*shared.HistoryEvent{
+				&shared.HistoryEvent{
+					EventId:   common.Int64Ptr(common.FirstEventID + 1),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(testCloseFailoverVersion + 1),
+				},
+			},
+		},
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(newHistoryBlob(historyBatches, true), nil),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), testURI, s.newArchiveRequest())
+	s.Equal(archiver.ErrArchiveNonRetriable, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_BucketNotExist() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(newHistoryBlob(s.historyBatchesV100, true), nil),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), "s3://some-other-bucket", s.newArchiveRequest())
+	s.Equal(archiver.ErrArchiveNonRetriable, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_TransientStoreError() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(newHistoryBlob(s.historyBatchesV100, true), nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	manifestKey := constructHistoryManifestKey("archival/history", testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	s.store.errors[manifestKey] = awserr.NewRequestFailure(awserr.New("SlowDown", "", nil), http.StatusServiceUnavailable, "")
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), testURI, s.newArchiveRequest())
+	s.Error(err)
+	s.NotEqual(archiver.ErrArchiveNonRetriable, err)
+
+	// history must not be readable before the manifest is written
+	response, err := historyArchiver.Get(context.Background(), testURI, s.newGetRequest())
+	s.Nil(response)
+	s.Equal(archiver.ErrHistoryNotExist, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Success() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(newHistoryBlob(s.historyBatchesV100[:1], false), nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(newHistoryBlob(s.historyBatchesV100[1:], true), nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), testURI, s.newArchiveRequest())
+	s.NoError(err)
+
+	for _, key := range []string{
+		constructHistoryPartKey("archival/history", testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion, 0),
+		constructHistoryPartKey("archival/history", testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion, 1),
+		constructHistoryManifestKey("archival/history", testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion),
+	} {
+		s.Contains(s.store.objects, key)
+	}
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	response, err := historyArchiver.Get(context.Background(), "wrongscheme://", s.newGetRequest())
+	s.Nil(response)
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newGetRequest()
+	request.PageSize = 0 // pageSize should be greater than 0
+	response, err := historyArchiver.Get(context.Background(), testURI, request)
+	s.Nil(response)
+	s.Equal(archiver.ErrInvalidGetHistoryRequest, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidToken() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newGetRequest()
+	request.NextPageToken = []byte{'r', 'a', 'n', 'd', 'o', 'm'}
+	response, err := historyArchiver.Get(context.Background(), testURI, request)
+	s.Nil(response)
+	s.Equal(archiver.ErrGetHistoryTokenCorrupted, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_HistoryNotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	response, err := historyArchiver.Get(context.Background(), testURI, s.newGetRequest())
+	s.Nil(response)
+	s.Equal(archiver.ErrHistoryNotExist, err)
+
+	request := s.newGetRequest()
+	request.CloseFailoverVersion = common.Int64Ptr(testCloseFailoverVersion)
+	response, err = historyArchiver.Get(context.Background(), testURI, request)
+	s.Nil(response)
+	s.Equal(archiver.ErrHistoryNotExist, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_PickHighestVersion() {
+	s.writeHistoryBatchesForGetTest()
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	response, err := historyArchiver.Get(context.Background(), testURI, s.newGetRequest())
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_UseProvidedVersion() {
+	s.writeHistoryBatchesForGetTest()
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newGetRequest()
+	request.CloseFailoverVersion = common.Int64Ptr(1)
+	response, err := historyArchiver.Get(context.Background(), testURI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV1, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_SmallPageSize() {
+	s.writeHistoryBatchesForGetTest()
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newGetRequest()
+	request.PageSize = 1
+	request.CloseFailoverVersion = common.Int64Ptr(testCloseFailoverVersion)
+	combinedHistory := []*shared.History{}
+
+	for i := 0; i != len(s.historyBatchesV100); i++ {
+		response, err := historyArchiver.Get(context.Background(), testURI, request)
+		s.NoError(err)
+		s.NotNil(response)
+		s.Len(response.HistoryBatches, 1)
+		combinedHistory = append(combinedHistory, response.HistoryBatches...)
+		if i == len(s.historyBatchesV100)-1 {
+			s.Nil(response.NextPageToken)
+		} else {
+			s.NotNil(response.NextPageToken)
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+
+	s.Equal(s.historyBatchesV100, combinedHistory)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(newHistoryBlob(s.historyBatchesV100[:2], false), nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(newHistoryBlob(s.historyBatchesV100[2:], true), nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), testURI, s.newArchiveRequest())
+	s.NoError(err)
+
+	request := s.newGetRequest()
+	request.PageSize = 3
+	response, err := historyArchiver.Get(context.Background(), testURI, request)
+	s.NoError(err)
+	s.NotNil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100[:2], response.HistoryBatches)
+
+	request.NextPageToken = response.NextPageToken
+	response, err = historyArchiver.Get(context.Background(), testURI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100[2:], response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
+	return newHistoryArchiver(s.container, s.store, historyIterator)
+}
+
+func (s *historyArchiverSuite) newArchiveRequest() *archiver.ArchiveHistoryRequest {
+	return &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+}
+
+func (s *historyArchiverSuite) newGetRequest() *archiver.GetHistoryRequest {
+	return &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	}
+}
+
+func (s *historyArchiverSuite) setupHistoryBatches() {
+	s.historyBatchesV1 = []*shared.History{
+		&shared.History{
+			Events: []*shared.HistoryEvent{
+				&shared.HistoryEvent{
+					EventId:   common.Int64Ptr(testNextEventID - 1),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(1),
+				},
+			},
+		},
+	}
+
+	s.historyBatchesV100 = []*shared.History{
+		&shared.History{
+			Events: []*shared.HistoryEvent{
+				&shared.HistoryEvent{
+					EventId:   common.Int64Ptr(common.FirstEventID),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(testCloseFailoverVersion),
+				},
+			},
+		},
+		&shared.History{
+			Events: []*shared.HistoryEvent{
+				&shared.HistoryEvent{
+					EventId:   common.Int64Ptr(common.FirstEventID + 1),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(testCloseFailoverVersion),
+				},
+				&shared.HistoryEvent{
+					EventId:   common.Int64Ptr(common.FirstEventID + 2),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(testCloseFailoverVersion),
+				},
+			},
+		},
+		&shared.History{
+			Events: []*shared.HistoryEvent{
+				&shared.HistoryEvent{
+					EventId:   common.Int64Ptr(testNextEventID - 1),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(testCloseFailoverVersion),
+				},
+			},
+		},
+	}
+}
+
+// writeHistoryBatchesForGetTest archives version 1 as a single part and version 100 as one part per batch
+func (s *historyArchiverSuite) writeHistoryBatchesForGetTest() {
+	s.writeHistoryParts([][]*shared.History{s.historyBatchesV1}, 1)
+	parts := [][]*shared.History{}
+	for _, batch := range s.historyBatchesV100 {
+		parts = append(parts, []*shared.History{batch})
+	}
+	s.writeHistoryParts(parts, testCloseFailoverVersion)
+}
+
+func (s *historyArchiverSuite) writeHistoryParts(parts [][]*shared.History, version int64) {
+	prefix := "archival/history"
+	for idx, part := range parts {
+		data, err := encodeHistoryBatches(part)
+		s.Require().NoError(err)
+		s.store.objects[constructHistoryPartKey(prefix, testDomainID, testWorkflowID, testRunID, version, idx)] = data
+	}
+	data, err := encodeHistoryManifest(&historyManifest{PartCount: len(parts)})
+	s.Require().NoError(err)
+	s.store.objects[constructHistoryManifestKey(prefix, testDomainID, testWorkflowID, testRunID, version)] = data
+}
+
+func newHistoryBlob(historyBatches []*shared.History, isLast bool) *archiver.HistoryBlob {
+	return &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(isLast),
+		},
+		Body: historyBatches,
+	}
+}
+
+func getCanceledContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+// fakeObjectStore is an in memory objectStore of a single bucket
+type fakeObjectStore struct {
+	sync.Mutex
+	bucket  string
+	objects map[string][]byte
+	// errors are returned once by the next request for their key
+	errors map[string]error
+}
+
+func newFakeObjectStore(bucket string) *fakeObjectStore {
+	return &fakeObjectStore{
+		bucket:  bucket,
+		objects: make(map[string][]byte),
+		errors:  make(map[string]error),
+	}
+}
+
+func (s *fakeObjectStore) PutObject(ctx context.Context, bucket, key string, body []byte) error {
+	s.Lock()
+	defer s.Unlock()
+	if err := s.getError(bucket, key); err != nil {
+		return err
+	}
+	s.objects[key] = body
+	return nil
+}
+
+func (s *fakeObjectStore) GetObject(ctx context.Context, bucket, key string) ([]byte, error) {
+	s.Lock()
+	defer s.Unlock()
+	if err := s.getError(bucket, key); err != nil {
+		return nil, err
+	}
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, errObjectNotFound
+	}
+	return data, nil
+}
+
+func (s *fakeObjectStore) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	s.Lock()
+	defer s.Unlock()
+	if err := s.getError(bucket, prefix); err != nil {
+		return nil, err
+	}
+	var keys []string
+	for key := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *fakeObjectStore) getError(bucket, key string) error {
+	if bucket != s.bucket {
+		return awserr.NewRequestFailure(awserr.New("NoSuchBucket", "", nil), http.StatusNotFound, "")
+	}
+	err := s.errors[key]
+	delete(s.errors, key)
+	return err
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	historyPartSuffix   = ".history"
+	historyManifestName = "manifest"
+)
+
+var (
+	errEmptyBucket   = errors.New("bucket name is empty")
+	errInvalidBucket = errors.New("bucket name is invalid")
+
+	bucketNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9.\-]{1,61}[a-z0-9]$`)
+)
+
+type (
+	// historyManifest is written after all parts of a history have been uploaded,
+	// its presence marks the archived history as complete
+	historyManifest struct {
+		PartCount int
+	}
+)
+
+func parseURI(URI string) (bucket string, prefix string, err error) {
+	if !strings.HasPrefix(URI, URIScheme+"://") {
+		return "", "", archiver.ErrInvalidURIScheme
+	}
+	path := URI[len(URIScheme+"://"):]
+	bucket = path
+	if idx := strings.Index(path, "/"); idx != -1 {
+		bucket = path[:idx]
+		prefix = strings.Trim(path[idx+1:], "/")
+	}
+	if len(bucket) == 0 {
+		return "", "", errEmptyBucket
+	}
+	if !bucketNameRegex.MatchString(bucket) || strings.Contains(bucket, "..") {
+		return "", "", errInvalidBucket
+	}
+	return bucket, prefix, nil
+}
+
+func constructHistoryKeyPrefix(prefix, domainID, workflowID, runID string) string {
+	parts := []string{domainID, url.PathEscape(workflowID), runID}
+	if len(prefix) != 0 {
+		parts = append([]string{prefix}, parts...)
+	}
+	return strings.Join(parts, "/") + "/"
+}
+
+func constructHistoryVersionPrefix(prefix, domainID, workflowID, runID string, version int64) string {
+	return constructHistoryKeyPrefix(prefix, domainID, workflowID, runID) + strconv.FormatInt(version, 10) + "/"
+}
+
+func constructHistoryPartKey(prefix, domainID, workflowID, runID string, version int64, part int) string {
+	return constructHistoryVersionPrefix(prefix, domainID, workflowID, runID, version) + strconv.Itoa(part) + historyPartSuffix
+}
+
+func constructHistoryManifestKey(prefix, domainID, workflowID, runID string, version int64) string {
+	return constructHistoryVersionPrefix(prefix, domainID, workflowID, runID, version) + historyManifestName
+}
+
+// extractCloseFailoverVersion returns the version of a manifest key listed under the run prefix
+func extractCloseFailoverVersion(keyPrefix, key string) (int64, error) {
+	if !strings.HasPrefix(key, keyPrefix) || !strings.HasSuffix(key, "/"+historyManifestName) {
+		return -1, errors.New("not a history manifest key")
+	}
+	return strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(key, keyPrefix), "/"+historyManifestName), 10, 64)
+}
+
+func encodeHistoryBatches(historyBatches []*shared.History) ([]byte, error) {
+	return json.Marshal(historyBatches)
+}
+
+func decodeHistoryBatches(data []byte) ([]*shared.History, error) {
+	historyBatches := []*shared.History{}
+	if err := json.Unmarshal(data, &historyBatches); err != nil {
+		return nil, err
+	}
+	return historyBatches, nil
+}
+
+func encodeHistoryManifest(manifest *historyManifest) ([]byte, error) {
+	return json.Marshal(manifest)
+}
+
+func decodeHistoryManifest(data []byte) (*historyManifest, error) {
+	manifest := &historyManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
+	token := &getHistoryToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+func serializeGetHistoryToken(token *getHistoryToken) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+
+	bytes, err := json.Marshal(token)
+	return bytes, err
+}
+
+func tagLoggerWithArchiveHistoryRequest(logger log.Logger, request *archiver.ArchiveHistoryRequest) log.Logger {
+	return logger.WithTags(
+		tag.ShardID(request.ShardID),
+		tag.ArchivalRequestDomainID(request.DomainID),
+		tag.ArchivalRequestDomainName(request.DomainName),
+		tag.ArchivalRequestWorkflowID(request.WorkflowID),
+		tag.ArchivalRequestRunID(request.RunID),
+		tag.ArchivalRequestEventStoreVersion(request.EventStoreVersion),
+		tag.ArchivalRequestBranchToken(request.BranchToken),
+		tag.ArchivalRequestNextEventID(request.NextEventID),
+		tag.ArchivalRequestCloseFailoverVersion(request.CloseFailoverVersion),
+	)
+}
+
+func contextExpired(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+func historyMutated(request *archiver.ArchiveHistoryRequest, historyBatches []*shared.History, isLast bool) bool {
+	lastBatch := historyBatches[len(historyBatches)-1].Events
+	lastEvent := lastBatch[len(lastBatch)-1]
+	lastFailoverVersion := lastEvent.GetVersion()
+	if lastFailoverVersion > request.CloseFailoverVersion {
+		return true
+	}
+
+	if !isLast {
+		return false
+	}
+	lastEventID := lastEvent.GetEventId()
+	return lastFailoverVersion != request.CloseFailoverVersion || lastEventID+1 != request.NextEventID
+}
+
+func validateArchiveRequest(request *archiver.ArchiveHistoryRequest) error {
+	if request.DomainID == "" {
+		return errors.New("DomainID is empty")
+	}
+	if request.WorkflowID == "" {
+		return errors.New("WorkflowID is empty")
+	}
+	if request.RunID == "" {
+		return errors.New("RunID is empty")
+	}
+	if request.DomainName == "" {
+		return errors.New("DomainName is empty")
+	}
+	return nil
+}
+
+func validateGetRequest(request *archiver.GetHistoryRequest) error {
+	if request.DomainID == "" {
+		return errors.New("DomainID is empty")
+	}
+	if request.WorkflowID == "" {
+		return errors.New("WorkflowID is empty")
+	}
+	if request.RunID == "" {
+		return errors.New("RunID is empty")
+	}
+	if request.PageSize == 0 {
+		return errors.New("PageSize should not be 0")
+	}
+	return nil
+}
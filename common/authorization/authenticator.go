@@ -0,0 +1,188 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/yarpc"
+)
+
+const (
+	// TokenHeaderName is the rpc header carrying the token which authenticates the caller
+	TokenHeaderName = "cadence-auth-token"
+
+	// tokenSeparator separates the actor, key ID, expiration and signature of a token,
+	// only the actor may contain it so tokens are parsed from the end
+	tokenSeparator = ":"
+
+	// tokenKeyIDSize is the number of bytes of the key digest identifying the key signing a token
+	tokenKeyIDSize = 6
+)
+
+type (
+	// Authenticator verifies the identity of the caller of an rpc.
+	// It returns an AccessDeniedError if the caller cannot be authenticated.
+	Authenticator interface {
+		Authenticate(ctx context.Context) (string, error)
+	}
+
+	nopAuthenticator struct{}
+
+	tokenAuthenticator struct {
+		key   []byte
+		keyID string
+	}
+
+	actorContextKey struct{}
+)
+
+var errNoToken = &shared.AccessDeniedError{Message: "caller is not authenticated, the request carries no token"}
+
+var errInvalidToken = &shared.AccessDeniedError{Message: "caller is not authenticated, the token of the request is invalid"}
+
+var errUnknownTokenKey = &shared.AccessDeniedError{Message: "caller is not authenticated, the token of the request is signed with an unknown key"}
+
+var errExpiredToken = &shared.AccessDeniedError{Message: "caller is not authenticated, the token of the request has expired"}
+
+// NewAuthenticator creates the authenticator described by config. Callers are not authenticated if config does
+// not configure any actor, since every call is allowed then, otherwise they must present a token signed with the
+// key in the token key file of config.
+func NewAuthenticator(cfg *config.Authorization) (Authenticator, error) {
+	if cfg == nil || len(cfg.Actors) == 0 {
+		return NewNopAuthenticator(), nil
+	}
+	if cfg.TokenKeyFile == "" {
+		return nil, errors.New("a token key file is required to authenticate the configured actors")
+	}
+	key, err := LoadTokenKey(cfg.TokenKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	return NewTokenAuthenticator(key), nil
+}
+
+// NewNopAuthenticator creates an authenticator which takes the caller declared by the rpc at its word,
+// it must only be used when every call is allowed
+func NewNopAuthenticator() Authenticator {
+	return &nopAuthenticator{}
+}
+
+func (a *nopAuthenticator) Authenticate(ctx context.Context) (string, error) {
+	return yarpc.CallFromContext(ctx).Caller(), nil
+}
+
+// NewTokenAuthenticator creates an authenticator which identifies the caller by the token in the
+// TokenHeaderName header of the rpc, the token must be created by NewToken with key
+func NewTokenAuthenticator(key []byte) Authenticator {
+	return &tokenAuthenticator{key: key, keyID: getTokenKeyID(key)}
+}
+
+func (a *tokenAuthenticator) Authenticate(ctx context.Context) (string, error) {
+	token := yarpc.CallFromContext(ctx).Header(TokenHeaderName)
+	if token == "" {
+		return "", errNoToken
+	}
+	// the actor is the only part which may contain the separator, so split the other parts off the end
+	parts := strings.Split(token, tokenSeparator)
+	if len(parts) < 4 {
+		return "", errInvalidToken
+	}
+	n := len(parts)
+	actor := strings.Join(parts[:n-3], tokenSeparator)
+	keyID, expiration, encodedSignature := parts[n-3], parts[n-2], parts[n-1]
+	if actor == "" {
+		return "", errInvalidToken
+	}
+	if keyID != a.keyID {
+		return "", errUnknownTokenKey
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, signToken(a.key, keyID, expiration, actor)) {
+		return "", errInvalidToken
+	}
+	expirationSeconds, err := strconv.ParseInt(expiration, 10, 64)
+	if err != nil {
+		return "", errInvalidToken
+	}
+	if !time.Now().Before(time.Unix(expirationSeconds, 0)) {
+		return "", errExpiredToken
+	}
+	return actor, nil
+}
+
+// NewToken returns the token which authenticates actor to the authenticator created with key until expiration
+func NewToken(key []byte, actor string, expiration time.Time) string {
+	keyID := getTokenKeyID(key)
+	expirationSeconds := strconv.FormatInt(expiration.Unix(), 10)
+	signature := base64.RawURLEncoding.EncodeToString(signToken(key, keyID, expirationSeconds, actor))
+	return strings.Join([]string{actor, keyID, expirationSeconds, signature}, tokenSeparator)
+}
+
+// LoadTokenKey reads the key signing the tokens from keyFile
+func LoadTokenKey(keyFile string) ([]byte, error) {
+	data, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token key file: %v", err)
+	}
+	key := []byte(strings.TrimSpace(string(data)))
+	if len(key) == 0 {
+		return nil, fmt.Errorf("token key file %v is empty", keyFile)
+	}
+	return key, nil
+}
+
+// WithActor returns a copy of ctx carrying the authenticated actor
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// GetActor returns the authenticated actor carried by ctx, it is empty if the caller is not authenticated
+func GetActor(ctx context.Context) string {
+	actor, _ := ctx.Value(actorContextKey{}).(string)
+	return actor
+}
+
+// getTokenKeyID returns the ID of the key signing tokens, so tokens signed with a rotated key are told
+// apart from forged ones without revealing the key
+func getTokenKeyID(key []byte) string {
+	digest := sha256.Sum256(key)
+	return base64.RawURLEncoding.EncodeToString(digest[:tokenKeyIDSize])
+}
+
+// signToken signs the key ID, expiration and actor of a token, the actor comes last
+// so the signed payload is unambiguous even if the actor contains the separator
+func signToken(key []byte, keyID string, expiration string, actor string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(keyID + tokenSeparator + expiration + tokenSeparator + actor))
+	return mac.Sum(nil)
+}
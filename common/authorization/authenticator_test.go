@@ -0,0 +1,144 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+)
+
+type (
+	authenticatorSuite struct {
+		suite.Suite
+		key           []byte
+		expiration    time.Time
+		authenticator Authenticator
+	}
+)
+
+func TestAuthenticatorSuite(t *testing.T) {
+	s := new(authenticatorSuite)
+	suite.Run(t, s)
+}
+
+func (s *authenticatorSuite) SetupTest() {
+	s.key = []byte("some random key")
+	s.expiration = time.Now().Add(time.Hour)
+	s.authenticator = NewTokenAuthenticator(s.key)
+}
+
+func (s *authenticatorSuite) TestAuthenticate() {
+	actor, err := s.authenticator.Authenticate(s.newContext("", NewToken(s.key, "alice", s.expiration)))
+	s.NoError(err)
+	s.Equal("alice", actor)
+
+	actor, err = s.authenticator.Authenticate(s.newContext("", NewToken(s.key, "team:alice", s.expiration)))
+	s.NoError(err)
+	s.Equal("team:alice", actor)
+}
+
+func (s *authenticatorSuite) TestAuthenticate_NoToken() {
+	_, err := s.authenticator.Authenticate(context.Background())
+	s.IsType(&shared.AccessDeniedError{}, err)
+
+	_, err = s.authenticator.Authenticate(s.newContext("alice", ""))
+	s.IsType(&shared.AccessDeniedError{}, err)
+}
+
+func (s *authenticatorSuite) TestAuthenticate_InvalidToken() {
+	token := NewToken(s.key, "alice", s.expiration)
+	parts := strings.Split(token, tokenSeparator)
+	laterParts := strings.Split(NewToken(s.key, "alice", s.expiration.Add(time.Hour)), tokenSeparator)
+	for _, invalidToken := range []string{
+		"alice",
+		token[len("alice"):],
+		"mallory" + token[len("alice"):],
+		token + "x",
+		strings.Join([]string{parts[0], parts[1], laterParts[2], parts[3]}, tokenSeparator),
+		strings.Join([]string{parts[0], parts[1], parts[3]}, tokenSeparator),
+	} {
+		_, err := s.authenticator.Authenticate(s.newContext("", invalidToken))
+		s.Equal(errInvalidToken, err, invalidToken)
+	}
+}
+
+func (s *authenticatorSuite) TestAuthenticate_UnknownKey() {
+	_, err := s.authenticator.Authenticate(s.newContext("", NewToken([]byte("some other key"), "alice", s.expiration)))
+	s.Equal(errUnknownTokenKey, err)
+}
+
+func (s *authenticatorSuite) TestAuthenticate_ExpiredToken() {
+	_, err := s.authenticator.Authenticate(s.newContext("", NewToken(s.key, "alice", time.Now().Add(-time.Second))))
+	s.Equal(errExpiredToken, err)
+}
+
+func (s *authenticatorSuite) TestNewAuthenticator() {
+	authenticator, err := NewAuthenticator(&config.Authorization{})
+	s.NoError(err)
+	actor, err := authenticator.Authenticate(s.newContext("alice", ""))
+	s.NoError(err)
+	s.Equal("alice", actor)
+
+	actors := map[string]map[string]string{"alice": {"orders": "reader"}}
+	_, err = NewAuthenticator(&config.Authorization{Actors: actors})
+	s.Error(err)
+
+	keyFile, err := ioutil.TempFile("", "token-key")
+	s.Require().NoError(err)
+	defer os.Remove(keyFile.Name())
+	_, err = keyFile.WriteString(string(s.key) + "\n")
+	s.Require().NoError(err)
+	s.Require().NoError(keyFile.Close())
+
+	authenticator, err = NewAuthenticator(&config.Authorization{Actors: actors, TokenKeyFile: keyFile.Name()})
+	s.NoError(err)
+	actor, err = authenticator.Authenticate(s.newContext("", NewToken(s.key, "alice", s.expiration)))
+	s.NoError(err)
+	s.Equal("alice", actor)
+	_, err = authenticator.Authenticate(s.newContext("alice", ""))
+	s.IsType(&shared.AccessDeniedError{}, err)
+}
+
+func (s *authenticatorSuite) TestGetActor() {
+	s.Equal("", GetActor(context.Background()))
+	s.Equal("", GetActor(s.newContext("alice", "")))
+	s.Equal("alice", GetActor(WithActor(context.Background(), "alice")))
+}
+
+func (s *authenticatorSuite) newContext(caller string, token string) context.Context {
+	headers := transport.NewHeaders()
+	if token != "" {
+		headers = headers.With(TokenHeaderName, token)
+	}
+	ctx, call := encoding.NewInboundCall(context.Background())
+	s.Require().NoError(call.ReadFromRequest(&transport.Request{Caller: caller, Headers: headers}))
+	return ctx
+}
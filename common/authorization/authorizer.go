@@ -0,0 +1,84 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	// Role is a level of access to a domain, each role grants the access of the roles below it
+	Role int
+
+	// Attributes describes a call to be authorized
+	Attributes struct {
+		// Actor is the identity of the caller
+		Actor string
+		// APIName is the name of the API called
+		APIName string
+		// DomainName is the domain the API operates on, it is empty for APIs which are not scoped to a domain
+		DomainName string
+		// WorkflowID is the workflow the API operates on, it is empty for APIs which do not target a workflow
+		WorkflowID string
+		// Role is the role the API requires on the domain
+		Role Role
+	}
+
+	// Authorizer decides whether a call is allowed.
+	// It returns an AccessDeniedError if the call is denied, and any other error if it cannot decide.
+	Authorizer interface {
+		Authorize(ctx context.Context, attributes *Attributes) error
+	}
+)
+
+const (
+	// RoleReader can call APIs which only read from a domain
+	RoleReader Role = iota + 1
+	// RoleWriter can additionally start, signal, cancel, terminate and reset workflows, and process their tasks
+	RoleWriter
+	// RoleAdmin can additionally register and update a domain, and call admin APIs
+	RoleAdmin
+)
+
+var roleNames = map[Role]string{
+	RoleReader: "reader",
+	RoleWriter: "writer",
+	RoleAdmin:  "admin",
+}
+
+// String returns the name of the role as used in config
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return "none"
+}
+
+// NewAuthorizer creates the authorizer described by config, calls are allowed unconditionally
+// if config does not configure any actor
+func NewAuthorizer(cfg *config.Authorization) (Authorizer, error) {
+	if cfg == nil || len(cfg.Actors) == 0 {
+		return NewNopAuthorizer(), nil
+	}
+	return NewRoleBasedAuthorizer(cfg)
+}
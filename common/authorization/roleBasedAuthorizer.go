@@ -0,0 +1,97 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// Wildcard matches every actor or every domain in config
+	Wildcard = "*"
+)
+
+type (
+	roleBasedAuthorizer struct {
+		// actor -> domain -> role
+		roles map[string]map[string]Role
+	}
+)
+
+// NewRoleBasedAuthorizer creates an authorizer which allows a call if the role of its actor in its domain
+// is at least the role its API requires
+func NewRoleBasedAuthorizer(cfg *config.Authorization) (Authorizer, error) {
+	roles := make(map[string]map[string]Role, len(cfg.Actors))
+	for actor, domains := range cfg.Actors {
+		roles[actor] = make(map[string]Role, len(domains))
+		for domain, name := range domains {
+			role, err := parseRole(name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid role of actor %v in domain %v: %v", actor, domain, err)
+			}
+			roles[actor][domain] = role
+		}
+	}
+	return &roleBasedAuthorizer{roles: roles}, nil
+}
+
+func (a *roleBasedAuthorizer) Authorize(ctx context.Context, attributes *Attributes) error {
+	role := a.getRole(attributes.Actor, attributes.DomainName)
+	if wildcardRole := a.getRole(Wildcard, attributes.DomainName); wildcardRole > role {
+		role = wildcardRole
+	}
+	if role >= attributes.Role {
+		return nil
+	}
+
+	domain := attributes.DomainName
+	if domain == "" {
+		domain = Wildcard
+	}
+	return &shared.AccessDeniedError{
+		Message: fmt.Sprintf("caller %q with role %v in domain %v is not allowed to call %v, which requires role %v",
+			attributes.Actor, role, domain, attributes.APIName, attributes.Role),
+	}
+}
+
+func (a *roleBasedAuthorizer) getRole(actor string, domain string) Role {
+	domains, ok := a.roles[actor]
+	if !ok {
+		return 0
+	}
+	if role, ok := domains[domain]; ok && domain != "" {
+		return role
+	}
+	return domains[Wildcard]
+}
+
+func parseRole(name string) (Role, error) {
+	for role, roleName := range roleNames {
+		if name == roleName {
+			return role, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown role %q", name)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	roleBasedAuthorizerSuite struct {
+		suite.Suite
+		authorizer Authorizer
+	}
+)
+
+func TestRoleBasedAuthorizerSuite(t *testing.T) {
+	s := new(roleBasedAuthorizerSuite)
+	suite.Run(t, s)
+}
+
+func (s *roleBasedAuthorizerSuite) SetupTest() {
+	var err error
+	s.authorizer, err = NewRoleBasedAuthorizer(&config.Authorization{
+		Actors: map[string]map[string]string{
+			"alice": {
+				"orders":   "writer",
+				"payments": "reader",
+			},
+			"ops": {
+				Wildcard: "admin",
+			},
+			"bob": {
+				Wildcard:   "reader",
+				"payments": "admin",
+			},
+			Wildcard: {
+				"public": "reader",
+			},
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *roleBasedAuthorizerSuite) TestAuthorize_DomainRole() {
+	s.NoError(s.authorize("alice", "orders", RoleReader))
+	s.NoError(s.authorize("alice", "orders", RoleWriter))
+	s.assertDenied(s.authorize("alice", "orders", RoleAdmin))
+
+	s.NoError(s.authorize("alice", "payments", RoleReader))
+	s.assertDenied(s.authorize("alice", "payments", RoleWriter))
+
+	s.assertDenied(s.authorize("alice", "shipping", RoleReader))
+}
+
+func (s *roleBasedAuthorizerSuite) TestAuthorize_WildcardDomain() {
+	s.NoError(s.authorize("ops", "orders", RoleAdmin))
+	s.NoError(s.authorize("ops", "", RoleAdmin))
+
+	// a domain entry takes precedence over the wildcard domain entry of the same actor
+	s.NoError(s.authorize("bob", "payments", RoleAdmin))
+	s.NoError(s.authorize("bob", "orders", RoleReader))
+	s.assertDenied(s.authorize("bob", "orders", RoleWriter))
+}
+
+func (s *roleBasedAuthorizerSuite) TestAuthorize_NoDomain() {
+	// calls which are not scoped to a domain require the role in every domain
+	s.assertDenied(s.authorize("alice", "", RoleReader))
+	s.NoError(s.authorize("bob", "", RoleReader))
+	s.assertDenied(s.authorize("bob", "", RoleAdmin))
+}
+
+func (s *roleBasedAuthorizerSuite) TestAuthorize_WildcardActor() {
+	s.NoError(s.authorize("", "public", RoleReader))
+	s.NoError(s.authorize("mallory", "public", RoleReader))
+	s.assertDenied(s.authorize("mallory", "public", RoleWriter))
+	s.assertDenied(s.authorize("mallory", "orders", RoleReader))
+	s.NoError(s.authorize("alice", "public", RoleReader))
+}
+
+func (s *roleBasedAuthorizerSuite) TestNewRoleBasedAuthorizer_UnknownRole() {
+	_, err := NewRoleBasedAuthorizer(&config.Authorization{
+		Actors: map[string]map[string]string{
+			"alice": {"orders": "owner"},
+		},
+	})
+	s.Error(err)
+}
+
+func (s *roleBasedAuthorizerSuite) TestNewAuthorizer() {
+	authorizer, err := NewAuthorizer(&config.Authorization{})
+	s.NoError(err)
+	s.NoError(authorizer.Authorize(context.Background(), &Attributes{Actor: "mallory", Role: RoleAdmin}))
+
+	authorizer, err = NewAuthorizer(&config.Authorization{
+		Actors: map[string]map[string]string{"alice": {"orders": "reader"}},
+	})
+	s.NoError(err)
+	s.assertDenied(authorizer.Authorize(context.Background(), &Attributes{Actor: "mallory", DomainName: "orders", Role: RoleReader}))
+}
+
+func (s *roleBasedAuthorizerSuite) authorize(actor string, domain string, role Role) error {
+	return s.authorizer.Authorize(context.Background(), &Attributes{
+		Actor:      actor,
+		APIName:    "SomeAPI",
+		DomainName: domain,
+		Role:       role,
+	})
+}
+
+func (s *roleBasedAuthorizerSuite) assertDenied(err error) {
+	s.IsType(&shared.AccessDeniedError{}, err)
+}
@@ -58,11 +58,12 @@ const (
 
 // Data encoding types
 const (
-	EncodingTypeJSON     EncodingType = "json"
-	EncodingTypeThriftRW EncodingType = "thriftrw"
-	EncodingTypeGob      EncodingType = "gob"
-	EncodingTypeUnknown  EncodingType = "unknow"
-	EncodingTypeEmpty    EncodingType = ""
+	EncodingTypeJSON      EncodingType = "json"
+	EncodingTypeThriftRW  EncodingType = "thriftrw"
+	EncodingTypeGob       EncodingType = "gob"
+	EncodingTypeEncrypted EncodingType = "encrypted"
+	EncodingTypeUnknown   EncodingType = "unknow"
+	EncodingTypeEmpty     EncodingType = ""
 )
 
 type (
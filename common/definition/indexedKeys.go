@@ -44,6 +44,11 @@ const (
 	CustomDatetimeField = "CustomDatetimeField"
 )
 
+// valid search attributes written by Cadence, stored along with custom search attributes
+const (
+	Paused = "Paused"
+)
+
 // valid non-indexed fields on ES
 const (
 	Memo = "Memo"
@@ -67,6 +72,9 @@ func createDefaultIndexedKeys() map[string]interface{} {
 	for k, v := range systemIndexedKeys {
 		defaultIndexedKeys[k] = v
 	}
+	for k, v := range reservedSearchAttributes {
+		defaultIndexedKeys[k] = v
+	}
 	return defaultIndexedKeys
 }
 
@@ -93,3 +101,15 @@ func IsSystemIndexedKey(key string) bool {
 	_, ok := systemIndexedKeys[key]
 	return ok
 }
+
+// reservedSearchAttributes are search attributes maintained by Cadence, they can be queried like
+// custom search attributes but cannot be upserted by workflows
+var reservedSearchAttributes = map[string]interface{}{
+	Paused: shared.IndexedValueTypeBool,
+}
+
+// IsReservedSearchAttribute return true if key is a search attribute maintained by Cadence
+func IsReservedSearchAttribute(key string) bool {
+	_, ok := reservedSearchAttributes[key]
+	return ok
+}
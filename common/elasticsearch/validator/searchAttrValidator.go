@@ -80,7 +80,7 @@ func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *gen.SearchA
 			return &gen.BadRequestError{Message: fmt.Sprintf("%s is not valid search attribute", key)}
 		}
 		// verify: key is not system reserved
-		if definition.IsSystemIndexedKey(key) {
+		if definition.IsSystemIndexedKey(key) || definition.IsReservedSearchAttribute(key) {
 			sv.logger.WithTags(tag.ESKey(key), tag.WorkflowDomainName(domain)).
 				Error("illegal update of system reserved attribute")
 			return &gen.BadRequestError{Message: fmt.Sprintf("%s is read-only Cadence reservered attribute", key)}
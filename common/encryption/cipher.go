@@ -0,0 +1,108 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+)
+
+// Ciphertext layout:
+//
+//	version (1 byte) | key ID length (1 byte) | key ID | nonce | AES-GCM sealed plaintext
+//
+// The header up to the key ID is authenticated along with the plaintext.
+const (
+	cipherVersion  byte = 1
+	maxKeyIDLength      = 255
+)
+
+var errMalformedCiphertext = errors.New("malformed ciphertext")
+
+// Encrypt encrypts plaintext with the key using AES-GCM. The ID of the key is
+// kept in the returned ciphertext
+func Encrypt(key *Key, plaintext []byte) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	headerLength := 2 + len(key.ID)
+	ciphertext := make([]byte, headerLength+aead.NonceSize(), headerLength+aead.NonceSize()+len(plaintext)+aead.Overhead())
+	ciphertext[0] = cipherVersion
+	ciphertext[1] = byte(len(key.ID))
+	copy(ciphertext[2:], key.ID)
+	nonce := ciphertext[headerLength:]
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(ciphertext, nonce, plaintext, ciphertext[:headerLength]), nil
+}
+
+// Decrypt decrypts ciphertext returned by Encrypt, with the key of the ID kept in it
+func Decrypt(keyProvider KeyProvider, ciphertext []byte) ([]byte, error) {
+	keyID, err := GetKeyID(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keyProvider.GetKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	headerLength := 2 + len(keyID)
+	if len(ciphertext) < headerLength+aead.NonceSize()+aead.Overhead() {
+		return nil, errMalformedCiphertext
+	}
+	nonce := ciphertext[headerLength : headerLength+aead.NonceSize()]
+	sealed := ciphertext[headerLength+aead.NonceSize():]
+	return aead.Open(nil, nonce, sealed, ciphertext[:headerLength])
+}
+
+// GetKeyID returns the ID of the key ciphertext returned by Encrypt was encrypted with
+func GetKeyID(ciphertext []byte) (string, error) {
+	if len(ciphertext) < 2 || ciphertext[0] != cipherVersion {
+		return "", errMalformedCiphertext
+	}
+	keyIDLength := int(ciphertext[1])
+	if len(ciphertext) < 2+keyIDLength {
+		return "", errMalformedCiphertext
+	}
+	return string(ciphertext[2 : 2+keyIDLength]), nil
+}
+
+func newAEAD(key *Key) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key.Data)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	cipherSuite struct {
+		suite.Suite
+
+		keyProvider *localKeyProvider
+	}
+)
+
+func TestCipherSuite(t *testing.T) {
+	s := new(cipherSuite)
+	suite.Run(t, s)
+}
+
+func (s *cipherSuite) SetupTest() {
+	var err error
+	s.keyProvider, err = newLocalKeyProvider(&localKeyFile{
+		ActiveKey: "key-2",
+		Keys: map[string]string{
+			"key-1": "MDEyMzQ1Njc4OWFiY2RlZg==",
+			"key-2": "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=",
+		},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *cipherSuite) TestEncryptDecrypt() {
+	for _, keyID := range []string{"key-1", "key-2"} {
+		key, err := s.keyProvider.GetKey(keyID)
+		s.NoError(err)
+
+		ciphertext, err := Encrypt(key, []byte("some random payload"))
+		s.NoError(err)
+		s.NotContains(string(ciphertext), "some random payload")
+
+		id, err := GetKeyID(ciphertext)
+		s.NoError(err)
+		s.Equal(keyID, id)
+
+		plaintext, err := Decrypt(s.keyProvider, ciphertext)
+		s.NoError(err)
+		s.Equal("some random payload", string(plaintext))
+	}
+}
+
+func (s *cipherSuite) TestEncrypt_RandomNonce() {
+	key, err := s.keyProvider.GetActiveKey("")
+	s.NoError(err)
+
+	ciphertext1, err := Encrypt(key, []byte("some random payload"))
+	s.NoError(err)
+	ciphertext2, err := Encrypt(key, []byte("some random payload"))
+	s.NoError(err)
+	s.NotEqual(ciphertext1, ciphertext2)
+}
+
+func (s *cipherSuite) TestDecrypt_UnknownKey() {
+	ciphertext, err := Encrypt(&Key{ID: "key-3", Data: make([]byte, 32)}, []byte("some random payload"))
+	s.NoError(err)
+
+	_, err = Decrypt(s.keyProvider, ciphertext)
+	s.IsType(&KeyNotFoundError{}, err)
+}
+
+func (s *cipherSuite) TestDecrypt_Tampered() {
+	key, err := s.keyProvider.GetActiveKey("")
+	s.NoError(err)
+	ciphertext, err := Encrypt(key, []byte("some random payload"))
+	s.NoError(err)
+
+	tampered := append([]byte{}, ciphertext...)
+	tampered[len(tampered)-1] ^= 1
+	_, err = Decrypt(s.keyProvider, tampered)
+	s.Error(err)
+
+	// the key ID is authenticated too
+	tampered = append([]byte{}, ciphertext...)
+	copy(tampered[2:], "key-1")
+	_, err = Decrypt(s.keyProvider, tampered)
+	s.Error(err)
+}
+
+func (s *cipherSuite) TestDecrypt_Malformed() {
+	for _, ciphertext := range [][]byte{nil, {cipherVersion}, {cipherVersion, 10, 'k'}, {2, 0}} {
+		_, err := Decrypt(s.keyProvider, ciphertext)
+		s.Equal(errMalformedCiphertext, err)
+	}
+
+	_, err := Decrypt(s.keyProvider, []byte{cipherVersion, 5, 'k', 'e', 'y', '-', '1', 0})
+	s.Equal(errMalformedCiphertext, err)
+}
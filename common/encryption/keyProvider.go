@@ -0,0 +1,63 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/aes"
+	"fmt"
+)
+
+type (
+	// Key is a data key payloads are encrypted with. The ID of the key is kept
+	// along with every payload it encrypts, so that the payload can still be
+	// decrypted after another key becomes active
+	Key struct {
+		ID   string
+		Data []byte
+	}
+
+	// KeyProvider provides the data keys of domains
+	KeyProvider interface {
+		// GetActiveKey returns the key new payloads of the domain are encrypted with
+		GetActiveKey(domainID string) (*Key, error)
+		// GetKey returns the key with the given ID
+		GetKey(keyID string) (*Key, error)
+	}
+
+	// KeyNotFoundError is returned when a key is not known to the key provider
+	KeyNotFoundError struct {
+		KeyID string
+	}
+)
+
+func (e *KeyNotFoundError) Error() string {
+	return fmt.Sprintf("encryption key %q not found", e.KeyID)
+}
+
+func validateKey(key *Key) error {
+	if key.ID == "" || len(key.ID) > maxKeyIDLength {
+		return fmt.Errorf("encryption key ID must be between 1 and %v bytes", maxKeyIDLength)
+	}
+	if _, err := aes.NewCipher(key.Data); err != nil {
+		return fmt.Errorf("invalid encryption key %q: %v", key.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+type (
+	// localKeyFile is the content of the key file of the local key provider
+	localKeyFile struct {
+		// ActiveKey is the ID of the key new payloads are encrypted with
+		ActiveKey string `yaml:"activeKey"`
+		// DomainKeys overrides the active key of individual domains, by domain ID
+		DomainKeys map[string]string `yaml:"domainKeys"`
+		// Keys contains every key by ID, each a base64 encoded 128, 192 or 256 bit AES key.
+		// Keys which are no longer active are kept to decrypt the payloads they encrypted
+		Keys map[string]string `yaml:"keys"`
+	}
+
+	localKeyProvider struct {
+		activeKey  *Key
+		domainKeys map[string]*Key
+		keys       map[string]*Key
+	}
+)
+
+// NewLocalKeyProvider creates a key provider which reads its keys from a local yaml file:
+//
+//	activeKey: key-2
+//	domainKeys:
+//	  <domain ID>: key-3
+//	keys:
+//	  key-1: <base64 encoded key>
+//	  key-2: <base64 encoded key>
+//	  key-3: <base64 encoded key>
+//
+// A key is rotated by adding a new key to the file, making it active and restarting
+// the services. The old key has to stay in the file until no payload encrypted with
+// it is left. The admin re-encryption command only rewrites the history v2 events,
+// while the payloads of mutable states, history v1 events and visibility memos keep
+// their key until they are rewritten by a later update, or deleted once their workflow
+// is closed and past the retention of its domain
+func NewLocalKeyProvider(keyFile string) (KeyProvider, error) {
+	data, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read encryption key file: %v", err)
+	}
+	var content localKeyFile
+	if err := yaml.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("failed to parse encryption key file: %v", err)
+	}
+	return newLocalKeyProvider(&content)
+}
+
+func newLocalKeyProvider(content *localKeyFile) (*localKeyProvider, error) {
+	provider := &localKeyProvider{
+		domainKeys: make(map[string]*Key, len(content.DomainKeys)),
+		keys:       make(map[string]*Key, len(content.Keys)),
+	}
+	for id, encoded := range content.Keys {
+		data, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid encryption key %q: %v", id, err)
+		}
+		key := &Key{ID: id, Data: data}
+		if err := validateKey(key); err != nil {
+			return nil, err
+		}
+		provider.keys[id] = key
+	}
+
+	var err error
+	if provider.activeKey, err = provider.GetKey(content.ActiveKey); err != nil {
+		return nil, fmt.Errorf("invalid active encryption key: %v", err)
+	}
+	for domainID, keyID := range content.DomainKeys {
+		if provider.domainKeys[domainID], err = provider.GetKey(keyID); err != nil {
+			return nil, fmt.Errorf("invalid active encryption key of domain %v: %v", domainID, err)
+		}
+	}
+	return provider, nil
+}
+
+func (p *localKeyProvider) GetActiveKey(domainID string) (*Key, error) {
+	if key, ok := p.domainKeys[domainID]; ok {
+		return key, nil
+	}
+	return p.activeKey, nil
+}
+
+func (p *localKeyProvider) GetKey(keyID string) (*Key, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, &KeyNotFoundError{KeyID: keyID}
+	}
+	return key, nil
+}
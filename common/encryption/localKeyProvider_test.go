@@ -0,0 +1,97 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	localKeyProviderSuite struct {
+		suite.Suite
+	}
+)
+
+func TestLocalKeyProviderSuite(t *testing.T) {
+	s := new(localKeyProviderSuite)
+	suite.Run(t, s)
+}
+
+func (s *localKeyProviderSuite) TestNewLocalKeyProvider() {
+	keyFile, err := ioutil.TempFile("", "keys")
+	s.Require().NoError(err)
+	defer os.Remove(keyFile.Name())
+	_, err = keyFile.WriteString(`
+activeKey: key-1
+domainKeys:
+  some-random-domain-id: key-2
+keys:
+  key-1: MDEyMzQ1Njc4OWFiY2RlZg==
+  key-2: MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=
+`)
+	s.Require().NoError(err)
+	s.Require().NoError(keyFile.Close())
+
+	provider, err := NewLocalKeyProvider(keyFile.Name())
+	s.NoError(err)
+
+	key, err := provider.GetActiveKey("some-other-domain-id")
+	s.NoError(err)
+	s.Equal("key-1", key.ID)
+	s.Equal([]byte("0123456789abcdef"), key.Data)
+
+	key, err = provider.GetActiveKey("some-random-domain-id")
+	s.NoError(err)
+	s.Equal("key-2", key.ID)
+
+	key, err = provider.GetKey("key-2")
+	s.NoError(err)
+	s.Equal("key-2", key.ID)
+
+	_, err = provider.GetKey("key-3")
+	s.Equal(&KeyNotFoundError{KeyID: "key-3"}, err)
+}
+
+func (s *localKeyProviderSuite) TestNewLocalKeyProvider_MissingFile() {
+	_, err := NewLocalKeyProvider("/some/random/path")
+	s.Error(err)
+}
+
+func (s *localKeyProviderSuite) TestNewLocalKeyProvider_InvalidContent() {
+	for _, content := range []*localKeyFile{
+		{ActiveKey: "key-1", Keys: map[string]string{"key-1": "not base64"}},
+		{ActiveKey: "key-1", Keys: map[string]string{"key-1": "c2hvcnQ="}},
+		{ActiveKey: "key-2", Keys: map[string]string{"key-1": "MDEyMzQ1Njc4OWFiY2RlZg=="}},
+		{ActiveKey: "", Keys: map[string]string{"key-1": "MDEyMzQ1Njc4OWFiY2RlZg=="}},
+		{
+			ActiveKey:  "key-1",
+			DomainKeys: map[string]string{"some-random-domain-id": "key-2"},
+			Keys:       map[string]string{"key-1": "MDEyMzQ1Njc4OWFiY2RlZg=="},
+		},
+	} {
+		_, err := newLocalKeyProvider(content)
+		s.Error(err)
+	}
+}
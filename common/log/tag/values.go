@@ -34,6 +34,10 @@ var (
 	// workflow cancellation / sign
 	WorkflowActionWorkflowCancelRequested        = workflowAction("add-workflow-cancel-requested-event")
 	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
+	WorkflowActionWorkflowPaused                 = workflowAction("add-workflow-paused-event")
+	WorkflowActionWorkflowResumed                = workflowAction("add-workflow-resumed-event")
+	WorkflowActionWorkflowUpdateRequested        = workflowAction("add-workflow-update-requested-event")
+	WorkflowActionWorkflowUpdateCompleted        = workflowAction("add-workflow-update-completed-event")
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
 
@@ -115,6 +119,7 @@ var (
 	ComponentESVisibilityManager      = component("es-visibility-manager")
 	ComponentArchiver                 = component("archiver")
 	ComponentBatcher                  = component("batcher")
+	ComponentVisibilityBackfiller     = component("visibility-backfiller")
 	ComponentWorker                   = component("worker")
 	ComponentServiceResolver          = component("service-resolver")
 )
@@ -61,6 +61,9 @@ type (
 		AddListener(service string, name string, notifyChannel chan<- *ChangedEvent) error
 		// RemoveListener removes a listener for this service.
 		RemoveListener(service string, name string) error
+		// SetDraining marks this host as draining or not. Draining hosts are excluded
+		// from the ring of their service, so the keys they serve move to other hosts.
+		SetDraining(draining bool) error
 	}
 
 	// ServiceResolver provides membership information for a specific cadence service.
@@ -135,3 +135,24 @@ func (rpo *ringpopMonitor) RemoveListener(service string, name string) error {
 	}
 	return ring.RemoveListener(name)
 }
+
+func (rpo *ringpopMonitor) SetDraining(draining bool) error {
+	labels, err := rpo.rp.Labels()
+	if err != nil {
+		return err
+	}
+	if draining {
+		err = labels.Set(DrainingKey, "true")
+	} else {
+		_, err = labels.Remove(DrainingKey)
+	}
+	if err != nil {
+		return err
+	}
+
+	// apply the change to the local rings right away, instead of waiting for the next refresh
+	for _, ring := range rpo.rings {
+		ring.refreshAndNotify()
+	}
+	return nil
+}
@@ -21,6 +21,7 @@
 package membership
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -87,3 +88,64 @@ func (s *RpoSuite) TestRingpopMonitor() {
 	rpm.Stop()
 	testService.Stop()
 }
+
+func (s *RpoSuite) TestRingpopMonitorDraining() {
+	testService := NewTestRingpopCluster("rpm-test", 3, "127.0.0.1", "", "rpm-test")
+	s.NotNil(testService, "Failed to create test service")
+
+	services := []string{"rpm-test"}
+
+	logger := loggerimpl.NewNopLogger()
+	rpm := NewRingpopMonitor(services, testService.rings[0], logger)
+	err := rpm.Start()
+	s.Nil(err, "Failed to start ringpop monitor")
+	drainingRpm := NewRingpopMonitor(services, testService.rings[1], logger)
+	err = drainingRpm.Start()
+	s.Nil(err, "Failed to start ringpop monitor")
+
+	// Sleep to give time for the ring to stabilize
+	time.Sleep(time.Second)
+
+	listenCh := make(chan *ChangedEvent, 5)
+	err = rpm.AddListener("rpm-test", "test-listener", listenCh)
+	s.Nil(err, "AddListener failed")
+
+	logger.Info("Draining host 1")
+	err = drainingRpm.SetDraining(true)
+	s.Nil(err, "SetDraining failed")
+
+	select {
+	case e := <-listenCh:
+		s.Equal(1, len(e.HostsRemoved), "ringpop monitor event does not report the draining host")
+		s.Equal(testService.hostAddrs[1], e.HostsRemoved[0].GetAddress(), "ringpop monitor reported that a wrong host was removed")
+		s.Nil(e.HostsAdded, "Unexpected host reported to be added by ringpop monitor")
+	case <-time.After(time.Minute):
+		s.Fail("Timed out waiting for draining host to be removed from the ring")
+	}
+
+	for i := 0; i < 100; i++ {
+		host, err := rpm.Lookup("rpm-test", fmt.Sprintf("key-%v", i))
+		s.Nil(err, "Ringpop monitor failed to find host for key")
+		s.NotEqual(testService.hostAddrs[1], host.GetAddress(), "Ringpop monitor assigned key to draining host")
+	}
+
+	logger.Info("Undraining host 1")
+	err = drainingRpm.SetDraining(false)
+	s.Nil(err, "SetDraining failed")
+
+	select {
+	case e := <-listenCh:
+		s.Equal(1, len(e.HostsAdded), "ringpop monitor event does not report the undrained host")
+		s.Equal(testService.hostAddrs[1], e.HostsAdded[0].GetAddress(), "ringpop monitor reported that a wrong host was added")
+		s.Nil(e.HostsRemoved, "Unexpected host reported to be removed by ringpop monitor")
+	case <-time.After(time.Minute):
+		s.Fail("Timed out waiting for undrained host to be added to the ring")
+	}
+
+	err = rpm.RemoveListener("rpm-test", "test-listener")
+	s.Nil(err, "RemoveListener() failed")
+
+	drainingRpm.Stop()
+	rpm.Stop()
+	testService.Stop()
+}
@@ -37,7 +37,10 @@ import (
 const (
 	// RoleKey label is set by every single service as soon as it bootstraps its
 	// ringpop instance. The data for this key is the service name
-	RoleKey                = "serviceName"
+	RoleKey = "serviceName"
+	// DrainingKey label is set by a host which is draining, such a host
+	// is not part of the ring of its service
+	DrainingKey            = "draining"
 	defaultRefreshInterval = time.Second * 10
 	replicaPoints          = 100
 )
@@ -53,6 +56,7 @@ type ringpopServiceResolver struct {
 
 	ringLock sync.RWMutex
 	ring     *hashring.HashRing
+	members  map[string]struct{}
 
 	listenerLock sync.RWMutex
 	listeners    map[string]chan<- *ChangedEvent
@@ -66,6 +70,7 @@ func newRingpopServiceResolver(service string, rp *ringpop.Ringpop, logger log.L
 		rp:         rp,
 		logger:     logger.WithTags(tag.ComponentServiceResolver, tag.Service(service)),
 		ring:       hashring.New(farm.Fingerprint32, replicaPoints),
+		members:    make(map[string]struct{}),
 		listeners:  make(map[string]chan<- *ChangedEvent),
 		shutdownCh: make(chan struct{}),
 	}
@@ -81,7 +86,7 @@ func (r *ringpopServiceResolver) Start() error {
 	}
 
 	r.rp.AddListener(r)
-	addrs, err := r.rp.GetReachableMembers(swim.MemberWithLabelAndValue(RoleKey, r.service))
+	addrs, err := r.rp.GetReachableMembers(swim.MemberWithLabelAndValue(RoleKey, r.service), isNotDrainingMember)
 	if err != nil {
 		return err
 	}
@@ -89,6 +94,7 @@ func (r *ringpopServiceResolver) Start() error {
 	for _, addr := range addrs {
 		labels := r.getLabelsMap()
 		r.ring.AddMembers(NewHostInfo(addr, labels))
+		r.members[addr] = struct{}{}
 	}
 
 	r.shutdownWG.Add(1)
@@ -112,6 +118,7 @@ func (r *ringpopServiceResolver) Stop() error {
 	if r.isStarted {
 		r.rp.RemoveListener(r)
 		r.ring = hashring.New(farm.Fingerprint32, replicaPoints)
+		r.members = make(map[string]struct{})
 		r.listeners = make(map[string]chan<- *ChangedEvent)
 		close(r.shutdownCh)
 	}
@@ -159,37 +166,66 @@ func (r *ringpopServiceResolver) RemoveListener(name string) error {
 
 // HandleEvent handles updates from ringpop
 func (r *ringpopServiceResolver) HandleEvent(event events.Event) {
-	// We only care about RingChangedEvent
-	e, ok := event.(events.RingChangedEvent)
-	if ok {
+	switch e := event.(type) {
+	case events.RingChangedEvent:
 		r.logger.Info("Received a ring changed event")
 		// Note that we receive events asynchronously, possibly out of order.
 		// We cannot rely on the content of the event, rather we load everything
 		// from ringpop when we get a notification that something changed.
 		r.refresh()
 		r.emitEvent(e)
+	case swim.MemberlistChangesAppliedEvent:
+		// ringpop does not change its ring when the labels of a member change,
+		// a member starting to drain is only visible from the memberlist changes
+		r.refreshAndNotify()
 	}
 }
 
-func (r *ringpopServiceResolver) refresh() {
+// refresh reloads the ring from ringpop, and returns the members added to and removed from the ring
+func (r *ringpopServiceResolver) refresh() (added []string, removed []string) {
 	r.ringLock.Lock()
 	defer r.ringLock.Unlock()
 
-	r.ring = hashring.New(farm.Fingerprint32, replicaPoints)
-
-	addrs, err := r.rp.GetReachableMembers(swim.MemberWithLabelAndValue(RoleKey, r.service))
+	addrs, err := r.rp.GetReachableMembers(swim.MemberWithLabelAndValue(RoleKey, r.service), isNotDrainingMember)
 	if err != nil {
 		// This will happen when service stop and destroy ringpop while there are go-routines pending to call this.
 		r.logger.Warn("Error during ringpop refresh.", tag.Error(err))
-		return
+		return nil, nil
 	}
 
+	r.ring = hashring.New(farm.Fingerprint32, replicaPoints)
+	members := make(map[string]struct{}, len(addrs))
 	for _, addr := range addrs {
 		host := NewHostInfo(addr, r.getLabelsMap())
 		r.ring.AddMembers(host)
+		members[addr] = struct{}{}
+		if _, ok := r.members[addr]; !ok {
+			added = append(added, addr)
+		}
+	}
+	for addr := range r.members {
+		if _, ok := members[addr]; !ok {
+			removed = append(removed, addr)
+		}
 	}
+	r.members = members
 
 	r.logger.Debug("Current reachable members", tag.Addresses(addrs))
+	return added, removed
+}
+
+// refreshAndNotify reloads the ring from ringpop, and notifies the listeners if the ring changed
+func (r *ringpopServiceResolver) refreshAndNotify() {
+	added, removed := r.refresh()
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+
+	r.logger.Info("Ring members changed", tag.NumberProcessed(len(added)), tag.NumberDeleted(len(removed)))
+	r.emitEvent(events.RingChangedEvent{
+		ServersAdded:   added,
+		ServersRemoved: removed,
+	})
 }
 
 func (r *ringpopServiceResolver) emitEvent(rpEvent events.RingChangedEvent) {
@@ -229,11 +265,16 @@ func (r *ringpopServiceResolver) refreshRingWorker() {
 		case <-r.shutdownCh:
 			return
 		case <-refreshTicker.C:
-			r.refresh()
+			r.refreshAndNotify()
 		}
 	}
 }
 
+func isNotDrainingMember(member swim.Member) bool {
+	_, draining := member.Label(DrainingKey)
+	return !draining
+}
+
 func (r *ringpopServiceResolver) getLabelsMap() map[string]string {
 	labels := make(map[string]string)
 	labels[RoleKey] = r.service
@@ -148,6 +148,10 @@ const (
 	PersistenceDeleteCurrentWorkflowExecutionScope
 	// PersistenceGetCurrentExecutionScope tracks GetCurrentExecution calls made by service to persistence layer
 	PersistenceGetCurrentExecutionScope
+	// PersistenceListConcreteExecutionsScope tracks ListConcreteExecutions calls made by service to persistence layer
+	PersistenceListConcreteExecutionsScope
+	// PersistenceListCurrentExecutionsScope tracks ListCurrentExecutions calls made by service to persistence layer
+	PersistenceListCurrentExecutionsScope
 	// PersistenceGetTransferTasksScope tracks GetTransferTasks calls made by service to persistence layer
 	PersistenceGetTransferTasksScope
 	// PersistenceGetReplicationTasksScope tracks GetReplicationTasks calls made by service to persistence layer
@@ -246,6 +250,10 @@ const (
 	HistoryClientRespondActivityTaskCanceledScope
 	// HistoryClientGetMutableStateScope tracks RPC calls to history service
 	HistoryClientGetMutableStateScope
+	// HistoryClientQueryWorkflowScope tracks RPC calls to history service
+	HistoryClientQueryWorkflowScope
+	// HistoryClientUpdateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowExecutionScope
 	// HistoryClientResetStickyTaskListScope tracks RPC calls to history service
 	HistoryClientResetStickyTaskListScope
 	// HistoryClientDescribeWorkflowExecutionScope tracks RPC calls to history service
@@ -264,6 +272,10 @@ const (
 	HistoryClientRemoveSignalMutableStateScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientResumeWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientResumeWorkflowExecutionScope
 	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientResetWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
@@ -278,6 +290,24 @@ const (
 	HistoryClientSyncShardStatusScope
 	// HistoryClientSyncActivityScope tracks RPC calls to history service
 	HistoryClientSyncActivityScope
+	// HistoryClientListDLQTasksScope tracks RPC calls to history service
+	HistoryClientListDLQTasksScope
+	// HistoryClientDescribeDLQTaskScope tracks RPC calls to history service
+	HistoryClientDescribeDLQTaskScope
+	// HistoryClientRequeueDLQTaskScope tracks RPC calls to history service
+	HistoryClientRequeueDLQTaskScope
+	// HistoryClientPurgeDLQTasksScope tracks RPC calls to history service
+	HistoryClientPurgeDLQTasksScope
+	// HistoryClientDescribeShardQueuesScope tracks RPC calls to history service
+	HistoryClientDescribeShardQueuesScope
+	// HistoryClientListShardQueueTasksScope tracks RPC calls to history service
+	HistoryClientListShardQueueTasksScope
+	// HistoryClientDeleteShardQueueTaskScope tracks RPC calls to history service
+	HistoryClientDeleteShardQueueTaskScope
+	// HistoryClientRefireShardQueueTaskScope tracks RPC calls to history service
+	HistoryClientRefireShardQueueTaskScope
+	// HistoryClientHandoffShardScope tracks RPC calls to history service
+	HistoryClientHandoffShardScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -304,6 +334,8 @@ const (
 	FrontendClientDescribeWorkflowExecutionScope
 	// FrontendClientGetWorkflowExecutionHistoryScope tracks RPC calls to frontend service
 	FrontendClientGetWorkflowExecutionHistoryScope
+	// FrontendClientListArchivedWorkflowExecutionsScope tracks RPC calls to frontend service
+	FrontendClientListArchivedWorkflowExecutionsScope
 	// FrontendClientListClosedWorkflowExecutionsScope tracks RPC calls to frontend service
 	FrontendClientListClosedWorkflowExecutionsScope
 	// FrontendClientListDomainsScope tracks RPC calls to frontend service
@@ -316,6 +348,8 @@ const (
 	FrontendClientPollForDecisionTaskScope
 	// FrontendClientQueryWorkflowScope tracks RPC calls to frontend service
 	FrontendClientQueryWorkflowScope
+	// FrontendClientUpdateWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUpdateWorkflowExecutionScope
 	// FrontendClientRecordActivityTaskHeartbeatScope tracks RPC calls to frontend service
 	FrontendClientRecordActivityTaskHeartbeatScope
 	// FrontendClientRecordActivityTaskHeartbeatByIDScope tracks RPC calls to frontend service
@@ -354,6 +388,10 @@ const (
 	FrontendClientStartWorkflowExecutionScope
 	// FrontendClientTerminateWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientTerminateWorkflowExecutionScope
+	// FrontendClientPauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientPauseWorkflowExecutionScope
+	// FrontendClientResumeWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientResumeWorkflowExecutionScope
 	// FrontendClientUpdateDomainScope tracks RPC calls to frontend service
 	FrontendClientUpdateDomainScope
 	// AdminClientAddSearchAttributeScope tracks RPC calls to admin service
@@ -364,6 +402,24 @@ const (
 	AdminClientDescribeWorkflowExecutionScope
 	// AdminClientGetWorkflowExecutionRawHistoryScope tracks RPC calls to admin service
 	AdminClientGetWorkflowExecutionRawHistoryScope
+	// AdminClientListDLQTasksScope tracks RPC calls to admin service
+	AdminClientListDLQTasksScope
+	// AdminClientDescribeDLQTaskScope tracks RPC calls to admin service
+	AdminClientDescribeDLQTaskScope
+	// AdminClientRequeueDLQTaskScope tracks RPC calls to admin service
+	AdminClientRequeueDLQTaskScope
+	// AdminClientPurgeDLQTasksScope tracks RPC calls to admin service
+	AdminClientPurgeDLQTasksScope
+	// AdminClientDescribeShardQueuesScope tracks RPC calls to admin service
+	AdminClientDescribeShardQueuesScope
+	// AdminClientListShardQueueTasksScope tracks RPC calls to admin service
+	AdminClientListShardQueueTasksScope
+	// AdminClientDeleteShardQueueTaskScope tracks RPC calls to admin service
+	AdminClientDeleteShardQueueTaskScope
+	// AdminClientRefireShardQueueTaskScope tracks RPC calls to admin service
+	AdminClientRefireShardQueueTaskScope
+	// AdminClientListAuditRecordsScope tracks RPC calls to admin service
+	AdminClientListAuditRecordsScope
 	// DCRedirectionDeprecateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateDomainScope
 	// DCRedirectionDescribeDomainScope tracks RPC calls for dc redirection
@@ -374,6 +430,8 @@ const (
 	DCRedirectionDescribeWorkflowExecutionScope
 	// DCRedirectionGetWorkflowExecutionHistoryScope tracks RPC calls for dc redirection
 	DCRedirectionGetWorkflowExecutionHistoryScope
+	// DCRedirectionListArchivedWorkflowExecutionsScope tracks RPC calls for dc redirection
+	DCRedirectionListArchivedWorkflowExecutionsScope
 	// DCRedirectionListClosedWorkflowExecutionsScope tracks RPC calls for dc redirection
 	DCRedirectionListClosedWorkflowExecutionsScope
 	// DCRedirectionListDomainsScope tracks RPC calls for dc redirection
@@ -394,6 +452,8 @@ const (
 	DCRedirectionPollForDecisionTaskScope
 	// DCRedirectionQueryWorkflowScope tracks RPC calls for dc redirection
 	DCRedirectionQueryWorkflowScope
+	// DCRedirectionUpdateWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateWorkflowExecutionScope
 	// DCRedirectionRecordActivityTaskHeartbeatScope tracks RPC calls for dc redirection
 	DCRedirectionRecordActivityTaskHeartbeatScope
 	// DCRedirectionRecordActivityTaskHeartbeatByIDScope tracks RPC calls for dc redirection
@@ -432,6 +492,10 @@ const (
 	DCRedirectionStartWorkflowExecutionScope
 	// DCRedirectionTerminateWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionTerminateWorkflowExecutionScope
+	// DCRedirectionPauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionPauseWorkflowExecutionScope
+	// DCRedirectionResumeWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionResumeWorkflowExecutionScope
 	// DCRedirectionUpdateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionUpdateDomainScope
 
@@ -465,6 +529,24 @@ const (
 	PersistenceCompleteForkBranchScope
 	// PersistenceGetHistoryTreeScope tracks GetHistoryTree calls made by service to persistence layer
 	PersistenceGetHistoryTreeScope
+	// PersistenceGetAllHistoryTreeBranchesScope tracks GetAllHistoryTreeBranches calls made by service to persistence layer
+	PersistenceGetAllHistoryTreeBranchesScope
+	// PersistenceReencryptHistoryBranchScope tracks ReencryptHistoryBranch calls made by service to persistence layer
+	PersistenceReencryptHistoryBranchScope
+	// PersistenceCreateAuditRecordScope tracks CreateAuditRecord calls made by service to persistence layer
+	PersistenceCreateAuditRecordScope
+	// PersistenceListAuditRecordsScope tracks ListAuditRecords calls made by service to persistence layer
+	PersistenceListAuditRecordsScope
+	// PersistencePutDLQTaskScope tracks PutDLQTask calls made by service to persistence layer
+	PersistencePutDLQTaskScope
+	// PersistenceListDLQTasksScope tracks ListDLQTasks calls made by service to persistence layer
+	PersistenceListDLQTasksScope
+	// PersistenceGetDLQTaskScope tracks GetDLQTask calls made by service to persistence layer
+	PersistenceGetDLQTaskScope
+	// PersistenceDeleteDLQTaskScope tracks DeleteDLQTask calls made by service to persistence layer
+	PersistenceDeleteDLQTaskScope
+	// PersistenceRangeDeleteDLQTasksScope tracks RangeDeleteDLQTasks calls made by service to persistence layer
+	PersistenceRangeDeleteDLQTasksScope
 
 	// ClusterMetadataArchivalConfigScope tracks ArchivalConfig calls to ClusterMetadata
 	ClusterMetadataArchivalConfigScope
@@ -500,6 +582,8 @@ const (
 
 	// SequentialTaskProcessingScope is used by sequential task processing logic
 	SequentialTaskProcessingScope
+	// TaskSchedulerScope is used by the task scheduler shared by multiple task producers
+	TaskSchedulerScope
 
 	// HistoryArchiverScope is used by history archivers
 	HistoryArchiverScope
@@ -515,6 +599,26 @@ const (
 	AdminDescribeWorkflowExecutionScope
 	// AdminGetWorkflowExecutionRawHistoryScope is the metric scope for admin.GetWorkflowExecutionRawHistoryScope
 	AdminGetWorkflowExecutionRawHistoryScope
+	// AdminAddSearchAttributeScope is the metric scope for admin.AddSearchAttribute
+	AdminAddSearchAttributeScope
+	// AdminListDLQTasksScope is the metric scope for admin.ListDLQTasks
+	AdminListDLQTasksScope
+	// AdminDescribeDLQTaskScope is the metric scope for admin.DescribeDLQTask
+	AdminDescribeDLQTaskScope
+	// AdminRequeueDLQTaskScope is the metric scope for admin.RequeueDLQTask
+	AdminRequeueDLQTaskScope
+	// AdminPurgeDLQTasksScope is the metric scope for admin.PurgeDLQTasks
+	AdminPurgeDLQTasksScope
+	// AdminDescribeShardQueuesScope is the metric scope for admin.DescribeShardQueues
+	AdminDescribeShardQueuesScope
+	// AdminListShardQueueTasksScope is the metric scope for admin.ListShardQueueTasks
+	AdminListShardQueueTasksScope
+	// AdminDeleteShardQueueTaskScope is the metric scope for admin.DeleteShardQueueTask
+	AdminDeleteShardQueueTaskScope
+	// AdminRefireShardQueueTaskScope is the metric scope for admin.RefireShardQueueTask
+	AdminRefireShardQueueTaskScope
+	// AdminListAuditRecordsScope is the metric scope for admin.ListAuditRecords
+	AdminListAuditRecordsScope
 
 	NumAdminScopes
 )
@@ -557,10 +661,16 @@ const (
 	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendPauseWorkflowExecutionScope is the metric scope for frontend.PauseWorkflowExecution
+	FrontendPauseWorkflowExecutionScope
+	// FrontendResumeWorkflowExecutionScope is the metric scope for frontend.ResumeWorkflowExecution
+	FrontendResumeWorkflowExecutionScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
 	// FrontendListOpenWorkflowExecutionsScope is the metric scope for frontend.ListOpenWorkflowExecutions
 	FrontendListOpenWorkflowExecutionsScope
+	// FrontendListArchivedWorkflowExecutionsScope is the metric scope for frontend.ListArchivedWorkflowExecutions
+	FrontendListArchivedWorkflowExecutionsScope
 	// FrontendListClosedWorkflowExecutionsScope is the metric scope for frontend.ListClosedWorkflowExecutions
 	FrontendListClosedWorkflowExecutionsScope
 	// FrontendListWorkflowExecutionsScope is the metric scope for frontend.ListWorkflowExecutions
@@ -579,6 +689,8 @@ const (
 	FrontendDeprecateDomainScope
 	// FrontendQueryWorkflowScope is the metric scope for frontend.QueryWorkflow
 	FrontendQueryWorkflowScope
+	// FrontendUpdateWorkflowExecutionScope is the metric scope for frontend.UpdateWorkflowExecution
+	FrontendUpdateWorkflowExecutionScope
 	// FrontendDescribeWorkflowExecutionScope is the metric scope for frontend.DescribeWorkflowExecution
 	FrontendDescribeWorkflowExecutionScope
 	// FrontendDescribeTaskListScope is the metric scope for frontend.DescribeTaskList
@@ -613,6 +725,10 @@ const (
 	HistoryRespondActivityTaskCanceledScope
 	// HistoryGetMutableStateScope tracks GetMutableStateScope API calls received by service
 	HistoryGetMutableStateScope
+	// HistoryQueryWorkflowScope tracks QueryWorkflow API calls received by service
+	HistoryQueryWorkflowScope
+	// HistoryUpdateWorkflowExecutionScope tracks UpdateWorkflowExecution API calls received by service
+	HistoryUpdateWorkflowExecutionScope
 	// HistoryResetStickyTaskListScope tracks ResetStickyTaskListScope API calls received by service
 	HistoryResetStickyTaskListScope
 	// HistoryDescribeWorkflowExecutionScope tracks DescribeWorkflowExecution API calls received by service
@@ -629,6 +745,10 @@ const (
 	HistoryRemoveSignalMutableStateScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryPauseWorkflowExecutionScope tracks PauseWorkflowExecution API calls received by service
+	HistoryPauseWorkflowExecutionScope
+	// HistoryResumeWorkflowExecutionScope tracks ResumeWorkflowExecution API calls received by service
+	HistoryResumeWorkflowExecutionScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
@@ -645,6 +765,24 @@ const (
 	HistorySyncActivityScope
 	// HistoryDescribeMutableStateScope tracks HistoryActivity API calls received by service
 	HistoryDescribeMutableStateScope
+	// HistoryListDLQTasksScope tracks ListDLQTasks API calls received by service
+	HistoryListDLQTasksScope
+	// HistoryDescribeDLQTaskScope tracks DescribeDLQTask API calls received by service
+	HistoryDescribeDLQTaskScope
+	// HistoryRequeueDLQTaskScope tracks RequeueDLQTask API calls received by service
+	HistoryRequeueDLQTaskScope
+	// HistoryPurgeDLQTasksScope tracks PurgeDLQTasks API calls received by service
+	HistoryPurgeDLQTasksScope
+	// HistoryDescribeShardQueuesScope tracks DescribeShardQueues API calls received by service
+	HistoryDescribeShardQueuesScope
+	// HistoryListShardQueueTasksScope tracks ListShardQueueTasks API calls received by service
+	HistoryListShardQueueTasksScope
+	// HistoryDeleteShardQueueTaskScope tracks DeleteShardQueueTask API calls received by service
+	HistoryDeleteShardQueueTaskScope
+	// HistoryRefireShardQueueTaskScope tracks RefireShardQueueTask API calls received by service
+	HistoryRefireShardQueueTaskScope
+	// HistoryHandoffShardScope tracks HandoffShard API calls received by service
+	HistoryHandoffShardScope
 	// HistoryShardControllerScope is the scope used by shard controller
 	HistoryShardControllerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -823,10 +961,18 @@ const (
 	ArchiverPumpScope
 	// ArchiverArchivalWorkflowScope is scope used by all metrics emitted by archiver.ArchivalWorkflow
 	ArchiverArchivalWorkflowScope
+	// ArchiverVerifyHistoryActivityScope is scope used by all metrics emitted by archiver.VerifyHistoryActivity
+	ArchiverVerifyHistoryActivityScope
+	// ArchiverScannerScope is scope used by all metrics emitted by archiver.ArchivalScannerWorkflow
+	ArchiverScannerScope
 	// TaskListScavengerScope is scope used by all metrics emitted by worker.tasklist.Scavenger module
 	TaskListScavengerScope
+	// ExecutionsScannerScope is scope used by all metrics emitted by worker.executions.Scanner module
+	ExecutionsScannerScope
 	// BatcherScope is scope used by all metrics emitted by worker.Batcher module
 	BatcherScope
+	// VisibilityBackfillScope is scope used by all metrics emitted by worker.backfiller module
+	VisibilityBackfillScope
 
 	NumWorkerScopes
 )
@@ -846,6 +992,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceDeleteWorkflowExecutionScope:                  {operation: "DeleteWorkflowExecution"},
 		PersistenceDeleteCurrentWorkflowExecutionScope:           {operation: "DeleteCurrentWorkflowExecution"},
 		PersistenceGetCurrentExecutionScope:                      {operation: "GetCurrentExecution"},
+		PersistenceListConcreteExecutionsScope:                   {operation: "ListConcreteExecutions"},
+		PersistenceListCurrentExecutionsScope:                    {operation: "ListCurrentExecutions"},
 		PersistenceGetTransferTasksScope:                         {operation: "GetTransferTasks"},
 		PersistenceGetReplicationTasksScope:                      {operation: "GetReplicationTasks"},
 		PersistenceCompleteTransferTaskScope:                     {operation: "CompleteTransferTask"},
@@ -893,6 +1041,15 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceDeleteHistoryBranchScope:                      {operation: "DeleteHistoryBranch"},
 		PersistenceCompleteForkBranchScope:                       {operation: "CompleteForkBranch"},
 		PersistenceGetHistoryTreeScope:                           {operation: "GetHistoryTree"},
+		PersistenceGetAllHistoryTreeBranchesScope:                {operation: "GetAllHistoryTreeBranches"},
+		PersistenceReencryptHistoryBranchScope:                   {operation: "ReencryptHistoryBranch"},
+		PersistenceCreateAuditRecordScope:                        {operation: "CreateAuditRecord"},
+		PersistenceListAuditRecordsScope:                         {operation: "ListAuditRecords"},
+		PersistencePutDLQTaskScope:                               {operation: "PutDLQTask"},
+		PersistenceListDLQTasksScope:                             {operation: "ListDLQTasks"},
+		PersistenceGetDLQTaskScope:                               {operation: "GetDLQTask"},
+		PersistenceDeleteDLQTaskScope:                            {operation: "DeleteDLQTask"},
+		PersistenceRangeDeleteDLQTasksScope:                      {operation: "RangeDeleteDLQTasks"},
 
 		ClusterMetadataArchivalConfigScope: {operation: "ArchivalConfig"},
 
@@ -904,6 +1061,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientRespondActivityTaskFailedScope:         {operation: "HistoryClientRespondActivityTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRespondActivityTaskCanceledScope:       {operation: "HistoryClientRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientGetMutableStateScope:                   {operation: "HistoryClientGetMutableState", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientQueryWorkflowScope:                     {operation: "HistoryClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpdateWorkflowExecutionScope:           {operation: "HistoryClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientResetStickyTaskListScope:               {operation: "HistoryClientResetStickyTaskListScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientDescribeWorkflowExecutionScope:         {operation: "HistoryClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRecordDecisionTaskStartedScope:         {operation: "HistoryClientRecordDecisionTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
@@ -913,6 +1072,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientSignalWithStartWorkflowExecutionScope:  {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:          {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientTerminateWorkflowExecutionScope:        {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:            {operation: "HistoryClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientResumeWorkflowExecutionScope:           {operation: "HistoryClientResumeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientResetWorkflowExecutionScope:            {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientScheduleDecisionTaskScope:              {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRecordChildExecutionCompletedScope:     {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
@@ -920,6 +1081,15 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientReplicateRawEventsScope:                {operation: "HistoryClientReplicateRawEvents", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSyncShardStatusScope:                   {operation: "HistoryClientSyncShardStatusScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSyncActivityScope:                      {operation: "HistoryClientSyncActivityScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientListDLQTasksScope:                      {operation: "HistoryClientListDLQTasks", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDescribeDLQTaskScope:                   {operation: "HistoryClientDescribeDLQTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRequeueDLQTaskScope:                    {operation: "HistoryClientRequeueDLQTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPurgeDLQTasksScope:                     {operation: "HistoryClientPurgeDLQTasks", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDescribeShardQueuesScope:               {operation: "HistoryClientDescribeShardQueues", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientListShardQueueTasksScope:               {operation: "HistoryClientListShardQueueTasks", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDeleteShardQueueTaskScope:              {operation: "HistoryClientDeleteShardQueueTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRefireShardQueueTaskScope:              {operation: "HistoryClientRefireShardQueueTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientHandoffShardScope:                      {operation: "HistoryClientHandoffShard", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollForDecisionTaskScope:              {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:              {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                  {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
@@ -933,12 +1103,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientDescribeTaskListScope:                 {operation: "FrontendClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeWorkflowExecutionScope:        {operation: "FrontendClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientGetWorkflowExecutionHistoryScope:      {operation: "FrontendClientGetWorkflowExecutionHistory", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientListArchivedWorkflowExecutionsScope:   {operation: "FrontendClientListArchivedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientListClosedWorkflowExecutionsScope:     {operation: "FrontendClientListClosedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientListDomainsScope:                      {operation: "FrontendClientListDomains", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientListOpenWorkflowExecutionsScope:       {operation: "FrontendClientListOpenWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientPollForActivityTaskScope:              {operation: "FrontendClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientPollForDecisionTaskScope:              {operation: "FrontendClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientQueryWorkflowScope:                    {operation: "FrontendClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientUpdateWorkflowExecutionScope:          {operation: "FrontendClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientRecordActivityTaskHeartbeatScope:      {operation: "FrontendClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientRecordActivityTaskHeartbeatByIDScope:  {operation: "FrontendClientRecordActivityTaskHeartbeatByID", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientRegisterDomainScope:                   {operation: "FrontendClientRegisterDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
@@ -958,16 +1130,28 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientSignalWorkflowExecutionScope:          {operation: "FrontendClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientStartWorkflowExecutionScope:           {operation: "FrontendClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientTerminateWorkflowExecutionScope:       {operation: "FrontendClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientPauseWorkflowExecutionScope:           {operation: "FrontendClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientResumeWorkflowExecutionScope:          {operation: "FrontendClientResumeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientUpdateDomainScope:                     {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		AdminClientAddSearchAttributeScope:                  {operation: "AdminClientAddSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeHistoryHostScope:                 {operation: "AdminClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeWorkflowExecutionScope:           {operation: "AdminClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryScope:      {operation: "AdminClientGetWorkflowExecutionRawHistory", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientListDLQTasksScope:                        {operation: "AdminClientListDLQTasks", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeDLQTaskScope:                     {operation: "AdminClientDescribeDLQTask", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientRequeueDLQTaskScope:                      {operation: "AdminClientRequeueDLQTask", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientPurgeDLQTasksScope:                       {operation: "AdminClientPurgeDLQTasks", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeShardQueuesScope:                 {operation: "AdminClientDescribeShardQueues", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientListShardQueueTasksScope:                 {operation: "AdminClientListShardQueueTasks", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteShardQueueTaskScope:                {operation: "AdminClientDeleteShardQueueTask", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientRefireShardQueueTaskScope:                {operation: "AdminClientRefireShardQueueTask", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientListAuditRecordsScope:                    {operation: "AdminClientListAuditRecords", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		DCRedirectionDeprecateDomainScope:                   {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeDomainScope:                    {operation: "DCRedirectionDescribeDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeTaskListScope:                  {operation: "DCRedirectionDescribeTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeWorkflowExecutionScope:         {operation: "DCRedirectionDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionGetWorkflowExecutionHistoryScope:       {operation: "DCRedirectionGetWorkflowExecutionHistory", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListArchivedWorkflowExecutionsScope:    {operation: "DCRedirectionListArchivedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionListClosedWorkflowExecutionsScope:      {operation: "DCRedirectionListClosedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionListDomainsScope:                       {operation: "DCRedirectionListDomains", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionListOpenWorkflowExecutionsScope:        {operation: "DCRedirectionListOpenWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -978,6 +1162,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionPollForActivityTaskScope:               {operation: "DCRedirectionPollForActivityTask", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionPollForDecisionTaskScope:               {operation: "DCRedirectionPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionQueryWorkflowScope:                     {operation: "DCRedirectionQueryWorkflow", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateWorkflowExecutionScope:           {operation: "DCRedirectionUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRecordActivityTaskHeartbeatScope:       {operation: "DCRedirectionRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRecordActivityTaskHeartbeatByIDScope:   {operation: "DCRedirectionRecordActivityTaskHeartbeatByID", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRegisterDomainScope:                    {operation: "DCRedirectionRegisterDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -997,6 +1182,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionSignalWorkflowExecutionScope:           {operation: "DCRedirectionSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionStartWorkflowExecutionScope:            {operation: "DCRedirectionStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionTerminateWorkflowExecutionScope:        {operation: "DCRedirectionTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseWorkflowExecutionScope:            {operation: "DCRedirectionPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionResumeWorkflowExecutionScope:           {operation: "DCRedirectionResumeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUpdateDomainScope:                      {operation: "DCRedirectionUpdateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
@@ -1024,6 +1211,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ElasticsearchScanWorkflowExecutionsScope:                   {operation: "ScanWorkflowExecutions"},
 		ElasticsearchCountWorkflowExecutionsScope:                  {operation: "CountWorkflowExecutions"},
 		SequentialTaskProcessingScope:                              {operation: "SequentialTaskProcessing"},
+		TaskSchedulerScope:                                         {operation: "TaskScheduler"},
 
 		HistoryArchiverScope: {operation: "HistoryArchiver"},
 	},
@@ -1033,6 +1221,16 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminDescribeHistoryHostScope:            {operation: "DescribeHistoryHost"},
 		AdminDescribeWorkflowExecutionScope:      {operation: "DescribeWorkflowExecution"},
 		AdminGetWorkflowExecutionRawHistoryScope: {operation: "GetWorkflowExecutionRawHistory"},
+		AdminAddSearchAttributeScope:             {operation: "AddSearchAttribute"},
+		AdminListDLQTasksScope:                   {operation: "ListDLQTasks"},
+		AdminDescribeDLQTaskScope:                {operation: "DescribeDLQTask"},
+		AdminRequeueDLQTaskScope:                 {operation: "RequeueDLQTask"},
+		AdminPurgeDLQTasksScope:                  {operation: "PurgeDLQTasks"},
+		AdminDescribeShardQueuesScope:            {operation: "DescribeShardQueues"},
+		AdminListShardQueueTasksScope:            {operation: "ListShardQueueTasks"},
+		AdminDeleteShardQueueTaskScope:           {operation: "DeleteShardQueueTask"},
+		AdminRefireShardQueueTaskScope:           {operation: "RefireShardQueueTask"},
+		AdminListAuditRecordsScope:               {operation: "ListAuditRecords"},
 
 		FrontendStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
@@ -1052,9 +1250,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendSignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
 		FrontendTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
+		FrontendPauseWorkflowExecutionScope:           {operation: "PauseWorkflowExecution"},
+		FrontendResumeWorkflowExecutionScope:          {operation: "ResumeWorkflowExecution"},
 		FrontendResetWorkflowExecutionScope:           {operation: "ResetWorkflowExecution"},
 		FrontendRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
 		FrontendListOpenWorkflowExecutionsScope:       {operation: "ListOpenWorkflowExecutions"},
+		FrontendListArchivedWorkflowExecutionsScope:   {operation: "ListArchivedWorkflowExecutions"},
 		FrontendListClosedWorkflowExecutionsScope:     {operation: "ListClosedWorkflowExecutions"},
 		FrontendListWorkflowExecutionsScope:           {operation: "ListWorkflowExecutions"},
 		FrontendScanWorkflowExecutionsScope:           {operation: "ScanWorkflowExecutions"},
@@ -1065,6 +1266,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendUpdateDomainScope:                     {operation: "UpdateDomain"},
 		FrontendDeprecateDomainScope:                  {operation: "DeprecateDomain"},
 		FrontendQueryWorkflowScope:                    {operation: "QueryWorkflow"},
+		FrontendUpdateWorkflowExecutionScope:          {operation: "UpdateWorkflowExecution"},
 		FrontendDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
 		FrontendDescribeTaskListScope:                 {operation: "DescribeTaskList"},
 		FrontendResetStickyTaskListScope:              {operation: "ResetStickyTaskList"},
@@ -1080,6 +1282,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryRespondActivityTaskFailedScope:                  {operation: "RespondActivityTaskFailed"},
 		HistoryRespondActivityTaskCanceledScope:                {operation: "RespondActivityTaskCanceled"},
 		HistoryGetMutableStateScope:                            {operation: "GetMutableState"},
+		HistoryQueryWorkflowScope:                              {operation: "QueryWorkflow"},
+		HistoryUpdateWorkflowExecutionScope:                    {operation: "UpdateWorkflowExecution"},
 		HistoryResetStickyTaskListScope:                        {operation: "ResetStickyTaskListScope"},
 		HistoryDescribeWorkflowExecutionScope:                  {operation: "DescribeWorkflowExecution"},
 		HistoryRecordDecisionTaskStartedScope:                  {operation: "RecordDecisionTaskStarted"},
@@ -1088,6 +1292,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySignalWithStartWorkflowExecutionScope:           {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:                   {operation: "RemoveSignalMutableState"},
 		HistoryTerminateWorkflowExecutionScope:                 {operation: "TerminateWorkflowExecution"},
+		HistoryPauseWorkflowExecutionScope:                     {operation: "PauseWorkflowExecution"},
+		HistoryResumeWorkflowExecutionScope:                    {operation: "ResumeWorkflowExecution"},
 		HistoryResetWorkflowExecutionScope:                     {operation: "ResetWorkflowExecution"},
 		HistoryProcessDeleteHistoryEventScope:                  {operation: "ProcessDeleteHistoryEvent"},
 		HistoryScheduleDecisionTaskScope:                       {operation: "ScheduleDecisionTask"},
@@ -1098,6 +1304,15 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySyncShardStatusScope:                            {operation: "SyncShardStatus"},
 		HistorySyncActivityScope:                               {operation: "SyncActivity"},
 		HistoryDescribeMutableStateScope:                       {operation: "DescribeMutableState"},
+		HistoryListDLQTasksScope:                               {operation: "ListDLQTasks"},
+		HistoryDescribeDLQTaskScope:                            {operation: "DescribeDLQTask"},
+		HistoryRequeueDLQTaskScope:                             {operation: "RequeueDLQTask"},
+		HistoryPurgeDLQTasksScope:                              {operation: "PurgeDLQTasks"},
+		HistoryDescribeShardQueuesScope:                        {operation: "DescribeShardQueues"},
+		HistoryListShardQueueTasksScope:                        {operation: "ListShardQueueTasks"},
+		HistoryDeleteShardQueueTaskScope:                       {operation: "DeleteShardQueueTask"},
+		HistoryRefireShardQueueTaskScope:                       {operation: "RefireShardQueueTask"},
+		HistoryHandoffShardScope:                               {operation: "HandoffShard"},
 		HistoryShardControllerScope:                            {operation: "ShardController"},
 		TransferQueueProcessorScope:                            {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:                      {operation: "TransferActiveQueueProcessor"},
@@ -1185,8 +1400,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ArchiverScope:                       {operation: "Archiver"},
 		ArchiverPumpScope:                   {operation: "ArchiverPump"},
 		ArchiverArchivalWorkflowScope:       {operation: "ArchiverArchivalWorkflow"},
+		ArchiverVerifyHistoryActivityScope:  {operation: "ArchiverVerifyHistoryActivity"},
+		ArchiverScannerScope:                {operation: "ArchiverScanner"},
 		TaskListScavengerScope:              {operation: "tasklistscavenger"},
+		ExecutionsScannerScope:              {operation: "executionsscanner"},
 		BatcherScope:                        {operation: "batcher"},
+		VisibilityBackfillScope:             {operation: "visibilitybackfill"},
 	},
 }
 
@@ -1208,6 +1427,7 @@ const (
 	CadenceErrContextTimeoutCounter
 	CadenceErrRetryTaskCounter
 	CadenceErrClientVersionNotSupportedCounter
+	CadenceErrAccessDeniedCounter
 	PersistenceRequests
 	PersistenceFailures
 	PersistenceLatency
@@ -1250,6 +1470,11 @@ const (
 	SequentialTaskQueueProcessingLatency
 	SequentialTaskTaskProcessingLatency
 
+	TaskSchedulerSubmitRequest
+	TaskSchedulerQueueLatency
+	TaskSchedulerProcessingLatency
+	TaskSchedulerTaskFailures
+
 	HistoryArchiverHistoryMutatedCount
 	HistoryArchiverTotalUploadSize
 
@@ -1273,6 +1498,7 @@ const (
 	TaskLatency
 	TaskFailures
 	TaskDiscarded
+	TaskMovedToDLQCounter
 	TaskAttemptTimer
 	TaskStandbyRetryCounter
 	TaskNotActiveCounter
@@ -1315,6 +1541,9 @@ const (
 	ShardClosedCounter
 	ShardItemCreatedCounter
 	ShardItemRemovedCounter
+	ShardHandoffCounter
+	ShardHandoffLatency
+	ShardHandoffTimeoutCounter
 	ShardInfoReplicationPendingTasksTimer
 	ShardInfoTransferActivePendingTasksTimer
 	ShardInfoTransferStandbyPendingTasksTimer
@@ -1376,12 +1605,14 @@ const (
 	WorkflowCronBackoffTimerCount
 	WorkflowCleanupDeleteCount
 	WorkflowCleanupArchiveCount
+	WorkflowCleanupVisibilityArchiveFailureCount
 	WorkflowCleanupNopCount
 	WorkflowSuccessCount
 	WorkflowCancelCount
 	WorkflowFailedCount
 	WorkflowTimeoutCount
 	WorkflowTerminateCount
+	ParentClosePolicyProcessedCounter
 
 	NumHistoryMetrics
 )
@@ -1431,6 +1662,12 @@ const (
 	ArchiverDeleteLocalSuccessCount
 	ArchiverDeleteFailedAllRetriesCount
 	ArchiverDeleteSuccessCount
+	ArchiverVerifySuccessCount
+	ArchiverVerifyFailedCount
+	ArchiverHistoryMismatchCount
+	ArchiverScannerCheckedCount
+	ArchiverScannerCorruptedCount
+	ArchiverScannerFailureCount
 	ArchiverBacklogSizeGauge
 	ArchiverPumpTimeoutCount
 	ArchiverPumpSignalThresholdCount
@@ -1445,17 +1682,31 @@ const (
 	ArchiverClientSendSignalFailureCount
 	ArchiverClientInlineArchiveAttemptCount
 	ArchiverClientInlineArchiveFailureCount
+	ArchiverClientVisibilityArchiveAttemptCount
+	ArchiverClientVisibilityArchiveFailureCount
 	TaskProcessedCount
 	TaskDeletedCount
 	TaskListProcessedCount
 	TaskListDeletedCount
 	TaskListOutstandingCount
+	ExecutionProcessedCount
+	CurrentExecutionProcessedCount
+	ExecutionHistoryMissingCount
+	ExecutionHistoryTruncatedCount
+	ExecutionTimerTasksMissingCount
+	CurrentExecutionOrphanedCount
+	ExecutionFixedCount
+	ExecutionScanFailedCount
+	ShardOutstandingCount
 	StartedCount
 	StoppedCount
 	ExecutorTasksDeferredCount
 	ExecutorTasksDroppedCount
 	BatcherProcessorSuccess
 	BatcherProcessorFailures
+	VisibilityBackfillRecordCount
+	VisibilityBackfillFailures
+	VisibilityBackfillCountMismatch
 	NumWorkerMetrics
 )
 
@@ -1478,6 +1729,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CadenceErrContextTimeoutCounter:                     {metricName: "cadence_errors_context_timeout", metricType: Counter},
 		CadenceErrRetryTaskCounter:                          {metricName: "cadence_errors_retry_task", metricType: Counter},
 		CadenceErrClientVersionNotSupportedCounter:          {metricName: "cadence_errors_client_version_not_supported", metricType: Counter},
+		CadenceErrAccessDeniedCounter:                       {metricName: "cadence_errors_access_denied", metricType: Counter},
 		PersistenceRequests:                                 {metricName: "persistence_requests", metricType: Counter},
 		PersistenceFailures:                                 {metricName: "persistence_errors", metricType: Counter},
 		PersistenceLatency:                                  {metricName: "persistence_latency", metricType: Timer},
@@ -1513,6 +1765,10 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		SequentialTaskQueueSize:                             {metricName: "sequentialtask_queue_size", metricType: Timer},
 		SequentialTaskQueueProcessingLatency:                {metricName: "sequentialtask_queue_processing_latency", metricType: Timer},
 		SequentialTaskTaskProcessingLatency:                 {metricName: "sequentialtask_task_processing_latency", metricType: Timer},
+		TaskSchedulerSubmitRequest:                          {metricName: "taskscheduler_submit_request", metricType: Counter},
+		TaskSchedulerQueueLatency:                           {metricName: "taskscheduler_queue_latency", metricType: Timer},
+		TaskSchedulerProcessingLatency:                      {metricName: "taskscheduler_processing_latency", metricType: Timer},
+		TaskSchedulerTaskFailures:                           {metricName: "taskscheduler_task_failures", metricType: Counter},
 
 		HistoryArchiverHistoryMutatedCount:                            {metricName: "history_archiver_history_mutated_count", metricType: Counter},
 		HistoryArchiverTotalUploadSize:                                {metricName: "history_archiver_total_upload_size", metricType: Timer},
@@ -1532,6 +1788,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskAttemptTimer:                                  {metricName: "task_attempt", metricType: Timer},
 		TaskFailures:                                      {metricName: "task_errors", metricType: Counter},
 		TaskDiscarded:                                     {metricName: "task_errors_discarded", metricType: Counter},
+		TaskMovedToDLQCounter:                             {metricName: "task_moved_to_dlq", metricType: Counter},
 		TaskStandbyRetryCounter:                           {metricName: "task_errors_standby_retry_counter", metricType: Counter},
 		TaskNotActiveCounter:                              {metricName: "task_errors_not_active_counter", metricType: Counter},
 		TaskLimitExceededCounter:                          {metricName: "task_errors_limit_exceeded_counter", metricType: Counter},
@@ -1572,6 +1829,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ShardClosedCounter:                                {metricName: "shard_closed_count", metricType: Counter},
 		ShardItemCreatedCounter:                           {metricName: "sharditem_created_count", metricType: Counter},
 		ShardItemRemovedCounter:                           {metricName: "sharditem_removed_count", metricType: Counter},
+		ShardHandoffCounter:                               {metricName: "shard_handoff_count", metricType: Counter},
+		ShardHandoffLatency:                               {metricName: "shard_handoff_latency", metricType: Timer},
+		ShardHandoffTimeoutCounter:                        {metricName: "shard_handoff_timeout_count", metricType: Counter},
 		ShardInfoReplicationPendingTasksTimer:             {metricName: "shardinfo_replication_pending_task", metricType: Timer},
 		ShardInfoTransferActivePendingTasksTimer:          {metricName: "shardinfo_transfer_active_pending_task", metricType: Timer},
 		ShardInfoTransferStandbyPendingTasksTimer:         {metricName: "shardinfo_transfer_standby_pending_task", metricType: Timer},
@@ -1633,12 +1893,14 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		WorkflowCronBackoffTimerCount:                     {metricName: "workflow_cron_backoff_timer", metricType: Counter},
 		WorkflowCleanupDeleteCount:                        {metricName: "workflow_cleanup_delete", metricType: Counter},
 		WorkflowCleanupArchiveCount:                       {metricName: "workflow_cleanup_archive", metricType: Counter},
+		WorkflowCleanupVisibilityArchiveFailureCount:      {metricName: "workflow_cleanup_visibility_archive_failure", metricType: Counter},
 		WorkflowCleanupNopCount:                           {metricName: "workflow_cleanup_nop", metricType: Counter},
 		WorkflowSuccessCount:                              {metricName: "workflow_success", metricType: Counter},
 		WorkflowCancelCount:                               {metricName: "workflow_cancel", metricType: Counter},
 		WorkflowFailedCount:                               {metricName: "workflow_failed", metricType: Counter},
 		WorkflowTimeoutCount:                              {metricName: "workflow_timeout", metricType: Counter},
 		WorkflowTerminateCount:                            {metricName: "workflow_terminate", metricType: Counter},
+		ParentClosePolicyProcessedCounter:                 {metricName: "parent_close_policy_processed", metricType: Counter},
 	},
 	Matching: {
 		PollSuccessCounter:            {metricName: "poll_success"},
@@ -1655,56 +1917,76 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		AsyncMatchLatency:             {metricName: "asyncmatch_latency", metricType: Timer},
 	},
 	Worker: {
-		ReplicatorMessages:                       {metricName: "replicator_messages"},
-		ReplicatorFailures:                       {metricName: "replicator_errors"},
-		ReplicatorMessagesDropped:                {metricName: "replicator_messages_dropped"},
-		ReplicatorLatency:                        {metricName: "replicator_latency"},
-		ESProcessorRequests:                      {metricName: "es_processor_requests"},
-		ESProcessorRetries:                       {metricName: "es_processor_retries"},
-		ESProcessorFailures:                      {metricName: "es_processor_errors"},
-		ESProcessorCorruptedData:                 {metricName: "es_processor_corrupted_data"},
-		ESProcessorProcessMsgLatency:             {metricName: "es_processor_process_msg_latency", metricType: Timer},
-		IndexProcessorCorruptedData:              {metricName: "index_processor_corrupted_data"},
-		IndexProcessorProcessMsgLatency:          {metricName: "index_processor_process_msg_latency", metricType: Timer},
-		ArchiverNonRetryableErrorCount:           {metricName: "archiver_non_retryable_error"},
-		ArchiverStartedCount:                     {metricName: "archiver_started"},
-		ArchiverStoppedCount:                     {metricName: "archiver_stopped"},
-		ArchiverCoroutineStartedCount:            {metricName: "archiver_coroutine_started"},
-		ArchiverCoroutineStoppedCount:            {metricName: "archiver_coroutine_stopped"},
-		ArchiverHandleRequestLatency:             {metricName: "archiver_handle_request_latency"},
-		ArchiverUploadWithRetriesLatency:         {metricName: "archiver_upload_with_retries_latency"},
-		ArchiverDeleteWithRetriesLatency:         {metricName: "archiver_delete_with_retries_latency"},
-		ArchiverUploadFailedAllRetriesCount:      {metricName: "archiver_upload_failed_all_retries"},
-		ArchiverUploadSuccessCount:               {metricName: "archiver_upload_success"},
-		ArchiverDeleteLocalFailedAllRetriesCount: {metricName: "archiver_delete_local_failed_all_retries"},
-		ArchiverDeleteLocalSuccessCount:          {metricName: "archiver_delete_local_success"},
-		ArchiverDeleteFailedAllRetriesCount:      {metricName: "archiver_delete_failed_all_retries"},
-		ArchiverDeleteSuccessCount:               {metricName: "archiver_delete_success"},
-		ArchiverBacklogSizeGauge:                 {metricName: "archiver_backlog_size"},
-		ArchiverPumpTimeoutCount:                 {metricName: "archiver_pump_timeout"},
-		ArchiverPumpSignalThresholdCount:         {metricName: "archiver_pump_signal_threshold"},
-		ArchiverPumpTimeoutWithoutSignalsCount:   {metricName: "archiver_pump_timeout_without_signals"},
-		ArchiverPumpSignalChannelClosedCount:     {metricName: "archiver_pump_signal_channel_closed"},
-		ArchiverWorkflowStartedCount:             {metricName: "archiver_workflow_started"},
-		ArchiverNumPumpedRequestsCount:           {metricName: "archiver_num_pumped_requests"},
-		ArchiverNumHandledRequestsCount:          {metricName: "archiver_num_handled_requests"},
-		ArchiverPumpedNotEqualHandledCount:       {metricName: "archiver_pumped_not_equal_handled"},
-		ArchiverHandleAllRequestsLatency:         {metricName: "archiver_handle_all_requests_latency"},
-		ArchiverWorkflowStoppingCount:            {metricName: "archiver_workflow_stopping"},
-		ArchiverClientSendSignalFailureCount:     {metricName: "archiver_client_send_signal_error"},
-		ArchiverClientInlineArchiveAttemptCount:  {metricName: "archiver_client_inline_archive_attempt"},
-		ArchiverClientInlineArchiveFailureCount:  {metricName: "archiver_client_inline_archive_failure"},
-		TaskProcessedCount:                       {metricName: "task_processed", metricType: Gauge},
-		TaskDeletedCount:                         {metricName: "task_deleted", metricType: Gauge},
-		TaskListProcessedCount:                   {metricName: "tasklist_processed", metricType: Gauge},
-		TaskListDeletedCount:                     {metricName: "tasklist_deleted", metricType: Gauge},
-		TaskListOutstandingCount:                 {metricName: "tasklist_outstanding", metricType: Gauge},
-		StartedCount:                             {metricName: "started", metricType: Counter},
-		StoppedCount:                             {metricName: "stopped", metricType: Counter},
-		ExecutorTasksDeferredCount:               {metricName: "executor_deferred", metricType: Counter},
-		ExecutorTasksDroppedCount:                {metricName: "executor_dropped", metricType: Counter},
-		BatcherProcessorSuccess:                  {metricName: "batcher_processor_requests", metricType: Counter},
-		BatcherProcessorFailures:                 {metricName: "batcher_processor_errors", metricType: Counter},
+		ReplicatorMessages:                          {metricName: "replicator_messages"},
+		ReplicatorFailures:                          {metricName: "replicator_errors"},
+		ReplicatorMessagesDropped:                   {metricName: "replicator_messages_dropped"},
+		ReplicatorLatency:                           {metricName: "replicator_latency"},
+		ESProcessorRequests:                         {metricName: "es_processor_requests"},
+		ESProcessorRetries:                          {metricName: "es_processor_retries"},
+		ESProcessorFailures:                         {metricName: "es_processor_errors"},
+		ESProcessorCorruptedData:                    {metricName: "es_processor_corrupted_data"},
+		ESProcessorProcessMsgLatency:                {metricName: "es_processor_process_msg_latency", metricType: Timer},
+		IndexProcessorCorruptedData:                 {metricName: "index_processor_corrupted_data"},
+		IndexProcessorProcessMsgLatency:             {metricName: "index_processor_process_msg_latency", metricType: Timer},
+		ArchiverNonRetryableErrorCount:              {metricName: "archiver_non_retryable_error"},
+		ArchiverStartedCount:                        {metricName: "archiver_started"},
+		ArchiverStoppedCount:                        {metricName: "archiver_stopped"},
+		ArchiverCoroutineStartedCount:               {metricName: "archiver_coroutine_started"},
+		ArchiverCoroutineStoppedCount:               {metricName: "archiver_coroutine_stopped"},
+		ArchiverHandleRequestLatency:                {metricName: "archiver_handle_request_latency"},
+		ArchiverUploadWithRetriesLatency:            {metricName: "archiver_upload_with_retries_latency"},
+		ArchiverDeleteWithRetriesLatency:            {metricName: "archiver_delete_with_retries_latency"},
+		ArchiverUploadFailedAllRetriesCount:         {metricName: "archiver_upload_failed_all_retries"},
+		ArchiverUploadSuccessCount:                  {metricName: "archiver_upload_success"},
+		ArchiverDeleteLocalFailedAllRetriesCount:    {metricName: "archiver_delete_local_failed_all_retries"},
+		ArchiverDeleteLocalSuccessCount:             {metricName: "archiver_delete_local_success"},
+		ArchiverDeleteFailedAllRetriesCount:         {metricName: "archiver_delete_failed_all_retries"},
+		ArchiverDeleteSuccessCount:                  {metricName: "archiver_delete_success"},
+		ArchiverVerifySuccessCount:                  {metricName: "archiver_verify_success"},
+		ArchiverVerifyFailedCount:                   {metricName: "archiver_verify_failed"},
+		ArchiverHistoryMismatchCount:                {metricName: "archiver_history_mismatch"},
+		ArchiverScannerCheckedCount:                 {metricName: "archiver_scanner_checked"},
+		ArchiverScannerCorruptedCount:               {metricName: "archiver_scanner_corrupted"},
+		ArchiverScannerFailureCount:                 {metricName: "archiver_scanner_failure"},
+		ArchiverBacklogSizeGauge:                    {metricName: "archiver_backlog_size"},
+		ArchiverPumpTimeoutCount:                    {metricName: "archiver_pump_timeout"},
+		ArchiverPumpSignalThresholdCount:            {metricName: "archiver_pump_signal_threshold"},
+		ArchiverPumpTimeoutWithoutSignalsCount:      {metricName: "archiver_pump_timeout_without_signals"},
+		ArchiverPumpSignalChannelClosedCount:        {metricName: "archiver_pump_signal_channel_closed"},
+		ArchiverWorkflowStartedCount:                {metricName: "archiver_workflow_started"},
+		ArchiverNumPumpedRequestsCount:              {metricName: "archiver_num_pumped_requests"},
+		ArchiverNumHandledRequestsCount:             {metricName: "archiver_num_handled_requests"},
+		ArchiverPumpedNotEqualHandledCount:          {metricName: "archiver_pumped_not_equal_handled"},
+		ArchiverHandleAllRequestsLatency:            {metricName: "archiver_handle_all_requests_latency"},
+		ArchiverWorkflowStoppingCount:               {metricName: "archiver_workflow_stopping"},
+		ArchiverClientSendSignalFailureCount:        {metricName: "archiver_client_send_signal_error"},
+		ArchiverClientInlineArchiveAttemptCount:     {metricName: "archiver_client_inline_archive_attempt"},
+		ArchiverClientInlineArchiveFailureCount:     {metricName: "archiver_client_inline_archive_failure"},
+		ArchiverClientVisibilityArchiveAttemptCount: {metricName: "archiver_client_visibility_archive_attempt"},
+		ArchiverClientVisibilityArchiveFailureCount: {metricName: "archiver_client_visibility_archive_failure"},
+		TaskProcessedCount:                          {metricName: "task_processed", metricType: Gauge},
+		TaskDeletedCount:                            {metricName: "task_deleted", metricType: Gauge},
+		TaskListProcessedCount:                      {metricName: "tasklist_processed", metricType: Gauge},
+		TaskListDeletedCount:                        {metricName: "tasklist_deleted", metricType: Gauge},
+		TaskListOutstandingCount:                    {metricName: "tasklist_outstanding", metricType: Gauge},
+		ExecutionProcessedCount:                     {metricName: "execution_processed", metricType: Gauge},
+		CurrentExecutionProcessedCount:              {metricName: "current_execution_processed", metricType: Gauge},
+		ExecutionHistoryMissingCount:                {metricName: "execution_history_missing", metricType: Gauge},
+		ExecutionHistoryTruncatedCount:              {metricName: "execution_history_truncated", metricType: Gauge},
+		ExecutionTimerTasksMissingCount:             {metricName: "execution_timer_tasks_missing", metricType: Gauge},
+		CurrentExecutionOrphanedCount:               {metricName: "current_execution_orphaned", metricType: Gauge},
+		ExecutionFixedCount:                         {metricName: "execution_fixed", metricType: Gauge},
+		ExecutionScanFailedCount:                    {metricName: "execution_scan_failed", metricType: Gauge},
+		ShardOutstandingCount:                       {metricName: "shard_outstanding", metricType: Gauge},
+		StartedCount:                                {metricName: "started", metricType: Counter},
+		StoppedCount:                                {metricName: "stopped", metricType: Counter},
+		ExecutorTasksDeferredCount:                  {metricName: "executor_deferred", metricType: Counter},
+		ExecutorTasksDroppedCount:                   {metricName: "executor_dropped", metricType: Counter},
+		BatcherProcessorSuccess:                     {metricName: "batcher_processor_requests", metricType: Counter},
+		BatcherProcessorFailures:                    {metricName: "batcher_processor_errors", metricType: Counter},
+		VisibilityBackfillRecordCount:               {metricName: "visibility_backfill_records", metricType: Counter},
+		VisibilityBackfillFailures:                  {metricName: "visibility_backfill_errors", metricType: Counter},
+		VisibilityBackfillCountMismatch:             {metricName: "visibility_backfill_count_mismatch", metricType: Counter},
 	},
 }
 
@@ -58,6 +58,159 @@ func (_m *AdminClient) AddSearchAttribute(ctx context.Context, Request *admin.Ad
 	return r0
 }
 
+// RequeueDLQTask provides a mock function with given fields: ctx, Request, opts
+func (_m *AdminClient) RequeueDLQTask(ctx context.Context, Request *shared.RequeueDLQTaskRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, Request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.RequeueDLQTaskRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, Request, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeDLQTasks provides a mock function with given fields: ctx, Request, opts
+func (_m *AdminClient) PurgeDLQTasks(ctx context.Context, Request *shared.PurgeDLQTasksRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, Request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.PurgeDLQTasksRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, Request, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListShardQueueTasks provides a mock function with given fields: ctx, request
+func (_m *AdminClient) ListShardQueueTasks(ctx context.Context, request *shared.ListShardQueueTasksRequest, opts ...yarpc.CallOption) (*shared.ListShardQueueTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ListShardQueueTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ListShardQueueTasksRequest) *shared.ListShardQueueTasksResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListShardQueueTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ListShardQueueTasksRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeShardQueues provides a mock function with given fields: ctx, request
+func (_m *AdminClient) DescribeShardQueues(ctx context.Context, request *shared.DescribeShardQueuesRequest, opts ...yarpc.CallOption) (*shared.DescribeShardQueuesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeShardQueuesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DescribeShardQueuesRequest) *shared.DescribeShardQueuesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeShardQueuesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.DescribeShardQueuesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefireShardQueueTask provides a mock function with given fields: ctx, Request, opts
+func (_m *AdminClient) RefireShardQueueTask(ctx context.Context, Request *shared.RefireShardQueueTaskRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, Request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.RefireShardQueueTaskRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, Request, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListAuditRecords provides a mock function with given fields: ctx, request
+func (_m *AdminClient) ListAuditRecords(ctx context.Context, request *admin.ListAuditRecordsRequest, opts ...yarpc.CallOption) (*admin.ListAuditRecordsResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.ListAuditRecordsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ListAuditRecordsRequest) *admin.ListAuditRecordsResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.ListAuditRecordsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ListAuditRecordsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteShardQueueTask provides a mock function with given fields: ctx, Request, opts
+func (_m *AdminClient) DeleteShardQueueTask(ctx context.Context, Request *shared.DeleteShardQueueTaskRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, Request)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DeleteShardQueueTaskRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, Request, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DescribeHistoryHost provides a mock function with given fields: ctx, request
 func (_m *AdminClient) DescribeHistoryHost(ctx context.Context, request *shared.DescribeHistoryHostRequest, opts ...yarpc.CallOption) (*shared.DescribeHistoryHostResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -81,6 +234,52 @@ func (_m *AdminClient) DescribeHistoryHost(ctx context.Context, request *shared.
 	return r0, r1
 }
 
+// ListDLQTasks provides a mock function with given fields: ctx, request
+func (_m *AdminClient) ListDLQTasks(ctx context.Context, request *shared.ListDLQTasksRequest, opts ...yarpc.CallOption) (*shared.ListDLQTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ListDLQTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ListDLQTasksRequest) *shared.ListDLQTasksResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListDLQTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ListDLQTasksRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDLQTask provides a mock function with given fields: ctx, request
+func (_m *AdminClient) DescribeDLQTask(ctx context.Context, request *shared.DescribeDLQTaskRequest, opts ...yarpc.CallOption) (*shared.DescribeDLQTaskResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeDLQTaskResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DescribeDLQTaskRequest) *shared.DescribeDLQTaskResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeDLQTaskResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.DescribeDLQTaskRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeWorkflowExecution provides a mock function with given fields: ctx, request
 func (_m *AdminClient) DescribeWorkflowExecution(ctx context.Context, request *admin.DescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*admin.DescribeWorkflowExecutionResponse, error) {
 	ret := _m.Called(ctx, request)
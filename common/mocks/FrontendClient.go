@@ -179,6 +179,36 @@ func (_m *FrontendClient) GetWorkflowExecutionHistory(ctx context.Context, GetRe
 	return r0, r1
 }
 
+// ListArchivedWorkflowExecutions provides a mock function with given fields: ctx, ListRequest, opts
+func (_m *FrontendClient) ListArchivedWorkflowExecutions(ctx context.Context, ListRequest *shared.ListArchivedWorkflowExecutionsRequest, opts ...yarpc.CallOption) (*shared.ListArchivedWorkflowExecutionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, ListRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *shared.ListArchivedWorkflowExecutionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ListArchivedWorkflowExecutionsRequest, ...yarpc.CallOption) *shared.ListArchivedWorkflowExecutionsResponse); ok {
+		r0 = rf(ctx, ListRequest, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListArchivedWorkflowExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ListArchivedWorkflowExecutionsRequest, ...yarpc.CallOption) error); ok {
+		r1 = rf(ctx, ListRequest, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListClosedWorkflowExecutions provides a mock function with given fields: ctx, ListRequest, opts
 func (_m *FrontendClient) ListClosedWorkflowExecutions(ctx context.Context, ListRequest *shared.ListClosedWorkflowExecutionsRequest, opts ...yarpc.CallOption) (*shared.ListClosedWorkflowExecutionsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -479,6 +509,36 @@ func (_m *FrontendClient) QueryWorkflow(ctx context.Context, QueryRequest *share
 	return r0, r1
 }
 
+// UpdateWorkflowExecution provides a mock function with given fields: ctx, UpdateRequest, opts
+func (_m *FrontendClient) UpdateWorkflowExecution(ctx context.Context, UpdateRequest *shared.UpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*shared.UpdateWorkflowExecutionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, UpdateRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *shared.UpdateWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.UpdateWorkflowExecutionRequest, ...yarpc.CallOption) *shared.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, UpdateRequest, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.UpdateWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.UpdateWorkflowExecutionRequest, ...yarpc.CallOption) error); ok {
+		r1 = rf(ctx, UpdateRequest, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RecordActivityTaskHeartbeat provides a mock function with given fields: ctx, HeartbeatRequest, opts
 func (_m *FrontendClient) RecordActivityTaskHeartbeat(ctx context.Context, HeartbeatRequest *shared.RecordActivityTaskHeartbeatRequest, opts ...yarpc.CallOption) (*shared.RecordActivityTaskHeartbeatResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -941,6 +1001,48 @@ func (_m *FrontendClient) TerminateWorkflowExecution(ctx context.Context, Termin
 	return r0
 }
 
+// PauseWorkflowExecution provides a mock function with given fields: ctx, PauseRequest, opts
+func (_m *FrontendClient) PauseWorkflowExecution(ctx context.Context, PauseRequest *shared.PauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, PauseRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.PauseWorkflowExecutionRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, PauseRequest, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeWorkflowExecution provides a mock function with given fields: ctx, ResumeRequest, opts
+func (_m *FrontendClient) ResumeWorkflowExecution(ctx context.Context, ResumeRequest *shared.ResumeWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, ResumeRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ResumeWorkflowExecutionRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, ResumeRequest, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateDomain provides a mock function with given fields: ctx, UpdateRequest, opts
 func (_m *FrontendClient) UpdateDomain(ctx context.Context, UpdateRequest *shared.UpdateDomainRequest, opts ...yarpc.CallOption) (*shared.UpdateDomainResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -61,6 +61,52 @@ func (_m *HistoryClient) DescribeHistoryHost(ctx context.Context, request *share
 	return r0, r1
 }
 
+// ListDLQTasks provides a mock function with given fields: ctx, getRequest
+func (_m *HistoryClient) ListDLQTasks(ctx context.Context, request *shared.ListDLQTasksRequest, opts ...yarpc.CallOption) (*shared.ListDLQTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ListDLQTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ListDLQTasksRequest) *shared.ListDLQTasksResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListDLQTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ListDLQTasksRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDLQTask provides a mock function with given fields: ctx, getRequest
+func (_m *HistoryClient) DescribeDLQTask(ctx context.Context, request *shared.DescribeDLQTaskRequest, opts ...yarpc.CallOption) (*shared.DescribeDLQTaskResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeDLQTaskResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DescribeDLQTaskRequest) *shared.DescribeDLQTaskResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeDLQTaskResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.DescribeDLQTaskRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeMutableState provides a mock function with given fields: ctx, getRequest
 func (_m *HistoryClient) DescribeMutableState(ctx context.Context, request *history.DescribeMutableStateRequest, opts ...yarpc.CallOption) (*history.DescribeMutableStateResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -107,6 +153,52 @@ func (_m *HistoryClient) GetMutableState(ctx context.Context, getRequest *histor
 	return r0, r1
 }
 
+// QueryWorkflow provides a mock function with given fields: ctx, queryRequest
+func (_m *HistoryClient) QueryWorkflow(ctx context.Context, queryRequest *history.QueryWorkflowRequest, opts ...yarpc.CallOption) (*history.QueryWorkflowResponse, error) {
+	ret := _m.Called(ctx, queryRequest)
+
+	var r0 *history.QueryWorkflowResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.QueryWorkflowRequest) *history.QueryWorkflowResponse); ok {
+		r0 = rf(ctx, queryRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.QueryWorkflowResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.QueryWorkflowRequest) error); ok {
+		r1 = rf(ctx, queryRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWorkflowExecution provides a mock function with given fields: ctx, updateRequest
+func (_m *HistoryClient) UpdateWorkflowExecution(ctx context.Context, updateRequest *history.UpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*history.UpdateWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, updateRequest)
+
+	var r0 *history.UpdateWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.UpdateWorkflowExecutionRequest) *history.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, updateRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.UpdateWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.UpdateWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, updateRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResetStickyTaskList provides a mock function with given fields: ctx, getRequest
 func (_m *HistoryClient) ResetStickyTaskList(ctx context.Context, request *history.ResetStickyTaskListRequest, opts ...yarpc.CallOption) (*history.ResetStickyTaskListResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -405,6 +497,34 @@ func (_m *HistoryClient) TerminateWorkflowExecution(ctx context.Context, termina
 	return r0
 }
 
+// PauseWorkflowExecution provides a mock function with given fields: ctx, pauseRequest
+func (_m *HistoryClient) PauseWorkflowExecution(ctx context.Context, pauseRequest *history.PauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, pauseRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.PauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, pauseRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeWorkflowExecution provides a mock function with given fields: ctx, resumeRequest
+func (_m *HistoryClient) ResumeWorkflowExecution(ctx context.Context, resumeRequest *history.ResumeWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, resumeRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.ResumeWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, resumeRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResetWorkflowExecution provides a mock function with given fields: ctx, terminateRequest
 func (_m *HistoryClient) ResetWorkflowExecution(ctx context.Context, resetRequest *history.ResetWorkflowExecutionRequest, opts ...yarpc.CallOption) (*shared.ResetWorkflowExecutionResponse, error) {
 	ret := _m.Called(ctx, resetRequest)
@@ -498,6 +618,122 @@ func (_m *HistoryClient) SyncShardStatus(ctx context.Context, request *history.S
 	return r0
 }
 
+// RequeueDLQTask provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) RequeueDLQTask(ctx context.Context, request *shared.RequeueDLQTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.RequeueDLQTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeDLQTasks provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) PurgeDLQTasks(ctx context.Context, request *shared.PurgeDLQTasksRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.PurgeDLQTasksRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListShardQueueTasks provides a mock function with given fields: ctx, getRequest
+func (_m *HistoryClient) ListShardQueueTasks(ctx context.Context, request *shared.ListShardQueueTasksRequest, opts ...yarpc.CallOption) (*shared.ListShardQueueTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ListShardQueueTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ListShardQueueTasksRequest) *shared.ListShardQueueTasksResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListShardQueueTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ListShardQueueTasksRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeShardQueues provides a mock function with given fields: ctx, getRequest
+func (_m *HistoryClient) DescribeShardQueues(ctx context.Context, request *shared.DescribeShardQueuesRequest, opts ...yarpc.CallOption) (*shared.DescribeShardQueuesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeShardQueuesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DescribeShardQueuesRequest) *shared.DescribeShardQueuesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeShardQueuesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.DescribeShardQueuesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefireShardQueueTask provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) RefireShardQueueTask(ctx context.Context, request *shared.RefireShardQueueTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.RefireShardQueueTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteShardQueueTask provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DeleteShardQueueTask(ctx context.Context, request *shared.DeleteShardQueueTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DeleteShardQueueTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HandoffShard provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) HandoffShard(ctx context.Context, request *history.HandoffShardRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.HandoffShardRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SyncActivity provides a mock function with given fields: ctx, request
 func (_m *HistoryClient) SyncActivity(ctx context.Context, request *history.SyncActivityRequest, opts ...yarpc.CallOption) error {
 	ret := _m.Called(ctx, request)
@@ -0,0 +1,138 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+import persistence "github.com/uber/cadence/common/persistence"
+
+// TaskDLQManager is an autogenerated mock type for the TaskDLQManager type
+type TaskDLQManager struct {
+	mock.Mock
+}
+
+// GetName provides a mock function with given fields:
+func (_m *TaskDLQManager) GetName() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Close provides a mock function with given fields:
+func (_m *TaskDLQManager) Close() {
+	_m.Called()
+}
+
+// PutDLQTask provides a mock function with given fields: request
+func (_m *TaskDLQManager) PutDLQTask(request *persistence.PutDLQTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.PutDLQTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListDLQTasks provides a mock function with given fields: request
+func (_m *TaskDLQManager) ListDLQTasks(request *persistence.ListDLQTasksRequest) (*persistence.ListDLQTasksResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.ListDLQTasksResponse
+	if rf, ok := ret.Get(0).(func(*persistence.ListDLQTasksRequest) *persistence.ListDLQTasksResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ListDLQTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.ListDLQTasksRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDLQTask provides a mock function with given fields: request
+func (_m *TaskDLQManager) GetDLQTask(request *persistence.GetDLQTaskRequest) (*persistence.GetDLQTaskResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetDLQTaskResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetDLQTaskRequest) *persistence.GetDLQTaskResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetDLQTaskResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetDLQTaskRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDLQTask provides a mock function with given fields: request
+func (_m *TaskDLQManager) DeleteDLQTask(request *persistence.DeleteDLQTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.DeleteDLQTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RangeDeleteDLQTasks provides a mock function with given fields: request
+func (_m *TaskDLQManager) RangeDeleteDLQTasks(request *persistence.RangeDeleteDLQTasksRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.RangeDeleteDLQTasksRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+var _ persistence.TaskDLQManager = (*TaskDLQManager)(nil)
@@ -0,0 +1,83 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"time"
+)
+
+// Interfaces for the Audit Store.
+// The audit store is an append only log of the mutating control plane
+// operations (domain management, terminate, reset etc) that were issued
+// against the cluster, kept so that operators can later find out who
+// changed what and why.
+
+type (
+	// AuditRecord is a single entry of the audit log
+	AuditRecord struct {
+		ID        string
+		Timestamp time.Time
+		// Operation is the name of the API that was invoked, ex: TerminateWorkflowExecution
+		Operation string
+		// DomainName is empty for cluster level operations, ex: AddSearchAttribute
+		DomainName     string
+		WorkflowID     string
+		RunID          string
+		Identity       string
+		Reason         string
+		RequestSummary string
+		// Result is either AuditResultSuccess or the error returned by the operation
+		Result string
+	}
+
+	// CreateAuditRecordRequest is used to append a record to the audit log
+	CreateAuditRecordRequest struct {
+		Record *AuditRecord
+	}
+
+	// ListAuditRecordsRequest is used to list the audit records of a domain,
+	// newest first. An empty DomainName lists the cluster level records
+	ListAuditRecordsRequest struct {
+		DomainName    string
+		EarliestTime  time.Time
+		LatestTime    time.Time
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListAuditRecordsResponse is the response to ListAuditRecordsRequest
+	ListAuditRecordsResponse struct {
+		Records []*AuditRecord
+		// NextPageToken is empty when there are no more records to read
+		NextPageToken []byte
+	}
+
+	// AuditManager is used to manage the audit log
+	AuditManager interface {
+		Closeable
+		GetName() string
+		CreateAuditRecord(request *CreateAuditRecordRequest) error
+		ListAuditRecords(request *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error)
+	}
+)
+
+// AuditResultSuccess is the result recorded for operations that succeeded
+const AuditResultSuccess = "Success"
@@ -0,0 +1,203 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	templateCreateAuditRecordQuery = `INSERT INTO audit_log (` +
+		`domain_name, bucket, created_time, record_id, operation, workflow_id, run_id, identity, reason, request_summary, result) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateListAuditRecordsQuery = `SELECT created_time, record_id, operation, workflow_id, run_id, identity, reason, request_summary, result ` +
+		`FROM audit_log ` +
+		`WHERE domain_name = ? ` +
+		`AND bucket = ? ` +
+		`AND created_time >= ? ` +
+		`AND created_time <= ? `
+)
+
+const (
+	// auditBucketSize is the span of time whose records of a domain share a partition
+	auditBucketSize = 24 * time.Hour
+	// auditRecordTTL matches the default_time_to_live of the audit_log table
+	auditRecordTTL = 365 * 24 * time.Hour
+)
+
+type (
+	cassandraAuditPersistence struct {
+		cassandraStore
+	}
+
+	// auditPageToken is the position of a list query, it is the bucket to
+	// resume from and the cassandra page state within that bucket
+	auditPageToken struct {
+		Bucket    time.Time
+		PageState []byte
+	}
+)
+
+var _ p.AuditStore = (*cassandraAuditPersistence)(nil)
+
+// newAuditPersistence is used to create an instance of AuditManager implementation
+func newAuditPersistence(cfg config.Cassandra, logger log.Logger) (p.AuditStore, error) {
+	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
+	cluster.Keyspace = cfg.Keyspace
+	cluster.ProtoVersion = cassandraProtoVersion
+	cluster.Consistency = gocql.LocalQuorum
+	cluster.SerialConsistency = gocql.LocalSerial
+	cluster.Timeout = defaultSessionTimeout
+
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return &cassandraAuditPersistence{
+		cassandraStore: cassandraStore{session: session, logger: logger},
+	}, nil
+}
+
+func (a *cassandraAuditPersistence) CreateAuditRecord(request *p.CreateAuditRecordRequest) error {
+	record := request.Record
+	query := a.session.Query(templateCreateAuditRecordQuery,
+		record.DomainName,
+		getAuditBucket(record.Timestamp),
+		record.Timestamp,
+		record.ID,
+		record.Operation,
+		record.WorkflowID,
+		record.RunID,
+		record.Identity,
+		record.Reason,
+		record.RequestSummary,
+		record.Result,
+	)
+	if err := query.Exec(); err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("CreateAuditRecord operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CreateAuditRecord operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (a *cassandraAuditPersistence) ListAuditRecords(request *p.ListAuditRecordsRequest) (*p.ListAuditRecordsResponse, error) {
+	// records older than the TTL are gone, so there is no point in reading their buckets
+	earliestBucket := getAuditBucket(request.EarliestTime)
+	if retained := getAuditBucket(time.Now().Add(-auditRecordTTL)); earliestBucket.Before(retained) {
+		earliestBucket = retained
+	}
+	token := &auditPageToken{Bucket: getAuditBucket(request.LatestTime)}
+	if len(request.NextPageToken) != 0 {
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, &workflow.BadRequestError{
+				Message: fmt.Sprintf("ListAuditRecords operation failed. Invalid next page token: %v", err),
+			}
+		}
+	}
+
+	response := &p.ListAuditRecordsResponse{}
+	for !token.Bucket.Before(earliestBucket) {
+		query := a.session.Query(templateListAuditRecordsQuery,
+			request.DomainName,
+			token.Bucket,
+			request.EarliestTime,
+			request.LatestTime,
+		)
+		pageSize := request.PageSize - len(response.Records)
+		iter := query.PageSize(pageSize).PageState(token.PageState).Iter()
+		if iter == nil {
+			return nil, &workflow.InternalServiceError{
+				Message: "ListAuditRecords operation failed.  Not able to create query iterator.",
+			}
+		}
+
+		record := &p.AuditRecord{DomainName: request.DomainName}
+		for len(response.Records) < request.PageSize && iter.Scan(
+			&record.Timestamp,
+			&record.ID,
+			&record.Operation,
+			&record.WorkflowID,
+			&record.RunID,
+			&record.Identity,
+			&record.Reason,
+			&record.RequestSummary,
+			&record.Result,
+		) {
+			response.Records = append(response.Records, record)
+			record = &p.AuditRecord{DomainName: request.DomainName}
+		}
+
+		pageState := iter.PageState()
+		if err := iter.Close(); err != nil {
+			if isThrottlingError(err) {
+				return nil, &workflow.ServiceBusyError{
+					Message: fmt.Sprintf("ListAuditRecords operation failed. Error: %v", err),
+				}
+			}
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListAuditRecords operation failed. Error: %v", err),
+			}
+		}
+
+		if len(pageState) != 0 {
+			token.PageState = make([]byte, len(pageState))
+			copy(token.PageState, pageState)
+		} else {
+			token = &auditPageToken{Bucket: token.Bucket.Add(-auditBucketSize)}
+		}
+		if len(response.Records) >= request.PageSize {
+			break
+		}
+	}
+
+	if !token.Bucket.Before(earliestBucket) {
+		nextPageToken, err := json.Marshal(token)
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListAuditRecords operation failed. Error: %v", err),
+			}
+		}
+		response.NextPageToken = nextPageToken
+	}
+	return response, nil
+}
+
+// getAuditBucket returns the start of the UTC day of the given time, the
+// records created within that day share a partition
+func getAuditBucket(t time.Time) time.Time {
+	return t.UTC().Truncate(auditBucketSize)
+}
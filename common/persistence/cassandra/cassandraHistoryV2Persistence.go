@@ -44,6 +44,9 @@ const (
 
 	v2templateRangeDeleteData = `DELETE FROM history_node WHERE tree_id = ? AND branch_id = ? AND node_id >= ? `
 
+	v2templateUpdateData = `UPDATE history_node SET data = ?, data_encoding = ? ` +
+		`WHERE tree_id = ? AND branch_id = ? AND node_id = ? AND txn_id = ? IF EXISTS `
+
 	// below are templates for history_tree table
 	v2templateInsertTree = `INSERT INTO history_tree (` +
 		`tree_id, branch_id, ancestors, in_progress, fork_time, info) ` +
@@ -54,6 +57,8 @@ const (
 	v2templateDeleteBranch = `DELETE FROM history_tree WHERE tree_id = ? AND branch_id = ? `
 
 	v2templateUpdateBranch = `UPDATE history_tree set in_progress = ? WHERE tree_id = ? AND branch_id = ? `
+
+	v2templateScanAllBranches = `SELECT tree_id, branch_id, fork_time, info FROM history_tree `
 )
 
 type (
@@ -169,6 +174,9 @@ func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(request *p.InternalRea
 	nodeID := int64(0)
 	txnID := int64(0)
 
+	nodeIDs := make([]int64, 0, int(request.PageSize))
+	txnIDs := make([]int64, 0, int(request.PageSize))
+
 	for iter.Scan(&nodeID, &txnID, &eventBlob.Data, &eventBlob.Encoding) {
 		if nodeID == lastNodeID {
 			if txnID < lastTxnID {
@@ -183,6 +191,8 @@ func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(request *p.InternalRea
 		lastTxnID = txnID
 		lastNodeID = nodeID
 		history = append(history, eventBlob)
+		nodeIDs = append(nodeIDs, nodeID)
+		txnIDs = append(txnIDs, txnID)
 		eventBlob = &p.DataBlob{}
 	}
 
@@ -193,8 +203,10 @@ func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(request *p.InternalRea
 	}
 
 	response := &p.InternalReadHistoryBranchResponse{
-		History:       history,
-		NextPageToken: pagingToken,
+		History:        history,
+		NodeIDs:        nodeIDs,
+		TransactionIDs: txnIDs,
+		NextPageToken:  pagingToken,
 	}
 
 	return response, nil
@@ -490,3 +502,57 @@ func (h *cassandraHistoryV2Persistence) parseBranchAncestors(ancestors []map[str
 	}
 	return ans
 }
+
+// GetAllHistoryTreeBranches returns all branches of all trees
+func (h *cassandraHistoryV2Persistence) GetAllHistoryTreeBranches(request *p.GetAllHistoryTreeBranchesRequest) (*p.GetAllHistoryTreeBranchesResponse, error) {
+	query := h.session.Query(v2templateScanAllBranches)
+
+	iter := query.PageSize(int(request.PageSize)).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetAllHistoryTreeBranches operation failed.  Not able to create query iterator.",
+		}
+	}
+	pagingToken := iter.PageState()
+
+	branches := make([]p.HistoryBranchDetail, 0, int(request.PageSize))
+	treeUUID := gocql.UUID{}
+	branchUUID := gocql.UUID{}
+	forkTime := time.Time{}
+	info := ""
+
+	for iter.Scan(&treeUUID, &branchUUID, &forkTime, &info) {
+		branches = append(branches, p.HistoryBranchDetail{
+			TreeID:   treeUUID.String(),
+			BranchID: branchUUID.String(),
+			ForkTime: forkTime,
+			Info:     info,
+		})
+
+		treeUUID = gocql.UUID{}
+		branchUUID = gocql.UUID{}
+		forkTime = time.Time{}
+		info = ""
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, convertCommonErrors("GetAllHistoryTreeBranches", err)
+	}
+
+	return &p.GetAllHistoryTreeBranchesResponse{
+		Branches:      branches,
+		NextPageToken: pagingToken,
+	}, nil
+}
+
+// UpdateHistoryNode overwrites the events of an existing node
+func (h *cassandraHistoryV2Persistence) UpdateHistoryNode(request *p.InternalUpdateHistoryNodeRequest) error {
+	query := h.session.Query(v2templateUpdateData,
+		request.Events.Data, request.Events.Encoding, request.TreeID, request.BranchID, request.NodeID, request.TransactionID)
+
+	// the node is left alone if it has been deleted in the meantime
+	if _, err := query.MapScanCAS(make(map[string]interface{})); err != nil {
+		return convertCommonErrors("UpdateHistoryNode", err)
+	}
+	return nil
+}
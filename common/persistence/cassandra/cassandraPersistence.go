@@ -166,7 +166,9 @@ const (
 		`branch_token: ?, ` +
 		`cron_schedule: ?, ` +
 		`expiration_seconds: ?, ` +
-		`search_attributes: ? ` +
+		`search_attributes: ?, ` +
+		`paused: ?, ` +
+		`requested_updates: ? ` +
 		`}`
 
 	templateReplicationStateType = `{` +
@@ -420,6 +422,11 @@ workflow_state = ? ` +
 		`and visibility_ts = ? ` +
 		`and task_id = ?`
 
+	templateListWorkflowExecutionQuery = `SELECT domain_id, workflow_id, run_id, current_run_id, execution ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ?`
+
 	templateCheckWorkflowExecutionQuery = `UPDATE executions ` +
 		`SET next_event_id = ? ` +
 		`WHERE shard_id = ? ` +
@@ -1771,6 +1778,102 @@ func (d *cassandraPersistence) GetCurrentExecution(request *p.GetCurrentExecutio
 	}, nil
 }
 
+func (d *cassandraPersistence) ListConcreteExecutions(
+	request *p.ListConcreteExecutionsRequest,
+) (*p.InternalListConcreteExecutionsResponse, error) {
+
+	query := d.session.Query(templateListWorkflowExecutionQuery,
+		d.shardID,
+		rowTypeExecution,
+	).PageSize(request.PageSize).PageState(request.PageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListConcreteExecutions operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.InternalListConcreteExecutionsResponse{}
+	result := make(map[string]interface{})
+	for iter.MapScan(result) {
+		// the current execution records share the partition with the concrete execution records
+		if result["run_id"].(gocql.UUID).String() != permanentRunID {
+			info := createWorkflowExecutionInfo(result["execution"].(map[string]interface{}))
+			response.ExecutionInfos = append(response.ExecutionInfos, info)
+		}
+		// Reset result map to get it ready for next scan
+		result = make(map[string]interface{})
+	}
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("ListConcreteExecutions operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListConcreteExecutions operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+func (d *cassandraPersistence) ListCurrentExecutions(
+	request *p.ListCurrentExecutionsRequest,
+) (*p.ListCurrentExecutionsResponse, error) {
+
+	query := d.session.Query(templateListWorkflowExecutionQuery,
+		d.shardID,
+		rowTypeExecution,
+	).PageSize(request.PageSize).PageState(request.PageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListCurrentExecutions operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.ListCurrentExecutionsResponse{}
+	result := make(map[string]interface{})
+	for iter.MapScan(result) {
+		// the concrete execution records share the partition with the current execution records
+		if result["run_id"].(gocql.UUID).String() == permanentRunID {
+			info := createWorkflowExecutionInfo(result["execution"].(map[string]interface{}))
+			response.Executions = append(response.Executions, &p.CurrentWorkflowExecution{
+				DomainID:    result["domain_id"].(gocql.UUID).String(),
+				WorkflowID:  result["workflow_id"].(string),
+				RunID:       result["current_run_id"].(gocql.UUID).String(),
+				State:       info.State,
+				CloseStatus: info.CloseStatus,
+			})
+		}
+		// Reset result map to get it ready for next scan
+		result = make(map[string]interface{})
+	}
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("ListCurrentExecutions operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListCurrentExecutions operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
 func (d *cassandraPersistence) GetTransferTasks(request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
 
 	// Reading transfer tasks need to be quorum level consistent, otherwise we could loose task
@@ -448,6 +448,8 @@ func createExecution(
 			executionInfo.CronSchedule,
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
+			executionInfo.Paused,
+			executionInfo.RequestedUpdates,
 			executionInfo.NextEventID,
 			defaultVisibilityTimestamp,
 			rowTypeExecutionTaskID)
@@ -519,6 +521,8 @@ func createExecution(
 			executionInfo.CronSchedule,
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
+			executionInfo.Paused,
+			executionInfo.RequestedUpdates,
 			replicationState.CurrentVersion,
 			replicationState.StartVersion,
 			replicationState.LastWriteVersion,
@@ -625,6 +629,8 @@ func updateExecution(
 			executionInfo.CronSchedule,
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
+			executionInfo.Paused,
+			executionInfo.RequestedUpdates,
 			executionInfo.NextEventID,
 			shardID,
 			rowTypeExecution,
@@ -697,6 +703,8 @@ func updateExecution(
 			executionInfo.CronSchedule,
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
+			executionInfo.Paused,
+			executionInfo.RequestedUpdates,
 			replicationState.CurrentVersion,
 			replicationState.StartVersion,
 			replicationState.LastWriteVersion,
@@ -1758,6 +1766,10 @@ func createWorkflowExecutionInfo(
 			info.ExpirationSeconds = int32(v.(int))
 		case "search_attributes":
 			info.SearchAttributes = v.(map[string][]byte)
+		case "paused":
+			info.Paused = v.(bool)
+		case "requested_updates":
+			info.RequestedUpdates = v.(map[string]bool)
 		}
 	}
 	info.CompletionEvent = p.NewDataBlob(completionEventData, completionEventEncoding)
@@ -0,0 +1,209 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"fmt"
+
+	"github.com/gocql/gocql"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	templateDLQTaskColumns = `task_id, task_type, domain_id, workflow_id, run_id, visibility_ts, data, last_error, attempt, created_time`
+
+	templatePutDLQTaskQuery = `INSERT INTO task_dlq (` +
+		`shard_id, category, ` + templateDLQTaskColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateListDLQTasksQuery = `SELECT ` + templateDLQTaskColumns + ` ` +
+		`FROM task_dlq ` +
+		`WHERE shard_id = ? ` +
+		`AND category = ?`
+
+	templateGetDLQTaskQuery = templateListDLQTasksQuery + ` ` +
+		`AND task_id = ?`
+
+	templateDeleteDLQTaskQuery = `DELETE FROM task_dlq ` +
+		`WHERE shard_id = ? ` +
+		`AND category = ? ` +
+		`AND task_id = ?`
+
+	templateRangeDeleteDLQTasksQuery = `DELETE FROM task_dlq ` +
+		`WHERE shard_id = ? ` +
+		`AND category = ?`
+)
+
+type (
+	cassandraTaskDLQPersistence struct {
+		cassandraStore
+	}
+)
+
+var _ p.TaskDLQStore = (*cassandraTaskDLQPersistence)(nil)
+
+// newTaskDLQPersistence is used to create an instance of TaskDLQManager implementation
+func newTaskDLQPersistence(cfg config.Cassandra, logger log.Logger) (p.TaskDLQStore, error) {
+	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
+	cluster.Keyspace = cfg.Keyspace
+	cluster.ProtoVersion = cassandraProtoVersion
+	cluster.Consistency = gocql.LocalQuorum
+	cluster.SerialConsistency = gocql.LocalSerial
+	cluster.Timeout = defaultSessionTimeout
+
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return &cassandraTaskDLQPersistence{
+		cassandraStore: cassandraStore{session: session, logger: logger},
+	}, nil
+}
+
+func (d *cassandraTaskDLQPersistence) PutDLQTask(request *p.PutDLQTaskRequest) error {
+	task := request.Task
+	query := d.session.Query(templatePutDLQTaskQuery,
+		task.ShardID,
+		task.Category,
+		task.TaskID,
+		task.TaskType,
+		task.DomainID,
+		task.WorkflowID,
+		task.RunID,
+		task.VisibilityTimestamp,
+		task.Data,
+		task.LastError,
+		task.Attempt,
+		task.CreatedTime,
+	)
+	if err := query.Exec(); err != nil {
+		return convertTaskDLQError("PutDLQTask", err)
+	}
+	return nil
+}
+
+func (d *cassandraTaskDLQPersistence) ListDLQTasks(request *p.ListDLQTasksRequest) (*p.ListDLQTasksResponse, error) {
+	query := d.session.Query(templateListDLQTasksQuery,
+		request.ShardID,
+		request.Category,
+	)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListDLQTasks operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.ListDLQTasksResponse{}
+	task := &p.DLQTask{ShardID: request.ShardID, Category: request.Category}
+	for scanDLQTask(iter, task) {
+		response.Tasks = append(response.Tasks, task)
+		task = &p.DLQTask{ShardID: request.ShardID, Category: request.Category}
+	}
+
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+	if err := iter.Close(); err != nil {
+		return nil, convertTaskDLQError("ListDLQTasks", err)
+	}
+	return response, nil
+}
+
+func (d *cassandraTaskDLQPersistence) GetDLQTask(request *p.GetDLQTaskRequest) (*p.GetDLQTaskResponse, error) {
+	query := d.session.Query(templateGetDLQTaskQuery,
+		request.ShardID,
+		request.Category,
+		request.TaskID,
+	)
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetDLQTask operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	task := &p.DLQTask{ShardID: request.ShardID, Category: request.Category}
+	found := scanDLQTask(iter, task)
+	if err := iter.Close(); err != nil {
+		return nil, convertTaskDLQError("GetDLQTask", err)
+	}
+	if !found {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("DLQ task not found.  ShardId: %v, Category: %v, TaskId: %v",
+				request.ShardID, request.Category, request.TaskID),
+		}
+	}
+	return &p.GetDLQTaskResponse{Task: task}, nil
+}
+
+func (d *cassandraTaskDLQPersistence) DeleteDLQTask(request *p.DeleteDLQTaskRequest) error {
+	query := d.session.Query(templateDeleteDLQTaskQuery,
+		request.ShardID,
+		request.Category,
+		request.TaskID,
+	)
+	if err := query.Exec(); err != nil {
+		return convertTaskDLQError("DeleteDLQTask", err)
+	}
+	return nil
+}
+
+func (d *cassandraTaskDLQPersistence) RangeDeleteDLQTasks(request *p.RangeDeleteDLQTasksRequest) error {
+	query := d.session.Query(templateRangeDeleteDLQTasksQuery,
+		request.ShardID,
+		request.Category,
+	)
+	if err := query.Exec(); err != nil {
+		return convertTaskDLQError("RangeDeleteDLQTasks", err)
+	}
+	return nil
+}
+
+func scanDLQTask(iter *gocql.Iter, task *p.DLQTask) bool {
+	return iter.Scan(
+		&task.TaskID,
+		&task.TaskType,
+		&task.DomainID,
+		&task.WorkflowID,
+		&task.RunID,
+		&task.VisibilityTimestamp,
+		&task.Data,
+		&task.LastError,
+		&task.Attempt,
+		&task.CreatedTime,
+	)
+}
+
+func convertTaskDLQError(operation string, err error) error {
+	if isThrottlingError(err) {
+		return &workflow.ServiceBusyError{
+			Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
+		}
+	}
+	return &workflow.InternalServiceError{
+		Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
+	}
+}
@@ -103,6 +103,16 @@ func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
 	return newVisibilityPersistence(f.cfg, f.logger)
 }
 
+// NewAuditStore returns an audit log store
+func (f *Factory) NewAuditStore() (p.AuditStore, error) {
+	return newAuditPersistence(f.cfg, f.logger)
+}
+
+// NewTaskDLQStore returns a task DLQ store
+func (f *Factory) NewTaskDLQStore() (p.TaskDLQStore, error) {
+	return newTaskDLQPersistence(f.cfg, f.logger)
+}
+
 // Close closes the factory
 func (f *Factory) Close() {
 	f.Lock()
@@ -282,6 +282,10 @@ type (
 		// Cron
 		CronSchedule      string
 		ExpirationSeconds int32
+		// Pause
+		Paused bool
+		// Update, the update ID to whether the update is completed
+		RequestedUpdates map[string]bool
 	}
 
 	// ExecutionStats is the statistics about workflow execution
@@ -715,6 +719,39 @@ type (
 		LastWriteVersion int64
 	}
 
+	// ListConcreteExecutionsRequest contains the request params needed to invoke ListConcreteExecutions API
+	ListConcreteExecutionsRequest struct {
+		PageSize  int
+		PageToken []byte
+	}
+
+	// ListConcreteExecutionsResponse is the response from ListConcreteExecutions API
+	ListConcreteExecutionsResponse struct {
+		ExecutionInfos []*WorkflowExecutionInfo
+		NextPageToken  []byte
+	}
+
+	// ListCurrentExecutionsRequest contains the request params needed to invoke ListCurrentExecutions API
+	ListCurrentExecutionsRequest struct {
+		PageSize  int
+		PageToken []byte
+	}
+
+	// ListCurrentExecutionsResponse is the response from ListCurrentExecutions API
+	ListCurrentExecutionsResponse struct {
+		Executions    []*CurrentWorkflowExecution
+		NextPageToken []byte
+	}
+
+	// CurrentWorkflowExecution is the current run of a workflow, as recorded by the current execution record
+	CurrentWorkflowExecution struct {
+		DomainID    string
+		WorkflowID  string
+		RunID       string
+		State       int
+		CloseStatus int
+	}
+
 	// UpdateWorkflowExecutionRequest is used to update a workflow execution
 	UpdateWorkflowExecutionRequest struct {
 		RangeID int64
@@ -1225,6 +1262,8 @@ type (
 		IsNewBranch bool
 		// the info for clean up data in background
 		Info string
+		// the domain of the workflow, whose data key the events are encrypted with
+		DomainID string
 		// The branch to be appended
 		BranchToken []byte
 		// The batch of events to be appended. The first eventID will become the nodeID of this batch
@@ -1350,6 +1389,52 @@ type (
 		ForkingInProgressBranches []ForkingInProgressBranch
 	}
 
+	// GetAllHistoryTreeBranchesRequest is a request of GetAllHistoryTreeBranches
+	GetAllHistoryTreeBranchesRequest struct {
+		// pagination token
+		NextPageToken []byte
+		// maximum number of branches on one page
+		PageSize int
+	}
+
+	// HistoryBranchDetail contains detailed information of a branch
+	HistoryBranchDetail struct {
+		TreeID   string
+		BranchID string
+		ForkTime time.Time
+		Info     string
+		// the shard of the branch, only set by stores which partition history by shard
+		ShardID int
+	}
+
+	// GetAllHistoryTreeBranchesResponse is a response to GetAllHistoryTreeBranches
+	GetAllHistoryTreeBranchesResponse struct {
+		// pagination token
+		NextPageToken []byte
+		// all branches of all trees
+		Branches []HistoryBranchDetail
+	}
+
+	// ReencryptHistoryBranchRequest is used to re-encrypt the history nodes of a branch
+	// with the active data key of its domain
+	ReencryptHistoryBranchRequest struct {
+		// A UUID of a tree
+		TreeID string
+		// A UUID of a branch, only the nodes written to this branch are re-encrypted,
+		// nodes inherited from ancestors are re-encrypted along with the ancestors
+		BranchID string
+		// the domain of the workflow
+		DomainID string
+		// Get data from this shard
+		ShardID *int
+	}
+
+	// ReencryptHistoryBranchResponse is a response to ReencryptHistoryBranchRequest
+	ReencryptHistoryBranchResponse struct {
+		// the number of nodes that have been rewritten
+		ReencryptedNodeCount int
+	}
+
 	// AppendHistoryEventsResponse is response for AppendHistoryEventsRequest
 	// Deprecated: uses V2 API-AppendHistoryNodesRequest
 	AppendHistoryEventsResponse struct {
@@ -1385,6 +1470,10 @@ type (
 		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
 
+		// Scan related methods
+		ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error)
+		ListCurrentExecutions(request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error)
+
 		// Transfer task related methods
 		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
 		CompleteTransferTask(request *CompleteTransferTaskRequest) error
@@ -1470,6 +1559,10 @@ type (
 		DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error
 		// GetHistoryTree returns all branch information of a tree
 		GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
+		// GetAllHistoryTreeBranches returns all branches of all trees
+		GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error)
+		// ReencryptHistoryBranch rewrites the nodes of a branch which are not encrypted with the active data key of its domain
+		ReencryptHistoryBranch(request *ReencryptHistoryBranchRequest) (*ReencryptHistoryBranchResponse, error)
 	}
 
 	// MetadataManager is used to manage metadata CRUD for domain entities
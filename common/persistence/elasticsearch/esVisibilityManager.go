@@ -34,10 +34,10 @@ import (
 // In history, it only needs kafka producer for writing data;
 // In frontend, it only needs ES client and related config for reading data
 func NewESVisibilityManager(indexName string, esClient es.Client, config *config.VisibilityConfig,
-	producer messaging.Producer, metricsClient metrics.Client, log log.Logger) p.VisibilityManager {
+	producer messaging.Producer, encryption p.PayloadEncryption, metricsClient metrics.Client, log log.Logger) p.VisibilityManager {
 
 	visibilityFromESStore := NewElasticSearchVisibilityStore(esClient, indexName, producer, config, log)
-	visibilityFromES := p.NewVisibilityManagerImpl(visibilityFromESStore, log)
+	visibilityFromES := p.NewVisibilityManagerImpl(visibilityFromESStore, encryption, log)
 
 	if config != nil {
 		// wrap with rate limiter
@@ -30,6 +30,7 @@ type (
 	// executionManagerImpl implements ExecutionManager based on ExecutionStore, statsComputer and PayloadSerializer
 	executionManagerImpl struct {
 		serializer    PayloadSerializer
+		encryption    PayloadEncryption
 		persistence   ExecutionStore
 		statsComputer statsComputer
 		logger        log.Logger
@@ -41,11 +42,13 @@ var _ ExecutionManager = (*executionManagerImpl)(nil)
 // NewExecutionManagerImpl returns new ExecutionManager
 func NewExecutionManagerImpl(
 	persistence ExecutionStore,
+	encryption PayloadEncryption,
 	logger log.Logger,
 ) ExecutionManager {
 
 	return &executionManagerImpl{
-		serializer:    NewPayloadSerializer(),
+		serializer:    NewEncryptingPayloadSerializer(encryption, ""),
+		encryption:    encryption,
 		persistence:   persistence,
 		statsComputer: statsComputer{},
 		logger:        logger,
@@ -169,6 +172,8 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		ExpirationSeconds:            info.ExpirationSeconds,
 		AutoResetPoints:              autoResetPoints,
 		SearchAttributes:             info.SearchAttributes,
+		Paused:                       info.Paused,
+		RequestedUpdates:             info.RequestedUpdates,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -319,16 +324,18 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(
 
 func (m *executionManagerImpl) SerializeUpsertChildExecutionInfos(
 	infos []*ChildExecutionInfo,
+	domainID string,
 	encoding common.EncodingType,
 ) ([]*InternalChildExecutionInfo, error) {
 
+	serializer := NewEncryptingPayloadSerializer(m.encryption, domainID)
 	newInfos := make([]*InternalChildExecutionInfo, 0)
 	for _, v := range infos {
-		initiatedEvent, err := m.serializer.SerializeEvent(v.InitiatedEvent, encoding)
+		initiatedEvent, err := serializer.SerializeEvent(v.InitiatedEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
-		startedEvent, err := m.serializer.SerializeEvent(v.StartedEvent, encoding)
+		startedEvent, err := serializer.SerializeEvent(v.StartedEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -353,16 +360,18 @@ func (m *executionManagerImpl) SerializeUpsertChildExecutionInfos(
 
 func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 	infos []*ActivityInfo,
+	domainID string,
 	encoding common.EncodingType,
 ) ([]*InternalActivityInfo, error) {
 
+	serializer := NewEncryptingPayloadSerializer(m.encryption, domainID)
 	newInfos := make([]*InternalActivityInfo, 0)
 	for _, v := range infos {
-		scheduledEvent, err := m.serializer.SerializeEvent(v.ScheduledEvent, encoding)
+		scheduledEvent, err := serializer.SerializeEvent(v.ScheduledEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
-		startedEvent, err := m.serializer.SerializeEvent(v.StartedEvent, encoding)
+		startedEvent, err := serializer.SerializeEvent(v.StartedEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -415,12 +424,13 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 	if info == nil {
 		return &InternalWorkflowExecutionInfo{}, nil
 	}
-	completionEvent, err := m.serializer.SerializeEvent(info.CompletionEvent, encoding)
+	serializer := NewEncryptingPayloadSerializer(m.encryption, info.DomainID)
+	completionEvent, err := serializer.SerializeEvent(info.CompletionEvent, encoding)
 	if err != nil {
 		return nil, err
 	}
 
-	resetPoints, err := m.serializer.SerializeResetPoints(info.AutoResetPoints, encoding)
+	resetPoints, err := serializer.SerializeResetPoints(info.AutoResetPoints, encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -479,6 +489,8 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		CronSchedule:                 info.CronSchedule,
 		ExpirationSeconds:            info.ExpirationSeconds,
 		SearchAttributes:             info.SearchAttributes,
+		Paused:                       info.Paused,
+		RequestedUpdates:             info.RequestedUpdates,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
@@ -584,17 +596,19 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 	if err != nil {
 		return nil, err
 	}
-	serializedUpsertActivityInfos, err := m.SerializeUpsertActivityInfos(input.UpsertActivityInfos, encoding)
+	domainID := getDomainID(input.ExecutionInfo)
+	serializedUpsertActivityInfos, err := m.SerializeUpsertActivityInfos(input.UpsertActivityInfos, domainID, encoding)
 	if err != nil {
 		return nil, err
 	}
-	serializedUpsertChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(input.UpsertChildExecutionInfos, encoding)
+	serializedUpsertChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(input.UpsertChildExecutionInfos, domainID, encoding)
 	if err != nil {
 		return nil, err
 	}
 	var serializedNewBufferedEvents *DataBlob
 	if input.NewBufferedEvents != nil {
-		serializedNewBufferedEvents, err = m.serializer.SerializeBatchEvents(input.NewBufferedEvents, encoding)
+		serializer := NewEncryptingPayloadSerializer(m.encryption, domainID)
+		serializedNewBufferedEvents, err = serializer.SerializeBatchEvents(input.NewBufferedEvents, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -640,11 +654,12 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot(
 	if err != nil {
 		return nil, err
 	}
-	serializedActivityInfos, err := m.SerializeUpsertActivityInfos(input.ActivityInfos, encoding)
+	domainID := getDomainID(input.ExecutionInfo)
+	serializedActivityInfos, err := m.SerializeUpsertActivityInfos(input.ActivityInfos, domainID, encoding)
 	if err != nil {
 		return nil, err
 	}
-	serializedChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(input.ChildExecutionInfos, encoding)
+	serializedChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(input.ChildExecutionInfos, domainID, encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -686,6 +701,34 @@ func (m *executionManagerImpl) GetCurrentExecution(
 	return m.persistence.GetCurrentExecution(request)
 }
 
+// Scan related methods
+func (m *executionManagerImpl) ListConcreteExecutions(
+	request *ListConcreteExecutionsRequest,
+) (*ListConcreteExecutionsResponse, error) {
+
+	response, err := m.persistence.ListConcreteExecutions(request)
+	if err != nil {
+		return nil, err
+	}
+	newResponse := &ListConcreteExecutionsResponse{
+		ExecutionInfos: make([]*WorkflowExecutionInfo, len(response.ExecutionInfos)),
+		NextPageToken:  response.NextPageToken,
+	}
+	for i, info := range response.ExecutionInfos {
+		newResponse.ExecutionInfos[i], _, err = m.DeserializeExecutionInfo(info)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newResponse, nil
+}
+
+func (m *executionManagerImpl) ListCurrentExecutions(
+	request *ListCurrentExecutionsRequest,
+) (*ListCurrentExecutionsResponse, error) {
+	return m.persistence.ListCurrentExecutions(request)
+}
+
 // Transfer task related methods
 func (m *executionManagerImpl) GetTransferTasks(
 	request *GetTransferTasksRequest,
@@ -740,3 +783,10 @@ func (m *executionManagerImpl) RangeCompleteTimerTask(
 func (m *executionManagerImpl) Close() {
 	m.persistence.Close()
 }
+
+func getDomainID(info *WorkflowExecutionInfo) string {
+	if info == nil {
+		return ""
+	}
+	return info.DomainID
+}
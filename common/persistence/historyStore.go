@@ -35,6 +35,7 @@ type (
 	// historyManagerImpl implements HistoryManager based on HistoryStore and PayloadSerializer
 	historyManagerImpl struct {
 		serializer           PayloadSerializer
+		encryption           PayloadEncryption
 		persistence          HistoryStore
 		logger               log.Logger
 		transactionSizeLimit dynamicconfig.IntPropertyFn
@@ -51,9 +52,10 @@ type (
 var _ HistoryManager = (*historyManagerImpl)(nil)
 
 //NewHistoryManagerImpl returns new HistoryManager
-func NewHistoryManagerImpl(persistence HistoryStore, logger log.Logger, transactionSizeLimit dynamicconfig.IntPropertyFn) HistoryManager {
+func NewHistoryManagerImpl(persistence HistoryStore, encryption PayloadEncryption, logger log.Logger, transactionSizeLimit dynamicconfig.IntPropertyFn) HistoryManager {
 	return &historyManagerImpl{
-		serializer:           NewPayloadSerializer(),
+		serializer:           NewEncryptingPayloadSerializer(encryption, ""),
+		encryption:           encryption,
 		persistence:          persistence,
 		logger:               logger,
 		transactionSizeLimit: transactionSizeLimit,
@@ -68,7 +70,8 @@ func (m *historyManagerImpl) AppendHistoryEvents(request *AppendHistoryEventsReq
 	if len(request.Events) == 0 {
 		return nil, fmt.Errorf("events to be appended cannot be empty")
 	}
-	eventsData, err := m.serializer.SerializeBatchEvents(request.Events, request.Encoding)
+	serializer := NewEncryptingPayloadSerializer(m.encryption, request.DomainID)
+	eventsData, err := serializer.SerializeBatchEvents(request.Events, request.Encoding)
 	if err != nil {
 		return nil, err
 	}
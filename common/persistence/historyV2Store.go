@@ -37,6 +37,7 @@ type (
 	// historyManagerImpl implements HistoryManager based on HistoryStore and PayloadSerializer
 	historyV2ManagerImpl struct {
 		historySerializer     PayloadSerializer
+		encryption            PayloadEncryption
 		persistence           HistoryV2Store
 		logger                log.Logger
 		thriftEncoder         codec.BinaryEncoder
@@ -45,12 +46,17 @@ type (
 	}
 )
 
+const (
+	reencryptHistoryBranchPageSize = 100
+)
+
 var _ HistoryV2Manager = (*historyV2ManagerImpl)(nil)
 
 //NewHistoryV2ManagerImpl returns new HistoryManager
-func NewHistoryV2ManagerImpl(persistence HistoryV2Store, logger log.Logger, transactionSizeLimit dynamicconfig.IntPropertyFn) HistoryV2Manager {
+func NewHistoryV2ManagerImpl(persistence HistoryV2Store, encryption PayloadEncryption, logger log.Logger, transactionSizeLimit dynamicconfig.IntPropertyFn) HistoryV2Manager {
 	return &historyV2ManagerImpl{
-		historySerializer:     NewPayloadSerializer(),
+		historySerializer:     NewEncryptingPayloadSerializer(encryption, ""),
+		encryption:            encryption,
 		persistence:           persistence,
 		logger:                logger,
 		thriftEncoder:         codec.NewThriftRWEncoder(),
@@ -201,7 +207,11 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(request *AppendHistoryNodesReq
 	}
 
 	// nodeID will be the first eventID
-	blob, err := m.historySerializer.SerializeBatchEvents(request.Events, request.Encoding)
+	serializer := NewEncryptingPayloadSerializer(m.encryption, request.DomainID)
+	blob, err := serializer.SerializeBatchEvents(request.Events, request.Encoding)
+	if err != nil {
+		return nil, err
+	}
 	size := len(blob.Data)
 	sizeLimit := m.transactionSizeLimit()
 	if size > sizeLimit {
@@ -442,6 +452,71 @@ func (m *historyV2ManagerImpl) readHistoryBranch(byBatch bool, request *ReadHist
 	return events, historyBatches, nextToken, dataSize, lastFirstEventID, nil
 }
 
+// GetAllHistoryTreeBranches returns all branches of all trees
+func (m *historyV2ManagerImpl) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, &InvalidPersistenceRequestError{
+			Msg: fmt.Sprintf("pageSize must be greater than 0"),
+		}
+	}
+	return m.persistence.GetAllHistoryTreeBranches(request)
+}
+
+// ReencryptHistoryBranch rewrites the nodes of a branch which are not encrypted with the active data key of its domain.
+// A node is overwritten with the same transaction ID, so a node rewritten concurrently by a newer transaction is left alone
+func (m *historyV2ManagerImpl) ReencryptHistoryBranch(request *ReencryptHistoryBranchRequest) (*ReencryptHistoryBranchResponse, error) {
+	shardID, err := getShardID(request.ShardID)
+	if err != nil {
+		m.logger.Error("shardID is not set in reencrypt history branch operation", tag.Error(err))
+		return nil, &workflow.InternalServiceError{
+			Message: err.Error(),
+		}
+	}
+
+	response := &ReencryptHistoryBranchResponse{}
+	var token []byte
+	for {
+		resp, err := m.persistence.ReadHistoryBranch(&InternalReadHistoryBranchRequest{
+			TreeID:        request.TreeID,
+			BranchID:      request.BranchID,
+			MinNodeID:     common.FirstEventID,
+			MaxNodeID:     common.EndEventID,
+			PageSize:      reencryptHistoryBranchPageSize,
+			NextPageToken: token,
+			ShardID:       shardID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for i, blob := range resp.History {
+			events, changed, err := m.encryption.Reencrypt(request.DomainID, blob)
+			if err != nil {
+				return nil, err
+			}
+			if !changed {
+				continue
+			}
+			if err := m.persistence.UpdateHistoryNode(&InternalUpdateHistoryNodeRequest{
+				TreeID:        request.TreeID,
+				BranchID:      request.BranchID,
+				NodeID:        resp.NodeIDs[i],
+				TransactionID: resp.TransactionIDs[i],
+				Events:        events,
+				ShardID:       shardID,
+			}); err != nil {
+				return nil, err
+			}
+			response.ReencryptedNodeCount++
+		}
+
+		if len(resp.NextPageToken) == 0 {
+			return response, nil
+		}
+		token = resp.NextPageToken
+	}
+}
+
 func (m *historyV2ManagerImpl) Close() {
 	m.persistence.Close()
 }
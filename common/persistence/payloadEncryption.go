@@ -0,0 +1,229 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/encryption"
+)
+
+type (
+	// PayloadEncryption encrypts serialized payloads at rest. Payloads are encrypted with
+	// the data key of the domain they belong to, and decrypted with the key recorded in them
+	PayloadEncryption interface {
+		// Encrypt returns the blob encrypted with the active key of the domain,
+		// or the blob itself if encryption is disabled
+		Encrypt(domainID string, blob *DataBlob) (*DataBlob, error)
+		// Decrypt returns the blob in the encoding it was serialized with,
+		// blobs which are not encrypted are returned as is
+		Decrypt(blob *DataBlob) (*DataBlob, error)
+		// Reencrypt returns the blob as Encrypt would write it today, and whether that
+		// differs from the given blob, i.e. it is encrypted with a key which is no longer
+		// active for the domain, or its encryption does not match the current setting
+		Reencrypt(domainID string, blob *DataBlob) (*DataBlob, bool, error)
+	}
+
+	payloadEncryptionImpl struct {
+		keyProvider encryption.KeyProvider
+		enabled     bool
+	}
+
+	encryptingSerializer struct {
+		serializer PayloadSerializer
+		encryption PayloadEncryption
+		domainID   string
+	}
+)
+
+var _ PayloadSerializer = (*encryptingSerializer)(nil)
+
+// NewPayloadEncryption returns a PayloadEncryption which encrypts new payloads with keys from
+// the key provider if enabled is set. The key provider can be nil if encryption has never been enabled
+func NewPayloadEncryption(keyProvider encryption.KeyProvider, enabled bool) PayloadEncryption {
+	return &payloadEncryptionImpl{
+		keyProvider: keyProvider,
+		enabled:     enabled && keyProvider != nil,
+	}
+}
+
+// NewNoopPayloadEncryption returns a PayloadEncryption which neither encrypts nor decrypts payloads
+func NewNoopPayloadEncryption() PayloadEncryption {
+	return NewPayloadEncryption(nil, false)
+}
+
+func (e *payloadEncryptionImpl) Encrypt(domainID string, blob *DataBlob) (*DataBlob, error) {
+	if !e.enabled || blob == nil || blob.GetEncoding() == common.EncodingTypeEncrypted {
+		return blob, nil
+	}
+	key, err := e.keyProvider.GetActiveKey(domainID)
+	if err != nil {
+		return nil, NewCadenceSerializationError(err.Error())
+	}
+	return e.encrypt(key, blob)
+}
+
+func (e *payloadEncryptionImpl) Decrypt(blob *DataBlob) (*DataBlob, error) {
+	if blob == nil || blob.GetEncoding() != common.EncodingTypeEncrypted {
+		return blob, nil
+	}
+	if e.keyProvider == nil {
+		return nil, NewCadenceDeserializationError("payload is encrypted but no encryption key is configured")
+	}
+	plaintext, err := encryption.Decrypt(e.keyProvider, blob.Data)
+	if err != nil {
+		return nil, NewCadenceDeserializationError(fmt.Sprintf("failed to decrypt payload: %v", err))
+	}
+	if len(plaintext) == 0 || len(plaintext) < 1+int(plaintext[0]) {
+		return nil, NewCadenceDeserializationError("malformed encrypted payload")
+	}
+	encodingLength := int(plaintext[0])
+	return &DataBlob{
+		Encoding: common.EncodingType(plaintext[1 : 1+encodingLength]),
+		Data:     plaintext[1+encodingLength:],
+	}, nil
+}
+
+func (e *payloadEncryptionImpl) Reencrypt(domainID string, blob *DataBlob) (*DataBlob, bool, error) {
+	if blob == nil {
+		return nil, false, nil
+	}
+	encrypted := blob.GetEncoding() == common.EncodingTypeEncrypted
+	if !e.enabled {
+		if !encrypted {
+			return blob, false, nil
+		}
+		decrypted, err := e.Decrypt(blob)
+		return decrypted, err == nil, err
+	}
+
+	key, err := e.keyProvider.GetActiveKey(domainID)
+	if err != nil {
+		return nil, false, NewCadenceSerializationError(err.Error())
+	}
+	if encrypted {
+		if keyID, err := encryption.GetKeyID(blob.Data); err == nil && keyID == key.ID {
+			return blob, false, nil
+		}
+	}
+	decrypted, err := e.Decrypt(blob)
+	if err != nil {
+		return nil, false, err
+	}
+	reencrypted, err := e.encrypt(key, decrypted)
+	return reencrypted, err == nil, err
+}
+
+func (e *payloadEncryptionImpl) encrypt(key *encryption.Key, blob *DataBlob) (*DataBlob, error) {
+	encoding := blob.Encoding
+	plaintext := make([]byte, 0, 1+len(encoding)+len(blob.Data))
+	plaintext = append(plaintext, byte(len(encoding)))
+	plaintext = append(plaintext, encoding...)
+	plaintext = append(plaintext, blob.Data...)
+	ciphertext, err := encryption.Encrypt(key, plaintext)
+	if err != nil {
+		return nil, NewCadenceSerializationError(fmt.Sprintf("failed to encrypt payload: %v", err))
+	}
+	return &DataBlob{
+		Encoding: common.EncodingTypeEncrypted,
+		Data:     ciphertext,
+	}, nil
+}
+
+// NewEncryptingPayloadSerializer returns a PayloadSerializer which encrypts the payloads it
+// serializes with the data key of the domain, and decrypts payloads before deserializing them.
+// Deserialization does not depend on the domain, as payloads carry the ID of their key
+func NewEncryptingPayloadSerializer(encryption PayloadEncryption, domainID string) PayloadSerializer {
+	return &encryptingSerializer{
+		serializer: NewPayloadSerializer(),
+		encryption: encryption,
+		domainID:   domainID,
+	}
+}
+
+func (t *encryptingSerializer) SerializeBatchEvents(batch []*workflow.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error) {
+	return t.encrypt(t.serializer.SerializeBatchEvents(batch, encodingType))
+}
+
+func (t *encryptingSerializer) DeserializeBatchEvents(data *DataBlob) ([]*workflow.HistoryEvent, error) {
+	data, err := t.encryption.Decrypt(data)
+	if err != nil {
+		return nil, err
+	}
+	return t.serializer.DeserializeBatchEvents(data)
+}
+
+func (t *encryptingSerializer) SerializeEvent(event *workflow.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error) {
+	return t.encrypt(t.serializer.SerializeEvent(event, encodingType))
+}
+
+func (t *encryptingSerializer) DeserializeEvent(data *DataBlob) (*workflow.HistoryEvent, error) {
+	data, err := t.encryption.Decrypt(data)
+	if err != nil {
+		return nil, err
+	}
+	return t.serializer.DeserializeEvent(data)
+}
+
+func (t *encryptingSerializer) SerializeVisibilityMemo(memo *workflow.Memo, encodingType common.EncodingType) (*DataBlob, error) {
+	return t.encrypt(t.serializer.SerializeVisibilityMemo(memo, encodingType))
+}
+
+func (t *encryptingSerializer) DeserializeVisibilityMemo(data *DataBlob) (*workflow.Memo, error) {
+	data, err := t.encryption.Decrypt(data)
+	if err != nil {
+		return nil, err
+	}
+	return t.serializer.DeserializeVisibilityMemo(data)
+}
+
+func (t *encryptingSerializer) SerializeResetPoints(rp *workflow.ResetPoints, encodingType common.EncodingType) (*DataBlob, error) {
+	return t.encrypt(t.serializer.SerializeResetPoints(rp, encodingType))
+}
+
+func (t *encryptingSerializer) DeserializeResetPoints(data *DataBlob) (*workflow.ResetPoints, error) {
+	data, err := t.encryption.Decrypt(data)
+	if err != nil {
+		return nil, err
+	}
+	return t.serializer.DeserializeResetPoints(data)
+}
+
+func (t *encryptingSerializer) SerializeBadBinaries(bb *workflow.BadBinaries, encodingType common.EncodingType) (*DataBlob, error) {
+	return t.encrypt(t.serializer.SerializeBadBinaries(bb, encodingType))
+}
+
+func (t *encryptingSerializer) DeserializeBadBinaries(data *DataBlob) (*workflow.BadBinaries, error) {
+	data, err := t.encryption.Decrypt(data)
+	if err != nil {
+		return nil, err
+	}
+	return t.serializer.DeserializeBadBinaries(data)
+}
+
+func (t *encryptingSerializer) encrypt(blob *DataBlob, err error) (*DataBlob, error) {
+	if err != nil {
+		return nil, err
+	}
+	return t.encryption.Encrypt(t.domainID, blob)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/encryption"
+)
+
+type (
+	payloadEncryptionSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+
+		keyFile string
+	}
+)
+
+const (
+	testEncryptionDomainID = "3a8fd4b5-c4ba-4bc6-8a97-7c5ad6a4b8c1"
+
+	testKeyFileBeforeRotation = `
+activeKey: key-1
+keys:
+  key-1: MDEyMzQ1Njc4OWFiY2RlZg==
+`
+	testKeyFileAfterRotation = `
+activeKey: key-1
+domainKeys:
+  3a8fd4b5-c4ba-4bc6-8a97-7c5ad6a4b8c1: key-2
+keys:
+  key-1: MDEyMzQ1Njc4OWFiY2RlZg==
+  key-2: MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=
+`
+	testKeyFileAfterRetirement = `
+activeKey: key-2
+keys:
+  key-2: MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=
+`
+)
+
+func TestPayloadEncryptionSuite(t *testing.T) {
+	s := new(payloadEncryptionSuite)
+	suite.Run(t, s)
+}
+
+func (s *payloadEncryptionSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+
+	file, err := ioutil.TempFile("", "cadence-keys-*.yaml")
+	s.NoError(err)
+	s.NoError(file.Close())
+	s.keyFile = file.Name()
+}
+
+func (s *payloadEncryptionSuite) TearDownTest() {
+	s.NoError(os.Remove(s.keyFile))
+}
+
+func (s *payloadEncryptionSuite) TestEncryptDecrypt() {
+	payloadEncryption := s.newPayloadEncryption(testKeyFileBeforeRotation, true)
+	blob := NewDataBlob([]byte(`{"some":"payload"}`), common.EncodingTypeJSON)
+
+	encrypted, err := payloadEncryption.Encrypt(testEncryptionDomainID, blob)
+	s.NoError(err)
+	s.Equal(common.EncodingTypeEncrypted, encrypted.GetEncoding())
+	s.NotContains(string(encrypted.Data), "payload")
+
+	decrypted, err := payloadEncryption.Decrypt(encrypted)
+	s.NoError(err)
+	s.Equal(blob, decrypted)
+
+	// blobs written before encryption was enabled are still readable
+	decrypted, err = payloadEncryption.Decrypt(blob)
+	s.NoError(err)
+	s.Equal(blob, decrypted)
+}
+
+func (s *payloadEncryptionSuite) TestEncrypt_Disabled() {
+	payloadEncryption := s.newPayloadEncryption(testKeyFileBeforeRotation, false)
+	blob := NewDataBlob([]byte(`{"some":"payload"}`), common.EncodingTypeJSON)
+
+	encrypted, err := payloadEncryption.Encrypt(testEncryptionDomainID, blob)
+	s.NoError(err)
+	s.Equal(blob, encrypted)
+}
+
+func (s *payloadEncryptionSuite) TestDecrypt_NoKeyProvider() {
+	encrypted, err := s.newPayloadEncryption(testKeyFileBeforeRotation, true).
+		Encrypt(testEncryptionDomainID, NewDataBlob([]byte("payload"), common.EncodingTypeThriftRW))
+	s.NoError(err)
+
+	_, err = NewNoopPayloadEncryption().Decrypt(encrypted)
+	s.IsType(&CadenceDeserializationError{}, err)
+}
+
+func (s *payloadEncryptionSuite) TestDecrypt_RetiredKey() {
+	encrypted, err := s.newPayloadEncryption(testKeyFileBeforeRotation, true).
+		Encrypt(testEncryptionDomainID, NewDataBlob([]byte("payload"), common.EncodingTypeThriftRW))
+	s.NoError(err)
+
+	_, err = s.newPayloadEncryption(testKeyFileAfterRetirement, true).Decrypt(encrypted)
+	s.IsType(&CadenceDeserializationError{}, err)
+}
+
+func (s *payloadEncryptionSuite) TestReencrypt_Rotation() {
+	blob := NewDataBlob([]byte("payload"), common.EncodingTypeThriftRW)
+	encrypted, err := s.newPayloadEncryption(testKeyFileBeforeRotation, true).Encrypt(testEncryptionDomainID, blob)
+	s.NoError(err)
+
+	payloadEncryption := s.newPayloadEncryption(testKeyFileAfterRotation, true)
+	reencrypted, changed, err := payloadEncryption.Reencrypt(testEncryptionDomainID, encrypted)
+	s.NoError(err)
+	s.True(changed)
+	keyID, err := encryption.GetKeyID(reencrypted.Data)
+	s.NoError(err)
+	s.Equal("key-2", keyID)
+
+	// blobs of other domains stay on the cluster wide active key
+	_, changed, err = payloadEncryption.Reencrypt("some other domain", encrypted)
+	s.NoError(err)
+	s.False(changed)
+
+	// blobs on the active key are left untouched
+	unchanged, changed, err := payloadEncryption.Reencrypt(testEncryptionDomainID, reencrypted)
+	s.NoError(err)
+	s.False(changed)
+	s.Equal(reencrypted, unchanged)
+
+	decrypted, err := s.newPayloadEncryption(testKeyFileAfterRetirement, true).Decrypt(reencrypted)
+	s.NoError(err)
+	s.Equal(blob, decrypted)
+}
+
+func (s *payloadEncryptionSuite) TestReencrypt_PlainText() {
+	blob := NewDataBlob([]byte("payload"), common.EncodingTypeThriftRW)
+
+	reencrypted, changed, err := s.newPayloadEncryption(testKeyFileBeforeRotation, true).Reencrypt(testEncryptionDomainID, blob)
+	s.NoError(err)
+	s.True(changed)
+	s.Equal(common.EncodingTypeEncrypted, reencrypted.GetEncoding())
+
+	// turning encryption off and re-encrypting writes blobs back in plain text
+	decrypted, changed, err := s.newPayloadEncryption(testKeyFileBeforeRotation, false).Reencrypt(testEncryptionDomainID, reencrypted)
+	s.NoError(err)
+	s.True(changed)
+	s.Equal(blob, decrypted)
+}
+
+func (s *payloadEncryptionSuite) TestEncryptingPayloadSerializer() {
+	serializer := NewEncryptingPayloadSerializer(s.newPayloadEncryption(testKeyFileBeforeRotation, true), testEncryptionDomainID)
+	events := []*workflow.HistoryEvent{
+		{
+			EventId:   common.Int64Ptr(1),
+			Version:   common.Int64Ptr(0),
+			EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionStarted),
+			WorkflowExecutionStartedEventAttributes: &workflow.WorkflowExecutionStartedEventAttributes{
+				Input: []byte("workflow input"),
+			},
+		},
+	}
+
+	for _, encoding := range []common.EncodingType{common.EncodingTypeThriftRW, common.EncodingTypeJSON} {
+		blob, err := serializer.SerializeBatchEvents(events, encoding)
+		s.NoError(err)
+		s.Equal(common.EncodingTypeEncrypted, blob.GetEncoding())
+
+		deserialized, err := serializer.DeserializeBatchEvents(blob)
+		s.NoError(err)
+		s.Equal(events, deserialized)
+	}
+
+	// payloads written in plain text remain readable
+	blob, err := NewPayloadSerializer().SerializeEvent(events[0], common.EncodingTypeThriftRW)
+	s.NoError(err)
+	deserialized, err := serializer.DeserializeEvent(blob)
+	s.NoError(err)
+	s.Equal(events[0], deserialized)
+}
+
+func (s *payloadEncryptionSuite) newPayloadEncryption(keyFile string, enabled bool) PayloadEncryption {
+	s.NoError(ioutil.WriteFile(s.keyFile, []byte(keyFile), 0600))
+	keyProvider, err := encryption.NewLocalKeyProvider(s.keyFile)
+	s.NoError(err)
+	return NewPayloadEncryption(keyProvider, enabled)
+}
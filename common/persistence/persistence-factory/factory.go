@@ -25,7 +25,9 @@ import (
 
 	"github.com/uber/cadence/common/quotas"
 
+	"github.com/uber/cadence/common/encryption"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/cassandra"
@@ -55,6 +57,10 @@ type (
 		NewExecutionManager(shardID int) (p.ExecutionManager, error)
 		// NewVisibilityManager returns a new visibility manager
 		NewVisibilityManager() (p.VisibilityManager, error)
+		// NewAuditManager returns a new audit log manager
+		NewAuditManager() (p.AuditManager, error)
+		// NewTaskDLQManager returns a new task DLQ manager
+		NewTaskDLQManager() (p.TaskDLQManager, error)
 	}
 	// DataStoreFactory is a low level interface to be implemented by a datastore
 	// Examples of datastores are cassandra, mysql etc
@@ -79,6 +85,10 @@ type (
 		NewExecutionStore(shardID int) (p.ExecutionStore, error)
 		// NewVisibilityStore returns a new visibility store
 		NewVisibilityStore() (p.VisibilityStore, error)
+		// NewAuditStore returns a new audit log store
+		NewAuditStore() (p.AuditStore, error)
+		// NewTaskDLQStore returns a new task DLQ store
+		NewTaskDLQStore() (p.TaskDLQStore, error)
 	}
 	// Datastore represents a datastore
 	Datastore struct {
@@ -90,6 +100,7 @@ type (
 		config        *config.Persistence
 		metricsClient metrics.Client
 		logger        log.Logger
+		encryption    p.PayloadEncryption
 		datastores    map[storeType]Datastore
 	}
 
@@ -106,6 +117,8 @@ const (
 	storeTypeMetadata
 	storeTypeExecution
 	storeTypeVisibility
+	storeTypeAudit
+	storeTypeTaskDLQ
 )
 
 const (
@@ -118,7 +131,7 @@ const (
 )
 
 var storeTypes = []storeType{
-	storeTypeHistory, storeTypeTask, storeTypeShard, storeTypeMetadata, storeTypeExecution, storeTypeVisibility}
+	storeTypeHistory, storeTypeTask, storeTypeShard, storeTypeMetadata, storeTypeExecution, storeTypeVisibility, storeTypeAudit, storeTypeTaskDLQ}
 
 // New returns an implementation of factory that vends persistence objects based on
 // specified configuration. This factory takes as input a config.Persistence object
@@ -136,12 +149,31 @@ func New(
 		config:        cfg,
 		metricsClient: metricsClient,
 		logger:        logger,
+		encryption:    NewPayloadEncryption(cfg, logger),
 	}
 	limiters := buildRatelimiters(cfg)
 	factory.init(clusterName, limiters)
 	return factory
 }
 
+// NewPayloadEncryption returns the payload encryption layer described by the
+// encryption section of the given persistence config. Blobs are written in
+// plain text when encryption is not configured, and encrypted blobs can still
+// be read as long as a key file is configured.
+func NewPayloadEncryption(cfg *config.Persistence, logger log.Logger) p.PayloadEncryption {
+	if cfg.Encryption == nil || cfg.Encryption.KeyFile == "" {
+		if cfg.Encryption != nil && cfg.Encryption.Enabled {
+			logger.Fatal("invalid config: encryption is enabled but no key file is specified")
+		}
+		return p.NewNoopPayloadEncryption()
+	}
+	keyProvider, err := encryption.NewLocalKeyProvider(cfg.Encryption.KeyFile)
+	if err != nil {
+		logger.Fatal("unable to load encryption key file", tag.Error(err))
+	}
+	return p.NewPayloadEncryption(keyProvider, cfg.Encryption.Enabled)
+}
+
 // NewTaskManager returns a new task manager
 func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	ds := f.datastores[storeTypeTask]
@@ -182,7 +214,7 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewHistoryManagerImpl(store, f.encryption, f.logger, f.config.TransactionSizeLimit)
 	if ds.ratelimit != nil {
 		result = p.NewHistoryPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -199,7 +231,7 @@ func (f *factoryImpl) NewHistoryV2Manager() (p.HistoryV2Manager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewHistoryV2ManagerImpl(store, f.encryption, f.logger, f.config.TransactionSizeLimit)
 	if ds.ratelimit != nil {
 		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -244,7 +276,7 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewExecutionManagerImpl(store, f.logger)
+	result := p.NewExecutionManagerImpl(store, f.encryption, f.logger)
 	if ds.ratelimit != nil {
 		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -265,8 +297,11 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 	if visConfig != nil && visConfig.EnableReadFromClosedExecutionV2() && f.isCassandra() {
 		store, err = cassandra.NewVisibilityPersistenceV2(store, f.getCassandraConfig(), f.logger)
 	}
+	if visConfig != nil && visConfig.ValidSearchAttributes != nil && !f.isCassandra() {
+		store = sql.WithValidSearchAttributes(store, visConfig.ValidSearchAttributes)
+	}
 
-	result := p.NewVisibilityManagerImpl(store, f.logger)
+	result := p.NewVisibilityManagerImpl(store, f.encryption, f.logger)
 	if ds.ratelimit != nil {
 		result = p.NewVisibilityPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -280,6 +315,38 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 	return result, nil
 }
 
+// NewAuditManager returns a new audit log manager
+func (f *factoryImpl) NewAuditManager() (p.AuditManager, error) {
+	ds := f.datastores[storeTypeAudit]
+	result, err := ds.factory.NewAuditStore()
+	if err != nil {
+		return nil, err
+	}
+	if ds.ratelimit != nil {
+		result = p.NewAuditPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+	}
+	if f.metricsClient != nil {
+		result = p.NewAuditPersistenceMetricsClient(result, f.metricsClient, f.logger)
+	}
+	return result, nil
+}
+
+// NewTaskDLQManager returns a new task DLQ manager
+func (f *factoryImpl) NewTaskDLQManager() (p.TaskDLQManager, error) {
+	ds := f.datastores[storeTypeTaskDLQ]
+	result, err := ds.factory.NewTaskDLQStore()
+	if err != nil {
+		return nil, err
+	}
+	if ds.ratelimit != nil {
+		result = p.NewTaskDLQPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+	}
+	if f.metricsClient != nil {
+		result = p.NewTaskDLQPersistenceMetricsClient(result, f.metricsClient, f.logger)
+	}
+	return result, nil
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	ds := f.datastores[storeTypeExecution]
@@ -0,0 +1,227 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencetests
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// AuditPersistenceSuite contains audit log persistence tests
+	AuditPersistenceSuite struct {
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+// SetupSuite implementation
+func (s *AuditPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+}
+
+// SetupTest implementation
+func (s *AuditPersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+// TearDownSuite implementation
+func (s *AuditPersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+// TestCreateAndListAuditRecords test
+func (s *AuditPersistenceSuite) TestCreateAndListAuditRecords() {
+	domainName := "audit-domain-" + uuid.New()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	terminate := s.newAuditRecord(domainName, "TerminateWorkflowExecution", now.Add(-time.Minute))
+	terminate.WorkflowID = "audit-workflow"
+	terminate.RunID = uuid.New()
+	terminate.Reason = "stuck workflow"
+	s.NoError(s.AuditMgr.CreateAuditRecord(&p.CreateAuditRecordRequest{Record: terminate}))
+
+	update := s.newAuditRecord(domainName, "UpdateDomain", now)
+	update.Result = "BadRequestError{Message: invalid retention}"
+	s.NoError(s.AuditMgr.CreateAuditRecord(&p.CreateAuditRecordRequest{Record: update}))
+
+	// records of other domains must not be returned
+	s.NoError(s.AuditMgr.CreateAuditRecord(&p.CreateAuditRecordRequest{
+		Record: s.newAuditRecord("audit-other-domain-"+uuid.New(), "UpdateDomain", now),
+	}))
+
+	resp, err := s.AuditMgr.ListAuditRecords(&p.ListAuditRecordsRequest{
+		DomainName:   domainName,
+		EarliestTime: now.Add(-time.Hour),
+		LatestTime:   now.Add(time.Hour),
+		PageSize:     10,
+	})
+	s.NoError(err)
+	s.Equal(2, len(resp.Records))
+	s.assertAuditRecordEqual(update, resp.Records[0])
+	s.assertAuditRecordEqual(terminate, resp.Records[1])
+	s.Empty(resp.NextPageToken)
+
+	resp, err = s.AuditMgr.ListAuditRecords(&p.ListAuditRecordsRequest{
+		DomainName:   domainName,
+		EarliestTime: now.Add(-time.Hour),
+		LatestTime:   now.Add(-time.Second),
+		PageSize:     10,
+	})
+	s.NoError(err)
+	s.Equal(1, len(resp.Records))
+	s.assertAuditRecordEqual(terminate, resp.Records[0])
+}
+
+// TestListAuditRecordsPagination test
+func (s *AuditPersistenceSuite) TestListAuditRecordsPagination() {
+	domainName := "audit-domain-" + uuid.New()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	// two records share the same timestamp to make sure paging does not skip or repeat them
+	timestamps := []time.Time{now, now, now.Add(-time.Second), now.Add(-2 * time.Second), now.Add(-3 * time.Second)}
+	for _, timestamp := range timestamps {
+		s.NoError(s.AuditMgr.CreateAuditRecord(&p.CreateAuditRecordRequest{
+			Record: s.newAuditRecord(domainName, "RequestCancelWorkflowExecution", timestamp),
+		}))
+	}
+
+	var records []*p.AuditRecord
+	var token []byte
+	for {
+		resp, err := s.AuditMgr.ListAuditRecords(&p.ListAuditRecordsRequest{
+			DomainName:    domainName,
+			EarliestTime:  now.Add(-time.Hour),
+			LatestTime:    now.Add(time.Hour),
+			PageSize:      2,
+			NextPageToken: token,
+		})
+		s.NoError(err)
+		s.True(len(resp.Records) <= 2)
+		records = append(records, resp.Records...)
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		token = resp.NextPageToken
+	}
+
+	s.Equal(len(timestamps), len(records))
+	ids := make(map[string]struct{})
+	for i, record := range records {
+		s.Equal(domainName, record.DomainName)
+		s.True(timestamps[i].Equal(record.Timestamp))
+		ids[record.ID] = struct{}{}
+	}
+	s.Equal(len(timestamps), len(ids))
+}
+
+// TestListAuditRecordsAcrossDays test
+func (s *AuditPersistenceSuite) TestListAuditRecordsAcrossDays() {
+	domainName := "audit-domain-" + uuid.New()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	// the records are a day apart so that each of them is stored in a different time bucket
+	timestamps := []time.Time{now, now.Add(-25 * time.Hour), now.Add(-49 * time.Hour)}
+	for _, timestamp := range timestamps {
+		s.NoError(s.AuditMgr.CreateAuditRecord(&p.CreateAuditRecordRequest{
+			Record: s.newAuditRecord(domainName, "UpdateDomain", timestamp),
+		}))
+	}
+
+	var records []*p.AuditRecord
+	var token []byte
+	for {
+		resp, err := s.AuditMgr.ListAuditRecords(&p.ListAuditRecordsRequest{
+			DomainName:    domainName,
+			EarliestTime:  now.Add(-72 * time.Hour),
+			LatestTime:    now.Add(time.Hour),
+			PageSize:      2,
+			NextPageToken: token,
+		})
+		s.NoError(err)
+		s.True(len(resp.Records) <= 2)
+		records = append(records, resp.Records...)
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		token = resp.NextPageToken
+	}
+
+	s.Equal(len(timestamps), len(records))
+	for i, record := range records {
+		s.True(timestamps[i].Equal(record.Timestamp))
+	}
+}
+
+// TestClusterLevelAuditRecords test
+func (s *AuditPersistenceSuite) TestClusterLevelAuditRecords() {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	record := s.newAuditRecord("", "AddSearchAttribute", now)
+	record.RequestSummary = "SearchAttribute: {AuditTestKey: STRING}"
+	s.NoError(s.AuditMgr.CreateAuditRecord(&p.CreateAuditRecordRequest{Record: record}))
+
+	resp, err := s.AuditMgr.ListAuditRecords(&p.ListAuditRecordsRequest{
+		EarliestTime: now,
+		LatestTime:   now,
+		PageSize:     100,
+	})
+	s.NoError(err)
+	found := false
+	for _, r := range resp.Records {
+		if r.ID == record.ID {
+			s.assertAuditRecordEqual(record, r)
+			found = true
+		}
+	}
+	s.True(found)
+}
+
+func (s *AuditPersistenceSuite) newAuditRecord(domainName string, operation string, timestamp time.Time) *p.AuditRecord {
+	return &p.AuditRecord{
+		ID:             uuid.New(),
+		Timestamp:      timestamp,
+		Operation:      operation,
+		DomainName:     domainName,
+		Identity:       "audit-test-identity",
+		RequestSummary: "{}",
+		Result:         p.AuditResultSuccess,
+	}
+}
+
+func (s *AuditPersistenceSuite) assertAuditRecordEqual(expected *p.AuditRecord, actual *p.AuditRecord) {
+	s.True(expected.Timestamp.Equal(actual.Timestamp))
+	expectedCopy := *expected
+	actualCopy := *actual
+	expectedCopy.Timestamp = time.Time{}
+	actualCopy.Timestamp = time.Time{}
+	s.Equal(expectedCopy, actualCopy)
+}
@@ -88,3 +88,17 @@ func TestCassandraExecutionManagerWithEventsV2(t *testing.T) {
 	s.TestBase.Setup()
 	suite.Run(t, s)
 }
+
+func TestCassandraAuditPersistence(t *testing.T) {
+	s := new(AuditPersistenceSuite)
+	s.TestBase = NewTestBaseWithCassandra(&TestBaseOptions{})
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestCassandraTaskDLQPersistence(t *testing.T) {
+	s := new(TaskDLQPersistenceSuite)
+	s.TestBase = NewTestBaseWithCassandra(&TestBaseOptions{})
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
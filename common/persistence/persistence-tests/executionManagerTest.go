@@ -1386,6 +1386,88 @@ func (s *ExecutionManagerSuite) TestGetCurrentWorkflow() {
 	s.Empty(task1, "Expected empty task identifier.")
 }
 
+// TestListExecutions test
+func (s *ExecutionManagerSuite) TestListExecutions() {
+	domainID := "7b9a1fd3-0c4e-4d0b-9d4a-33f7e3b2a6c1"
+	numWorkflows := 5
+	runIDs := make(map[string]string)
+	for i := 0; i < numWorkflows; i++ {
+		workflowExecution := gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(fmt.Sprintf("list-executions-test-%v", i)),
+			RunId:      common.StringPtr(uuid.New()),
+		}
+		_, err := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+		s.NoError(err)
+		runIDs[workflowExecution.GetWorkflowId()] = workflowExecution.GetRunId()
+	}
+
+	// close the first workflow, then start a new run of it
+	closedExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("list-executions-test-0"),
+		RunId:      common.StringPtr(runIDs["list-executions-test-0"]),
+	}
+	info, err := s.GetWorkflowExecutionInfo(domainID, closedExecution)
+	s.NoError(err)
+	updatedInfo := copyWorkflowExecutionInfo(info.ExecutionInfo)
+	updatedStats := copyExecutionStats(info.ExecutionStats)
+	updatedInfo.NextEventID = int64(6)
+	updatedInfo.LastProcessedEvent = int64(2)
+	newExecution := gen.WorkflowExecution{
+		WorkflowId: closedExecution.WorkflowId,
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	err = s.ContinueAsNewExecution(updatedInfo, updatedStats, int64(3), newExecution, int64(3), int64(2), nil)
+	s.NoError(err)
+	runIDs[newExecution.GetWorkflowId()] = newExecution.GetRunId()
+
+	concreteRunIDs := make(map[string]struct{})
+	var pageToken []byte
+	for {
+		response, err := s.ExecutionManager.ListConcreteExecutions(&p.ListConcreteExecutionsRequest{
+			PageSize:  2,
+			PageToken: pageToken,
+		})
+		s.NoError(err)
+		s.True(len(response.ExecutionInfos) <= 2)
+		for _, info := range response.ExecutionInfos {
+			if info.DomainID == domainID {
+				concreteRunIDs[info.RunID] = struct{}{}
+			}
+		}
+		pageToken = response.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	s.Equal(numWorkflows+1, len(concreteRunIDs))
+	s.Contains(concreteRunIDs, closedExecution.GetRunId())
+	for _, runID := range runIDs {
+		s.Contains(concreteRunIDs, runID)
+	}
+
+	currentRunIDs := make(map[string]string)
+	pageToken = nil
+	for {
+		response, err := s.ExecutionManager.ListCurrentExecutions(&p.ListCurrentExecutionsRequest{
+			PageSize:  2,
+			PageToken: pageToken,
+		})
+		s.NoError(err)
+		s.True(len(response.Executions) <= 2)
+		for _, execution := range response.Executions {
+			if execution.DomainID == domainID {
+				s.NotEqual(p.WorkflowStateCompleted, execution.State)
+				currentRunIDs[execution.WorkflowID] = execution.RunID
+			}
+		}
+		pageToken = response.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	s.Equal(runIDs, currentRunIDs)
+}
+
 // TestTransferTasksThroughUpdate test
 func (s *ExecutionManagerSuite) TestTransferTasksThroughUpdate() {
 	domainID := "b785a8ba-bd7d-4760-bb05-41b115f3e10a"
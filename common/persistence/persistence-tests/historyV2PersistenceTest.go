@@ -21,6 +21,7 @@
 package persistencetests
 
 import (
+	"io/ioutil"
 	"math/rand"
 	"os"
 	"sync"
@@ -37,6 +38,8 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	p "github.com/uber/cadence/common/persistence"
+	pfactory "github.com/uber/cadence/common/persistence/persistence-factory"
+	"github.com/uber/cadence/common/service/config"
 )
 
 type (
@@ -589,6 +592,110 @@ func (s *HistoryV2PersistenceSuite) TestConcurrentlyForkAndAppendBranches() {
 
 }
 
+// TestReencryptHistoryBranch test
+func (s *HistoryV2PersistenceSuite) TestReencryptHistoryBranch() {
+	domainID := uuid.New()
+	treeID := uuid.New()
+	bi, err := s.newHistoryBranch(treeID)
+	s.Nil(err)
+
+	keyFile, err := ioutil.TempFile("", "cadence-keys-*.yaml")
+	s.Nil(err)
+	s.Nil(keyFile.Close())
+	defer os.Remove(keyFile.Name())
+
+	// write history with the cluster wide key
+	historyV2Mgr := s.newEncryptingHistoryV2Manager(keyFile.Name(), `
+activeKey: key-1
+keys:
+  key-1: MDEyMzQ1Njc4OWFiY2RlZg==
+`)
+	defer historyV2Mgr.Close()
+	events := s.genRandomEvents([]int64{1, 2}, 1)
+	_, err = historyV2Mgr.AppendHistoryNodes(&p.AppendHistoryNodesRequest{
+		IsNewBranch: true,
+		Info:        domainID + ":" + "test-workflow-id:" + uuid.New(),
+		DomainID:    domainID,
+		BranchToken: bi,
+		Events:      events,
+		Encoding:    common.EncodingTypeThriftRW,
+		ShardID:     common.IntPtr(s.ShardInfo.ShardID),
+	})
+	s.Nil(err)
+	events = s.genRandomEvents([]int64{3, 4, 5}, 1)
+	_, err = historyV2Mgr.AppendHistoryNodes(&p.AppendHistoryNodesRequest{
+		DomainID:      domainID,
+		BranchToken:   bi,
+		Events:        events,
+		TransactionID: 1,
+		Encoding:      common.EncodingTypeThriftRW,
+		ShardID:       common.IntPtr(s.ShardInfo.ShardID),
+	})
+	s.Nil(err)
+
+	// plain text readers cannot read encrypted history
+	_, err = s.readWithError(bi, 1, 6)
+	s.IsType(&p.CadenceDeserializationError{}, err)
+
+	var branch *p.HistoryBranchDetail
+	request := &p.GetAllHistoryTreeBranchesRequest{PageSize: 2}
+	for branch == nil {
+		resp, err := s.HistoryV2Mgr.GetAllHistoryTreeBranches(request)
+		s.Nil(err)
+		for i := range resp.Branches {
+			if resp.Branches[i].TreeID == treeID {
+				branch = &resp.Branches[i]
+			}
+		}
+		s.True(branch != nil || len(resp.NextPageToken) > 0)
+		request.NextPageToken = resp.NextPageToken
+	}
+
+	// rotate the key of the domain and re-encrypt its history
+	historyV2Mgr = s.newEncryptingHistoryV2Manager(keyFile.Name(), `
+activeKey: key-1
+domainKeys:
+  `+domainID+`: key-2
+keys:
+  key-1: MDEyMzQ1Njc4OWFiY2RlZg==
+  key-2: MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=
+`)
+	defer historyV2Mgr.Close()
+	reencryptRequest := &p.ReencryptHistoryBranchRequest{
+		TreeID:   branch.TreeID,
+		BranchID: branch.BranchID,
+		DomainID: domainID,
+		ShardID:  common.IntPtr(branch.ShardID),
+	}
+	resp, err := historyV2Mgr.ReencryptHistoryBranch(reencryptRequest)
+	s.Nil(err)
+	s.Equal(2, resp.ReencryptedNodeCount)
+	resp, err = historyV2Mgr.ReencryptHistoryBranch(reencryptRequest)
+	s.Nil(err)
+	s.Equal(0, resp.ReencryptedNodeCount)
+
+	// the history is readable after the old key is retired
+	historyV2Mgr = s.newEncryptingHistoryV2Manager(keyFile.Name(), `
+activeKey: key-2
+keys:
+  key-2: MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=
+`)
+	defer historyV2Mgr.Close()
+	readResp, err := historyV2Mgr.ReadHistoryBranch(&p.ReadHistoryBranchRequest{
+		BranchToken: bi,
+		MinEventID:  1,
+		MaxEventID:  6,
+		PageSize:    10,
+		ShardID:     common.IntPtr(s.ShardInfo.ShardID),
+	})
+	s.Nil(err)
+	s.Equal(5, len(readResp.HistoryEvents))
+	s.Equal(events, readResp.HistoryEvents[2:])
+
+	err = s.deleteHistoryBranch(bi)
+	s.Nil(err)
+}
+
 func (s *HistoryV2PersistenceSuite) getBranchByKey(m sync.Map, k int) []byte {
 	v, ok := m.Load(k)
 	s.Equal(true, ok)
@@ -615,6 +722,19 @@ func (s *HistoryV2PersistenceSuite) genRandomEvents(eventIDs []int64, version in
 	return events
 }
 
+// persistence helper
+func (s *HistoryV2PersistenceSuite) newEncryptingHistoryV2Manager(keyFileName string, keyFile string) p.HistoryV2Manager {
+	s.Nil(ioutil.WriteFile(keyFileName, []byte(keyFile), 0600))
+	cfg := s.DefaultTestCluster.Config()
+	cfg.Encryption = &config.Encryption{
+		Enabled: true,
+		KeyFile: keyFileName,
+	}
+	historyV2Mgr, err := pfactory.New(&cfg, s.ClusterMetadata.GetCurrentClusterName(), nil, s.logger).NewHistoryV2Manager()
+	s.Nil(err)
+	return historyV2Mgr
+}
+
 // persistence helper
 func (s *HistoryV2PersistenceSuite) newHistoryBranch(treeID string) ([]byte, error) {
 	return p.NewHistoryBranchToken(treeID)
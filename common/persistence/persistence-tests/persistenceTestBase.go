@@ -52,6 +52,7 @@ type (
 		DBName          string
 		DBPort          int              `yaml:"-"`
 		StoreType       string           `yaml:"-"`
+		DriverName      string           `yaml:"-"`
 		SchemaDir       string           `yaml:"-"`
 		ClusterMetadata cluster.Metadata `yaml:"-"`
 	}
@@ -69,6 +70,8 @@ type (
 		MetadataManagerV2     p.MetadataManager
 		MetadataProxy         p.MetadataManager
 		VisibilityMgr         p.VisibilityManager
+		AuditMgr              p.AuditManager
+		TaskDLQMgr            p.TaskDLQManager
 		ShardInfo             *p.ShardInfo
 		TaskIDGenerator       TransferTaskIDGenerator
 		ClusterMetadata       cluster.Metadata
@@ -115,7 +118,7 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 	if options.DBName == "" {
 		options.DBName = GenerateRandomDBName(10)
 	}
-	testCluster := sql.NewTestCluster(options.DBName, options.DBPort, options.SchemaDir)
+	testCluster := sql.NewTestCluster(options.DBName, options.DriverName, options.DBPort, options.SchemaDir)
 	return newTestBase(options, testCluster)
 }
 
@@ -197,6 +200,12 @@ func (s *TestBase) Setup() {
 	s.ShardMgr, err = factory.NewShardManager()
 	s.fatalOnError("NewShardManager", err)
 
+	s.AuditMgr, err = factory.NewAuditManager()
+	s.fatalOnError("NewAuditManager", err)
+
+	s.TaskDLQMgr, err = factory.NewTaskDLQManager()
+	s.fatalOnError("NewTaskDLQManager", err)
+
 	s.ExecutionMgrFactory = factory
 	s.ExecutionManager, err = factory.NewExecutionManager(shardID)
 	s.fatalOnError("NewExecutionManager", err)
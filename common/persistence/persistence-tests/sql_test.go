@@ -81,3 +81,17 @@ func TestSQLVisibilityPersistenceSuite(t *testing.T) {
 	s.TestBase.Setup()
 	suite.Run(t, s)
 }
+
+func TestSQLAuditPersistenceSuite(t *testing.T) {
+	s := new(AuditPersistenceSuite)
+	s.TestBase = NewTestBaseWithSQL(&TestBaseOptions{})
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestSQLTaskDLQPersistenceSuite(t *testing.T) {
+	s := new(TaskDLQPersistenceSuite)
+	s.TestBase = NewTestBaseWithSQL(&TestBaseOptions{})
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencetests
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// TaskDLQPersistenceSuite contains task DLQ persistence tests
+	TaskDLQPersistenceSuite struct {
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+// SetupSuite implementation
+func (s *TaskDLQPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+}
+
+// SetupTest implementation
+func (s *TaskDLQPersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+// TearDownSuite implementation
+func (s *TaskDLQPersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+// TestPutAndGetDLQTask test
+func (s *TaskDLQPersistenceSuite) TestPutAndGetDLQTask() {
+	shardID := 1001
+	task := s.newDLQTask(shardID, p.DLQTaskCategoryTransfer, 10)
+	s.NoError(s.TaskDLQMgr.PutDLQTask(&p.PutDLQTaskRequest{Task: task}))
+
+	resp, err := s.TaskDLQMgr.GetDLQTask(&p.GetDLQTaskRequest{ShardID: shardID, Category: p.DLQTaskCategoryTransfer, TaskID: 10})
+	s.NoError(err)
+	s.assertDLQTaskEqual(task, resp.Task)
+
+	// putting the same task again overwrites the existing entry
+	task.LastError = "another error"
+	task.Attempt = 200
+	s.NoError(s.TaskDLQMgr.PutDLQTask(&p.PutDLQTaskRequest{Task: task}))
+	resp, err = s.TaskDLQMgr.GetDLQTask(&p.GetDLQTaskRequest{ShardID: shardID, Category: p.DLQTaskCategoryTransfer, TaskID: 10})
+	s.NoError(err)
+	s.assertDLQTaskEqual(task, resp.Task)
+
+	// the same task ID in another category is a different entry
+	_, err = s.TaskDLQMgr.GetDLQTask(&p.GetDLQTaskRequest{ShardID: shardID, Category: p.DLQTaskCategoryTimer, TaskID: 10})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+
+	s.NoError(s.TaskDLQMgr.DeleteDLQTask(&p.DeleteDLQTaskRequest{ShardID: shardID, Category: p.DLQTaskCategoryTransfer, TaskID: 10}))
+	_, err = s.TaskDLQMgr.GetDLQTask(&p.GetDLQTaskRequest{ShardID: shardID, Category: p.DLQTaskCategoryTransfer, TaskID: 10})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
+// TestListAndRangeDeleteDLQTasks test
+func (s *TaskDLQPersistenceSuite) TestListAndRangeDeleteDLQTasks() {
+	shardID := 1002
+	taskIDs := []int64{5, 1, 3, 4, 2}
+	for _, taskID := range taskIDs {
+		s.NoError(s.TaskDLQMgr.PutDLQTask(&p.PutDLQTaskRequest{Task: s.newDLQTask(shardID, p.DLQTaskCategoryTimer, taskID)}))
+	}
+	// tasks of other categories and shards must not be returned
+	s.NoError(s.TaskDLQMgr.PutDLQTask(&p.PutDLQTaskRequest{Task: s.newDLQTask(shardID, p.DLQTaskCategoryTransfer, 3)}))
+	s.NoError(s.TaskDLQMgr.PutDLQTask(&p.PutDLQTaskRequest{Task: s.newDLQTask(shardID+1, p.DLQTaskCategoryTimer, 3)}))
+
+	var tasks []*p.DLQTask
+	var token []byte
+	for {
+		resp, err := s.TaskDLQMgr.ListDLQTasks(&p.ListDLQTasksRequest{
+			ShardID:       shardID,
+			Category:      p.DLQTaskCategoryTimer,
+			PageSize:      2,
+			NextPageToken: token,
+		})
+		s.NoError(err)
+		s.True(len(resp.Tasks) <= 2)
+		tasks = append(tasks, resp.Tasks...)
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		token = resp.NextPageToken
+	}
+	s.Equal(len(taskIDs), len(tasks))
+	for i, task := range tasks {
+		s.Equal(int64(i+1), task.TaskID)
+		s.Equal(shardID, task.ShardID)
+		s.Equal(p.DLQTaskCategoryTimer, task.Category)
+	}
+
+	s.NoError(s.TaskDLQMgr.RangeDeleteDLQTasks(&p.RangeDeleteDLQTasksRequest{ShardID: shardID, Category: p.DLQTaskCategoryTimer}))
+	resp, err := s.TaskDLQMgr.ListDLQTasks(&p.ListDLQTasksRequest{ShardID: shardID, Category: p.DLQTaskCategoryTimer, PageSize: 10})
+	s.NoError(err)
+	s.Empty(resp.Tasks)
+
+	_, err = s.TaskDLQMgr.GetDLQTask(&p.GetDLQTaskRequest{ShardID: shardID, Category: p.DLQTaskCategoryTransfer, TaskID: 3})
+	s.NoError(err)
+	_, err = s.TaskDLQMgr.GetDLQTask(&p.GetDLQTaskRequest{ShardID: shardID + 1, Category: p.DLQTaskCategoryTimer, TaskID: 3})
+	s.NoError(err)
+}
+
+func (s *TaskDLQPersistenceSuite) newDLQTask(shardID int, category int, taskID int64) *p.DLQTask {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	return &p.DLQTask{
+		ShardID:             shardID,
+		Category:            category,
+		TaskID:              taskID,
+		TaskType:            p.TransferTaskTypeDecisionTask,
+		DomainID:            uuid.New(),
+		WorkflowID:          "dlq-workflow",
+		RunID:               uuid.New(),
+		VisibilityTimestamp: now.Add(-time.Minute),
+		Data:                []byte(`{"TaskID":1}`),
+		LastError:           "InternalServiceError{Message: poison task}",
+		Attempt:             100,
+		CreatedTime:         now,
+	}
+}
+
+func (s *TaskDLQPersistenceSuite) assertDLQTaskEqual(expected *p.DLQTask, actual *p.DLQTask) {
+	s.True(expected.VisibilityTimestamp.Equal(actual.VisibilityTimestamp))
+	s.True(expected.CreatedTime.Equal(actual.CreatedTime))
+	expectedCopy := *expected
+	actualCopy := *actual
+	expectedCopy.VisibilityTimestamp = time.Time{}
+	actualCopy.VisibilityTimestamp = time.Time{}
+	expectedCopy.CreatedTime = time.Time{}
+	actualCopy.CreatedTime = time.Time{}
+	s.Equal(expectedCopy, actualCopy)
+}
@@ -40,6 +40,10 @@ type (
 	ShardStore = ShardManager
 	// TaskStore is a lower level of TaskManager
 	TaskStore = TaskManager
+	// AuditStore is a lower level of AuditManager
+	AuditStore = AuditManager
+	// TaskDLQStore is a lower level of TaskDLQManager
+	TaskDLQStore = TaskDLQManager
 	// MetadataStore is a lower level of MetadataManager
 	MetadataStore interface {
 		Closeable
@@ -69,6 +73,10 @@ type (
 		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
 
+		// Scan related methods
+		ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*InternalListConcreteExecutionsResponse, error)
+		ListCurrentExecutions(request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error)
+
 		// Transfer task related methods
 		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
 		CompleteTransferTask(request *CompleteTransferTaskRequest) error
@@ -119,6 +127,10 @@ type (
 		CompleteForkBranch(request *InternalCompleteForkBranchRequest) error
 		// GetHistoryTree returns all branch information of a tree
 		GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
+		// GetAllHistoryTreeBranches returns all branches of all trees
+		GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error)
+		// UpdateHistoryNode overwrites the events of an existing node, it is a no-op if the node does not exist
+		UpdateHistoryNode(request *InternalUpdateHistoryNodeRequest) error
 	}
 
 	// VisibilityStore is the store interface for visibility
@@ -219,6 +231,8 @@ type (
 		CronSchedule      string
 		ExpirationSeconds int32
 		SearchAttributes  map[string][]byte
+		Paused            bool
+		RequestedUpdates  map[string]bool
 
 		// attributes which are not related to mutable state at all
 		HistorySize int64
@@ -411,11 +425,31 @@ type (
 		ShardID int
 	}
 
+	// InternalUpdateHistoryNodeRequest is used to overwrite the events of an existing node
+	InternalUpdateHistoryNodeRequest struct {
+		// The branch of the node
+		TreeID   string
+		BranchID string
+		// The node to be overwritten
+		NodeID        int64
+		TransactionID int64
+		// The events to overwrite the node with
+		Events *DataBlob
+		// Used in sharded data stores to identify which shard to use
+		ShardID int
+	}
+
 	// InternalGetWorkflowExecutionResponse is the response to GetworkflowExecutionRequest for Persistence Interface
 	InternalGetWorkflowExecutionResponse struct {
 		State *InternalWorkflowMutableState
 	}
 
+	// InternalListConcreteExecutionsResponse is the response to ListConcreteExecutionsRequest for Persistence Interface
+	InternalListConcreteExecutionsResponse struct {
+		ExecutionInfos []*InternalWorkflowExecutionInfo
+		NextPageToken  []byte
+	}
+
 	// InternalGetWorkflowExecutionHistoryRequest is used to retrieve history of a workflow execution
 	InternalGetWorkflowExecutionHistoryRequest struct {
 		// an extra field passing from GetWorkflowExecutionHistoryRequest
@@ -503,6 +537,9 @@ type (
 	InternalReadHistoryBranchResponse struct {
 		// History events
 		History []*DataBlob
+		// The node ID and transaction ID of each of the history events
+		NodeIDs        []int64
+		TransactionIDs []int64
 		// Pagination token
 		NextPageToken []byte
 	}
@@ -668,6 +705,8 @@ func (d *DataBlob) GetEncoding() common.EncodingType {
 		return common.EncodingTypeJSON
 	case common.EncodingTypeThriftRW:
 		return common.EncodingTypeThriftRW
+	case common.EncodingTypeEncrypted:
+		return common.EncodingTypeEncrypted
 	case common.EncodingTypeEmpty:
 		return common.EncodingTypeEmpty
 	default:
@@ -69,6 +69,18 @@ type (
 		persistence  VisibilityManager
 		logger       log.Logger
 	}
+
+	auditPersistenceClient struct {
+		metricClient metrics.Client
+		persistence  AuditManager
+		logger       log.Logger
+	}
+
+	taskDLQPersistenceClient struct {
+		metricClient metrics.Client
+		persistence  TaskDLQManager
+		logger       log.Logger
+	}
 )
 
 var _ ShardManager = (*shardPersistenceClient)(nil)
@@ -78,6 +90,8 @@ var _ HistoryManager = (*historyPersistenceClient)(nil)
 var _ HistoryV2Manager = (*historyV2PersistenceClient)(nil)
 var _ MetadataManager = (*metadataPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityPersistenceClient)(nil)
+var _ AuditManager = (*auditPersistenceClient)(nil)
+var _ TaskDLQManager = (*taskDLQPersistenceClient)(nil)
 
 // NewShardPersistenceMetricsClient creates a client to manage shards
 func NewShardPersistenceMetricsClient(persistence ShardManager, metricClient metrics.Client, logger log.Logger) ShardManager {
@@ -330,6 +344,34 @@ func (p *workflowExecutionPersistenceClient) GetCurrentExecution(request *GetCur
 	return response, err
 }
 
+func (p *workflowExecutionPersistenceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListConcreteExecutionsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceListConcreteExecutionsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListConcreteExecutions(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListConcreteExecutionsScope, err)
+	}
+
+	return response, err
+}
+
+func (p *workflowExecutionPersistenceClient) ListCurrentExecutions(request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListCurrentExecutionsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceListCurrentExecutionsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListCurrentExecutions(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListCurrentExecutionsScope, err)
+	}
+
+	return response, err
+}
+
 func (p *workflowExecutionPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTransferTasksScope, metrics.PersistenceRequests)
 
@@ -1138,6 +1180,30 @@ func (p *historyV2PersistenceClient) GetHistoryTree(request *GetHistoryTreeReque
 	return response, err
 }
 
+// GetAllHistoryTreeBranches returns all branches of all trees
+func (p *historyV2PersistenceClient) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetAllHistoryTreeBranchesScope, metrics.PersistenceRequests)
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetAllHistoryTreeBranchesScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetAllHistoryTreeBranches(request)
+	sw.Stop()
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetAllHistoryTreeBranchesScope, err)
+	}
+	return response, err
+}
+
+// ReencryptHistoryBranch rewrites the nodes of a branch which are not encrypted with the active data key of its domain
+func (p *historyV2PersistenceClient) ReencryptHistoryBranch(request *ReencryptHistoryBranchRequest) (*ReencryptHistoryBranchResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceReencryptHistoryBranchScope, metrics.PersistenceRequests)
+	sw := p.metricClient.StartTimer(metrics.PersistenceReencryptHistoryBranchScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ReencryptHistoryBranch(request)
+	sw.Stop()
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceReencryptHistoryBranchScope, err)
+	}
+	return response, err
+}
+
 func (p *historyV2PersistenceClient) updateErrorMetric(scope int, err error) {
 	switch err.(type) {
 	case *workflow.EntityNotExistsError:
@@ -1156,3 +1222,146 @@ func (p *historyV2PersistenceClient) updateErrorMetric(scope int, err error) {
 		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
 	}
 }
+
+// NewAuditPersistenceMetricsClient creates a client to manage the audit log
+func NewAuditPersistenceMetricsClient(persistence AuditManager, metricClient metrics.Client, logger log.Logger) AuditManager {
+	return &auditPersistenceClient{
+		persistence:  persistence,
+		metricClient: metricClient,
+		logger:       logger,
+	}
+}
+
+func (p *auditPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *auditPersistenceClient) CreateAuditRecord(request *CreateAuditRecordRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceCreateAuditRecordScope, metrics.PersistenceRequests)
+	sw := p.metricClient.StartTimer(metrics.PersistenceCreateAuditRecordScope, metrics.PersistenceLatency)
+	err := p.persistence.CreateAuditRecord(request)
+	sw.Stop()
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceCreateAuditRecordScope, err)
+	}
+	return err
+}
+
+func (p *auditPersistenceClient) ListAuditRecords(request *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListAuditRecordsScope, metrics.PersistenceRequests)
+	sw := p.metricClient.StartTimer(metrics.PersistenceListAuditRecordsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListAuditRecords(request)
+	sw.Stop()
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListAuditRecordsScope, err)
+	}
+	return response, err
+}
+
+func (p *auditPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *auditPersistenceClient) updateErrorMetric(scope int, err error) {
+	switch err.(type) {
+	case *workflow.BadRequestError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrBadRequestCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	case *workflow.ServiceBusyError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	default:
+		p.logger.Error("Operation failed with internal error.",
+			tag.Error(err), tag.MetricScope(scope))
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	}
+}
+
+// NewTaskDLQPersistenceMetricsClient creates a client to manage the task DLQ
+func NewTaskDLQPersistenceMetricsClient(persistence TaskDLQManager, metricClient metrics.Client, logger log.Logger) TaskDLQManager {
+	return &taskDLQPersistenceClient{
+		persistence:  persistence,
+		metricClient: metricClient,
+		logger:       logger,
+	}
+}
+
+func (p *taskDLQPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskDLQPersistenceClient) PutDLQTask(request *PutDLQTaskRequest) error {
+	p.metricClient.IncCounter(metrics.PersistencePutDLQTaskScope, metrics.PersistenceRequests)
+	sw := p.metricClient.StartTimer(metrics.PersistencePutDLQTaskScope, metrics.PersistenceLatency)
+	err := p.persistence.PutDLQTask(request)
+	sw.Stop()
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistencePutDLQTaskScope, err)
+	}
+	return err
+}
+
+func (p *taskDLQPersistenceClient) ListDLQTasks(request *ListDLQTasksRequest) (*ListDLQTasksResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListDLQTasksScope, metrics.PersistenceRequests)
+	sw := p.metricClient.StartTimer(metrics.PersistenceListDLQTasksScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListDLQTasks(request)
+	sw.Stop()
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListDLQTasksScope, err)
+	}
+	return response, err
+}
+
+func (p *taskDLQPersistenceClient) GetDLQTask(request *GetDLQTaskRequest) (*GetDLQTaskResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetDLQTaskScope, metrics.PersistenceRequests)
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetDLQTaskScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetDLQTask(request)
+	sw.Stop()
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetDLQTaskScope, err)
+	}
+	return response, err
+}
+
+func (p *taskDLQPersistenceClient) DeleteDLQTask(request *DeleteDLQTaskRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteDLQTaskScope, metrics.PersistenceRequests)
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteDLQTaskScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteDLQTask(request)
+	sw.Stop()
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceDeleteDLQTaskScope, err)
+	}
+	return err
+}
+
+func (p *taskDLQPersistenceClient) RangeDeleteDLQTasks(request *RangeDeleteDLQTasksRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceRangeDeleteDLQTasksScope, metrics.PersistenceRequests)
+	sw := p.metricClient.StartTimer(metrics.PersistenceRangeDeleteDLQTasksScope, metrics.PersistenceLatency)
+	err := p.persistence.RangeDeleteDLQTasks(request)
+	sw.Stop()
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRangeDeleteDLQTasksScope, err)
+	}
+	return err
+}
+
+func (p *taskDLQPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskDLQPersistenceClient) updateErrorMetric(scope int, err error) {
+	switch err.(type) {
+	case *workflow.EntityNotExistsError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
+	case *workflow.BadRequestError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrBadRequestCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	case *workflow.ServiceBusyError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	default:
+		p.logger.Error("Operation failed with internal error.",
+			tag.Error(err), tag.MetricScope(scope))
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	}
+}
@@ -75,6 +75,18 @@ type (
 		persistence VisibilityManager
 		logger      log.Logger
 	}
+
+	auditRateLimitedPersistenceClient struct {
+		rateLimiter quotas.Limiter
+		persistence AuditManager
+		logger      log.Logger
+	}
+
+	taskDLQRateLimitedPersistenceClient struct {
+		rateLimiter quotas.Limiter
+		persistence TaskDLQManager
+		logger      log.Logger
+	}
 )
 
 var _ ShardManager = (*shardRateLimitedPersistenceClient)(nil)
@@ -84,6 +96,8 @@ var _ HistoryManager = (*historyRateLimitedPersistenceClient)(nil)
 var _ HistoryV2Manager = (*historyV2RateLimitedPersistenceClient)(nil)
 var _ MetadataManager = (*metadataRateLimitedPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityRateLimitedPersistenceClient)(nil)
+var _ AuditManager = (*auditRateLimitedPersistenceClient)(nil)
+var _ TaskDLQManager = (*taskDLQRateLimitedPersistenceClient)(nil)
 
 // NewShardPersistenceRateLimitedClient creates a client to manage shards
 func NewShardPersistenceRateLimitedClient(persistence ShardManager, rateLimiter quotas.Limiter, logger log.Logger) ShardManager {
@@ -272,6 +286,24 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetCurrentExecution(requ
 	return response, err
 }
 
+func (p *workflowExecutionRateLimitedPersistenceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.ListConcreteExecutions(request)
+	return response, err
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) ListCurrentExecutions(request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.ListCurrentExecutions(request)
+	return response, err
+}
+
 func (p *workflowExecutionRateLimitedPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return nil, ErrPersistenceLimitExceeded
@@ -732,3 +764,104 @@ func (p *historyV2RateLimitedPersistenceClient) GetHistoryTree(request *GetHisto
 	response, err := p.persistence.GetHistoryTree(request)
 	return response, err
 }
+
+// GetAllHistoryTreeBranches returns all branches of all trees
+func (p *historyV2RateLimitedPersistenceClient) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	response, err := p.persistence.GetAllHistoryTreeBranches(request)
+	return response, err
+}
+
+// ReencryptHistoryBranch rewrites the nodes of a branch which are not encrypted with the active data key of its domain
+func (p *historyV2RateLimitedPersistenceClient) ReencryptHistoryBranch(request *ReencryptHistoryBranchRequest) (*ReencryptHistoryBranchResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	response, err := p.persistence.ReencryptHistoryBranch(request)
+	return response, err
+}
+
+// NewAuditPersistenceRateLimitedClient creates a client to manage the audit log
+func NewAuditPersistenceRateLimitedClient(persistence AuditManager, rateLimiter quotas.Limiter, logger log.Logger) AuditManager {
+	return &auditRateLimitedPersistenceClient{
+		persistence: persistence,
+		rateLimiter: rateLimiter,
+		logger:      logger,
+	}
+}
+
+func (p *auditRateLimitedPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *auditRateLimitedPersistenceClient) CreateAuditRecord(request *CreateAuditRecordRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	return p.persistence.CreateAuditRecord(request)
+}
+
+func (p *auditRateLimitedPersistenceClient) ListAuditRecords(request *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.ListAuditRecords(request)
+}
+
+func (p *auditRateLimitedPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+// NewTaskDLQPersistenceRateLimitedClient creates a client to manage the task DLQ
+func NewTaskDLQPersistenceRateLimitedClient(persistence TaskDLQManager, rateLimiter quotas.Limiter, logger log.Logger) TaskDLQManager {
+	return &taskDLQRateLimitedPersistenceClient{
+		persistence: persistence,
+		rateLimiter: rateLimiter,
+		logger:      logger,
+	}
+}
+
+func (p *taskDLQRateLimitedPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskDLQRateLimitedPersistenceClient) PutDLQTask(request *PutDLQTaskRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	return p.persistence.PutDLQTask(request)
+}
+
+func (p *taskDLQRateLimitedPersistenceClient) ListDLQTasks(request *ListDLQTasksRequest) (*ListDLQTasksResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.ListDLQTasks(request)
+}
+
+func (p *taskDLQRateLimitedPersistenceClient) GetDLQTask(request *GetDLQTaskRequest) (*GetDLQTaskResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.GetDLQTask(request)
+}
+
+func (p *taskDLQRateLimitedPersistenceClient) DeleteDLQTask(request *DeleteDLQTaskRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	return p.persistence.DeleteDLQTask(request)
+}
+
+func (p *taskDLQRateLimitedPersistenceClient) RangeDeleteDLQTasks(request *RangeDeleteDLQTasksRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	return p.persistence.RangeDeleteDLQTasks(request)
+}
+
+func (p *taskDLQRateLimitedPersistenceClient) Close() {
+	p.persistence.Close()
+}
@@ -130,6 +130,24 @@ func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
 	return NewSQLVisibilityStore(f.cfg, f.logger)
 }
 
+// NewAuditStore returns an audit log store
+func (f *Factory) NewAuditStore() (p.AuditStore, error) {
+	conn, err := f.dbConn.get()
+	if err != nil {
+		return nil, err
+	}
+	return newAuditPersistence(conn, f.logger)
+}
+
+// NewTaskDLQStore returns a task DLQ store
+func (f *Factory) NewTaskDLQStore() (p.TaskDLQStore, error) {
+	conn, err := f.dbConn.get()
+	if err != nil {
+		return nil, err
+	}
+	return newTaskDLQPersistence(conn, f.logger)
+}
+
 // Close closes the factory
 func (f *Factory) Close() {
 	f.dbConn.forceClose()
@@ -0,0 +1,128 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+type (
+	sqlAuditManager struct {
+		sqlStore
+	}
+
+	// auditPageToken is the position of the last record returned
+	// by ListAuditRecords, records are read newest first
+	auditPageToken struct {
+		Time     time.Time
+		RecordID string
+	}
+)
+
+var _ p.AuditStore = (*sqlAuditManager)(nil)
+
+// newAuditPersistence creates an instance of AuditManager
+func newAuditPersistence(db sqldb.Interface, logger log.Logger) (p.AuditStore, error) {
+	return &sqlAuditManager{
+		sqlStore: sqlStore{
+			db:     db,
+			logger: logger,
+		},
+	}, nil
+}
+
+func (m *sqlAuditManager) CreateAuditRecord(request *p.CreateAuditRecordRequest) error {
+	record := request.Record
+	if _, err := m.db.InsertIntoAuditLog(&sqldb.AuditLogRow{
+		DomainName:     record.DomainName,
+		CreatedTime:    record.Timestamp,
+		RecordID:       record.ID,
+		Operation:      record.Operation,
+		WorkflowID:     record.WorkflowID,
+		RunID:          record.RunID,
+		Identity:       record.Identity,
+		Reason:         record.Reason,
+		RequestSummary: record.RequestSummary,
+		Result:         record.Result,
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CreateAuditRecord operation failed. Failed to insert into audit_log table. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlAuditManager) ListAuditRecords(request *p.ListAuditRecordsRequest) (*p.ListAuditRecordsResponse, error) {
+	readLevel := &auditPageToken{Time: request.LatestTime}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, readLevel); err != nil {
+			return nil, &workflow.BadRequestError{
+				Message: fmt.Sprintf("ListAuditRecords operation failed. Invalid page token. Error: %v", err),
+			}
+		}
+	}
+
+	rows, err := m.db.SelectFromAuditLog(&sqldb.AuditLogFilter{
+		DomainName:     request.DomainName,
+		MinCreatedTime: request.EarliestTime,
+		MaxCreatedTime: readLevel.Time,
+		RecordID:       readLevel.RecordID,
+		PageSize:       request.PageSize,
+	})
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListAuditRecords operation failed. Select failed: %v", err),
+		}
+	}
+
+	response := &p.ListAuditRecordsResponse{Records: make([]*p.AuditRecord, len(rows))}
+	for i, row := range rows {
+		response.Records[i] = &p.AuditRecord{
+			ID:             row.RecordID,
+			Timestamp:      row.CreatedTime,
+			Operation:      row.Operation,
+			DomainName:     row.DomainName,
+			WorkflowID:     row.WorkflowID,
+			RunID:          row.RunID,
+			Identity:       row.Identity,
+			Reason:         row.Reason,
+			RequestSummary: row.RequestSummary,
+			Result:         row.Result,
+		}
+	}
+	if len(rows) > 0 && len(rows) == request.PageSize {
+		lastRow := rows[len(rows)-1]
+		response.NextPageToken, err = json.Marshal(&auditPageToken{Time: lastRow.CreatedTime, RecordID: lastRow.RecordID})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListAuditRecords operation failed. Failed to serialize page token: %v", err),
+			}
+		}
+	}
+	return response, nil
+}
@@ -179,36 +179,17 @@ func (m *sqlExecutionManager) createWorkflowExecutionTx(
 	return &p.CreateWorkflowExecutionResponse{}, nil
 }
 
-func (m *sqlExecutionManager) GetWorkflowExecution(
-	request *p.GetWorkflowExecutionRequest,
-) (*p.InternalGetWorkflowExecutionResponse, error) {
-
-	domainID := sqldb.MustParseUUID(request.DomainID)
-	runID := sqldb.MustParseUUID(*request.Execution.RunId)
-	wfID := *request.Execution.WorkflowId
-	execution, err := m.db.SelectFromExecutions(&sqldb.ExecutionsFilter{
-		ShardID: m.shardID, DomainID: domainID, WorkflowID: wfID, RunID: runID})
-
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, &workflow.EntityNotExistsError{
-				Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
-					*request.Execution.WorkflowId,
-					*request.Execution.RunId),
-			}
-		}
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("GetWorkflowExecution failed. Error: %v", err),
-		}
-	}
+// executionInfoFromRow converts a row of executions table to the execution info and replication state of the execution
+func executionInfoFromRow(
+	execution *sqldb.ExecutionsRow,
+) (*p.InternalWorkflowExecutionInfo, *p.ReplicationState, error) {
 
 	info, err := workflowExecutionInfoFromBlob(execution.Data, execution.DataEncoding)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	var state p.InternalWorkflowMutableState
-	state.ExecutionInfo = &p.InternalWorkflowExecutionInfo{
+	executionInfo := &p.InternalWorkflowExecutionInfo{
 		DomainID:                     execution.DomainID.String(),
 		WorkflowID:                   execution.WorkflowID,
 		RunID:                        execution.RunID.String(),
@@ -254,49 +235,84 @@ func (m *sqlExecutionManager) GetWorkflowExecution(
 		ExecutionContext:             info.GetExecutionContext(),
 		NonRetriableErrors:           info.GetRetryNonRetryableErrors(),
 		SearchAttributes:             info.GetSearchAttributes(),
+		Paused:                       info.GetPaused(),
+		RequestedUpdates:             info.GetRequestedUpdates(),
 	}
 
+	var replicationState *p.ReplicationState
 	if info.LastWriteEventID != nil {
-		state.ReplicationState = &p.ReplicationState{}
-		state.ReplicationState.StartVersion = info.GetStartVersion()
-		state.ReplicationState.CurrentVersion = info.GetCurrentVersion()
-		state.ReplicationState.LastWriteVersion = execution.LastWriteVersion
-		state.ReplicationState.LastWriteEventID = info.GetLastWriteEventID()
-		state.ReplicationState.LastReplicationInfo = make(map[string]*p.ReplicationInfo, len(info.LastReplicationInfo))
+		replicationState = &p.ReplicationState{}
+		replicationState.StartVersion = info.GetStartVersion()
+		replicationState.CurrentVersion = info.GetCurrentVersion()
+		replicationState.LastWriteVersion = execution.LastWriteVersion
+		replicationState.LastWriteEventID = info.GetLastWriteEventID()
+		replicationState.LastReplicationInfo = make(map[string]*p.ReplicationInfo, len(info.LastReplicationInfo))
 		for k, v := range info.LastReplicationInfo {
-			state.ReplicationState.LastReplicationInfo[k] = &p.ReplicationInfo{Version: v.GetVersion(), LastEventID: v.GetLastEventID()}
+			replicationState.LastReplicationInfo[k] = &p.ReplicationInfo{Version: v.GetVersion(), LastEventID: v.GetLastEventID()}
 		}
 	}
 
 	if info.ParentDomainID != nil {
-		state.ExecutionInfo.ParentDomainID = sqldb.UUID(info.ParentDomainID).String()
-		state.ExecutionInfo.ParentWorkflowID = info.GetParentWorkflowID()
-		state.ExecutionInfo.ParentRunID = sqldb.UUID(info.ParentRunID).String()
-		state.ExecutionInfo.InitiatedID = info.GetInitiatedID()
-		if state.ExecutionInfo.CompletionEvent != nil {
-			state.ExecutionInfo.CompletionEvent = nil
+		executionInfo.ParentDomainID = sqldb.UUID(info.ParentDomainID).String()
+		executionInfo.ParentWorkflowID = info.GetParentWorkflowID()
+		executionInfo.ParentRunID = sqldb.UUID(info.ParentRunID).String()
+		executionInfo.InitiatedID = info.GetInitiatedID()
+		if executionInfo.CompletionEvent != nil {
+			executionInfo.CompletionEvent = nil
 		}
 	}
 
 	if info.GetCancelRequested() {
-		state.ExecutionInfo.CancelRequested = true
-		state.ExecutionInfo.CancelRequestID = info.GetCancelRequestID()
+		executionInfo.CancelRequested = true
+		executionInfo.CancelRequestID = info.GetCancelRequestID()
 	}
 
 	if info.CompletionEventBatchID != nil {
-		state.ExecutionInfo.CompletionEventBatchID = info.GetCompletionEventBatchID()
+		executionInfo.CompletionEventBatchID = info.GetCompletionEventBatchID()
 	}
 
 	if info.CompletionEvent != nil {
-		state.ExecutionInfo.CompletionEvent = p.NewDataBlob(info.CompletionEvent,
+		executionInfo.CompletionEvent = p.NewDataBlob(info.CompletionEvent,
 			common.EncodingType(info.GetCompletionEventEncoding()))
 	}
 
 	if info.AutoResetPoints != nil {
-		state.ExecutionInfo.AutoResetPoints = p.NewDataBlob(info.AutoResetPoints,
+		executionInfo.AutoResetPoints = p.NewDataBlob(info.AutoResetPoints,
 			common.EncodingType(info.GetAutoResetPointsEncoding()))
 	}
 
+	return executionInfo, replicationState, nil
+}
+
+func (m *sqlExecutionManager) GetWorkflowExecution(
+	request *p.GetWorkflowExecutionRequest,
+) (*p.InternalGetWorkflowExecutionResponse, error) {
+
+	domainID := sqldb.MustParseUUID(request.DomainID)
+	runID := sqldb.MustParseUUID(*request.Execution.RunId)
+	wfID := *request.Execution.WorkflowId
+	execution, err := m.db.SelectFromExecutions(&sqldb.ExecutionsFilter{
+		ShardID: m.shardID, DomainID: domainID, WorkflowID: wfID, RunID: runID})
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+					*request.Execution.WorkflowId,
+					*request.Execution.RunId),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetWorkflowExecution failed. Error: %v", err),
+		}
+	}
+
+	var state p.InternalWorkflowMutableState
+	state.ExecutionInfo, state.ReplicationState, err = executionInfoFromRow(execution)
+	if err != nil {
+		return nil, err
+	}
+
 	{
 		var err error
 		state.ActivitInfos, err = getActivityInfoMap(m.db,
@@ -692,6 +708,105 @@ func (m *sqlExecutionManager) GetCurrentExecution(
 	}, nil
 }
 
+type executionsPageToken struct {
+	DomainID   string
+	WorkflowID string
+	RunID      string
+}
+
+func (m *sqlExecutionManager) ListConcreteExecutions(
+	request *p.ListConcreteExecutionsRequest,
+) (*p.InternalListConcreteExecutionsResponse, error) {
+
+	pageToken := executionsPageToken{DomainID: minUUID, RunID: minUUID}
+	if len(request.PageToken) > 0 {
+		if err := gobDeserialize(request.PageToken, &pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("error deserializing page token: %v", err)}
+		}
+	}
+	rows, err := m.db.RangeSelectFromExecutions(&sqldb.ExecutionsFilter{
+		ShardID:    m.shardID,
+		DomainID:   sqldb.MustParseUUID(pageToken.DomainID),
+		WorkflowID: pageToken.WorkflowID,
+		RunID:      sqldb.MustParseUUID(pageToken.RunID),
+		PageSize:   &request.PageSize,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListConcreteExecutions operation failed. Select failed. Error: %v", err),
+		}
+	}
+
+	response := &p.InternalListConcreteExecutionsResponse{
+		ExecutionInfos: make([]*p.InternalWorkflowExecutionInfo, len(rows)),
+	}
+	for i := range rows {
+		response.ExecutionInfos[i], _, err = executionInfoFromRow(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(rows) >= request.PageSize {
+		lastRow := &rows[len(rows)-1]
+		response.NextPageToken, err = gobSerialize(&executionsPageToken{
+			DomainID:   lastRow.DomainID.String(),
+			WorkflowID: lastRow.WorkflowID,
+			RunID:      lastRow.RunID.String(),
+		})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("error serializing nextPageToken:%v", err)}
+		}
+	}
+	return response, nil
+}
+
+func (m *sqlExecutionManager) ListCurrentExecutions(
+	request *p.ListCurrentExecutionsRequest,
+) (*p.ListCurrentExecutionsResponse, error) {
+
+	pageToken := executionsPageToken{DomainID: minUUID}
+	if len(request.PageToken) > 0 {
+		if err := gobDeserialize(request.PageToken, &pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("error deserializing page token: %v", err)}
+		}
+	}
+	rows, err := m.db.RangeSelectFromCurrentExecutions(&sqldb.CurrentExecutionsFilter{
+		ShardID:    int64(m.shardID),
+		DomainID:   sqldb.MustParseUUID(pageToken.DomainID),
+		WorkflowID: pageToken.WorkflowID,
+		PageSize:   &request.PageSize,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListCurrentExecutions operation failed. Select failed. Error: %v", err),
+		}
+	}
+
+	response := &p.ListCurrentExecutionsResponse{
+		Executions: make([]*p.CurrentWorkflowExecution, len(rows)),
+	}
+	for i, row := range rows {
+		response.Executions[i] = &p.CurrentWorkflowExecution{
+			DomainID:    row.DomainID.String(),
+			WorkflowID:  row.WorkflowID,
+			RunID:       row.RunID.String(),
+			State:       row.State,
+			CloseStatus: row.CloseStatus,
+		}
+	}
+	if len(rows) >= request.PageSize {
+		lastRow := &rows[len(rows)-1]
+		response.NextPageToken, err = gobSerialize(&executionsPageToken{
+			DomainID:   lastRow.DomainID.String(),
+			WorkflowID: lastRow.WorkflowID,
+		})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("error serializing nextPageToken:%v", err)}
+		}
+	}
+	return response, nil
+}
+
 func (m *sqlExecutionManager) GetTransferTasks(
 	request *p.GetTransferTasksRequest,
 ) (*p.GetTransferTasksResponse, error) {
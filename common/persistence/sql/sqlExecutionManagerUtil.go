@@ -1181,6 +1181,8 @@ func buildExecutionRow(
 		AutoResetPoints:                 executionInfo.AutoResetPoints.Data,
 		AutoResetPointsEncoding:         common.StringPtr(string(executionInfo.AutoResetPoints.GetEncoding())),
 		SearchAttributes:                executionInfo.SearchAttributes,
+		Paused:                          &executionInfo.Paused,
+		RequestedUpdates:                executionInfo.RequestedUpdates,
 	}
 
 	completionEvent := executionInfo.CompletionEvent
@@ -26,7 +26,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/common"
@@ -35,10 +34,19 @@ import (
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 )
 
-type sqlHistoryV2Manager struct {
-	sqlStore
-	shardID int
-}
+type (
+	sqlHistoryV2Manager struct {
+		sqlStore
+		shardID int
+	}
+
+	// historyTreePageToken is the last branch of a page of GetAllHistoryTreeBranches
+	historyTreePageToken struct {
+		ShardID  int
+		TreeID   string
+		BranchID string
+	}
+)
 
 // newHistoryV2Persistence creates an instance of HistoryManager
 func newHistoryV2Persistence(db sqldb.Interface, logger log.Logger) (p.HistoryV2Store, error) {
@@ -143,7 +151,7 @@ func (m *sqlHistoryV2Manager) AppendHistoryNodes(request *p.InternalAppendHistor
 
 	_, err := m.db.InsertIntoHistoryNode(nodeRow)
 	if err != nil {
-		if sqlErr, ok := err.(*mysql.MySQLError); ok && sqlErr.Number == ErrDupEntry {
+		if m.db.IsDupEntryError(err) {
 			return &p.ConditionFailedError{Msg: fmt.Sprintf("AppendHistoryNodes: row already exist: %v", err)}
 		}
 		return &shared.InternalServiceError{Message: fmt.Sprintf("AppendHistoryEvents: %v", err)}
@@ -183,6 +191,8 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(request *p.InternalReadHistoryBr
 	lastNodeID := int64(-1)
 	lastTxnID := int64(-1)
 	eventBlob := &p.DataBlob{}
+	nodeIDs := make([]int64, 0, int(request.PageSize))
+	txnIDs := make([]int64, 0, int(request.PageSize))
 
 	for _, row := range rows {
 		eventBlob.Data = row.Data
@@ -206,6 +216,8 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(request *p.InternalReadHistoryBr
 			lastTxnID = *row.TxnID
 			lastNodeID = row.NodeID
 			history = append(history, eventBlob)
+			nodeIDs = append(nodeIDs, row.NodeID)
+			txnIDs = append(txnIDs, *row.TxnID)
 			eventBlob = &p.DataBlob{}
 		}
 	}
@@ -215,8 +227,10 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(request *p.InternalReadHistoryBr
 		pagingToken = serializePageToken(lastNodeID)
 	}
 	response := &p.InternalReadHistoryBranchResponse{
-		History:       history,
-		NextPageToken: pagingToken,
+		History:        history,
+		NodeIDs:        nodeIDs,
+		TransactionIDs: txnIDs,
+		NextPageToken:  pagingToken,
 	}
 
 	return response, nil
@@ -517,3 +531,84 @@ func (m *sqlHistoryV2Manager) GetHistoryTree(request *p.GetHistoryTreeRequest) (
 		ForkingInProgressBranches: forkingBranches,
 	}, nil
 }
+
+// GetAllHistoryTreeBranches returns all branches of all trees
+func (m *sqlHistoryV2Manager) GetAllHistoryTreeBranches(request *p.GetAllHistoryTreeBranchesRequest) (*p.GetAllHistoryTreeBranchesResponse, error) {
+	// the first page starts before the lowest shard ID
+	token := historyTreePageToken{ShardID: -1}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, &token); err != nil {
+			return nil, &shared.BadRequestError{
+				Message: fmt.Sprintf("invalid next page token %v", request.NextPageToken)}
+		}
+	}
+
+	treeID := sqldb.UUID(make([]byte, 16))
+	branchID := sqldb.UUID(make([]byte, 16))
+	if token.TreeID != "" {
+		treeID = sqldb.MustParseUUID(token.TreeID)
+		branchID = sqldb.MustParseUUID(token.BranchID)
+	}
+	rows, err := m.db.SelectFromHistoryTree(&sqldb.HistoryTreeFilter{
+		ShardID:  token.ShardID,
+		TreeID:   treeID,
+		BranchID: &branchID,
+		PageSize: &request.PageSize,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, &shared.InternalServiceError{
+			Message: fmt.Sprintf("GetAllHistoryTreeBranches: %v", err),
+		}
+	}
+
+	branches := make([]p.HistoryBranchDetail, 0, len(rows))
+	for _, row := range rows {
+		treeInfo, err := historyTreeInfoFromBlob(row.Data, row.DataEncoding)
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, p.HistoryBranchDetail{
+			TreeID:   row.TreeID.String(),
+			BranchID: row.BranchID.String(),
+			ForkTime: time.Unix(0, treeInfo.GetCreatedTimeNanos()),
+			Info:     treeInfo.GetInfo(),
+			ShardID:  row.ShardID,
+		})
+	}
+
+	response := &p.GetAllHistoryTreeBranchesResponse{
+		Branches: branches,
+	}
+	if len(rows) == request.PageSize {
+		last := rows[len(rows)-1]
+		response.NextPageToken, err = json.Marshal(&historyTreePageToken{
+			ShardID:  last.ShardID,
+			TreeID:   last.TreeID.String(),
+			BranchID: last.BranchID.String(),
+		})
+		if err != nil {
+			return nil, &shared.InternalServiceError{
+				Message: fmt.Sprintf("GetAllHistoryTreeBranches: %v", err),
+			}
+		}
+	}
+	return response, nil
+}
+
+// UpdateHistoryNode overwrites the events of an existing node
+func (m *sqlHistoryV2Manager) UpdateHistoryNode(request *p.InternalUpdateHistoryNodeRequest) error {
+	txnID := request.TransactionID
+	_, err := m.db.UpdateHistoryNode(&sqldb.HistoryNodeRow{
+		ShardID:      request.ShardID,
+		TreeID:       sqldb.MustParseUUID(request.TreeID),
+		BranchID:     sqldb.MustParseUUID(request.BranchID),
+		NodeID:       request.NodeID,
+		TxnID:        &txnID,
+		Data:         request.Events.Data,
+		DataEncoding: string(request.Events.Encoding),
+	})
+	if err != nil {
+		return &shared.InternalServiceError{Message: fmt.Sprintf("UpdateHistoryNode: %v", err)}
+	}
+	return nil
+}
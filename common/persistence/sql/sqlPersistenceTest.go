@@ -23,6 +23,7 @@ package sql
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
@@ -34,9 +35,10 @@ import (
 )
 
 const (
-	testUser      = "uber"
-	testPassword  = "uber"
-	testSchemaDir = "schema/mysql/v57"
+	testUser              = "uber"
+	testPassword          = "uber"
+	testSchemaDir         = "schema/mysql/v57"
+	testPostgresSchemaDir = "schema/postgres"
 )
 
 // TestCluster allows executing cassandra operations in testing.
@@ -48,9 +50,26 @@ type TestCluster struct {
 }
 
 // NewTestCluster returns a new SQL test cluster
-func NewTestCluster(dbName string, port int, schemaDir string) *TestCluster {
+func NewTestCluster(dbName string, driverName string, port int, schemaDir string) *TestCluster {
 	var result TestCluster
 	result.dbName = dbName
+	if driverName == "" {
+		driverName = defaultDriverName
+	}
+	addr := environment.GetMySQLAddress()
+	if driverName == postgresDriverName {
+		addr = environment.GetPostgresAddress()
+		if port == 0 {
+			port = environment.GetPostgresPort()
+		}
+		if schemaDir == "" {
+			schemaDir = testPostgresSchemaDir
+		}
+	}
+	if driverName == sqliteDriverName {
+		// sqlite databases are files, which are kept in the temp dir for tests
+		dbName = filepath.Join(os.TempDir(), dbName+".db")
+	}
 	if port == 0 {
 		port = environment.GetMySQLPort()
 	}
@@ -61,9 +80,9 @@ func NewTestCluster(dbName string, port int, schemaDir string) *TestCluster {
 	result.cfg = config.SQL{
 		User:            testUser,
 		Password:        testPassword,
-		ConnectAddr:     fmt.Sprintf("%v:%v", environment.GetMySQLAddress(), port),
+		ConnectAddr:     fmt.Sprintf("%v:%v", addr, port),
 		ConnectProtocol: "tcp",
-		DriverName:      defaultDriverName,
+		DriverName:      driverName,
 		DatabaseName:    dbName,
 		NumShards:       4,
 	}
@@ -80,6 +99,11 @@ func (s *TestCluster) SetupTestDatabase() {
 	s.CreateDatabase()
 	s.CreateSession()
 
+	if s.cfg.DriverName == sqliteDriverName {
+		// the sqlite plugin creates the schema when the database is opened
+		return
+	}
+
 	schemaDir := s.schemaDir + "/"
 	if !strings.HasPrefix(schemaDir, "/") && !strings.HasPrefix(schemaDir, "../") {
 		cadencePackageDir, err := getCadencePackageDir()
@@ -107,8 +131,9 @@ func (s *TestCluster) Config() config.Persistence {
 
 // TearDownTestDatabase from PersistenceTestCluster interface
 func (s *TestCluster) TearDownTestDatabase() {
-	s.DropDatabase()
+	// the session must be closed first, since postgres doesn't allow dropping a database that is in use
 	s.db.Close()
+	s.DropDatabase()
 }
 
 // CreateSession from PersistenceTestCluster interface
@@ -122,7 +147,7 @@ func (s *TestCluster) CreateSession() {
 
 // CreateDatabase from PersistenceTestCluster interface
 func (s *TestCluster) CreateDatabase() {
-	err := createDatabase(s.cfg.DriverName, s.cfg.ConnectAddr, testUser, testPassword, s.dbName, true)
+	err := createDatabase(s.cfg, s.dbName, true)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -130,10 +155,20 @@ func (s *TestCluster) CreateDatabase() {
 
 // DropDatabase from PersistenceTestCluster interface
 func (s *TestCluster) DropDatabase() {
-	err := dropDatabase(s.db, s.dbName)
+	if s.cfg.DriverName == sqliteDriverName {
+		if err := removeDatabaseFile(s.cfg.DatabaseName); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	db, err := newAdminConnection(s.cfg)
 	if err != nil {
 		log.Fatal(err)
 	}
+	defer db.Close()
+	if err := dropDatabase(db, s.dbName); err != nil {
+		log.Fatal(err)
+	}
 }
 
 // LoadSchema from PersistenceTestCluster interface
@@ -0,0 +1,181 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+type (
+	sqlTaskDLQManager struct {
+		sqlStore
+	}
+
+	// taskDLQPageToken is the ID of the last task returned by ListDLQTasks
+	taskDLQPageToken struct {
+		TaskID int64
+	}
+)
+
+var _ p.TaskDLQStore = (*sqlTaskDLQManager)(nil)
+
+// newTaskDLQPersistence creates an instance of TaskDLQManager
+func newTaskDLQPersistence(db sqldb.Interface, logger log.Logger) (p.TaskDLQStore, error) {
+	return &sqlTaskDLQManager{
+		sqlStore: sqlStore{
+			db:     db,
+			logger: logger,
+		},
+	}, nil
+}
+
+func (m *sqlTaskDLQManager) PutDLQTask(request *p.PutDLQTaskRequest) error {
+	task := request.Task
+	if _, err := m.db.ReplaceIntoTaskDLQ(&sqldb.TaskDLQRow{
+		ShardID:             task.ShardID,
+		Category:            task.Category,
+		TaskID:              task.TaskID,
+		TaskType:            task.TaskType,
+		DomainID:            task.DomainID,
+		WorkflowID:          task.WorkflowID,
+		RunID:               task.RunID,
+		VisibilityTimestamp: task.VisibilityTimestamp,
+		Data:                task.Data,
+		LastError:           task.LastError,
+		Attempt:             task.Attempt,
+		CreatedTime:         task.CreatedTime,
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutDLQTask operation failed. Failed to insert into task_dlq table. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlTaskDLQManager) ListDLQTasks(request *p.ListDLQTasksRequest) (*p.ListDLQTasksResponse, error) {
+	readLevel := &taskDLQPageToken{TaskID: -1}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, readLevel); err != nil {
+			return nil, &workflow.BadRequestError{
+				Message: fmt.Sprintf("ListDLQTasks operation failed. Invalid page token. Error: %v", err),
+			}
+		}
+	}
+
+	rows, err := m.db.SelectFromTaskDLQ(&sqldb.TaskDLQFilter{
+		ShardID:   request.ShardID,
+		Category:  request.Category,
+		MinTaskID: common.Int64Ptr(readLevel.TaskID),
+		PageSize:  common.IntPtr(request.PageSize),
+	})
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListDLQTasks operation failed. Select failed: %v", err),
+		}
+	}
+
+	response := &p.ListDLQTasksResponse{Tasks: make([]*p.DLQTask, len(rows))}
+	for i := range rows {
+		response.Tasks[i] = dlqTaskFromRow(&rows[i])
+	}
+	if len(rows) > 0 && len(rows) == request.PageSize {
+		response.NextPageToken, err = json.Marshal(&taskDLQPageToken{TaskID: rows[len(rows)-1].TaskID})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListDLQTasks operation failed. Failed to serialize page token: %v", err),
+			}
+		}
+	}
+	return response, nil
+}
+
+func (m *sqlTaskDLQManager) GetDLQTask(request *p.GetDLQTaskRequest) (*p.GetDLQTaskResponse, error) {
+	rows, err := m.db.SelectFromTaskDLQ(&sqldb.TaskDLQFilter{
+		ShardID:  request.ShardID,
+		Category: request.Category,
+		TaskID:   common.Int64Ptr(request.TaskID),
+	})
+	if err == nil && len(rows) == 0 {
+		err = sql.ErrNoRows
+	}
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("DLQ task not found.  ShardId: %v, Category: %v, TaskId: %v",
+					request.ShardID, request.Category, request.TaskID),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetDLQTask operation failed. Select failed: %v", err),
+		}
+	}
+	return &p.GetDLQTaskResponse{Task: dlqTaskFromRow(&rows[0])}, nil
+}
+
+func (m *sqlTaskDLQManager) DeleteDLQTask(request *p.DeleteDLQTaskRequest) error {
+	if _, err := m.db.DeleteFromTaskDLQ(&sqldb.TaskDLQFilter{
+		ShardID:  request.ShardID,
+		Category: request.Category,
+		TaskID:   common.Int64Ptr(request.TaskID),
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteDLQTask operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlTaskDLQManager) RangeDeleteDLQTasks(request *p.RangeDeleteDLQTasksRequest) error {
+	if _, err := m.db.DeleteFromTaskDLQ(&sqldb.TaskDLQFilter{
+		ShardID:  request.ShardID,
+		Category: request.Category,
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RangeDeleteDLQTasks operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func dlqTaskFromRow(row *sqldb.TaskDLQRow) *p.DLQTask {
+	return &p.DLQTask{
+		ShardID:             row.ShardID,
+		Category:            row.Category,
+		TaskID:              row.TaskID,
+		TaskType:            row.TaskType,
+		DomainID:            row.DomainID,
+		WorkflowID:          row.WorkflowID,
+		RunID:               row.RunID,
+		VisibilityTimestamp: row.VisibilityTimestamp,
+		Data:                row.Data,
+		LastError:           row.LastError,
+		Attempt:             row.Attempt,
+		CreatedTime:         row.CreatedTime,
+	}
+}
@@ -21,31 +21,47 @@
 package sql
 
 import (
+	"bytes"
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/storage"
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
 	sqlVisibilityStore struct {
 		sqlStore
+		dialect               *visibilityQueryDialect
+		validSearchAttributes dynamicconfig.MapPropertyFn
 	}
 
 	visibilityPageToken struct {
 		Time  time.Time
 		RunID string
 	}
+
+	// visibilityQueryPageToken is the page token of ListWorkflowExecutions and ScanWorkflowExecutions,
+	// the former pages by offset while the latter pages by the last returned run ID
+	visibilityQueryPageToken struct {
+		Offset int
+		RunID  string
+	}
 )
 
+const defaultVisibilityQueryPageSize = 1000
+
 // NewSQLVisibilityStore creates an instance of ExecutionStore
 func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore, error) {
 	db, err := storage.NewSQLDB(&cfg)
@@ -57,11 +73,28 @@ func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore
 			db:     db,
 			logger: logger,
 		},
+		dialect:               visibilityQueryDialects[cfg.DriverName],
+		validSearchAttributes: dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
 	}, nil
 }
 
+// WithValidSearchAttributes makes the given sql visibility store use validSearchAttributes
+// to look up the types of the custom search attributes in list queries
+func WithValidSearchAttributes(store p.VisibilityStore, validSearchAttributes dynamicconfig.MapPropertyFn) p.VisibilityStore {
+	sqlStore, ok := store.(*sqlVisibilityStore)
+	if !ok {
+		return store
+	}
+	sqlStore.validSearchAttributes = validSearchAttributes
+	return sqlStore
+}
+
 func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalRecordWorkflowExecutionStartedRequest) error {
-	_, err := s.db.InsertIntoVisibility(&sqldb.VisibilityRow{
+	searchAttributes, err := s.serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.InsertIntoVisibility(&sqldb.VisibilityRow{
 		DomainID:         request.DomainUUID,
 		WorkflowID:       request.WorkflowID,
 		RunID:            request.RunID,
@@ -70,12 +103,17 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalR
 		WorkflowTypeName: request.WorkflowTypeName,
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		SearchAttributes: searchAttributes,
 	})
 
 	return err
 }
 
 func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRecordWorkflowExecutionClosedRequest) error {
+	searchAttributes, err := s.serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
 	closeTime := time.Unix(0, request.CloseTimestamp)
 	result, err := s.db.ReplaceIntoVisibility(&sqldb.VisibilityRow{
 		DomainID:         request.DomainUUID,
@@ -89,6 +127,7 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRe
 		HistoryLength:    &request.HistoryLength,
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		SearchAttributes: searchAttributes,
 	})
 	if err != nil {
 		return err
@@ -104,7 +143,23 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRe
 }
 
 func (s *sqlVisibilityStore) UpsertWorkflowExecution(request *p.InternalUpsertWorkflowExecutionRequest) error {
-	return p.NewOperationNotSupportErrorForVis()
+	searchAttributes, err := s.serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.UpdateVisibility(&sqldb.VisibilityRow{
+		DomainID:         request.DomainUUID,
+		RunID:            request.RunID,
+		Memo:             request.Memo.Data,
+		Encoding:         string(request.Memo.GetEncoding()),
+		SearchAttributes: searchAttributes,
+	})
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpsertWorkflowExecution operation failed. Update failed: %v", err),
+		}
+	}
+	return nil
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
@@ -250,15 +305,211 @@ func (s *sqlVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDelete
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := s.convertQuery(request.Query)
+	if err != nil {
+		return nil, err
+	}
+	token, err := s.deserializeQueryPageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// RunID is used as tie-breaker so that the order of rows is stable between pages
+	orderBy := fmt.Sprintf("%v DESC, %v DESC", defaultVisibilitySortColumn, visibilityTieBreakerColumn)
+	if len(query.sortColumn) > 0 {
+		direction := "ASC"
+		if query.sortDesc {
+			direction = "DESC"
+		}
+		orderBy = fmt.Sprintf("%v %v, %v DESC", query.sortColumn, direction, visibilityTieBreakerColumn)
+	}
+	pageSize := getVisibilityQueryPageSize(request.PageSize)
+	rows, err := s.db.SelectFromVisibilityByQuery(&sqldb.VisibilityQueryFilter{
+		DomainID:  request.DomainUUID,
+		Condition: query.condition,
+		Args:      query.args,
+		OrderBy:   orderBy,
+		PageSize:  pageSize,
+		Offset:    token.Offset,
+	})
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListWorkflowExecutions operation failed. Select failed: %v", err),
+		}
+	}
+	return s.getQueryResponse(rows, pageSize, &visibilityQueryPageToken{Offset: token.Offset + len(rows)})
 }
 
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := s.convertQuery(request.Query)
+	if err != nil {
+		return nil, err
+	}
+	token, err := s.deserializeQueryPageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// scan ignores the order by clause and pages by run ID, which is unique within a domain
+	condition := query.condition
+	args := query.args
+	if len(token.RunID) > 0 {
+		if len(condition) > 0 {
+			condition = fmt.Sprintf("%v > ? AND (%v)", visibilityTieBreakerColumn, condition)
+		} else {
+			condition = visibilityTieBreakerColumn + " > ?"
+		}
+		args = append([]interface{}{token.RunID}, args...)
+	}
+	pageSize := getVisibilityQueryPageSize(request.PageSize)
+	rows, err := s.db.SelectFromVisibilityByQuery(&sqldb.VisibilityQueryFilter{
+		DomainID:  request.DomainUUID,
+		Condition: condition,
+		Args:      args,
+		OrderBy:   visibilityTieBreakerColumn,
+		PageSize:  pageSize,
+	})
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ScanWorkflowExecutions operation failed. Select failed: %v", err),
+		}
+	}
+	var nextToken *visibilityQueryPageToken
+	if len(rows) > 0 {
+		nextToken = &visibilityQueryPageToken{RunID: rows[len(rows)-1].RunID}
+	}
+	return s.getQueryResponse(rows, pageSize, nextToken)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := s.convertQuery(request.Query)
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.db.CountFromVisibilityByQuery(&sqldb.VisibilityQueryFilter{
+		DomainID:  request.DomainUUID,
+		Condition: query.condition,
+		Args:      query.args,
+	})
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CountWorkflowExecutions operation failed. Select failed: %v", err),
+		}
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+func (s *sqlVisibilityStore) convertQuery(query string) (*visibilityQuery, error) {
+	if s.dialect == nil {
+		return nil, p.NewOperationNotSupportErrorForVis()
+	}
+	converter := newVisibilityQueryConverter(s.dialect, s.validSearchAttributes())
+	result, err := converter.convert(query)
+	if err != nil {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	return result, nil
+}
+
+func (s *sqlVisibilityStore) getQueryResponse(
+	rows []sqldb.VisibilityRow,
+	pageSize int,
+	nextToken *visibilityQueryPageToken,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	infos := make([]*p.VisibilityWorkflowExecutionInfo, len(rows))
+	for i := range rows {
+		infos[i] = s.rowToInfo(&rows[i])
+	}
+
+	response := &p.InternalListWorkflowExecutionsResponse{Executions: infos}
+	if len(rows) == pageSize {
+		data, err := json.Marshal(nextToken)
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("unable to serialize page token: %v", err),
+			}
+		}
+		response.NextPageToken = data
+	}
+	return response, nil
+}
+
+func (s *sqlVisibilityStore) deserializeQueryPageToken(data []byte) (*visibilityQueryPageToken, error) {
+	var token visibilityQueryPageToken
+	if len(data) == 0 {
+		return &token, nil
+	}
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("unable to deserialize page token: %v", err)}
+	}
+	return &token, nil
+}
+
+// serializeSearchAttributes encodes search attributes into the JSON document stored in search_attributes
+// column, datetime attributes are stored as unix nanos so that they can be compared and sorted
+func (s *sqlVisibilityStore) serializeSearchAttributes(attributes map[string][]byte) ([]byte, error) {
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+	validSearchAttributes := s.validSearchAttributes()
+	doc := make(map[string]json.RawMessage, len(attributes))
+	for name, value := range attributes {
+		if valueType, ok := getSearchAttributeType(validSearchAttributes, name); ok && valueType == workflow.IndexedValueTypeDatetime {
+			var datetime string
+			if err := json.Unmarshal(value, &datetime); err == nil {
+				nanos, err := parseDatetime(datetime)
+				if err != nil {
+					return nil, &workflow.BadRequestError{
+						Message: fmt.Sprintf("invalid value of datetime search attribute %v: %v", name, err),
+					}
+				}
+				value = []byte(strconv.FormatInt(nanos, 10))
+			}
+		}
+		doc[name] = value
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("unable to serialize search attributes: %v", err),
+		}
+	}
+	return data, nil
+}
+
+// deserializeSearchAttributes decodes the JSON document stored in search_attributes column,
+// datetime attributes are returned in RFC3339 format with nanoseconds
+func (s *sqlVisibilityStore) deserializeSearchAttributes(data []byte) map[string]interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	var attributes map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&attributes); err != nil {
+		s.logger.Error("unable to deserialize search attributes", tag.Error(err))
+		return nil
+	}
+	validSearchAttributes := s.validSearchAttributes()
+	for name, value := range attributes {
+		number, ok := value.(json.Number)
+		if !ok {
+			continue
+		}
+		if valueType, ok := getSearchAttributeType(validSearchAttributes, name); ok && valueType == workflow.IndexedValueTypeDatetime {
+			if nanos, err := number.Int64(); err == nil {
+				attributes[name] = time.Unix(0, nanos).UTC().Format(time.RFC3339Nano)
+			}
+		}
+	}
+	return attributes
+}
+
+func getVisibilityQueryPageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return defaultVisibilityQueryPageSize
+	}
+	return pageSize
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqldb.VisibilityRow) *p.VisibilityWorkflowExecutionInfo {
@@ -266,12 +517,13 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqldb.VisibilityRow) *p.VisibilityWo
 		row.ExecutionTime = row.StartTime
 	}
 	info := &p.VisibilityWorkflowExecutionInfo{
-		WorkflowID:    row.WorkflowID,
-		RunID:         row.RunID,
-		TypeName:      row.WorkflowTypeName,
-		StartTime:     row.StartTime,
-		ExecutionTime: row.ExecutionTime,
-		Memo:          p.NewDataBlob(row.Memo, common.EncodingType(row.Encoding)),
+		WorkflowID:       row.WorkflowID,
+		RunID:            row.RunID,
+		TypeName:         row.WorkflowTypeName,
+		StartTime:        row.StartTime,
+		ExecutionTime:    row.ExecutionTime,
+		Memo:             p.NewDataBlob(row.Memo, common.EncodingType(row.Encoding)),
+		SearchAttributes: s.deserializeSearchAttributes(row.SearchAttributes),
 	}
 	if row.CloseStatus != nil {
 		status := workflow.WorkflowExecutionCloseStatus(*row.CloseStatus)
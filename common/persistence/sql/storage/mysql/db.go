@@ -21,10 +21,19 @@
 package mysql
 
 import (
+	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 )
 
+const (
+	// DriverName is the name of the mysql sql driver
+	DriverName = "mysql"
+
+	// ErrDupEntry MySQL Error 1062 indicates a duplicate primary key i.e. the row already exists
+	ErrDupEntry = 1062
+)
+
 // DB represents a logical connection to mysql database
 type DB struct {
 	db        *sqlx.DB
@@ -48,6 +57,12 @@ func NewDB(xdb *sqlx.DB, tx *sqlx.Tx) *DB {
 	return mdb
 }
 
+// IsDupEntryError returns true if the error is due to a duplicate primary key
+func (mdb *DB) IsDupEntryError(err error) bool {
+	sqlErr, ok := err.(*mysql.MySQLError)
+	return ok && sqlErr.Number == ErrDupEntry
+}
+
 // BeginTx starts a new transaction and returns a reference to the Tx object
 func (mdb *DB) BeginTx() (sqldb.Tx, error) {
 	xtx, err := mdb.db.Beginx()
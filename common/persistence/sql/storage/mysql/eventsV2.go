@@ -37,6 +37,9 @@ const (
 
 	deleteHistoryNodesQry = `DELETE FROM history_node WHERE shard_id = ? AND tree_id = ? AND branch_id = ? AND node_id >= ? `
 
+	updateHistoryNodesQry = `UPDATE history_node SET data = :data, data_encoding = :data_encoding ` +
+		`WHERE shard_id = :shard_id AND tree_id = :tree_id AND branch_id = :branch_id AND node_id = :node_id AND txn_id = :txn_id `
+
 	// below are templates for history_tree table
 	addHistoryTreeQry = `INSERT INTO history_tree (` +
 		`shard_id, tree_id, branch_id, in_progress, data, data_encoding) ` +
@@ -44,6 +47,9 @@ const (
 
 	getHistoryTreeQry = `SELECT branch_id, in_progress, data, data_encoding FROM history_tree WHERE shard_id = ? AND tree_id = ? `
 
+	rangeGetHistoryTreeQry = `SELECT shard_id, tree_id, branch_id, in_progress, data, data_encoding FROM history_tree ` +
+		`WHERE (shard_id, tree_id, branch_id) > (?, ?, ?) ORDER BY shard_id, tree_id, branch_id LIMIT ? `
+
 	deleteHistoryTreeQry = `DELETE FROM history_tree WHERE shard_id = ? AND tree_id = ? AND branch_id = ? `
 
 	updateHistoryTreeQry = `UPDATE history_tree set in_progress = :in_progress WHERE shard_id = :shard_id AND tree_id = :tree_id AND branch_id = :branch_id `
@@ -58,6 +64,13 @@ func (mdb *DB) InsertIntoHistoryNode(row *sqldb.HistoryNodeRow) (sql.Result, err
 	return mdb.conn.NamedExec(addHistoryNodesQry, row)
 }
 
+// UpdateHistoryNode overwrites the data of a row in history_node table
+func (mdb *DB) UpdateHistoryNode(row *sqldb.HistoryNodeRow) (sql.Result, error) {
+	// NOTE: txn_id is stored multiplied by -1, see InsertIntoHistoryNode
+	*row.TxnID *= -1
+	return mdb.conn.NamedExec(updateHistoryNodesQry, row)
+}
+
 // SelectFromHistoryNode reads one or more rows from history_node table
 func (mdb *DB) SelectFromHistoryNode(filter *sqldb.HistoryNodeFilter) ([]sqldb.HistoryNodeRow, error) {
 	var rows []sqldb.HistoryNodeRow
@@ -84,11 +97,20 @@ func (mdb *DB) InsertIntoHistoryTree(row *sqldb.HistoryTreeRow) (sql.Result, err
 
 // SelectFromHistoryTree reads one or more rows from history_tree table
 func (mdb *DB) SelectFromHistoryTree(filter *sqldb.HistoryTreeFilter) ([]sqldb.HistoryTreeRow, error) {
+	if filter.PageSize != nil {
+		return mdb.rangeSelectFromHistoryTree(filter)
+	}
 	var rows []sqldb.HistoryTreeRow
 	err := mdb.conn.Select(&rows, getHistoryTreeQry, filter.ShardID, filter.TreeID)
 	return rows, err
 }
 
+func (mdb *DB) rangeSelectFromHistoryTree(filter *sqldb.HistoryTreeFilter) ([]sqldb.HistoryTreeRow, error) {
+	var rows []sqldb.HistoryTreeRow
+	err := mdb.conn.Select(&rows, rangeGetHistoryTreeQry, filter.ShardID, filter.TreeID, *filter.BranchID, *filter.PageSize)
+	return rows, err
+}
+
 // UpdateHistoryTree updates a row in history_tree table
 func (mdb *DB) UpdateHistoryTree(row *sqldb.HistoryTreeRow) (sql.Result, error) {
 	return mdb.conn.NamedExec(updateHistoryTreeQry, row)
@@ -39,6 +39,9 @@ const (
 	getExecutionQry = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
 
+	rangeGetExecutionsQry = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = ? AND (domain_id, workflow_id, run_id) > (?, ?, ?) ORDER BY domain_id, workflow_id, run_id LIMIT ?`
+
 	deleteExecutionQry = `DELETE FROM executions 
  WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
 
@@ -58,6 +61,10 @@ const (
 shard_id, domain_id, workflow_id, run_id, create_request_id, state, close_status, start_version, last_write_version
 FROM current_executions WHERE shard_id = ? AND domain_id = ? AND workflow_id = ?`
 
+	rangeGetCurrentExecutionsQry = `SELECT
+shard_id, domain_id, workflow_id, run_id, create_request_id, state, close_status, start_version, last_write_version
+FROM current_executions WHERE shard_id = ? AND (domain_id, workflow_id) > (?, ?) ORDER BY domain_id, workflow_id LIMIT ?`
+
 	lockCurrentExecutionJoinExecutionsQry = `SELECT
 ce.shard_id, ce.domain_id, ce.workflow_id, ce.run_id, ce.create_request_id, ce.state, ce.close_status, ce.start_version, e.last_write_version
 FROM current_executions ce
@@ -140,6 +147,13 @@ func (mdb *DB) SelectFromExecutions(filter *sqldb.ExecutionsFilter) (*sqldb.Exec
 	return &row, err
 }
 
+// RangeSelectFromExecutions reads one or more rows from executions table
+func (mdb *DB) RangeSelectFromExecutions(filter *sqldb.ExecutionsFilter) ([]sqldb.ExecutionsRow, error) {
+	var rows []sqldb.ExecutionsRow
+	err := mdb.conn.Select(&rows, rangeGetExecutionsQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID, *filter.PageSize)
+	return rows, err
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (mdb *DB) DeleteFromExecutions(filter *sqldb.ExecutionsFilter) (sql.Result, error) {
 	return mdb.conn.Exec(deleteExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
@@ -176,6 +190,13 @@ func (mdb *DB) SelectFromCurrentExecutions(filter *sqldb.CurrentExecutionsFilter
 	return &row, err
 }
 
+// RangeSelectFromCurrentExecutions reads one or more rows from current_executions table
+func (mdb *DB) RangeSelectFromCurrentExecutions(filter *sqldb.CurrentExecutionsFilter) ([]sqldb.CurrentExecutionsRow, error) {
+	var rows []sqldb.CurrentExecutionsRow
+	err := mdb.conn.Select(&rows, rangeGetCurrentExecutionsQry, filter.ShardID, filter.DomainID, filter.WorkflowID, *filter.PageSize)
+	return rows, err
+}
+
 // DeleteFromCurrentExecutions deletes a single row in current_executions table
 func (mdb *DB) DeleteFromCurrentExecutions(filter *sqldb.CurrentExecutionsFilter) (sql.Result, error) {
 	return mdb.conn.Exec(deleteCurrentExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
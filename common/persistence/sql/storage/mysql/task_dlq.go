@@ -0,0 +1,79 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+const (
+	taskDLQColumns = `shard_id, category, task_id, task_type, domain_id, workflow_id, run_id, ` +
+		`visibility_timestamp, data, last_error, attempt, created_time`
+
+	replaceTaskDLQQry = `REPLACE INTO task_dlq (` + taskDLQColumns + `)
+ VALUES (:shard_id, :category, :task_id, :task_type, :domain_id, :workflow_id, :run_id, :visibility_timestamp, :data, :last_error, :attempt, :created_time)`
+
+	getTaskDLQQry = `SELECT ` + taskDLQColumns + ` FROM task_dlq WHERE shard_id = ? AND category = ? AND task_id = ?`
+
+	rangeGetTaskDLQQry = `SELECT ` + taskDLQColumns + ` FROM task_dlq
+ WHERE shard_id = ? AND category = ? AND task_id > ?
+ ORDER BY task_id
+ LIMIT ?`
+
+	deleteTaskDLQQry      = `DELETE FROM task_dlq WHERE shard_id = ? AND category = ? AND task_id = ?`
+	rangeDeleteTaskDLQQry = `DELETE FROM task_dlq WHERE shard_id = ? AND category = ?`
+)
+
+// ReplaceIntoTaskDLQ inserts or replaces a row in task_dlq table
+func (mdb *DB) ReplaceIntoTaskDLQ(row *sqldb.TaskDLQRow) (sql.Result, error) {
+	row.VisibilityTimestamp = mdb.converter.ToMySQLDateTime(row.VisibilityTimestamp)
+	row.CreatedTime = mdb.converter.ToMySQLDateTime(row.CreatedTime)
+	return mdb.conn.NamedExec(replaceTaskDLQQry, row)
+}
+
+// SelectFromTaskDLQ reads one or more rows from task_dlq table
+func (mdb *DB) SelectFromTaskDLQ(filter *sqldb.TaskDLQFilter) ([]sqldb.TaskDLQRow, error) {
+	var rows []sqldb.TaskDLQRow
+	var err error
+	if filter.TaskID != nil {
+		err = mdb.conn.Select(&rows, getTaskDLQQry, filter.ShardID, filter.Category, *filter.TaskID)
+	} else {
+		err = mdb.conn.Select(&rows, rangeGetTaskDLQQry, filter.ShardID, filter.Category, *filter.MinTaskID, *filter.PageSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].VisibilityTimestamp = mdb.converter.FromMySQLDateTime(rows[i].VisibilityTimestamp)
+		rows[i].CreatedTime = mdb.converter.FromMySQLDateTime(rows[i].CreatedTime)
+	}
+	return rows, nil
+}
+
+// DeleteFromTaskDLQ deletes one or more rows from task_dlq table
+func (mdb *DB) DeleteFromTaskDLQ(filter *sqldb.TaskDLQFilter) (sql.Result, error) {
+	if filter.TaskID != nil {
+		return mdb.conn.Exec(deleteTaskDLQQry, filter.ShardID, filter.Category, *filter.TaskID)
+	}
+	return mdb.conn.Exec(rangeDeleteTaskDLQQry, filter.ShardID, filter.Category)
+}
@@ -30,12 +30,15 @@ import (
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT IGNORE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpdateWorkflowExecution = `UPDATE executions_visibility SET memo = ?, encoding = ?, search_attributes = ? ` +
+		`WHERE domain_id = ? AND run_id = ?`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND domain_id = ?
@@ -45,7 +48,7 @@ const (
          ORDER BY start_time DESC, run_id
          LIMIT ?`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, search_attributes`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE close_status IS NULL `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
@@ -65,12 +68,17 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND close_status = ?` + templateConditions
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, close_status, history_length 
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, search_attributes, close_time, workflow_type_name, close_status, history_length
 		 FROM executions_visibility
 		 WHERE domain_id = ? AND close_status IS NOT NULL
 		 AND run_id = ?`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=? AND run_id=?"
+
+	templateQuerySelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
+		 FROM executions_visibility WHERE domain_id = ?`
+
+	templateQueryCount = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ?`
 )
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
@@ -87,7 +95,8 @@ func (mdb *DB) InsertIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, error
 		row.ExecutionTime,
 		row.WorkflowTypeName,
 		row.Memo,
-		row.Encoding)
+		row.Encoding,
+		row.SearchAttributes)
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -107,7 +116,8 @@ func (mdb *DB) ReplaceIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, erro
 			*row.CloseStatus,
 			*row.HistoryLength,
 			row.Memo,
-			row.Encoding)
+			row.Encoding,
+			row.SearchAttributes)
 	default:
 		return nil, errCloseParams
 	}
@@ -118,6 +128,16 @@ func (mdb *DB) DeleteFromVisibility(filter *sqldb.VisibilityFilter) (sql.Result,
 	return mdb.conn.Exec(templateDeleteWorkflowExecution, filter.DomainID, filter.RunID)
 }
 
+// UpdateVisibility updates the memo and search attributes of an existing row in visibility table
+func (mdb *DB) UpdateVisibility(row *sqldb.VisibilityRow) (sql.Result, error) {
+	return mdb.conn.Exec(templateUpdateWorkflowExecution,
+		row.Memo,
+		row.Encoding,
+		row.SearchAttributes,
+		row.DomainID,
+		row.RunID)
+}
+
 // SelectFromVisibility reads one or more rows from visibility table
 func (mdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.VisibilityRow, error) {
 	var err error
@@ -202,3 +222,45 @@ func (mdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.Vis
 	}
 	return rows, err
 }
+
+// SelectFromVisibilityByQuery reads one page of rows, that match the condition of the given filter, from visibility table
+func (mdb *DB) SelectFromVisibilityByQuery(filter *sqldb.VisibilityQueryFilter) ([]sqldb.VisibilityRow, error) {
+	qry, args := buildVisibilityQuery(templateQuerySelect, filter)
+	if len(filter.OrderBy) > 0 {
+		qry += ` ORDER BY ` + filter.OrderBy
+	}
+	qry += ` LIMIT ? OFFSET ?`
+	args = append(args, filter.PageSize, filter.Offset)
+
+	var rows []sqldb.VisibilityRow
+	if err := mdb.conn.Select(&rows, qry, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = mdb.converter.FromMySQLDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = mdb.converter.FromMySQLDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := mdb.converter.FromMySQLDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows, that match the condition of the given filter, in visibility table
+func (mdb *DB) CountFromVisibilityByQuery(filter *sqldb.VisibilityQueryFilter) (int64, error) {
+	qry, args := buildVisibilityQuery(templateQueryCount, filter)
+	var count int64
+	err := mdb.conn.Get(&count, qry, args...)
+	return count, err
+}
+
+func buildVisibilityQuery(template string, filter *sqldb.VisibilityQueryFilter) (string, []interface{}) {
+	args := make([]interface{}, 0, len(filter.Args)+3)
+	args = append(args, filter.DomainID)
+	if len(filter.Condition) == 0 {
+		return template, args
+	}
+	args = append(args, filter.Args...)
+	return template + ` AND (` + filter.Condition + `)`, args
+}
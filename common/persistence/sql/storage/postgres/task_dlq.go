@@ -0,0 +1,83 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+const (
+	taskDLQColumns = `shard_id, category, task_id, task_type, domain_id, workflow_id, run_id, ` +
+		`visibility_timestamp, data, last_error, attempt, created_time`
+
+	replaceTaskDLQQry = `INSERT INTO task_dlq (` + taskDLQColumns + `)
+ VALUES (:shard_id, :category, :task_id, :task_type, :domain_id, :workflow_id, :run_id, :visibility_timestamp, :data, :last_error, :attempt, :created_time)
+ ON CONFLICT (shard_id, category, task_id) DO UPDATE
+ SET task_type = excluded.task_type, domain_id = excluded.domain_id, workflow_id = excluded.workflow_id,
+ run_id = excluded.run_id, visibility_timestamp = excluded.visibility_timestamp, data = excluded.data,
+ last_error = excluded.last_error, attempt = excluded.attempt, created_time = excluded.created_time`
+
+	getTaskDLQQry = `SELECT ` + taskDLQColumns + ` FROM task_dlq WHERE shard_id = $1 AND category = $2 AND task_id = $3`
+
+	rangeGetTaskDLQQry = `SELECT ` + taskDLQColumns + ` FROM task_dlq
+ WHERE shard_id = $1 AND category = $2 AND task_id > $3
+ ORDER BY task_id
+ LIMIT $4`
+
+	deleteTaskDLQQry      = `DELETE FROM task_dlq WHERE shard_id = $1 AND category = $2 AND task_id = $3`
+	rangeDeleteTaskDLQQry = `DELETE FROM task_dlq WHERE shard_id = $1 AND category = $2`
+)
+
+// ReplaceIntoTaskDLQ inserts or replaces a row in task_dlq table
+func (pdb *DB) ReplaceIntoTaskDLQ(row *sqldb.TaskDLQRow) (sql.Result, error) {
+	row.VisibilityTimestamp = pdb.converter.ToPostgresDateTime(row.VisibilityTimestamp)
+	row.CreatedTime = pdb.converter.ToPostgresDateTime(row.CreatedTime)
+	return pdb.conn.NamedExec(replaceTaskDLQQry, row)
+}
+
+// SelectFromTaskDLQ reads one or more rows from task_dlq table
+func (pdb *DB) SelectFromTaskDLQ(filter *sqldb.TaskDLQFilter) ([]sqldb.TaskDLQRow, error) {
+	var rows []sqldb.TaskDLQRow
+	var err error
+	if filter.TaskID != nil {
+		err = pdb.conn.Select(&rows, getTaskDLQQry, filter.ShardID, filter.Category, *filter.TaskID)
+	} else {
+		err = pdb.conn.Select(&rows, rangeGetTaskDLQQry, filter.ShardID, filter.Category, *filter.MinTaskID, *filter.PageSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].VisibilityTimestamp = pdb.converter.FromPostgresDateTime(rows[i].VisibilityTimestamp)
+		rows[i].CreatedTime = pdb.converter.FromPostgresDateTime(rows[i].CreatedTime)
+	}
+	return rows, nil
+}
+
+// DeleteFromTaskDLQ deletes one or more rows from task_dlq table
+func (pdb *DB) DeleteFromTaskDLQ(filter *sqldb.TaskDLQFilter) (sql.Result, error) {
+	if filter.TaskID != nil {
+		return pdb.conn.Exec(deleteTaskDLQQry, filter.ShardID, filter.Category, *filter.TaskID)
+	}
+	return pdb.conn.Exec(rangeDeleteTaskDLQQry, filter.ShardID, filter.Category)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import "time"
+
+var (
+	minPostgresDateTime = getMinPostgresDateTime()
+)
+
+type (
+	// DataConverter defines the API for conversions to/from
+	// go types to postgres datatypes
+	DataConverter interface {
+		ToPostgresDateTime(t time.Time) time.Time
+		FromPostgresDateTime(t time.Time) time.Time
+	}
+	converter struct{}
+)
+
+// ToPostgresDateTime converts to time to postgres timestamp. Timestamp columns
+// do not store the zone offset, so all values are normalized to UTC
+func (c *converter) ToPostgresDateTime(t time.Time) time.Time {
+	if t.IsZero() {
+		return minPostgresDateTime
+	}
+	return t.UTC()
+}
+
+// FromPostgresDateTime converts postgres timestamp and returns go time
+func (c *converter) FromPostgresDateTime(t time.Time) time.Time {
+	if t.Equal(minPostgresDateTime) {
+		return time.Time{}
+	}
+	return t.UTC()
+}
+
+func getMinPostgresDateTime() time.Time {
+	t, err := time.Parse(time.RFC3339, "1000-01-01T00:00:00Z")
+	if err != nil {
+		return time.Unix(0, 0)
+	}
+	return t
+}
@@ -102,6 +102,7 @@ type (
 		DomainID   UUID
 		WorkflowID string
 		RunID      UUID
+		PageSize   *int
 	}
 
 	// CurrentExecutionsRow represents a row in current_executions table
@@ -124,6 +125,7 @@ type (
 		DomainID   UUID
 		WorkflowID string
 		RunID      UUID
+		PageSize   *int
 	}
 
 	// BufferedEventsRow represents a row in buffered_events table
@@ -297,6 +299,7 @@ type (
 		ShardID  int
 		TreeID   UUID
 		BranchID *UUID
+		PageSize *int
 	}
 
 	// ActivityInfoMapsRow represents a row in activity_info_maps table
@@ -425,6 +428,58 @@ type (
 		SignalID   *string
 	}
 
+	// AuditLogRow represents a row in audit_log table
+	AuditLogRow struct {
+		DomainName     string
+		CreatedTime    time.Time
+		RecordID       string
+		Operation      string
+		WorkflowID     string
+		RunID          string
+		Identity       string
+		Reason         string
+		RequestSummary string
+		Result         string
+	}
+
+	// AuditLogFilter contains the column names within audit_log table that
+	// can be used to filter results through a WHERE clause. RecordID is the
+	// id of the last row of the previous page, rows having MaxCreatedTime
+	// are only returned when their id is greater than RecordID
+	AuditLogFilter struct {
+		DomainName     string
+		MinCreatedTime time.Time
+		MaxCreatedTime time.Time
+		RecordID       string
+		PageSize       int
+	}
+
+	// TaskDLQRow represents a row in task_dlq table
+	TaskDLQRow struct {
+		ShardID             int
+		Category            int
+		TaskID              int64
+		TaskType            int
+		DomainID            string
+		WorkflowID          string
+		RunID               string
+		VisibilityTimestamp time.Time
+		Data                []byte
+		LastError           string
+		Attempt             int
+		CreatedTime         time.Time
+	}
+
+	// TaskDLQFilter contains the column names within task_dlq table that
+	// can be used to filter results through a WHERE clause
+	TaskDLQFilter struct {
+		ShardID   int
+		Category  int
+		TaskID    *int64
+		MinTaskID *int64
+		PageSize  *int
+	}
+
 	// VisibilityRow represents a row in executions_visibility table
 	VisibilityRow struct {
 		DomainID         string
@@ -438,6 +493,7 @@ type (
 		HistoryLength    *int64
 		Memo             []byte
 		Encoding         string
+		SearchAttributes []byte
 	}
 
 	// VisibilityFilter contains the column names within domain table that
@@ -454,6 +510,20 @@ type (
 		PageSize         *int
 	}
 
+	// VisibilityQueryFilter contains a parameterized condition and ordering, built from
+	// a visibility list query, that is used to read rows from visibility table
+	VisibilityQueryFilter struct {
+		DomainID string
+		// Condition is an optional boolean expression over the columns of visibility
+		// table which uses ? as the placeholder for each of the Args
+		Condition string
+		Args      []interface{}
+		// OrderBy is an optional list of columns or expressions to sort the results by
+		OrderBy  string
+		PageSize int
+		Offset   int
+	}
+
 	// tableCRUD defines the API for interacting with the database tables
 	tableCRUD interface {
 		InsertIntoDomain(rows *DomainRow) (sql.Result, error)
@@ -508,9 +578,17 @@ type (
 
 		// eventsV2
 		InsertIntoHistoryNode(row *HistoryNodeRow) (sql.Result, error)
+		// UpdateHistoryNode overwrites the data of a row in history_node table
+		// Required params - {shardID, treeID, branchID, nodeID, txnID}
+		UpdateHistoryNode(row *HistoryNodeRow) (sql.Result, error)
 		SelectFromHistoryNode(filter *HistoryNodeFilter) ([]HistoryNodeRow, error)
 		DeleteFromHistoryNode(filter *HistoryNodeFilter) (sql.Result, error)
 		InsertIntoHistoryTree(row *HistoryTreeRow) (sql.Result, error)
+		// SelectFromHistoryTree returns one or more rows from history_tree table
+		// Required filter params:
+		//  to read the branches of a tree: {shardID, treeID}
+		//  to range read the branches of all trees: {shardID, treeID, branchID, pageSize}, which returns
+		//  the rows ordered by {shardID, treeID, branchID} and after the given ones
 		SelectFromHistoryTree(filter *HistoryTreeFilter) ([]HistoryTreeRow, error)
 		UpdateHistoryTree(row *HistoryTreeRow) (sql.Result, error)
 		DeleteFromHistoryTree(filter *HistoryTreeFilter) (sql.Result, error)
@@ -518,6 +596,10 @@ type (
 		InsertIntoExecutions(row *ExecutionsRow) (sql.Result, error)
 		UpdateExecutions(row *ExecutionsRow) (sql.Result, error)
 		SelectFromExecutions(filter *ExecutionsFilter) (*ExecutionsRow, error)
+		// RangeSelectFromExecutions returns the rows of a shard from executions table, ordered by
+		// {domainID, workflowID, runID} and after the given ones
+		// Required filter params - {shardID, domainID, workflowID, runID, pageSize}
+		RangeSelectFromExecutions(filter *ExecutionsFilter) ([]ExecutionsRow, error)
 		DeleteFromExecutions(filter *ExecutionsFilter) (sql.Result, error)
 		ReadLockExecutions(filter *ExecutionsFilter) (int, error)
 		WriteLockExecutions(filter *ExecutionsFilter) (int, error)
@@ -529,6 +611,10 @@ type (
 		// SelectFromCurrentExecutions returns one or more rows from current_executions table
 		// Required params - {shardID, domainID, workflowID}
 		SelectFromCurrentExecutions(filter *CurrentExecutionsFilter) (*CurrentExecutionsRow, error)
+		// RangeSelectFromCurrentExecutions returns the rows of a shard from current_executions table, ordered by
+		// {domainID, workflowID} and after the given ones
+		// Required filter params - {shardID, domainID, workflowID, pageSize}
+		RangeSelectFromCurrentExecutions(filter *CurrentExecutionsFilter) ([]CurrentExecutionsRow, error)
 		// DeleteFromCurrentExecutions deletes a single row that matches the filter criteria
 		// If a row exist, that row will be deleted and this method will return success
 		// If there is no row matching the filter criteria, this method will still return success
@@ -643,11 +729,45 @@ type (
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
 		SelectFromVisibility(filter *VisibilityFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(filter *VisibilityFilter) (sql.Result, error)
+		// UpdateVisibility updates the memo and search attributes of an existing row in visibility table
+		UpdateVisibility(row *VisibilityRow) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns one page of rows from visibility table that match
+		// the condition within the filter
+		// Required filter params - {domainID, pageSize}
+		SelectFromVisibilityByQuery(filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns the number of rows in visibility table that match
+		// the condition within the filter
+		// Required filter params - {domainID}
+		CountFromVisibilityByQuery(filter *VisibilityQueryFilter) (int64, error)
+
+		InsertIntoAuditLog(row *AuditLogRow) (sql.Result, error)
+		// SelectFromAuditLog returns one page of rows from audit_log table, newest first
+		// Required filter params - {domainName, minCreatedTime, maxCreatedTime, recordID, pageSize}
+		SelectFromAuditLog(filter *AuditLogFilter) ([]AuditLogRow, error)
+
+		ReplaceIntoTaskDLQ(row *TaskDLQRow) (sql.Result, error)
+		// SelectFromTaskDLQ returns one or more rows from task_dlq table
+		// Required filter params:
+		//  to read a single row: {shardID, category, taskID}
+		//  to range read multiple rows: {shardID, category, minTaskID, pageSize}
+		SelectFromTaskDLQ(filter *TaskDLQFilter) ([]TaskDLQRow, error)
+		// DeleteFromTaskDLQ deletes one or more rows from task_dlq table
+		// Required filter params
+		// - single row delete - {shardID, category, taskID}
+		// - range delete - {shardID, category}
+		DeleteFromTaskDLQ(filter *TaskDLQFilter) (sql.Result, error)
+	}
+
+	// ErrorChecker checks for common sql errors
+	ErrorChecker interface {
+		// IsDupEntryError returns true if the error is due to a duplicate primary key
+		IsDupEntryError(err error) bool
 	}
 
 	// Tx defines the API for a SQL transaction
 	Tx interface {
 		tableCRUD
+		ErrorChecker
 		Commit() error
 		Rollback() error
 	}
@@ -655,6 +775,7 @@ type (
 	// Interface defines the API for a SQL database
 	Interface interface {
 		tableCRUD
+		ErrorChecker
 		BeginTx() (Tx, error)
 		DriverName() string
 		Close() error
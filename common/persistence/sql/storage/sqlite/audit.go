@@ -0,0 +1,79 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+const (
+	createAuditRecordQry = `INSERT INTO audit_log (` +
+		`domain_name, created_time, record_id, operation, workflow_id, run_id, identity, reason, request_summary, result) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	// RecordID condition is needed for correct pagination
+	getAuditRecordsQry = `SELECT domain_name, created_time, record_id, operation, workflow_id, run_id, identity, reason, request_summary, result
+		 FROM audit_log
+		 WHERE domain_name = ?
+		 AND created_time >= ?
+		 AND created_time <= ?
+		 AND (record_id > ? OR created_time < ?)
+		 ORDER BY created_time DESC, record_id
+		 LIMIT ?`
+)
+
+// InsertIntoAuditLog inserts a row into audit_log table
+func (sdb *DB) InsertIntoAuditLog(row *sqldb.AuditLogRow) (sql.Result, error) {
+	return sdb.conn.Exec(createAuditRecordQry,
+		row.DomainName,
+		sdb.converter.ToSQLiteDateTime(row.CreatedTime),
+		row.RecordID,
+		row.Operation,
+		row.WorkflowID,
+		row.RunID,
+		row.Identity,
+		row.Reason,
+		row.RequestSummary,
+		row.Result)
+}
+
+// SelectFromAuditLog reads one page of rows from audit_log table
+func (sdb *DB) SelectFromAuditLog(filter *sqldb.AuditLogFilter) ([]sqldb.AuditLogRow, error) {
+	var rows []sqldb.AuditLogRow
+	maxCreatedTime := sdb.converter.ToSQLiteDateTime(filter.MaxCreatedTime)
+	err := sdb.conn.Select(&rows,
+		getAuditRecordsQry,
+		filter.DomainName,
+		sdb.converter.ToSQLiteDateTime(filter.MinCreatedTime),
+		maxCreatedTime,
+		filter.RecordID,
+		maxCreatedTime,
+		filter.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].CreatedTime = sdb.converter.FromSQLiteDateTime(rows[i].CreatedTime)
+	}
+	return rows, nil
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"sync"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+	"github.com/mattn/go-sqlite3"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+const (
+	// DriverName is the name of the sqlite sql driver
+	DriverName = "sqlite3"
+)
+
+type (
+	// DB represents a logical connection to sqlite database
+	DB struct {
+		db        *sqlx.DB
+		tx        *sqlx.Tx
+		conn      sqldb.Conn
+		converter DataConverter
+		dsn       string
+		closed    bool
+	}
+
+	// sharedConn is a connection to a sqlite database
+	// that is shared by all the DBs opened for its dsn
+	sharedConn struct {
+		db       *sqlx.DB
+		refCount int
+	}
+)
+
+var _ sqldb.Tx = (*DB)(nil)
+var _ sqldb.Interface = (*DB)(nil)
+
+var (
+	sharedConnsLock sync.Mutex
+	sharedConns     = make(map[string]*sharedConn)
+)
+
+// Open returns an instance of DB, which is a logical connection to the sqlite
+// database identified by the given dsn. An in-memory database only lives as long
+// as the connection that created it, and sqlite serializes writers anyway, so all
+// DBs opened for the same dsn within this process share a single connection. The
+// connection is closed after every DB opened for the dsn has been closed. The
+// cadence and visibility schemas are created when the connection is established
+func Open(dsn string) (*DB, error) {
+	sharedConnsLock.Lock()
+	defer sharedConnsLock.Unlock()
+
+	sc, ok := sharedConns[dsn]
+	if !ok {
+		xdb, err := sqlx.Connect(DriverName, dsn)
+		if err != nil {
+			return nil, err
+		}
+		xdb.SetMaxOpenConns(1)
+		xdb.SetMaxIdleConns(1)
+		// Maps struct names in CamelCase to snake without need for db struct tags.
+		xdb.MapperFunc(strcase.ToSnake)
+		if err := createSchema(xdb); err != nil {
+			xdb.Close()
+			return nil, err
+		}
+		sc = &sharedConn{db: xdb}
+		sharedConns[dsn] = sc
+	}
+	sc.refCount++
+	return newDB(sc.db, nil, dsn), nil
+}
+
+func newDB(xdb *sqlx.DB, tx *sqlx.Tx, dsn string) *DB {
+	sdb := &DB{db: xdb, tx: tx, dsn: dsn}
+	sdb.conn = xdb
+	if tx != nil {
+		sdb.conn = tx
+	}
+	sdb.converter = &converter{}
+	return sdb
+}
+
+// IsDupEntryError returns true if the error is due to a duplicate primary key
+func (sdb *DB) IsDupEntryError(err error) bool {
+	sqlErr, ok := err.(sqlite3.Error)
+	return ok && (sqlErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey ||
+		sqlErr.ExtendedCode == sqlite3.ErrConstraintUnique)
+}
+
+// BeginTx starts a new transaction and returns a reference to the Tx object
+func (sdb *DB) BeginTx() (sqldb.Tx, error) {
+	xtx, err := sdb.db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	return newDB(sdb.db, xtx, sdb.dsn), nil
+}
+
+// Commit commits a previously started transaction
+func (sdb *DB) Commit() error {
+	return sdb.tx.Commit()
+}
+
+// Rollback triggers rollback of a previously started transaction
+func (sdb *DB) Rollback() error {
+	return sdb.tx.Rollback()
+}
+
+// Close releases this DB's reference to the shared connection, and closes
+// the connection if no other DB is using it
+func (sdb *DB) Close() error {
+	sharedConnsLock.Lock()
+	defer sharedConnsLock.Unlock()
+
+	if sdb.closed {
+		return nil
+	}
+	sdb.closed = true
+	sc, ok := sharedConns[sdb.dsn]
+	if !ok || sc.db != sdb.db {
+		return nil
+	}
+	sc.refCount--
+	if sc.refCount > 0 {
+		return nil
+	}
+	delete(sharedConns, sdb.dsn)
+	return sc.db.Close()
+}
+
+// DriverName returns the name of the sqlite driver
+func (sdb *DB) DriverName() string {
+	return sdb.db.DriverName()
+}
@@ -0,0 +1,353 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// sqlite databases are local to the process, so there is no separate
+// schema setup step like there is for the other sql plugins. Instead, the
+// tables are created, if they don't already exist, when a database is opened.
+// The tables mirror schema/mysql/v57/cadence and schema/mysql/v57/visibility
+const (
+	cadenceSchema = `
+CREATE TABLE IF NOT EXISTS domains(
+  shard_id INTEGER NOT NULL DEFAULT 54321,
+  id BLOB NOT NULL,
+  name VARCHAR(255) UNIQUE NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  is_global BOOLEAN NOT NULL,
+  PRIMARY KEY(shard_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS domain_metadata (
+  notification_version BIGINT NOT NULL
+);
+
+INSERT INTO domain_metadata (notification_version)
+  SELECT 1 WHERE NOT EXISTS (SELECT 1 FROM domain_metadata);
+
+CREATE TABLE IF NOT EXISTS shards (
+  shard_id INTEGER NOT NULL,
+  --
+  range_id BIGINT NOT NULL,
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id)
+);
+
+CREATE TABLE IF NOT EXISTS transfer_tasks(
+  shard_id INTEGER NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, task_id)
+);
+
+CREATE TABLE IF NOT EXISTS executions(
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BLOB NOT NULL,
+  --
+  next_event_id BIGINT NOT NULL,
+  last_write_version BIGINT NOT NULL,
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
+);
+
+CREATE TABLE IF NOT EXISTS current_executions(
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  --
+  run_id BLOB NOT NULL,
+  create_request_id VARCHAR(64) NOT NULL,
+  state INTEGER NOT NULL,
+  close_status INTEGER NOT NULL,
+  start_version BIGINT NOT NULL,
+  last_write_version BIGINT NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id)
+);
+
+CREATE TABLE IF NOT EXISTS buffered_events (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BLOB NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS buffered_events_by_events_ids ON buffered_events(shard_id, domain_id, workflow_id, run_id);
+
+CREATE TABLE IF NOT EXISTS tasks (
+  domain_id BLOB NOT NULL,
+  task_list_name VARCHAR(255) NOT NULL,
+  task_type SMALLINT NOT NULL, -- {Activity, Decision}
+  task_id BIGINT NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (domain_id, task_list_name, task_type, task_id)
+);
+
+CREATE TABLE IF NOT EXISTS task_lists (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  task_type SMALLINT NOT NULL, -- {Activity, Decision}
+  --
+  range_id BIGINT NOT NULL,
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, name, task_type)
+);
+
+CREATE TABLE IF NOT EXISTS replication_tasks (
+  shard_id INTEGER NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, task_id)
+);
+
+CREATE TABLE IF NOT EXISTS timer_tasks (
+  shard_id INTEGER NOT NULL,
+  visibility_timestamp DATETIME NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, visibility_timestamp, task_id)
+);
+
+-- Deprecated in favor of history eventsV2
+CREATE TABLE IF NOT EXISTS events (
+  domain_id      BLOB NOT NULL,
+  workflow_id    VARCHAR(255) NOT NULL,
+  run_id         BLOB NOT NULL,
+  first_event_id BIGINT NOT NULL,
+  --
+  batch_version  BIGINT,
+  range_id       BIGINT NOT NULL,
+  tx_id          BIGINT NOT NULL,
+  data           BLOB NOT NULL,
+  data_encoding  VARCHAR(16) NOT NULL,
+  PRIMARY KEY (domain_id, workflow_id, run_id, first_event_id)
+);
+
+CREATE TABLE IF NOT EXISTS activity_info_maps (
+-- each row corresponds to one key of one map<string, ActivityInfo>
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BLOB NOT NULL,
+  schedule_id BIGINT NOT NULL,
+--
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16),
+  last_heartbeat_details BLOB,
+  last_heartbeat_updated_time DATETIME NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, schedule_id)
+);
+
+CREATE TABLE IF NOT EXISTS timer_info_maps (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BLOB NOT NULL,
+  timer_id VARCHAR(255) NOT NULL,
+--
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16),
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, timer_id)
+);
+
+CREATE TABLE IF NOT EXISTS child_execution_info_maps (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BLOB NOT NULL,
+  initiated_id BIGINT NOT NULL,
+--
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16),
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, initiated_id)
+);
+
+CREATE TABLE IF NOT EXISTS request_cancel_info_maps (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BLOB NOT NULL,
+  initiated_id BIGINT NOT NULL,
+--
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16),
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, initiated_id)
+);
+
+CREATE TABLE IF NOT EXISTS signal_info_maps (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BLOB NOT NULL,
+  initiated_id BIGINT NOT NULL,
+--
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16),
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, initiated_id)
+);
+
+CREATE TABLE IF NOT EXISTS buffered_replication_task_maps (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BLOB NOT NULL,
+  first_event_id BIGINT NOT NULL,
+--
+  version BIGINT NOT NULL,
+  next_event_id BIGINT NOT NULL,
+  history BLOB,
+  history_encoding VARCHAR(16) NOT NULL,
+  new_run_history BLOB,
+  new_run_history_encoding VARCHAR(16) NOT NULL DEFAULT 'json',
+  event_store_version          INTEGER NOT NULL, -- indiciates which version of event store to query
+  new_run_event_store_version  INTEGER NOT NULL, -- indiciates which version of event store to query for new run(continueAsNew)
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, first_event_id)
+);
+
+CREATE TABLE IF NOT EXISTS signals_requested_sets (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BLOB NOT NULL,
+  signal_id VARCHAR(64) NOT NULL,
+  --
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, signal_id)
+);
+
+-- history eventsV2: history_node stores history event data
+CREATE TABLE IF NOT EXISTS history_node (
+  shard_id       INTEGER NOT NULL,
+  tree_id        BLOB NOT NULL,
+  branch_id      BLOB NOT NULL,
+  node_id        BIGINT NOT NULL,
+  txn_id         BIGINT NOT NULL,
+  --
+  data           BLOB NOT NULL,
+  data_encoding  VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, tree_id, branch_id, node_id, txn_id)
+);
+
+-- history eventsV2: history_tree stores branch metadata
+CREATE TABLE IF NOT EXISTS history_tree (
+  shard_id       INTEGER NOT NULL,
+  tree_id        BLOB NOT NULL,
+  branch_id      BLOB NOT NULL,
+  --
+  in_progress    BOOLEAN NOT NULL,
+  data           BLOB NOT NULL,
+  data_encoding  VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, tree_id, branch_id)
+);
+
+-- audit_log stores the mutating control plane operations issued against the cluster
+CREATE TABLE IF NOT EXISTS audit_log (
+  domain_name      VARCHAR(255) NOT NULL,
+  created_time     DATETIME NOT NULL,
+  record_id        VARCHAR(64) NOT NULL,
+  --
+  operation        VARCHAR(255) NOT NULL,
+  workflow_id      VARCHAR(255) NOT NULL,
+  run_id           VARCHAR(64) NOT NULL,
+  identity         VARCHAR(255) NOT NULL,
+  reason           TEXT NOT NULL,
+  request_summary  TEXT NOT NULL,
+  result           TEXT NOT NULL,
+  PRIMARY KEY (domain_name, created_time, record_id)
+);
+
+-- task_dlq stores the transfer and timer tasks of a shard that kept failing and were moved out of their queue
+CREATE TABLE IF NOT EXISTS task_dlq (
+  shard_id              INTEGER NOT NULL,
+  category              INTEGER NOT NULL,
+  task_id               BIGINT NOT NULL,
+  --
+  task_type             INTEGER NOT NULL,
+  domain_id             VARCHAR(64) NOT NULL,
+  workflow_id           VARCHAR(255) NOT NULL,
+  run_id                VARCHAR(64) NOT NULL,
+  visibility_timestamp  DATETIME NOT NULL,
+  data                  BLOB NOT NULL,
+  last_error            TEXT NOT NULL,
+  attempt               INTEGER NOT NULL,
+  created_time          DATETIME NOT NULL,
+  PRIMARY KEY (shard_id, category, task_id)
+);
+`
+
+	visibilitySchema = `
+CREATE TABLE IF NOT EXISTS executions_visibility (
+  domain_id            CHAR(64) NOT NULL,
+  run_id               CHAR(64) NOT NULL,
+  start_time           DATETIME NOT NULL,
+  execution_time       DATETIME NOT NULL,
+  workflow_id          VARCHAR(255) NOT NULL,
+  workflow_type_name   VARCHAR(255) NOT NULL,
+  close_status         INTEGER,  -- enum WorkflowExecutionCloseStatus {COMPLETED, FAILED, CANCELED, TERMINATED, CONTINUED_AS_NEW, TIMED_OUT}
+  close_time           DATETIME NULL,
+  history_length       BIGINT,
+  memo                 BLOB,
+  encoding             VARCHAR(64) NOT NULL,
+  search_attributes    BLOB,
+
+  PRIMARY KEY  (domain_id, run_id)
+);
+
+CREATE INDEX IF NOT EXISTS by_type_start_time ON executions_visibility (domain_id, workflow_type_name, close_status, start_time DESC, run_id);
+CREATE INDEX IF NOT EXISTS by_workflow_id_start_time ON executions_visibility (domain_id, workflow_id, close_status, start_time DESC, run_id);
+CREATE INDEX IF NOT EXISTS by_status_by_close_time ON executions_visibility (domain_id, close_status, start_time DESC, run_id);
+`
+)
+
+// createSchema creates the cadence and visibility tables on the given
+// database. Tables that already exist are left untouched
+func createSchema(db *sqlx.DB) error {
+	if _, err := db.Exec(cadenceSchema); err != nil {
+		return fmt.Errorf("error creating cadence schema: %v", err)
+	}
+	if _, err := db.Exec(visibilitySchema); err != nil {
+		return fmt.Errorf("error creating visibility schema: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+const (
+	templateCreateWorkflowExecutionStarted = `INSERT OR IGNORE INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpdateWorkflowExecution = `UPDATE executions_visibility SET memo = ?, encoding = ?, search_attributes = ? ` +
+		`WHERE domain_id = ? AND run_id = ?`
+
+	// RunID condition is needed for correct pagination
+	templateConditions = ` AND domain_id = ?
+		 AND start_time >= ?
+		 AND start_time <= ?
+ 		 AND (run_id > ? OR start_time < ?)
+         ORDER BY start_time DESC, run_id
+         LIMIT ?`
+
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, search_attributes`
+	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE close_status IS NULL `
+
+	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
+		 FROM executions_visibility WHERE close_status IS NOT NULL `
+
+	templateGetOpenWorkflowExecutions = templateOpenSelect + templateConditions
+
+	templateGetClosedWorkflowExecutions = templateClosedSelect + templateConditions
+
+	templateGetOpenWorkflowExecutionsByType = templateOpenSelect + `AND workflow_type_name = ?` + templateConditions
+
+	templateGetClosedWorkflowExecutionsByType = templateClosedSelect + `AND workflow_type_name = ?` + templateConditions
+
+	templateGetOpenWorkflowExecutionsByID = templateOpenSelect + `AND workflow_id = ?` + templateConditions
+
+	templateGetClosedWorkflowExecutionsByID = templateClosedSelect + `AND workflow_id = ?` + templateConditions
+
+	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND close_status = ?` + templateConditions
+
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, search_attributes, close_time, workflow_type_name, close_status, history_length
+		 FROM executions_visibility
+		 WHERE domain_id = ? AND close_status IS NOT NULL
+		 AND run_id = ?`
+
+	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=? AND run_id=?"
+
+	templateQuerySelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
+		 FROM executions_visibility WHERE domain_id = ?`
+
+	templateQueryCount = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ?`
+)
+
+var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
+
+// InsertIntoVisibility inserts a row into visibility table. If an row already exist,
+// its left as such and no update will be made
+func (sdb *DB) InsertIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, error) {
+	row.StartTime = sdb.converter.ToSQLiteDateTime(row.StartTime)
+	row.ExecutionTime = sdb.converter.ToSQLiteDateTime(row.ExecutionTime)
+	return sdb.conn.Exec(templateCreateWorkflowExecutionStarted,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.SearchAttributes)
+}
+
+// ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
+func (sdb *DB) ReplaceIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, error) {
+	switch {
+	case row.CloseStatus != nil && row.CloseTime != nil && row.HistoryLength != nil:
+		row.StartTime = sdb.converter.ToSQLiteDateTime(row.StartTime)
+		row.ExecutionTime = sdb.converter.ToSQLiteDateTime(row.ExecutionTime)
+		closeTime := sdb.converter.ToSQLiteDateTime(*row.CloseTime)
+		return sdb.conn.Exec(templateCreateWorkflowExecutionClosed,
+			row.DomainID,
+			row.WorkflowID,
+			row.RunID,
+			row.StartTime,
+			row.ExecutionTime,
+			row.WorkflowTypeName,
+			closeTime,
+			*row.CloseStatus,
+			*row.HistoryLength,
+			row.Memo,
+			row.Encoding,
+			row.SearchAttributes)
+	default:
+		return nil, errCloseParams
+	}
+}
+
+// DeleteFromVisibility deletes a row from visibility table if it exist
+func (sdb *DB) DeleteFromVisibility(filter *sqldb.VisibilityFilter) (sql.Result, error) {
+	return sdb.conn.Exec(templateDeleteWorkflowExecution, filter.DomainID, filter.RunID)
+}
+
+// UpdateVisibility updates the memo and search attributes of an existing row in visibility table
+func (sdb *DB) UpdateVisibility(row *sqldb.VisibilityRow) (sql.Result, error) {
+	return sdb.conn.Exec(templateUpdateWorkflowExecution,
+		row.Memo,
+		row.Encoding,
+		row.SearchAttributes,
+		row.DomainID,
+		row.RunID)
+}
+
+// SelectFromVisibility reads one or more rows from visibility table
+func (sdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.VisibilityRow, error) {
+	var err error
+	var rows []sqldb.VisibilityRow
+	if filter.MinStartTime != nil {
+		*filter.MinStartTime = sdb.converter.ToSQLiteDateTime(*filter.MinStartTime)
+	}
+	if filter.MaxStartTime != nil {
+		*filter.MaxStartTime = sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime)
+	}
+	switch {
+	case filter.MinStartTime == nil && filter.RunID != nil && filter.Closed:
+		var row sqldb.VisibilityRow
+		err = sdb.conn.Get(&row, templateGetClosedWorkflowExecution, filter.DomainID, *filter.RunID)
+		if err == nil {
+			rows = append(rows, row)
+		}
+	case filter.MinStartTime != nil && filter.WorkflowID != nil:
+		qry := templateGetOpenWorkflowExecutionsByID
+		if filter.Closed {
+			qry = templateGetClosedWorkflowExecutionsByID
+		}
+		err = sdb.conn.Select(&rows,
+			qry,
+			*filter.WorkflowID,
+			filter.DomainID,
+			sdb.converter.ToSQLiteDateTime(*filter.MinStartTime),
+			sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime),
+			*filter.RunID,
+			*filter.MinStartTime,
+			*filter.PageSize)
+	case filter.MinStartTime != nil && filter.WorkflowTypeName != nil:
+		qry := templateGetOpenWorkflowExecutionsByType
+		if filter.Closed {
+			qry = templateGetClosedWorkflowExecutionsByType
+		}
+		err = sdb.conn.Select(&rows,
+			qry,
+			*filter.WorkflowTypeName,
+			filter.DomainID,
+			sdb.converter.ToSQLiteDateTime(*filter.MinStartTime),
+			sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime),
+			*filter.RunID,
+			*filter.MaxStartTime,
+			*filter.PageSize)
+	case filter.MinStartTime != nil && filter.CloseStatus != nil:
+		err = sdb.conn.Select(&rows,
+			templateGetClosedWorkflowExecutionsByStatus,
+			*filter.CloseStatus,
+			filter.DomainID,
+			sdb.converter.ToSQLiteDateTime(*filter.MinStartTime),
+			sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime),
+			*filter.RunID,
+			sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime),
+			*filter.PageSize)
+	case filter.MinStartTime != nil:
+		qry := templateGetOpenWorkflowExecutions
+		if filter.Closed {
+			qry = templateGetClosedWorkflowExecutions
+		}
+		err = sdb.conn.Select(&rows,
+			qry,
+			filter.DomainID,
+			sdb.converter.ToSQLiteDateTime(*filter.MinStartTime),
+			sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime),
+			*filter.RunID,
+			sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime),
+			*filter.PageSize)
+	default:
+		return nil, fmt.Errorf("invalid query filter")
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = sdb.converter.FromSQLiteDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = sdb.converter.FromSQLiteDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := sdb.converter.FromSQLiteDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, err
+}
+
+// SelectFromVisibilityByQuery reads one page of rows, that match the condition of the given filter, from visibility table
+func (sdb *DB) SelectFromVisibilityByQuery(filter *sqldb.VisibilityQueryFilter) ([]sqldb.VisibilityRow, error) {
+	qry, args := buildVisibilityQuery(templateQuerySelect, filter)
+	if len(filter.OrderBy) > 0 {
+		qry += ` ORDER BY ` + filter.OrderBy
+	}
+	qry += ` LIMIT ? OFFSET ?`
+	args = append(args, filter.PageSize, filter.Offset)
+
+	var rows []sqldb.VisibilityRow
+	if err := sdb.conn.Select(&rows, qry, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = sdb.converter.FromSQLiteDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = sdb.converter.FromSQLiteDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := sdb.converter.FromSQLiteDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows, that match the condition of the given filter, in visibility table
+func (sdb *DB) CountFromVisibilityByQuery(filter *sqldb.VisibilityQueryFilter) (int64, error) {
+	qry, args := buildVisibilityQuery(templateQueryCount, filter)
+	var count int64
+	err := sdb.conn.Get(&count, qry, args...)
+	return count, err
+}
+
+func buildVisibilityQuery(template string, filter *sqldb.VisibilityQueryFilter) (string, []interface{}) {
+	args := make([]interface{}, 0, len(filter.Args)+3)
+	args = append(args, filter.DomainID)
+	if len(filter.Condition) == 0 {
+		return template, args
+	}
+	args = append(args, filter.Args...)
+	return template + ` AND (` + filter.Condition + `)`, args
+}
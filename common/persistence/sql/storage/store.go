@@ -29,7 +29,9 @@ import (
 	"github.com/iancoleman/strcase"
 	"github.com/jmoiron/sqlx"
 	"github.com/uber/cadence/common/persistence/sql/storage/mysql"
+	"github.com/uber/cadence/common/persistence/sql/storage/postgres"
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqlite"
 	"github.com/uber/cadence/common/service/config"
 )
 
@@ -38,6 +40,14 @@ const (
 	isolationLevelAttrName       = "transaction_isolation"
 	isolationLevelAttrNameLegacy = "tx_isolation"
 	defaultIsolationLevel        = "'READ-COMMITTED'"
+
+	postgresSchemeName     = "postgres"
+	postgresSSLModeAttr    = "sslmode"
+	defaultPostgresSSLMode = "disable"
+
+	sqliteInMemoryDatabase   = ":memory:"
+	sqliteBusyTimeoutAttr    = "_busy_timeout"
+	defaultSQLiteBusyTimeout = "10000"
 )
 
 var dsnAttrOverrides = map[string]string{
@@ -51,7 +61,20 @@ var dsnAttrOverrides = map[string]string{
 // SQL database and the object can be used to perform CRUD operations on
 // the tables in the database
 func NewSQLDB(cfg *config.SQL) (sqldb.Interface, error) {
-	db, err := sqlx.Connect(cfg.DriverName, buildDSN(cfg))
+	var dsn string
+	switch cfg.DriverName {
+	case mysql.DriverName:
+		dsn = buildDSN(cfg)
+	case postgres.DriverName:
+		dsn = buildPostgresDSN(cfg)
+	case sqlite.DriverName:
+		// sqlite manages its own connection, since it
+		// must be shared by all stores using the same database
+		return sqlite.Open(buildSQLiteDSN(cfg))
+	default:
+		return nil, fmt.Errorf("unsupported sql driver: %v", cfg.DriverName)
+	}
+	db, err := sqlx.Connect(cfg.DriverName, dsn)
 	if err != nil {
 		return nil, err
 	}
@@ -66,9 +89,50 @@ func NewSQLDB(cfg *config.SQL) (sqldb.Interface, error) {
 	}
 	// Maps struct names in CamelCase to snake without need for db struct tags.
 	db.MapperFunc(strcase.ToSnake)
+	if cfg.DriverName == postgres.DriverName {
+		return postgres.NewDB(db, nil), nil
+	}
 	return mysql.NewDB(db, nil), nil
 }
 
+func buildPostgresDSN(cfg *config.SQL) string {
+	attrs := url.Values{}
+	for k, v := range cfg.ConnectAttributes {
+		attrs.Set(strings.TrimSpace(k), strings.TrimSpace(v))
+	}
+	// only override ssl mode if not specified
+	if attrs.Get(postgresSSLModeAttr) == "" {
+		attrs.Set(postgresSSLModeAttr, defaultPostgresSSLMode)
+	}
+	dsn := url.URL{
+		Scheme:   postgresSchemeName,
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     cfg.ConnectAddr,
+		Path:     cfg.DatabaseName,
+		RawQuery: attrs.Encode(),
+	}
+	return dsn.String()
+}
+
+// buildSQLiteDSN returns the dsn for the sqlite database file named by
+// DatabaseName. A DatabaseName of ":memory:" or an empty one refers to an
+// in-memory database
+func buildSQLiteDSN(cfg *config.SQL) string {
+	attrs := url.Values{}
+	for k, v := range cfg.ConnectAttributes {
+		attrs.Set(strings.TrimSpace(k), strings.TrimSpace(v))
+	}
+	// only override busy timeout if not specified
+	if attrs.Get(sqliteBusyTimeoutAttr) == "" {
+		attrs.Set(sqliteBusyTimeoutAttr, defaultSQLiteBusyTimeout)
+	}
+	dbName := cfg.DatabaseName
+	if dbName == "" {
+		dbName = sqliteInMemoryDatabase
+	}
+	return dbName + "?" + attrs.Encode()
+}
+
 func buildDSN(cfg *config.SQL) string {
 	attrs := buildDSNAttrs(cfg)
 	dsn := fmt.Sprintf(dsnFmt, cfg.User, cfg.Password, cfg.ConnectProtocol, cfg.ConnectAddr, cfg.DatabaseName)
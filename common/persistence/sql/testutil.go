@@ -23,6 +23,7 @@ package sql
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 
 	"github.com/iancoleman/strcase"
 	"github.com/jmoiron/sqlx"
@@ -33,11 +34,18 @@ import (
 const (
 	defaultDriverName = "mysql"
 	dataSourceName    = "%s:%s@%v(%v)/%s?multiStatements=true&transaction_isolation=%%27READ-COMMITTED%%27&parseTime=true&clientFoundRows=true"
+
+	postgresDriverName     = "postgres"
+	postgresDataSourceName = "postgres://%s:%s@%v/%s?sslmode=disable"
+	// postgres doesn't allow connections without a database, so
+	// admin operations are done on the default database instead
+	postgresDefaultDatabase = "postgres"
+
+	sqliteDriverName = "sqlite3"
 )
 
 func newConnection(cfg config.SQL) (*sqlx.DB, error) {
-	var db, err = sqlx.Connect(cfg.DriverName,
-		fmt.Sprintf(dataSourceName, cfg.User, cfg.Password, cfg.ConnectProtocol, cfg.ConnectAddr, cfg.DatabaseName))
+	var db, err = sqlx.Connect(cfg.DriverName, buildDataSourceName(cfg))
 	if err != nil {
 		return nil, err
 	}
@@ -46,11 +54,45 @@ func newConnection(cfg config.SQL) (*sqlx.DB, error) {
 	return db, nil
 }
 
-func createDatabase(driver string, addr string, username, password, dbName string, overwrite bool) error {
-	var db, err = sqlx.Connect(driver, fmt.Sprintf(dataSourceName, username, password, "tcp", addr, ""))
+func buildDataSourceName(cfg config.SQL) string {
+	if cfg.DriverName == sqliteDriverName {
+		return cfg.DatabaseName
+	}
+	if cfg.DriverName == postgresDriverName {
+		dbName := cfg.DatabaseName
+		if dbName == "" {
+			dbName = postgresDefaultDatabase
+		}
+		return fmt.Sprintf(postgresDataSourceName, cfg.User, cfg.Password, cfg.ConnectAddr, dbName)
+	}
+	return fmt.Sprintf(dataSourceName, cfg.User, cfg.Password, cfg.ConnectProtocol, cfg.ConnectAddr, cfg.DatabaseName)
+}
+
+// newAdminConnection returns a connection that isn't bound to the given database
+// so that it can be used to create or drop that database
+func newAdminConnection(cfg config.SQL) (*sqlx.DB, error) {
+	cfg.DatabaseName = ""
+	db, err := newConnection(cfg)
 	if err != nil {
-		return fmt.Errorf("failure connecting to mysql database: %v", err)
+		return nil, fmt.Errorf("failure connecting to %v database: %v", cfg.DriverName, err)
 	}
+	return db, nil
+}
+
+func createDatabase(cfg config.SQL, dbName string, overwrite bool) error {
+	if cfg.DriverName == sqliteDriverName {
+		// sqlite creates the database file when it is first opened
+		if overwrite {
+			return removeDatabaseFile(cfg.DatabaseName)
+		}
+		return nil
+	}
+
+	db, err := newAdminConnection(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
 
 	if overwrite {
 		dropDatabase(db, dbName)
@@ -73,6 +115,15 @@ func dropDatabase(db *sqlx.DB, dbName string) (err error) {
 	return nil
 }
 
+// removeDatabaseFile removes the given sqlite database file, if it exists
+func removeDatabaseFile(fileName string) error {
+	if err := os.Remove(fileName); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	log.WithField(`database-name`, fileName).Info(`dropped database`)
+	return nil
+}
+
 // loadDatabaseSchema loads the schema from the given .sql files on this database
 func loadDatabaseSchema(dir string, fileNames []string, db *sqlx.DB, override bool) (err error) {
 
@@ -0,0 +1,460 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+)
+
+type (
+	// visibilityQueryDialect describes how search attributes, which are stored in
+	// a JSON column, are read by a specific sql database
+	visibilityQueryDialect struct {
+		// searchAttribute returns the expression which reads the value of a search attribute
+		searchAttribute func(name string, valueType workflow.IndexedValueType) string
+		// boolPlaceholder is the placeholder used for a boolean value, which is passed as a "true" or "false" string
+		boolPlaceholder string
+	}
+
+	// visibilityQueryConverter turns the where clause of a visibility list query into a
+	// parameterized condition over executions_visibility table
+	visibilityQueryConverter struct {
+		dialect               *visibilityQueryDialect
+		validSearchAttributes map[string]interface{}
+		args                  []interface{}
+		filterExecutionTime   bool
+	}
+
+	// visibilityQuery is the parameterized form of a visibility list query
+	visibilityQuery struct {
+		condition string
+		args      []interface{}
+		// sortColumn is the expression used for ordering, empty when the query
+		// does not contain order by clause
+		sortColumn string
+		sortDesc   bool
+	}
+
+	visibilityQueryField struct {
+		column    string
+		valueType workflow.IndexedValueType
+		isTime    bool
+	}
+)
+
+const (
+	defaultVisibilitySortColumn = "start_time"
+	visibilityTieBreakerColumn  = "run_id"
+
+	// missingValue is used in a comparison to query for the absence of a field, e.g. CloseTime = missing
+	missingValue = "missing"
+)
+
+var (
+	mysqlVisibilityQueryDialect = &visibilityQueryDialect{
+		searchAttribute: func(name string, valueType workflow.IndexedValueType) string {
+			path := fmt.Sprintf(`JSON_EXTRACT(search_attributes, '$."%s"')`, name)
+			switch valueType {
+			case workflow.IndexedValueTypeString, workflow.IndexedValueTypeKeyword:
+				return "JSON_UNQUOTE(" + path + ")"
+			case workflow.IndexedValueTypeInt, workflow.IndexedValueTypeDatetime:
+				return "CAST(" + path + " AS SIGNED)"
+			case workflow.IndexedValueTypeDouble:
+				return "(" + path + " + 0)"
+			default:
+				return path
+			}
+		},
+		boolPlaceholder: "CAST(? AS JSON)",
+	}
+
+	postgresVisibilityQueryDialect = &visibilityQueryDialect{
+		searchAttribute: func(name string, valueType workflow.IndexedValueType) string {
+			path := fmt.Sprintf(`(search_attributes->>'%s')`, name)
+			switch valueType {
+			case workflow.IndexedValueTypeInt, workflow.IndexedValueTypeDatetime:
+				return path + "::bigint"
+			case workflow.IndexedValueTypeDouble:
+				return path + "::double precision"
+			case workflow.IndexedValueTypeBool:
+				return path + "::boolean"
+			default:
+				return path
+			}
+		},
+		boolPlaceholder: "CAST(? AS BOOLEAN)",
+	}
+
+	visibilityQueryDialects = map[string]*visibilityQueryDialect{
+		"mysql":    mysqlVisibilityQueryDialect,
+		"postgres": postgresVisibilityQueryDialect,
+	}
+
+	// visibilitySystemFields maps the system search attributes to the columns of executions_visibility table
+	visibilitySystemFields = map[string]visibilityQueryField{
+		definition.DomainID:      {column: "domain_id", valueType: workflow.IndexedValueTypeKeyword},
+		definition.WorkflowID:    {column: "workflow_id", valueType: workflow.IndexedValueTypeKeyword},
+		definition.RunID:         {column: "run_id", valueType: workflow.IndexedValueTypeKeyword},
+		definition.WorkflowType:  {column: "workflow_type_name", valueType: workflow.IndexedValueTypeKeyword},
+		definition.StartTime:     {column: "start_time", valueType: workflow.IndexedValueTypeDatetime, isTime: true},
+		definition.ExecutionTime: {column: "execution_time", valueType: workflow.IndexedValueTypeDatetime, isTime: true},
+		definition.CloseTime:     {column: "close_time", valueType: workflow.IndexedValueTypeDatetime, isTime: true},
+		definition.CloseStatus:   {column: "close_status", valueType: workflow.IndexedValueTypeInt},
+		definition.HistoryLength: {column: "history_length", valueType: workflow.IndexedValueTypeInt},
+	}
+
+	errInvalidWhereClause  = errors.New("invalid where clause")
+	errMultipleSortFields  = errors.New("only one field can be used to sort")
+	errSortByStringField   = errors.New("not able to sort by IndexedValueTypeString field, use IndexedValueTypeKeyword field")
+	errInvalidOrderByField = errors.New("invalid order by expression")
+)
+
+func newVisibilityQueryConverter(
+	dialect *visibilityQueryDialect,
+	validSearchAttributes map[string]interface{},
+) *visibilityQueryConverter {
+	return &visibilityQueryConverter{
+		dialect:               dialect,
+		validSearchAttributes: validSearchAttributes,
+	}
+}
+
+// convert parses the where clause of a visibility list query, which may also contain
+// an order by clause, and returns its parameterized form
+func (c *visibilityQueryConverter) convert(query string) (*visibilityQuery, error) {
+	query = strings.TrimSpace(query)
+	if len(query) == 0 {
+		return &visibilityQuery{}, nil
+	}
+
+	sqlQuery := "select * from dummy where " + query
+	if common.IsJustOrderByClause(query) {
+		sqlQuery = "select * from dummy " + query
+	}
+	stmt, err := sqlparser.Parse(sqlQuery)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, errInvalidWhereClause
+	}
+
+	result := &visibilityQuery{}
+	if sel.Where != nil {
+		condition, err := c.convertExpr(sel.Where.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if c.filterExecutionTime {
+			// records without execution time have it stored as unix epoch, which are
+			// excluded when querying on ExecutionTime just like elasticsearch does
+			condition = fmt.Sprintf("execution_time > ? AND (%v)", condition)
+			c.args = append([]interface{}{time.Unix(0, 0)}, c.args...)
+		}
+		result.condition = condition
+		result.args = c.args
+	}
+
+	if len(sel.OrderBy) > 1 {
+		return nil, errMultipleSortFields
+	}
+	if len(sel.OrderBy) == 1 {
+		order := sel.OrderBy[0]
+		colName, ok := order.Expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, errInvalidOrderByField
+		}
+		field, err := c.getField(colName)
+		if err != nil {
+			return nil, err
+		}
+		if field.valueType == workflow.IndexedValueTypeString {
+			return nil, errSortByStringField
+		}
+		result.sortColumn = field.column
+		result.sortDesc = order.Direction == sqlparser.DescScr
+	}
+	return result, nil
+}
+
+func (c *visibilityQueryConverter) convertExpr(expr sqlparser.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return c.convertBinaryExpr("AND", expr.Left, expr.Right)
+	case *sqlparser.OrExpr:
+		return c.convertBinaryExpr("OR", expr.Left, expr.Right)
+	case *sqlparser.ParenExpr:
+		inner, err := c.convertExpr(expr.Expr)
+		if err != nil {
+			return "", err
+		}
+		return "(" + inner + ")", nil
+	case *sqlparser.ComparisonExpr:
+		return c.convertComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return c.convertRangeCond(expr)
+	default:
+		return "", errInvalidWhereClause
+	}
+}
+
+func (c *visibilityQueryConverter) convertBinaryExpr(operator string, left, right sqlparser.Expr) (string, error) {
+	leftCondition, err := c.convertExpr(left)
+	if err != nil {
+		return "", err
+	}
+	rightCondition, err := c.convertExpr(right)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%v %v %v", leftCondition, operator, rightCondition), nil
+}
+
+func (c *visibilityQueryConverter) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (string, error) {
+	colName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return "", errors.New("invalid comparison expression")
+	}
+	field, err := c.getField(colName)
+	if err != nil {
+		return "", err
+	}
+
+	if isMissingValue(expr.Right) {
+		column := field.column
+		if field.column == "close_time" {
+			// close_status is set for every closed workflow and is the column used by the indexes
+			column = "close_status"
+		}
+		switch expr.Operator {
+		case sqlparser.EqualStr:
+			return column + " IS NULL", nil
+		case sqlparser.NotEqualStr:
+			return column + " IS NOT NULL", nil
+		default:
+			return "", fmt.Errorf("operator %v is not supported with missing", expr.Operator)
+		}
+	}
+
+	switch expr.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr:
+		if field.valueType == workflow.IndexedValueTypeString {
+			// IndexedValueTypeString is a text field, so match any value which contains the given one
+			value, err := c.convertValue(field, expr.Right)
+			if err != nil {
+				return "", err
+			}
+			operator := "LIKE"
+			if expr.Operator == sqlparser.NotEqualStr {
+				operator = "NOT LIKE"
+			}
+			return fmt.Sprintf("%v %v %v", field.column, operator, c.addArg("%"+escapeLikePattern(value.(string))+"%")), nil
+		}
+		fallthrough
+	case sqlparser.LessThanStr, sqlparser.GreaterThanStr, sqlparser.LessEqualStr, sqlparser.GreaterEqualStr:
+		value, err := c.convertValue(field, expr.Right)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%v %v %v", field.column, expr.Operator, c.addArg(value)), nil
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		if field.valueType != workflow.IndexedValueTypeString && field.valueType != workflow.IndexedValueTypeKeyword {
+			return "", fmt.Errorf("operator %v is only supported by string fields", expr.Operator)
+		}
+		value, err := c.convertValue(field, expr.Right)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%v %v %v", field.column, strings.ToUpper(expr.Operator), c.addArg(value)), nil
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return "", fmt.Errorf("operator %v requires a list of values", expr.Operator)
+		}
+		placeholders := make([]string, len(tuple))
+		for i, valExpr := range tuple {
+			value, err := c.convertValue(field, valExpr)
+			if err != nil {
+				return "", err
+			}
+			placeholders[i] = c.addArg(value)
+		}
+		return fmt.Sprintf("%v %v (%v)", field.column, strings.ToUpper(expr.Operator), strings.Join(placeholders, ", ")), nil
+	default:
+		return "", fmt.Errorf("operator %v is not supported", expr.Operator)
+	}
+}
+
+func (c *visibilityQueryConverter) convertRangeCond(expr *sqlparser.RangeCond) (string, error) {
+	colName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return "", errors.New("invalid range expression")
+	}
+	field, err := c.getField(colName)
+	if err != nil {
+		return "", err
+	}
+	from, err := c.convertValue(field, expr.From)
+	if err != nil {
+		return "", err
+	}
+	to, err := c.convertValue(field, expr.To)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%v %v %v AND %v", field.column, strings.ToUpper(expr.Operator), c.addArg(from), c.addArg(to)), nil
+}
+
+// getField returns the column or search attribute expression referred by the given column name,
+// custom search attributes are expected to be prefixed with Attr. by the frontend query validator
+func (c *visibilityQueryConverter) getField(colName *sqlparser.ColName) (visibilityQueryField, error) {
+	name := colName.Name.String()
+	if colName.Qualifier.Name.String() == definition.Attr {
+		// Attr.Name is parsed as column Name qualified by table Attr
+		return c.getSearchAttributeField(name)
+	}
+	if strings.HasPrefix(name, definition.Attr+".") {
+		return c.getSearchAttributeField(name[len(definition.Attr)+1:])
+	}
+	if field, ok := visibilitySystemFields[name]; ok {
+		if name == definition.ExecutionTime {
+			c.filterExecutionTime = true
+		}
+		return field, nil
+	}
+	return c.getSearchAttributeField(name)
+}
+
+func (c *visibilityQueryConverter) getSearchAttributeField(name string) (visibilityQueryField, error) {
+	valueType, ok := getSearchAttributeType(c.validSearchAttributes, name)
+	if !ok || definition.IsSystemIndexedKey(name) {
+		return visibilityQueryField{}, fmt.Errorf("invalid search attribute %v", name)
+	}
+	return visibilityQueryField{
+		column:    c.dialect.searchAttribute(name, valueType),
+		valueType: valueType,
+	}, nil
+}
+
+// getSearchAttributeType returns the type of a search attribute, which is either IndexedValueType or a number
+// depending on whether the valid search attributes come from the default value or from dynamic config
+func getSearchAttributeType(validSearchAttributes map[string]interface{}, name string) (workflow.IndexedValueType, bool) {
+	switch valueType := validSearchAttributes[name].(type) {
+	case workflow.IndexedValueType:
+		return valueType, true
+	case float64:
+		return workflow.IndexedValueType(valueType), true
+	case int:
+		return workflow.IndexedValueType(valueType), true
+	default:
+		return 0, false
+	}
+}
+
+// addArg records the value as an argument of the query and returns its placeholder
+func (c *visibilityQueryConverter) addArg(value interface{}) string {
+	if b, ok := value.(bool); ok {
+		c.args = append(c.args, strconv.FormatBool(b))
+		return c.dialect.boolPlaceholder
+	}
+	c.args = append(c.args, value)
+	return "?"
+}
+
+// convertValue converts a literal of the query to the representation used by the given field.
+// Times are accepted either as unix nanos or in RFC3339 format, just like elasticsearch
+func (c *visibilityQueryConverter) convertValue(field visibilityQueryField, expr sqlparser.Expr) (interface{}, error) {
+	literal, err := parseLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	switch field.valueType {
+	case workflow.IndexedValueTypeString, workflow.IndexedValueTypeKeyword:
+		return literal, nil
+	case workflow.IndexedValueTypeInt:
+		return strconv.ParseInt(literal, 10, 64)
+	case workflow.IndexedValueTypeDouble:
+		return strconv.ParseFloat(literal, 64)
+	case workflow.IndexedValueTypeBool:
+		return strconv.ParseBool(literal)
+	case workflow.IndexedValueTypeDatetime:
+		nanos, err := parseDatetime(literal)
+		if err != nil {
+			return nil, err
+		}
+		if field.isTime {
+			return time.Unix(0, nanos), nil
+		}
+		return nanos, nil
+	default:
+		return nil, fmt.Errorf("unknown value type %v", field.valueType)
+	}
+}
+
+func parseLiteral(expr sqlparser.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch expr.Type {
+		case sqlparser.StrVal, sqlparser.IntVal, sqlparser.FloatVal:
+			return string(expr.Val), nil
+		}
+	case sqlparser.BoolVal:
+		return strconv.FormatBool(bool(expr)), nil
+	case *sqlparser.UnaryExpr:
+		if val, ok := expr.Expr.(*sqlparser.SQLVal); ok && expr.Operator == sqlparser.UMinusStr &&
+			(val.Type == sqlparser.IntVal || val.Type == sqlparser.FloatVal) {
+			return "-" + string(val.Val), nil
+		}
+	}
+	return "", fmt.Errorf("invalid value %v", sqlparser.String(expr))
+}
+
+func parseDatetime(literal string) (int64, error) {
+	if nanos, err := strconv.ParseInt(literal, 10, 64); err == nil {
+		return nanos, nil
+	}
+	t, err := time.Parse(time.RFC3339, literal)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixNano(), nil
+}
+
+func isMissingValue(expr sqlparser.Expr) bool {
+	colName, ok := expr.(*sqlparser.ColName)
+	return ok && colName.Qualifier.IsEmpty() && colName.Name.EqualString(missingValue)
+}
+
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
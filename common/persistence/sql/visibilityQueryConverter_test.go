@@ -0,0 +1,173 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/definition"
+)
+
+type visibilityQueryConverterSuite struct {
+	suite.Suite
+}
+
+func TestVisibilityQueryConverterSuite(t *testing.T) {
+	s := new(visibilityQueryConverterSuite)
+	suite.Run(t, s)
+}
+
+func (s *visibilityQueryConverterSuite) convert(query string) (*visibilityQuery, error) {
+	converter := newVisibilityQueryConverter(mysqlVisibilityQueryDialect, definition.GetDefaultIndexedKeys())
+	return converter.convert(query)
+}
+
+func (s *visibilityQueryConverterSuite) TestConvert_EmptyQuery() {
+	query, err := s.convert("")
+	s.NoError(err)
+	s.Empty(query.condition)
+	s.Empty(query.args)
+	s.Empty(query.sortColumn)
+}
+
+func (s *visibilityQueryConverterSuite) TestConvert_SystemFields() {
+	query, err := s.convert("WorkflowID = 'wid' and (WorkflowType = \"type\" or CloseStatus != 1) and HistoryLength >= 10")
+	s.NoError(err)
+	s.Equal("workflow_id = ? AND (workflow_type_name = ? OR close_status != ?) AND history_length >= ?", query.condition)
+	s.Equal([]interface{}{"wid", "type", int64(1), int64(10)}, query.args)
+}
+
+func (s *visibilityQueryConverterSuite) TestConvert_TimeFields() {
+	startTime := time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC)
+	query, err := s.convert("StartTime > '2019-07-01T00:00:00Z' and CloseTime between 1 and 2")
+	s.NoError(err)
+	s.Equal("start_time > ? AND close_time BETWEEN ? AND ?", query.condition)
+	s.Equal(startTime.UnixNano(), query.args[0].(time.Time).UnixNano())
+	s.Equal(time.Unix(0, 1), query.args[1])
+	s.Equal(time.Unix(0, 2), query.args[2])
+
+	query, err = s.convert("ExecutionTime < 100")
+	s.NoError(err)
+	s.Equal("execution_time > ? AND (execution_time < ?)", query.condition)
+	s.Equal([]interface{}{time.Unix(0, 0), time.Unix(0, 100)}, query.args)
+
+	_, err = s.convert("StartTime > 'yesterday'")
+	s.Error(err)
+}
+
+func (s *visibilityQueryConverterSuite) TestConvert_Missing() {
+	query, err := s.convert("CloseTime = missing")
+	s.NoError(err)
+	s.Equal("close_status IS NULL", query.condition)
+	s.Empty(query.args)
+
+	query, err = s.convert("CloseTime != missing and `Attr.CustomKeywordField` = missing")
+	s.NoError(err)
+	s.Equal(`close_status IS NOT NULL AND JSON_UNQUOTE(JSON_EXTRACT(search_attributes, '$."CustomKeywordField"')) IS NULL`, query.condition)
+
+	_, err = s.convert("CloseTime > missing")
+	s.Error(err)
+}
+
+func (s *visibilityQueryConverterSuite) TestConvert_SearchAttributes() {
+	query, err := s.convert("`Attr.CustomKeywordField` in ('a', 'b') and `Attr.CustomIntField` between -1 and 10 and Attr.CustomDoubleField < 1.5")
+	s.NoError(err)
+	s.Equal(`JSON_UNQUOTE(JSON_EXTRACT(search_attributes, '$."CustomKeywordField"')) IN (?, ?) AND `+
+		`CAST(JSON_EXTRACT(search_attributes, '$."CustomIntField"') AS SIGNED) BETWEEN ? AND ? AND `+
+		`(JSON_EXTRACT(search_attributes, '$."CustomDoubleField"') + 0) < ?`, query.condition)
+	s.Equal([]interface{}{"a", "b", int64(-1), int64(10), 1.5}, query.args)
+
+	query, err = s.convert("`Attr.CustomBoolField` = true and `Attr.CustomDatetimeField` >= '1970-01-01T00:00:01Z'")
+	s.NoError(err)
+	s.Equal(`JSON_EXTRACT(search_attributes, '$."CustomBoolField"') = CAST(? AS JSON) AND `+
+		`CAST(JSON_EXTRACT(search_attributes, '$."CustomDatetimeField"') AS SIGNED) >= ?`, query.condition)
+	s.Equal([]interface{}{"true", int64(time.Second)}, query.args)
+
+	query, err = s.convert("`Attr.CustomStringField` = '50%_off'")
+	s.NoError(err)
+	s.Equal(`JSON_UNQUOTE(JSON_EXTRACT(search_attributes, '$."CustomStringField"')) LIKE ?`, query.condition)
+	s.Equal([]interface{}{`%50\%\_off%`}, query.args)
+
+	_, err = s.convert("`Attr.UnknownField` = 'value'")
+	s.Error(err)
+
+	_, err = s.convert("`Attr.CustomIntField` = 'not a number'")
+	s.Error(err)
+}
+
+func (s *visibilityQueryConverterSuite) TestConvert_SearchAttributesPostgres() {
+	converter := newVisibilityQueryConverter(postgresVisibilityQueryDialect, definition.GetDefaultIndexedKeys())
+	query, err := converter.convert("`Attr.CustomKeywordField` = 'a' and `Attr.CustomIntField` > 1 and " +
+		"Attr.CustomDoubleField < 1.5 and `Attr.CustomBoolField` = true")
+	s.NoError(err)
+	s.Equal(`(search_attributes->>'CustomKeywordField') = ? AND `+
+		`(search_attributes->>'CustomIntField')::bigint > ? AND `+
+		`(search_attributes->>'CustomDoubleField')::double precision < ? AND `+
+		`(search_attributes->>'CustomBoolField')::boolean = CAST(? AS BOOLEAN)`, query.condition)
+	s.Equal([]interface{}{"a", int64(1), 1.5, "true"}, query.args)
+}
+
+func (s *visibilityQueryConverterSuite) TestConvert_SearchAttributesFromDynamicConfig() {
+	converter := newVisibilityQueryConverter(mysqlVisibilityQueryDialect, map[string]interface{}{
+		"CustomIntField": float64(2),
+	})
+	query, err := converter.convert("`Attr.CustomIntField` = 1")
+	s.NoError(err)
+	s.Equal(`CAST(JSON_EXTRACT(search_attributes, '$."CustomIntField"') AS SIGNED) = ?`, query.condition)
+}
+
+func (s *visibilityQueryConverterSuite) TestConvert_OrderBy() {
+	query, err := s.convert("order by CloseTime desc")
+	s.NoError(err)
+	s.Empty(query.condition)
+	s.Equal("close_time", query.sortColumn)
+	s.True(query.sortDesc)
+
+	query, err = s.convert("WorkflowID = 'wid' order by `Attr.CustomKeywordField`")
+	s.NoError(err)
+	s.Equal("workflow_id = ?", query.condition)
+	s.Equal(`JSON_UNQUOTE(JSON_EXTRACT(search_attributes, '$."CustomKeywordField"'))`, query.sortColumn)
+	s.False(query.sortDesc)
+
+	_, err = s.convert("order by StartTime, RunID")
+	s.Equal(errMultipleSortFields, err)
+
+	_, err = s.convert("order by `Attr.CustomStringField`")
+	s.Equal(errSortByStringField, err)
+}
+
+func (s *visibilityQueryConverterSuite) TestConvert_InvalidQuery() {
+	for _, query := range []string{
+		"invalid sql",
+		"WorkflowID = 'wid' and 1 < 2",
+		"WorkflowID = 'wid'; drop table executions_visibility",
+		"WorkflowID regexp 'w.*'",
+		"StartTime in 1",
+		"WorkflowID = RunID",
+		"UnknownField = 1",
+	} {
+		_, err := s.convert(query)
+		s.Error(err, query)
+	}
+}
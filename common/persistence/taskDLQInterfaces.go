@@ -0,0 +1,116 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"time"
+)
+
+// Interfaces for the Task DLQ Store.
+// The task dead letter queue (DLQ) holds the transfer and timer tasks of a shard
+// that kept failing after the configured number of attempts. Such tasks are moved
+// out of the shard's queue so that a single bad workflow can not pin the queue ack
+// level, and are kept until an operator re-enqueues or purges them.
+
+// Categories of tasks stored in the task DLQ
+const (
+	DLQTaskCategoryTransfer = iota + 1
+	DLQTaskCategoryTimer
+)
+
+type (
+	// DLQTask is a transfer or timer task moved to the task DLQ
+	DLQTask struct {
+		ShardID int
+		// Category is either DLQTaskCategoryTransfer or DLQTaskCategoryTimer
+		Category            int
+		TaskID              int64
+		TaskType            int
+		DomainID            string
+		WorkflowID          string
+		RunID               string
+		VisibilityTimestamp time.Time
+		// Data is the JSON encoded TransferTaskInfo or TimerTaskInfo
+		Data []byte
+		// LastError is the error returned by the last attempt to process the task
+		LastError   string
+		Attempt     int
+		CreatedTime time.Time
+	}
+
+	// PutDLQTaskRequest is used to add a task to the task DLQ, an existing
+	// entry of the same task is overwritten
+	PutDLQTaskRequest struct {
+		Task *DLQTask
+	}
+
+	// ListDLQTasksRequest is used to list the DLQ tasks of a shard, ordered by task ID
+	ListDLQTasksRequest struct {
+		ShardID       int
+		Category      int
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListDLQTasksResponse is the response to ListDLQTasksRequest
+	ListDLQTasksResponse struct {
+		Tasks []*DLQTask
+		// NextPageToken is empty when there are no more tasks to read
+		NextPageToken []byte
+	}
+
+	// GetDLQTaskRequest is used to read a single task from the task DLQ
+	GetDLQTaskRequest struct {
+		ShardID  int
+		Category int
+		TaskID   int64
+	}
+
+	// GetDLQTaskResponse is the response to GetDLQTaskRequest
+	GetDLQTaskResponse struct {
+		Task *DLQTask
+	}
+
+	// DeleteDLQTaskRequest is used to remove a single task from the task DLQ
+	DeleteDLQTaskRequest struct {
+		ShardID  int
+		Category int
+		TaskID   int64
+	}
+
+	// RangeDeleteDLQTasksRequest is used to remove all tasks of a category from the task DLQ of a shard
+	RangeDeleteDLQTasksRequest struct {
+		ShardID  int
+		Category int
+	}
+
+	// TaskDLQManager is used to manage the task DLQ
+	TaskDLQManager interface {
+		Closeable
+		GetName() string
+		PutDLQTask(request *PutDLQTaskRequest) error
+		ListDLQTasks(request *ListDLQTasksRequest) (*ListDLQTasksResponse, error)
+		// GetDLQTask returns EntityNotExistsError if the task is not in the DLQ
+		GetDLQTask(request *GetDLQTaskRequest) (*GetDLQTaskResponse, error)
+		DeleteDLQTask(request *DeleteDLQTaskRequest) error
+		RangeDeleteDLQTasks(request *RangeDeleteDLQTasksRequest) error
+	}
+)
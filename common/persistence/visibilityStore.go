@@ -32,6 +32,7 @@ import (
 type (
 	visibilityManagerImpl struct {
 		serializer  PayloadSerializer
+		encryption  PayloadEncryption
 		persistence VisibilityStore
 		logger      log.Logger
 	}
@@ -43,9 +44,10 @@ const VisibilityEncoding = common.EncodingTypeThriftRW
 var _ VisibilityManager = (*visibilityManagerImpl)(nil)
 
 // NewVisibilityManagerImpl returns new VisibilityManager
-func NewVisibilityManagerImpl(persistence VisibilityStore, logger log.Logger) VisibilityManager {
+func NewVisibilityManagerImpl(persistence VisibilityStore, encryption PayloadEncryption, logger log.Logger) VisibilityManager {
 	return &visibilityManagerImpl{
-		serializer:  NewPayloadSerializer(),
+		serializer:  NewEncryptingPayloadSerializer(encryption, ""),
+		encryption:  encryption,
 		persistence: persistence,
 		logger:      logger,
 	}
@@ -288,7 +290,8 @@ func (v *visibilityManagerImpl) convertVisibilityWorkflowExecutionInfo(execution
 }
 
 func (v *visibilityManagerImpl) serializeMemo(visibilityMemo *shared.Memo, domainID, wID, rID string) *DataBlob {
-	memo, err := v.serializer.SerializeVisibilityMemo(visibilityMemo, VisibilityEncoding)
+	serializer := NewEncryptingPayloadSerializer(v.encryption, domainID)
+	memo, err := serializer.SerializeVisibilityMemo(visibilityMemo, VisibilityEncoding)
 	if err != nil {
 		v.logger.WithTags(
 			tag.WorkflowDomainID(domainID),
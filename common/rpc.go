@@ -21,6 +21,8 @@
 package common
 
 import (
+	"net"
+
 	"go.uber.org/yarpc"
 	"golang.org/x/net/context"
 )
@@ -50,6 +52,8 @@ type (
 	RPCFactory interface {
 		CreateDispatcher() *yarpc.Dispatcher
 		CreateDispatcherForOutbound(callerName, serviceName, hostName string) *yarpc.Dispatcher
+		// CreateHTTPListener returns the listener of the HTTP gateway, or nil if there is none
+		CreateHTTPListener() net.Listener
 	}
 )
 
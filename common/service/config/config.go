@@ -60,6 +60,19 @@ type (
 		DynamicConfigClient dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
 		// DomainDefaults is the default config for every domain
 		DomainDefaults DomainDefaults `yaml:"domainDefaults"`
+		// Authorization is the config for authorizing calls to frontend
+		Authorization Authorization `yaml:"authorization"`
+	}
+
+	// Authorization contains the config for authorizing calls to frontend
+	Authorization struct {
+		// Actors maps the identity of a caller to its role, either reader, writer or admin, in each domain.
+		// The "*" actor applies to every caller, and the "*" domain applies to every domain as well as to
+		// APIs which are not scoped to a domain. Authorization is disabled if no actor is configured.
+		Actors map[string]map[string]string `yaml:"actors"`
+		// TokenKeyFile is the path to the file containing the key signing the tokens callers authenticate
+		// with, which is required if any actor is configured
+		TokenKeyFile string `yaml:"tokenKeyFile"`
 	}
 
 	// Service contains the service specific config items
@@ -92,6 +105,44 @@ type (
 		DisableLogging bool `yaml:"disableLogging"`
 		// LogLevel is the desired log level
 		LogLevel string `yaml:"logLevel"`
+		// TLS encrypts the rpc of the service. The cadence hosts call each other over the gRPC inbound with
+		// mutual TLS, so every service must set GRPCPort, and callers outside of the cluster may use the gRPC
+		// inbound, the HTTP gateway or the TLS inbound. Ringpop keeps gossiping over the plain tchannel port,
+		// which tchannel cannot encrypt, so it must be restricted to the cluster network.
+		TLS TLS `yaml:"tls"`
+		// HTTPPort is the port of the JSON over HTTP gateway of the public API, which only frontend serves.
+		// The gateway is disabled if it is zero, and it is served over TLS if TLS is enabled.
+		HTTPPort int `yaml:"httpPort"`
+		// GRPCPort is the port of the gRPC inbound, which serves the same procedures as Port over the yarpc
+		// gRPC transport, it binds to the address of Port. The inbound is disabled if it is zero, and it is
+		// served over mutual TLS if TLS is enabled.
+		GRPCPort int `yaml:"grpcPort"`
+	}
+
+	// TLS contains the config for serving rpc over TLS
+	TLS struct {
+		// Enabled is true if the rpc of the service is encrypted
+		Enabled bool `yaml:"enabled"`
+		// Port is the port of the inbound serving tchannel over TLS to callers outside of the cluster, which
+		// only frontend needs. The inbound is disabled if it is zero, otherwise it must differ from the rpc port.
+		Port int `yaml:"port"`
+		// BindOnIP is the IPv4 address the TLS inbound and the HTTP gateway bind to, it defaults to the
+		// address of the plain inbound, and lets the encrypted ports alone be exposed outside of the cluster
+		BindOnIP string `yaml:"bindOnIP"`
+		// CertFile is the path of the PEM encoded certificate chain presented to callers, and to the called
+		// hosts when the cadence hosts call each other, so it must allow both server and client authentication
+		CertFile string `yaml:"certFile"`
+		// KeyFile is the path of the PEM encoded private key of the certificate
+		KeyFile string `yaml:"keyFile"`
+		// CaFile is the path of the PEM encoded CA certificates which the certificates of the cadence hosts
+		// and of the clients are verified against
+		CaFile string `yaml:"caFile"`
+		// RequireClientAuth is true if callers of the TLS inbound and of the HTTP gateway must present a
+		// certificate signed by the CA (mutual TLS), callers of the gRPC inbound always must
+		RequireClientAuth bool `yaml:"requireClientAuth"`
+		// ServerName is the name the certificates of the called hosts are verified against, the hosts are
+		// called by their IP so only the certificate chain is verified if it is empty
+		ServerName string `yaml:"serverName"`
 	}
 
 	// Ringpop contains the ringpop config items
@@ -128,6 +179,19 @@ type (
 		VisibilityConfig *VisibilityConfig
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn
+		// Encryption is the config for encrypting payloads at rest
+		Encryption *Encryption `yaml:"encryption"`
+	}
+
+	// Encryption contains the config for encrypting history events, memos and other
+	// payloads before they are written to the datastores
+	Encryption struct {
+		// Enabled turns on encryption of payloads written from now on. Payloads which
+		// are already encrypted are decrypted as long as their key is in KeyFile,
+		// regardless of this setting
+		Enabled bool `yaml:"enabled"`
+		// KeyFile is the path to the file containing the encryption keys
+		KeyFile string `yaml:"keyFile"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -243,6 +307,7 @@ type (
 		// RPCName indicate the remote service name
 		RPCName string `yaml:"rpcName"`
 		// Address indicate the remote service address(Host:Port). Host can be DNS name.
+		// It must be the gRPC port of the remote frontend if rpc TLS is enabled.
 		RPCAddress string `yaml:"rpcAddress"`
 	}
 
@@ -301,12 +366,26 @@ type (
 	// HistoryArchiverProvider contains the config for all history archivers
 	HistoryArchiverProvider struct {
 		Filestore *FilestoreHistoryArchiver `yaml:"filestore"`
+		S3store   *S3HistoryArchiver        `yaml:"s3store"`
 	}
 
 	// FilestoreHistoryArchiver contain the config for filestore history archiver
 	FilestoreHistoryArchiver struct {
 		FileMode string `yaml:"fileMode"`
 		DirMode  string `yaml:"dirMode"`
+		// Compression is the compression used for archived history files, either gzip (default) or zstd
+		Compression string `yaml:"compression"`
+	}
+
+	// S3HistoryArchiver contains the config for the s3 compatible history archiver.
+	// Credentials are read by the default credential chain of the AWS SDK
+	S3HistoryArchiver struct {
+		// Region is the region of the bucket
+		Region string `yaml:"region"`
+		// Endpoint is the endpoint of the s3 compatible service, defaults to AWS S3 for the region
+		Endpoint string `yaml:"endpoint"`
+		// S3ForcePathStyle addresses buckets as endpoint/bucket instead of bucket.endpoint
+		S3ForcePathStyle bool `yaml:"s3ForcePathStyle"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -325,11 +404,15 @@ type (
 	}
 
 	// FilestoreVisibilityArchiver contain the config for filestore visibility archiver
-	FilestoreVisibilityArchiver struct{}
+	FilestoreVisibilityArchiver struct {
+		FileMode string `yaml:"fileMode"`
+		DirMode  string `yaml:"dirMode"`
+	}
 
 	// PublicClient is config for connecting to cadence frontend
 	PublicClient struct {
-		// HostPort is the host port to connect on. Host can be DNS name
+		// HostPort is the host port to connect on. Host can be DNS name.
+		// It must be the gRPC port of frontend if rpc TLS is enabled.
 		HostPort string `yaml:"hostPort" validate:"nonzero"`
 		// interval to refresh DNS. Default to 10s
 		RefreshInterval time.Duration `yaml:"RefreshInterval"`
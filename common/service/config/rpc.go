@@ -21,31 +21,48 @@
 package config
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
+	"strconv"
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	tcg "github.com/uber/tchannel-go"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/peer"
+	"go.uber.org/yarpc/peer/hostport"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
+	"google.golang.org/grpc/credentials"
 )
 
 // RPCFactory is an implementation of service.RPCFactory interface
 type RPCFactory struct {
 	config      *RPC
 	serviceName string
+	grpcPorts   map[string]int
 	ch          *tchannel.ChannelTransport
+	grpc        *grpc.Transport
 	logger      log.Logger
 }
 
 // NewFactory builds a new RPCFactory
-// conforming to the underlying configuration
-func (cfg *RPC) NewFactory(sName string, logger log.Logger) *RPCFactory {
-	return newRPCFactory(cfg, sName, logger)
+// conforming to the underlying configuration, grpcPorts are the gRPC ports of the
+// services by service name, which the cadence hosts call each other on if TLS is enabled
+func (cfg *RPC) NewFactory(sName string, logger log.Logger, grpcPorts map[string]int) *RPCFactory {
+	return newRPCFactory(cfg, sName, logger, grpcPorts)
 }
 
-func newRPCFactory(cfg *RPC, sName string, logger log.Logger) *RPCFactory {
-	factory := &RPCFactory{config: cfg, serviceName: sName, logger: logger}
+func newRPCFactory(cfg *RPC, sName string, logger log.Logger, grpcPorts map[string]int) *RPCFactory {
+	factory := &RPCFactory{
+		config:      cfg,
+		serviceName: sName,
+		grpcPorts:   grpcPorts,
+		grpc:        grpc.NewTransport(),
+		logger:      logger,
+	}
 	return factory
 }
 
@@ -61,21 +78,121 @@ func (d *RPCFactory) CreateDispatcher() *yarpc.Dispatcher {
 		d.logger.Fatal("Failed to create transport channel", tag.Error(err))
 	}
 	d.logger.Info("Created RPC dispatcher and listening", tag.Service(d.serviceName), tag.Address(hostAddress))
+
+	// the plain inbound must come first, ringpop takes its channel from the first inbound
+	inbounds := yarpc.Inbounds{d.ch.NewInbound()}
+	if d.config.TLS.Enabled {
+		if d.config.GRPCPort == 0 {
+			d.logger.Fatal("gRPC port must be set if TLS is enabled, the cadence hosts call each other on it")
+		}
+		d.logger.Warn("The rpc port keeps serving plain tchannel for ringpop, it must not be exposed outside of the cluster network",
+			tag.Service(d.serviceName), tag.Address(hostAddress))
+		if d.config.TLS.Port != 0 {
+			inbounds = append(inbounds, d.createTLSInbound())
+		}
+	}
+	if d.config.GRPCPort != 0 {
+		inbounds = append(inbounds, d.createGRPCInbound())
+	}
 	return yarpc.NewDispatcher(yarpc.Config{
 		Name:     d.serviceName,
-		Inbounds: yarpc.Inbounds{d.ch.NewInbound()},
+		Inbounds: inbounds,
 	})
 }
 
+// createTLSInbound creates an inbound serving tchannel over TLS on its own port. TChannel can only dial
+// plain tcp, so the hosts of the cluster call each other over the gRPC inbound, and the TLS inbound
+// is meant for tchannel callers outside of the cluster, such as clients behind a TLS proxy.
+func (d *RPCFactory) createTLSInbound() transport.Inbound {
+	if d.config.TLS.Port == d.config.Port {
+		d.logger.Fatal("TLS port must differ from the rpc port")
+	}
+	tlsConfig := d.getTLSConfig()
+	hostAddress := fmt.Sprintf("%v:%v", d.getTLSListenIP(), d.config.TLS.Port)
+	listener, err := net.Listen("tcp", hostAddress)
+	if err != nil {
+		d.logger.Fatal("Failed to listen for TLS", tag.Error(err))
+	}
+	ch, err := tcg.NewChannel(d.serviceName, nil)
+	if err != nil {
+		d.logger.Fatal("Failed to create TLS channel", tag.Error(err))
+	}
+	// the transport does not listen again once the channel is serving
+	if err := ch.Serve(tls.NewListener(listener, tlsConfig)); err != nil {
+		d.logger.Fatal("Failed to serve TLS channel", tag.Error(err))
+	}
+	tlsTransport, err := tchannel.NewChannelTransport(
+		tchannel.ServiceName(d.serviceName),
+		tchannel.WithChannel(ch))
+	if err != nil {
+		d.logger.Fatal("Failed to create TLS transport channel", tag.Error(err))
+	}
+	d.logger.Info("Created TLS RPC inbound and listening", tag.Service(d.serviceName), tag.Address(hostAddress))
+	return tlsTransport.NewInbound()
+}
+
+// createGRPCInbound creates an inbound serving the procedures of the dispatcher over gRPC on its own port
+func (d *RPCFactory) createGRPCInbound() transport.Inbound {
+	if d.config.GRPCPort == d.config.Port || (d.config.TLS.Enabled && d.config.GRPCPort == d.config.TLS.Port) {
+		d.logger.Fatal("gRPC port must differ from the rpc and TLS ports")
+	}
+	hostAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.GRPCPort)
+	listener, err := net.Listen("tcp", hostAddress)
+	if err != nil {
+		d.logger.Fatal("Failed to listen for gRPC", tag.Error(err))
+	}
+	var options []grpc.InboundOption
+	if d.config.TLS.Enabled {
+		tlsConfig := d.getTLSConfig()
+		// the cadence hosts call each other over the gRPC inbound, which always requires mutual TLS
+		if tlsConfig.ClientCAs == nil {
+			d.logger.Fatal("TLS caFile must be set to verify the callers of the gRPC inbound")
+		}
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		options = append(options, grpc.InboundCredentials(credentials.NewTLS(tlsConfig)))
+	}
+	d.logger.Info("Created gRPC inbound and listening", tag.Service(d.serviceName), tag.Address(hostAddress))
+	return d.grpc.NewInbound(listener, options...)
+}
+
+// CreateHTTPListener creates the listener of the HTTP gateway, it returns nil if the gateway is not configured
+func (d *RPCFactory) CreateHTTPListener() net.Listener {
+	if d.config.HTTPPort == 0 {
+		return nil
+	}
+	if d.config.HTTPPort == d.config.Port || d.config.HTTPPort == d.config.GRPCPort ||
+		(d.config.TLS.Enabled && d.config.TLS.Port != 0 && d.config.HTTPPort == d.config.TLS.Port) {
+		d.logger.Fatal("HTTP port must differ from the rpc, gRPC and TLS ports")
+	}
+	listenIP := d.getListenIP()
+	if d.config.TLS.Enabled {
+		listenIP = d.getTLSListenIP()
+	}
+	hostAddress := fmt.Sprintf("%v:%v", listenIP, d.config.HTTPPort)
+	listener, err := net.Listen("tcp", hostAddress)
+	if err != nil {
+		d.logger.Fatal("Failed to listen for HTTP", tag.Error(err))
+	}
+	if d.config.TLS.Enabled {
+		listener = tls.NewListener(listener, d.getTLSConfig())
+	}
+	d.logger.Info("Created HTTP listener", tag.Service(d.serviceName), tag.Address(hostAddress))
+	return listener
+}
+
 // CreateDispatcherForOutbound creates a dispatcher for outbound connection
 func (d *RPCFactory) CreateDispatcherForOutbound(
 	callerName, serviceName, hostName string) *yarpc.Dispatcher {
 	// Setup dispatcher(outbound) for onebox
+	var outbound transport.UnaryOutbound = d.ch.NewSingleOutbound(hostName)
+	if d.config.TLS.Enabled {
+		outbound = d.createTLSOutbound(serviceName, hostName)
+	}
 	d.logger.Info("Created RPC dispatcher outbound", tag.Service(d.serviceName), tag.Address(hostName))
 	dispatcher := yarpc.NewDispatcher(yarpc.Config{
 		Name: callerName,
 		Outbounds: yarpc.Outbounds{
-			serviceName: {Unary: d.ch.NewSingleOutbound(hostName)},
+			serviceName: {Unary: outbound},
 		},
 	})
 	if err := dispatcher.Start(); err != nil {
@@ -84,6 +201,51 @@ func (d *RPCFactory) CreateDispatcherForOutbound(
 	return dispatcher
 }
 
+// createTLSOutbound creates an outbound calling the gRPC inbound of the host with mutual TLS, the host
+// is identified by the address of its plain inbound, which is the address membership knows it by
+func (d *RPCFactory) createTLSOutbound(serviceName, hostName string) transport.UnaryOutbound {
+	host, _, err := net.SplitHostPort(hostName)
+	if err != nil {
+		d.logger.Fatal("Failed to parse the address of the called host", tag.Address(hostName), tag.Error(err))
+	}
+	grpcPort := d.grpcPorts[serviceName]
+	if grpcPort == 0 {
+		d.logger.Fatal("gRPC port of the called service must be set if TLS is enabled", tag.Service(serviceName))
+	}
+	tlsConfig, err := d.config.TLS.NewClientConfig()
+	if err != nil {
+		d.logger.Fatal("Failed to create TLS client config", tag.Error(err))
+	}
+	// every outbound has its own transport, since stopping a dispatcher stops the transports of its outbounds
+	grpcTransport := grpc.NewTransport()
+	chooser := peer.NewSingle(
+		hostport.PeerIdentifier(net.JoinHostPort(host, strconv.Itoa(grpcPort))),
+		grpcTransport.NewDialer(grpc.DialerCredentials(credentials.NewTLS(tlsConfig))),
+	)
+	return grpcTransport.NewOutbound(chooser)
+}
+
+func (d *RPCFactory) getTLSConfig() *tls.Config {
+	tlsConfig, err := d.config.TLS.NewServerConfig()
+	if err != nil {
+		d.logger.Fatal("Failed to create TLS config", tag.Error(err))
+	}
+	return tlsConfig
+}
+
+// getTLSListenIP returns the address the encrypted ports bind to, which may differ from the address
+// of the plain inbound so that only the encrypted ports are exposed outside of the cluster network
+func (d *RPCFactory) getTLSListenIP() net.IP {
+	if len(d.config.TLS.BindOnIP) == 0 {
+		return d.getListenIP()
+	}
+	ip := net.ParseIP(d.config.TLS.BindOnIP)
+	if ip == nil || ip.To4() == nil {
+		d.logger.Fatal("TLS ListenIP failed, unable to parse bindOnIP value or it is not IPv4 address", tag.Address(d.config.TLS.BindOnIP))
+	}
+	return ip.To4()
+}
+
 func (d *RPCFactory) getListenIP() net.IP {
 	if d.config.BindOnLocalHost && len(d.config.BindOnIP) > 0 {
 		d.logger.Fatal("ListenIP failed, bindOnLocalHost and bindOnIP are mutually exclusive")
@@ -0,0 +1,135 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+// NewServerConfig builds the tls config of the TLS inbounds from the certificate files
+func (t *TLS) NewServerConfig() (*tls.Config, error) {
+	cert, err := t.loadCertificate()
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if t.CaFile != "" {
+		pool, err := t.loadCAs()
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	if t.RequireClientAuth {
+		if config.ClientCAs == nil {
+			return nil, errors.New("caFile is required to verify client certificates")
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
+
+// NewClientConfig builds the tls config the cadence hosts call each other with, they present the
+// certificate of their own inbounds and verify the certificate of the called host against the CA
+func (t *TLS) NewClientConfig() (*tls.Config, error) {
+	cert, err := t.loadCertificate()
+	if err != nil {
+		return nil, err
+	}
+	if t.CaFile == "" {
+		return nil, errors.New("caFile is required to verify the called hosts")
+	}
+	pool, err := t.loadCAs()
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   t.ServerName,
+		MinVersion:   tls.VersionTLS12,
+	}
+	if t.ServerName == "" {
+		// the hosts are called by their IP, which their certificates need not name,
+		// so the certificate chain is verified without its name
+		config.InsecureSkipVerify = true
+		config.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return verifyCertificateChain(rawCerts, pool)
+		}
+	}
+	return config, nil
+}
+
+func (t *TLS) loadCertificate() (tls.Certificate, error) {
+	if t.CertFile == "" || t.KeyFile == "" {
+		return tls.Certificate{}, errors.New("certFile and keyFile are required to serve TLS")
+	}
+	cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("unable to load certificate: %v", err)
+	}
+	return cert, nil
+}
+
+func (t *TLS) loadCAs() (*x509.CertPool, error) {
+	caPEM, err := ioutil.ReadFile(t.CaFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read CA certificates: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no CA certificate found in %v", t.CaFile)
+	}
+	return pool, nil
+}
+
+func verifyCertificateChain(rawCerts [][]byte, roots *x509.CertPool) error {
+	if len(rawCerts) == 0 {
+		return errors.New("the called host presented no certificate")
+	}
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("unable to parse the certificate of the called host: %v", err)
+		}
+		certs[i] = cert
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	return err
+}
@@ -0,0 +1,320 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/health"
+	"github.com/uber/cadence/.gen/go/health/metaclient"
+	"github.com/uber/cadence/.gen/go/health/metaserver"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/peer"
+	"go.uber.org/yarpc/peer/hostport"
+	"go.uber.org/yarpc/transport/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type (
+	tlsSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		dir        string
+		caFile     string
+		certFile   string
+		keyFile    string
+		clientCert tls.Certificate
+		rootCAs    *x509.CertPool
+	}
+
+	healthHandler struct{}
+)
+
+func TestTLSSuite(t *testing.T) {
+	suite.Run(t, new(tlsSuite))
+}
+
+func (s *tlsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	var err error
+	s.dir, err = ioutil.TempDir("", "config.tlsSuite")
+	s.NoError(err)
+
+	caTemplate := s.newTemplate(1, "test ca")
+	caTemplate.IsCA = true
+	caTemplate.BasicConstraintsValid = true
+	caTemplate.KeyUsage = x509.KeyUsageCertSign
+	caKey, caCert, caPEM := s.newCert(caTemplate, nil, nil)
+	s.caFile = s.writeFile("ca.pem", caPEM)
+	s.rootCAs = x509.NewCertPool()
+	s.rootCAs.AddCert(caCert)
+
+	serverTemplate := s.newTemplate(2, "127.0.0.1")
+	serverTemplate.IPAddresses = []net.IP{net.IPv4(127, 0, 0, 1)}
+	// the cadence hosts present the certificate of their inbounds when they call each other
+	serverTemplate.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	serverKey, _, serverPEM := s.newCert(serverTemplate, caCert, caKey)
+	s.certFile = s.writeFile("server.pem", serverPEM)
+	s.keyFile = s.writeFile("server-key.pem", s.encodeKey(serverKey))
+
+	clientTemplate := s.newTemplate(3, "test client")
+	clientTemplate.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	clientKey, _, clientPEM := s.newCert(clientTemplate, caCert, caKey)
+	s.clientCert, err = tls.X509KeyPair(clientPEM, s.encodeKey(clientKey))
+	s.NoError(err)
+}
+
+func (s *tlsSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *tlsSuite) TestNewServerConfig() {
+	cfg := &TLS{CertFile: s.certFile, KeyFile: s.keyFile}
+	tlsConfig, err := cfg.NewServerConfig()
+	s.NoError(err)
+	s.Len(tlsConfig.Certificates, 1)
+	s.Equal(tls.NoClientCert, tlsConfig.ClientAuth)
+
+	cfg.CaFile = s.caFile
+	tlsConfig, err = cfg.NewServerConfig()
+	s.NoError(err)
+	s.Equal(tls.VerifyClientCertIfGiven, tlsConfig.ClientAuth)
+
+	cfg.RequireClientAuth = true
+	tlsConfig, err = cfg.NewServerConfig()
+	s.NoError(err)
+	s.Equal(tls.RequireAndVerifyClientCert, tlsConfig.ClientAuth)
+}
+
+func (s *tlsSuite) TestNewServerConfig_Invalid() {
+	for _, cfg := range []*TLS{
+		{KeyFile: s.keyFile},
+		{CertFile: s.certFile, KeyFile: s.certFile},
+		{CertFile: s.certFile, KeyFile: s.keyFile, CaFile: filepath.Join(s.dir, "missing.pem")},
+		{CertFile: s.certFile, KeyFile: s.keyFile, CaFile: s.keyFile},
+		{CertFile: s.certFile, KeyFile: s.keyFile, RequireClientAuth: true},
+	} {
+		_, err := cfg.NewServerConfig()
+		s.Error(err)
+	}
+}
+
+func (s *tlsSuite) TestCreateDispatcher_MutualTLS() {
+	cfg := &RPC{
+		Port:            s.getFreePort(),
+		GRPCPort:        s.getFreePort(),
+		BindOnLocalHost: true,
+		TLS: TLS{
+			Enabled:           true,
+			Port:              s.getFreePort(),
+			CertFile:          s.certFile,
+			KeyFile:           s.keyFile,
+			CaFile:            s.caFile,
+			RequireClientAuth: true,
+		},
+	}
+	dispatcher := cfg.NewFactory("test-service", loggerimpl.NewNopLogger(), nil).CreateDispatcher()
+	s.Len(dispatcher.Inbounds(), 3)
+	s.NoError(dispatcher.Start())
+	defer dispatcher.Stop()
+
+	address := fmt.Sprintf("127.0.0.1:%v", cfg.TLS.Port)
+	conn, err := tls.Dial("tcp", address, &tls.Config{
+		RootCAs:      s.rootCAs,
+		Certificates: []tls.Certificate{s.clientCert},
+	})
+	s.NoError(err)
+	s.NoError(conn.Handshake())
+	conn.Close()
+
+	conn, err = tls.Dial("tcp", address, &tls.Config{RootCAs: s.rootCAs})
+	if err == nil {
+		// the server rejects the missing client certificate after the client side of the handshake completed
+		conn.SetDeadline(time.Now().Add(5 * time.Second))
+		_, err = conn.Read(make([]byte, 1))
+		conn.Close()
+	}
+	s.Error(err)
+}
+
+func (s *tlsSuite) TestCreateDispatcher_TLSBindOnIP() {
+	cfg := &RPC{
+		Port:            s.getFreePort(),
+		GRPCPort:        s.getFreePort(),
+		BindOnLocalHost: true,
+		TLS: TLS{
+			Enabled:  true,
+			Port:     s.getFreePort(),
+			BindOnIP: "127.0.0.2",
+			CertFile: s.certFile,
+			KeyFile:  s.keyFile,
+			CaFile:   s.caFile,
+		},
+	}
+	dispatcher := cfg.NewFactory("test-service", loggerimpl.NewNopLogger(), nil).CreateDispatcher()
+	s.NoError(dispatcher.Start())
+	defer dispatcher.Stop()
+
+	conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.2:%v", cfg.TLS.Port))
+	s.NoError(err)
+	conn.Close()
+
+	_, err = net.Dial("tcp", fmt.Sprintf("127.0.0.1:%v", cfg.TLS.Port))
+	s.Error(err)
+	_, err = net.Dial("tcp", fmt.Sprintf("127.0.0.2:%v", cfg.Port))
+	s.Error(err)
+}
+
+func (s *tlsSuite) TestNewClientConfig() {
+	cfg := &TLS{CertFile: s.certFile, KeyFile: s.keyFile, CaFile: s.caFile}
+	tlsConfig, err := cfg.NewClientConfig()
+	s.NoError(err)
+	s.Len(tlsConfig.Certificates, 1)
+	s.True(tlsConfig.InsecureSkipVerify)
+	s.NotNil(tlsConfig.VerifyPeerCertificate)
+
+	cfg.ServerName = "some random server name"
+	tlsConfig, err = cfg.NewClientConfig()
+	s.NoError(err)
+	s.False(tlsConfig.InsecureSkipVerify)
+	s.Equal("some random server name", tlsConfig.ServerName)
+
+	_, err = (&TLS{CertFile: s.certFile, KeyFile: s.keyFile}).NewClientConfig()
+	s.Error(err)
+}
+
+func (s *tlsSuite) TestCreateDispatcherForOutbound_MutualTLS() {
+	cfg := &RPC{
+		Port:            s.getFreePort(),
+		GRPCPort:        s.getFreePort(),
+		BindOnLocalHost: true,
+		TLS: TLS{
+			Enabled:  true,
+			CertFile: s.certFile,
+			KeyFile:  s.keyFile,
+			CaFile:   s.caFile,
+		},
+	}
+	grpcPorts := map[string]int{"test-service": cfg.GRPCPort}
+	factory := cfg.NewFactory("test-service", loggerimpl.NewNopLogger(), grpcPorts)
+	dispatcher := factory.CreateDispatcher()
+	s.Len(dispatcher.Inbounds(), 2)
+	dispatcher.Register(metaserver.New(&healthHandler{}))
+	s.NoError(dispatcher.Start())
+	defer dispatcher.Stop()
+
+	// the host is called on its gRPC port although membership knows it by its plain port
+	outboundDispatcher := factory.CreateDispatcherForOutbound(
+		"test-caller", "test-service", fmt.Sprintf("127.0.0.1:%v", cfg.Port))
+	defer outboundDispatcher.Stop()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	status, err := metaclient.New(outboundDispatcher.ClientConfig("test-service")).Health(ctx)
+	s.NoError(err)
+	s.True(status.GetOk())
+
+	// callers without a client certificate are rejected
+	grpcTransport := grpc.NewTransport()
+	outbound := grpcTransport.NewOutbound(peer.NewSingle(
+		hostport.PeerIdentifier(fmt.Sprintf("127.0.0.1:%v", cfg.GRPCPort)),
+		grpcTransport.NewDialer(grpc.DialerCredentials(credentials.NewTLS(&tls.Config{RootCAs: s.rootCAs}))),
+	))
+	noCertDispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name:      "test-caller",
+		Outbounds: yarpc.Outbounds{"test-service": {Unary: outbound}},
+	})
+	s.NoError(noCertDispatcher.Start())
+	defer noCertDispatcher.Stop()
+	ctx, cancel = context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, err = metaclient.New(noCertDispatcher.ClientConfig("test-service")).Health(ctx)
+	s.Error(err)
+}
+
+func (s *tlsSuite) newTemplate(serial int64, commonName string) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+}
+
+func (s *tlsSuite) newCert(
+	template *x509.Certificate,
+	parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+) (*ecdsa.PrivateKey, *x509.Certificate, []byte) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	s.NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	s.NoError(err)
+	return key, cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func (s *tlsSuite) encodeKey(key *ecdsa.PrivateKey) []byte {
+	der, err := x509.MarshalECPrivateKey(key)
+	s.NoError(err)
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func (s *tlsSuite) writeFile(name string, content []byte) string {
+	path := filepath.Join(s.dir, name)
+	s.NoError(ioutil.WriteFile(path, content, 0600))
+	return path
+}
+
+func (s *tlsSuite) getFreePort() int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+func (h *healthHandler) Health(ctx context.Context) (*health.HealthStatus, error) {
+	return &health.HealthStatus{Ok: true}, nil
+}
@@ -123,6 +123,8 @@ var keys = map[Key]string{
 	EventsCacheMaxSize:                                    "history.eventsCacheMaxSize",
 	EventsCacheTTL:                                        "history.eventsCacheTTL",
 	AcquireShardInterval:                                  "history.acquireShardInterval",
+	EnableGracefulShardHandoff:                            "history.enableGracefulShardHandoff",
+	ShardHandoffTimeout:                                   "history.shardHandoffTimeout",
 	StandbyClusterDelay:                                   "history.standbyClusterDelay",
 	TimerTaskBatchSize:                                    "history.timerTaskBatchSize",
 	TimerTaskWorkerCount:                                  "history.timerTaskWorkerCount",
@@ -156,6 +158,11 @@ var keys = map[Key]string{
 	TransferProcessorUpdateAckInterval:                    "history.transferProcessorUpdateAckInterval",
 	TransferProcessorUpdateAckIntervalJitterCoefficient:   "history.transferProcessorUpdateAckIntervalJitterCoefficient",
 	TransferProcessorCompleteTransferInterval:             "history.transferProcessorCompleteTransferInterval",
+	EnableTaskDLQ:                                         "history.enableTaskDLQ",
+	EnableTaskScheduler:                                   "history.enableTaskScheduler",
+	TaskSchedulerWorkerCount:                              "history.taskSchedulerWorkerCount",
+	TaskSchedulerDomainWeight:                             "history.taskSchedulerDomainWeight",
+	TaskSchedulerDomainMaxConcurrency:                     "history.taskSchedulerDomainMaxConcurrency",
 	ReplicatorTaskBatchSize:                               "history.replicatorTaskBatchSize",
 	ReplicatorTaskWorkerCount:                             "history.replicatorTaskWorkerCount",
 	ReplicatorTaskMaxRetryCount:                           "history.replicatorTaskMaxRetryCount",
@@ -170,12 +177,14 @@ var keys = map[Key]string{
 	HistoryMgrNumConns:                                    "history.historyMgrNumConns",
 	MaximumBufferedEventsBatch:                            "history.maximumBufferedEventsBatch",
 	MaximumSignalsPerExecution:                            "history.maximumSignalsPerExecution",
+	MaximumPendingUpdatesPerExecution:                     "history.maximumPendingUpdatesPerExecution",
 	ShardUpdateMinInterval:                                "history.shardUpdateMinInterval",
 	ShardSyncMinInterval:                                  "history.shardSyncMinInterval",
 	DefaultEventEncoding:                                  "history.defaultEventEncoding",
 	EnableAdminProtection:                                 "history.enableAdminProtection",
 	AdminOperationToken:                                   "history.adminOperationToken",
 	EnableEventsV2:                                        "history.enableEventsV2",
+	EnableParentClosePolicy:                               "history.enableParentClosePolicy",
 	NumArchiveSystemWorkflows:                             "history.numArchiveSystemWorkflows",
 	ArchiveRequestRPS:                                     "history.archiveRequestRPS",
 	EmitShardDiffLog:                                      "history.emitShardDiffLog",
@@ -202,8 +211,15 @@ var keys = map[Key]string{
 	WorkerDeterministicConstructionCheckProbability: "worker.DeterministicConstructionCheckProbability",
 	WorkerBlobIntegrityCheckProbability:             "worker.BlobIntegrityCheckProbability",
 	WorkerTimeLimitPerArchivalIteration:             "worker.TimeLimitPerArchivalIteration",
+	WorkerEnableArchivalVerification:                "worker.EnableArchivalVerification",
+	WorkerEnableArchivalScanner:                     "worker.EnableArchivalScanner",
+	WorkerArchivalScannerSampleSize:                 "worker.ArchivalScannerSampleSize",
 	WorkerThrottledLogRPS:                           "worker.throttledLogRPS",
 	ScannerPersistenceMaxQPS:                        "worker.scannerPersistenceMaxQPS",
+	ExecutionsScannerEnabled:                        "worker.executionsScannerEnabled",
+	ExecutionsScannerConcurrency:                    "worker.executionsScannerConcurrency",
+	ExecutionsScannerPersistencePageSize:            "worker.executionsScannerPersistencePageSize",
+	ExecutionsScannerFixEnabled:                     "worker.executionsScannerFixEnabled",
 }
 
 const (
@@ -364,6 +380,12 @@ const (
 	EventsCacheTTL
 	// AcquireShardInterval is interval that timer used to acquire shard
 	AcquireShardInterval
+	// EnableGracefulShardHandoff is whether shards are drained and explicitly handed off to their new owner,
+	// when the host stops or when the ring membership changes
+	EnableGracefulShardHandoff
+	// ShardHandoffTimeout is the max time to drain a shard before handing it off, and the max time the new owner
+	// keeps a handed off shard while its ring membership has not converged yet
+	ShardHandoffTimeout
 	// StandbyClusterDelay is the atrificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay
 	// TimerTaskBatchSize is batch size for timer processor to process tasks
@@ -430,6 +452,17 @@ const (
 	TransferProcessorUpdateAckIntervalJitterCoefficient
 	// TransferProcessorCompleteTransferInterval is complete timer interval for transferQueueProcessor
 	TransferProcessorCompleteTransferInterval
+	// EnableTaskDLQ is whether transfer and timer tasks exceeding max retry count are moved to the shard's DLQ
+	EnableTaskDLQ
+	// EnableTaskScheduler is whether transfer, timer and replication tasks are executed by the task scheduler
+	// shared by all the shards of the host, instead of the workers of each queue processor
+	EnableTaskScheduler
+	// TaskSchedulerWorkerCount is the number of workers of the task scheduler shared by all the shards of the host
+	TaskSchedulerWorkerCount
+	// TaskSchedulerDomainWeight is the number of tasks of a domain scheduled in each round robin of the task scheduler
+	TaskSchedulerDomainWeight
+	// TaskSchedulerDomainMaxConcurrency is the max number of tasks of a domain executed concurrently by the task scheduler
+	TaskSchedulerDomainMaxConcurrency
 	// ReplicatorTaskBatchSize is batch size for ReplicatorProcessor
 	ReplicatorTaskBatchSize
 	// ReplicatorTaskWorkerCount is number of worker for ReplicatorProcessor
@@ -458,6 +491,8 @@ const (
 	MaximumBufferedEventsBatch
 	// MaximumSignalsPerExecution is max number of signals supported by single execution
 	MaximumSignalsPerExecution
+	// MaximumPendingUpdatesPerExecution is max number of updates waiting for the worker in single execution
+	MaximumPendingUpdatesPerExecution
 	// ShardUpdateMinInterval is the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval
 	// ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote
@@ -478,6 +513,8 @@ const (
 
 	// EnableEventsV2 is whether to use eventsV2
 	EnableEventsV2
+	// EnableParentClosePolicy is whether to terminate or cancel the running children of a workflow when it closes
+	EnableParentClosePolicy
 	// HistoryThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	HistoryThrottledLogRPS
 
@@ -525,10 +562,24 @@ const (
 	WorkerBlobIntegrityCheckProbability
 	// WorkerTimeLimitPerArchivalIteration controls the time limit of each iteration of archival workflow
 	WorkerTimeLimitPerArchivalIteration
+	// WorkerEnableArchivalVerification controls whether archived history is read back and compared with the persisted history before it gets deleted
+	WorkerEnableArchivalVerification
+	// WorkerEnableArchivalScanner controls whether the archival scanner workflow checks the integrity of sampled archived histories
+	WorkerEnableArchivalScanner
+	// WorkerArchivalScannerSampleSize controls the number of archived histories checked per domain in each run of archival scanner workflow
+	WorkerArchivalScannerSampleSize
 	// WorkerThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	WorkerThrottledLogRPS
 	// ScannerPersistenceMaxQPS is the maximum rate of persistence calls from worker.Scanner
 	ScannerPersistenceMaxQPS
+	// ExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner
+	ExecutionsScannerEnabled
+	// ExecutionsScannerConcurrency is the number of shards scanned concurrently by the executions scanner
+	ExecutionsScannerConcurrency
+	// ExecutionsScannerPersistencePageSize is the page size used by the executions scanner when listing executions
+	ExecutionsScannerPersistencePageSize
+	// ExecutionsScannerFixEnabled indicates if the executions scanner should delete corrupted and orphaned executions it finds
+	ExecutionsScannerFixEnabled
 	// EnableBatcher decides whether start batcher in our worker
 	EnableBatcher
 
@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	es "github.com/uber/cadence/common/elasticsearch"
@@ -76,6 +77,8 @@ type (
 		DCRedirectionPolicy config.DCRedirectionPolicy
 		PublicClient        workflowserviceclient.Interface
 		ArchiverProvider    provider.ArchiverProvider
+		Authorizer          authorization.Authorizer
+		Authenticator       authorization.Authenticator
 	}
 
 	// MembershipMonitorFactory provides a bootstrapped membership monitor
@@ -46,6 +46,29 @@ type (
 		Nack()
 	}
 
+	// Scheduler is the generic coroutine pool interface which is shared by multiple task producers
+	// and schedules their tasks fairly across domains
+	Scheduler interface {
+		common.Daemon
+		Submit(task DomainTask) error
+	}
+
+	// DomainTask is the interface for tasks which are scheduled according to the domain they belong to
+	DomainTask interface {
+		// Domain returns the name of the domain the task belongs to
+		Domain() string
+		// Execute process this task
+		Execute() error
+		// HandleErr handle the error returned by Execute
+		HandleErr(err error) error
+		// RetryErr check whether to retry after HandleErr(Execute())
+		RetryErr(err error) bool
+		// Ack marks the task as successful completed
+		Ack()
+		// Nack marks the task as unsuccessful completed
+		Nack()
+	}
+
 	// SequentialTaskQueueFactory is the function which generate a new SequentialTaskQueue
 	// for a give SequentialTask
 	SequentialTaskQueueFactory func(task SequentialTask) SequentialTaskQueue
@@ -0,0 +1,321 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// WeightedRoundRobinSchedulerOptions is the options for the weighted round robin scheduler
+	WeightedRoundRobinSchedulerOptions struct {
+		// WorkerCount is the number of coroutines executing tasks
+		WorkerCount int
+		// DomainWeight is the number of tasks of a domain scheduled in each round
+		DomainWeight dynamicconfig.IntPropertyFnWithDomainFilter
+		// DomainMaxConcurrency is the max number of tasks of a domain executed concurrently, non positive means no limit
+		DomainMaxConcurrency dynamicconfig.IntPropertyFnWithDomainFilter
+		// RetryPolicy is the backoff before a failed task is executed again, tasks are retried immediately if not set
+		RetryPolicy backoff.RetryPolicy
+	}
+
+	weightedRoundRobinSchedulerImpl struct {
+		status       int32
+		shutdownChan chan struct{}
+		waitGroup    sync.WaitGroup
+
+		options *WeightedRoundRobinSchedulerOptions
+
+		sync.Mutex
+		taskAvailableCond *sync.Cond
+		domainQueues      map[string]*domainTaskQueue
+		// domains with pending or in flight tasks, visited in round robin
+		domainRing []*domainTaskQueue
+		cursor     int
+		// tasks waiting for their retry backoff to elapse
+		retryTimers map[*scheduledTask]*time.Timer
+
+		metricsScope  int
+		metricsClient metrics.Client
+		logger        log.Logger
+	}
+
+	domainTaskQueue struct {
+		domain   string
+		tasks    *list.List
+		inFlight int
+		// number of tasks the domain can still be scheduled in the current round
+		credit int
+	}
+
+	scheduledTask struct {
+		DomainTask
+		submitTime time.Time
+		attempt    int
+	}
+)
+
+// ErrSchedulerStopped is the error returned when a task is submitted to a stopped scheduler
+var ErrSchedulerStopped = errors.New("task scheduler is stopped")
+
+// NewWeightedRoundRobinScheduler creates a new scheduler which executes the tasks of each domain in FIFO order,
+// and round robins across domains, scheduling up to the domain weight number of tasks of a domain in each round
+func NewWeightedRoundRobinScheduler(options *WeightedRoundRobinSchedulerOptions, metricsClient metrics.Client,
+	logger log.Logger) Scheduler {
+
+	s := &weightedRoundRobinSchedulerImpl{
+		status:       common.DaemonStatusInitialized,
+		shutdownChan: make(chan struct{}),
+		options:      options,
+		domainQueues: make(map[string]*domainTaskQueue),
+		retryTimers:  make(map[*scheduledTask]*time.Timer),
+
+		metricsScope:  metrics.TaskSchedulerScope,
+		metricsClient: metricsClient,
+		logger:        logger,
+	}
+	s.taskAvailableCond = sync.NewCond(&s.Mutex)
+	return s
+}
+
+func (s *weightedRoundRobinSchedulerImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	s.waitGroup.Add(s.options.WorkerCount)
+	for i := 0; i < s.options.WorkerCount; i++ {
+		go s.pollAndProcessTask()
+	}
+	s.logger.Info("Task scheduler started.")
+}
+
+func (s *weightedRoundRobinSchedulerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(s.shutdownChan)
+	s.Lock()
+	s.taskAvailableCond.Broadcast()
+	s.Unlock()
+	if success := common.AwaitWaitGroup(&s.waitGroup, time.Minute); !success {
+		s.logger.Warn("Task scheduler timeout trying to stop.")
+	}
+
+	// tasks which were never executed are nacked so that their producers are not left waiting
+	s.Lock()
+	var pendingTasks []*scheduledTask
+	for _, queue := range s.domainRing {
+		for element := queue.tasks.Front(); element != nil; element = element.Next() {
+			pendingTasks = append(pendingTasks, element.Value.(*scheduledTask))
+		}
+		queue.tasks.Init()
+	}
+	for task, timer := range s.retryTimers {
+		// a timer which already fired requeues its task, which then sees the scheduler stopped
+		if timer.Stop() {
+			pendingTasks = append(pendingTasks, task)
+		}
+	}
+	s.retryTimers = make(map[*scheduledTask]*time.Timer)
+	s.Unlock()
+	for _, task := range pendingTasks {
+		task.Nack()
+	}
+	s.logger.Info("Task scheduler stopped.")
+}
+
+func (s *weightedRoundRobinSchedulerImpl) Submit(task DomainTask) error {
+	domain := task.Domain()
+	scope := s.metricsClient.Scope(s.metricsScope, metrics.DomainTag(domain))
+	scope.IncCounter(metrics.TaskSchedulerSubmitRequest)
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.isStopped() {
+		return ErrSchedulerStopped
+	}
+
+	s.enqueueLocked(&scheduledTask{
+		DomainTask: task,
+		submitTime: time.Now(),
+	})
+	return nil
+}
+
+func (s *weightedRoundRobinSchedulerImpl) enqueueLocked(task *scheduledTask) {
+	domain := task.Domain()
+	queue, ok := s.domainQueues[domain]
+	if !ok {
+		queue = &domainTaskQueue{
+			domain: domain,
+			tasks:  list.New(),
+		}
+		s.domainQueues[domain] = queue
+		s.domainRing = append(s.domainRing, queue)
+	}
+	queue.tasks.PushBack(task)
+	s.taskAvailableCond.Signal()
+}
+
+// requeueTask adds a task back to its domain queue once its retry backoff elapsed
+func (s *weightedRoundRobinSchedulerImpl) requeueTask(task *scheduledTask) {
+	s.Lock()
+	delete(s.retryTimers, task)
+	if s.isStopped() {
+		s.Unlock()
+		task.Nack()
+		return
+	}
+	s.enqueueLocked(task)
+	s.Unlock()
+}
+
+func (s *weightedRoundRobinSchedulerImpl) pollAndProcessTask() {
+	defer s.waitGroup.Done()
+
+	for {
+		task, queue := s.pollTask()
+		if task == nil {
+			return
+		}
+		s.processTask(task, queue)
+	}
+}
+
+// pollTask blocks until a task can be scheduled, or returns nil if the scheduler is stopped
+func (s *weightedRoundRobinSchedulerImpl) pollTask() (*scheduledTask, *domainTaskQueue) {
+	s.Lock()
+	defer s.Unlock()
+
+	for {
+		if s.isStopped() {
+			return nil, nil
+		}
+		if task, queue := s.pollTaskLocked(); task != nil {
+			return task, queue
+		}
+		s.taskAvailableCond.Wait()
+	}
+}
+
+func (s *weightedRoundRobinSchedulerImpl) pollTaskLocked() (*scheduledTask, *domainTaskQueue) {
+	for steps := len(s.domainRing); steps > 0; steps-- {
+		queue := s.domainRing[s.cursor]
+		if queue.tasks.Len() == 0 {
+			if queue.inFlight == 0 {
+				s.removeDomainLocked()
+			} else {
+				s.advanceLocked()
+			}
+			continue
+		}
+
+		if maxConcurrency := s.options.DomainMaxConcurrency(queue.domain); maxConcurrency > 0 && queue.inFlight >= maxConcurrency {
+			s.advanceLocked()
+			continue
+		}
+
+		if queue.credit <= 0 {
+			queue.credit = common.MaxInt(s.options.DomainWeight(queue.domain), 1)
+		}
+		task := queue.tasks.Remove(queue.tasks.Front()).(*scheduledTask)
+		queue.inFlight++
+		queue.credit--
+		if queue.credit == 0 {
+			s.advanceLocked()
+		}
+		return task, queue
+	}
+	return nil, nil
+}
+
+func (s *weightedRoundRobinSchedulerImpl) advanceLocked() {
+	s.domainRing[s.cursor].credit = 0
+	s.cursor = (s.cursor + 1) % len(s.domainRing)
+}
+
+func (s *weightedRoundRobinSchedulerImpl) removeDomainLocked() {
+	delete(s.domainQueues, s.domainRing[s.cursor].domain)
+	s.domainRing = append(s.domainRing[:s.cursor], s.domainRing[s.cursor+1:]...)
+	if s.cursor >= len(s.domainRing) {
+		s.cursor = 0
+	}
+}
+
+func (s *weightedRoundRobinSchedulerImpl) processTask(task *scheduledTask, queue *domainTaskQueue) {
+	scope := s.metricsClient.Scope(s.metricsScope, metrics.DomainTag(queue.domain))
+	scope.RecordTimer(metrics.TaskSchedulerQueueLatency, time.Since(task.submitTime))
+
+	metricsTimer := scope.StartTimer(metrics.TaskSchedulerProcessingLatency)
+	err := task.Execute()
+	err = task.HandleErr(err)
+	metricsTimer.Stop()
+
+	retry := err != nil && task.RetryErr(err)
+	retryDelay := time.Duration(0)
+	if retry && s.options.RetryPolicy != nil {
+		retryDelay = s.options.RetryPolicy.ComputeNextDelay(time.Since(task.submitTime), task.attempt)
+		task.attempt++
+		retry = retryDelay >= 0
+	}
+
+	s.Lock()
+	queue.inFlight--
+	if retry && retryDelay > 0 {
+		s.retryTimers[task] = time.AfterFunc(retryDelay, func() {
+			s.requeueTask(task)
+		})
+	} else if retry {
+		// the task yields to the other tasks of the domain, and to the other domains
+		queue.tasks.PushBack(task)
+	}
+	s.taskAvailableCond.Signal()
+	s.Unlock()
+
+	if err == nil {
+		task.Ack()
+	} else if !retry {
+		scope.IncCounter(metrics.TaskSchedulerTaskFailures)
+		task.Nack()
+	}
+}
+
+func (s *weightedRoundRobinSchedulerImpl) isStopped() bool {
+	select {
+	case <-s.shutdownChan:
+		return true
+	default:
+		return false
+	}
+}
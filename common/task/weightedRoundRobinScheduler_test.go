@@ -0,0 +1,348 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	WeightedRoundRobinSchedulerSuite struct {
+		suite.Suite
+		domainWeights        map[string]int
+		domainMaxConcurrency map[string]int
+		scheduler            *weightedRoundRobinSchedulerImpl
+	}
+
+	testDomainTaskImpl struct {
+		waitgroup *sync.WaitGroup
+		domain    string
+		taskID    int
+		// errors returned by the consecutive executions of the task
+		errs []error
+
+		lock     sync.Mutex
+		executed int
+		acked    int
+		nacked   int
+	}
+)
+
+var errTestRetryable = errors.New("retryable error")
+
+func TestWeightedRoundRobinSchedulerSuite(t *testing.T) {
+	suite.Run(t, new(WeightedRoundRobinSchedulerSuite))
+}
+
+func (s *WeightedRoundRobinSchedulerSuite) SetupTest() {
+	s.domainWeights = make(map[string]int)
+	s.domainMaxConcurrency = make(map[string]int)
+	s.scheduler = s.newScheduler(1)
+}
+
+func (s *WeightedRoundRobinSchedulerSuite) newScheduler(workerCount int) *weightedRoundRobinSchedulerImpl {
+	logger, err := loggerimpl.NewDevelopment()
+	s.Nil(err)
+	return NewWeightedRoundRobinScheduler(
+		&WeightedRoundRobinSchedulerOptions{
+			WorkerCount: workerCount,
+			DomainWeight: func(domain string) int {
+				return s.domainWeights[domain]
+			},
+			DomainMaxConcurrency: func(domain string) int {
+				return s.domainMaxConcurrency[domain]
+			},
+		},
+		metrics.NewClient(tally.NoopScope, metrics.Common),
+		logger,
+	).(*weightedRoundRobinSchedulerImpl)
+}
+
+func (s *WeightedRoundRobinSchedulerSuite) TestPollTask_WeightedRoundRobin() {
+	s.domainWeights["domain-a"] = 2
+	s.domainWeights["domain-b"] = 1
+
+	// do not start the scheduler
+	for _, task := range []*testDomainTaskImpl{
+		newTestDomainTaskImpl(nil, "domain-a", 1),
+		newTestDomainTaskImpl(nil, "domain-a", 2),
+		newTestDomainTaskImpl(nil, "domain-a", 3),
+		newTestDomainTaskImpl(nil, "domain-b", 1),
+		newTestDomainTaskImpl(nil, "domain-b", 2),
+	} {
+		s.Nil(s.scheduler.Submit(task))
+	}
+
+	var polled []string
+	for i := 0; i < 5; i++ {
+		task, _ := s.scheduler.pollTaskLocked()
+		s.NotNil(task)
+		polled = append(polled, task.DomainTask.(*testDomainTaskImpl).String())
+	}
+	s.Equal([]string{"domain-a-1", "domain-a-2", "domain-b-1", "domain-a-3", "domain-b-2"}, polled)
+
+	task, _ := s.scheduler.pollTaskLocked()
+	s.Nil(task)
+}
+
+func (s *WeightedRoundRobinSchedulerSuite) TestPollTask_DomainMaxConcurrency() {
+	s.domainMaxConcurrency["domain-a"] = 1
+
+	// do not start the scheduler
+	s.Nil(s.scheduler.Submit(newTestDomainTaskImpl(nil, "domain-a", 1)))
+	s.Nil(s.scheduler.Submit(newTestDomainTaskImpl(nil, "domain-a", 2)))
+	s.Nil(s.scheduler.Submit(newTestDomainTaskImpl(nil, "domain-b", 1)))
+
+	task, queueA := s.scheduler.pollTaskLocked()
+	s.Equal("domain-a-1", task.DomainTask.(*testDomainTaskImpl).String())
+	task, _ = s.scheduler.pollTaskLocked()
+	s.Equal("domain-b-1", task.DomainTask.(*testDomainTaskImpl).String())
+	task, _ = s.scheduler.pollTaskLocked()
+	s.Nil(task)
+
+	queueA.inFlight--
+	task, _ = s.scheduler.pollTaskLocked()
+	s.Equal("domain-a-2", task.DomainTask.(*testDomainTaskImpl).String())
+}
+
+func (s *WeightedRoundRobinSchedulerSuite) TestPollTask_RemoveIdleDomain() {
+	// do not start the scheduler
+	s.Nil(s.scheduler.Submit(newTestDomainTaskImpl(nil, "domain-a", 1)))
+
+	_, queue := s.scheduler.pollTaskLocked()
+	task, _ := s.scheduler.pollTaskLocked()
+	s.Nil(task)
+	s.Equal(1, len(s.scheduler.domainRing))
+
+	queue.inFlight--
+	task, _ = s.scheduler.pollTaskLocked()
+	s.Nil(task)
+	s.Empty(s.scheduler.domainRing)
+	s.Empty(s.scheduler.domainQueues)
+}
+
+func (s *WeightedRoundRobinSchedulerSuite) TestProcessTask_RetryThenAck() {
+	waitgroup := &sync.WaitGroup{}
+	waitgroup.Add(1)
+	task := newTestDomainTaskImpl(waitgroup, "domain-a", 1, errTestRetryable, errTestRetryable)
+
+	s.scheduler.Start()
+	defer s.scheduler.Stop()
+	s.Nil(s.scheduler.Submit(task))
+	waitgroup.Wait()
+
+	s.Equal(3, task.numExecuted())
+	s.Equal(1, task.numAcked())
+	s.Equal(0, task.numNacked())
+}
+
+func (s *WeightedRoundRobinSchedulerSuite) TestProcessTask_RetryWithBackoff() {
+	retryPolicy := backoff.NewExponentialRetryPolicy(50 * time.Millisecond)
+	retryPolicy.SetBackoffCoefficient(1)
+	s.scheduler.options.RetryPolicy = retryPolicy
+
+	waitgroup := &sync.WaitGroup{}
+	waitgroup.Add(2)
+	retriedTask := newTestDomainTaskImpl(waitgroup, "domain-a", 1, errTestRetryable)
+	otherTask := newTestDomainTaskImpl(waitgroup, "domain-a", 2)
+
+	s.scheduler.Start()
+	defer s.scheduler.Stop()
+	startTime := time.Now()
+	s.Nil(s.scheduler.Submit(retriedTask))
+	s.Nil(s.scheduler.Submit(otherTask))
+	waitgroup.Wait()
+
+	// the task waiting for its retry does not hold up the other tasks of the domain
+	s.True(time.Since(startTime) >= 40*time.Millisecond)
+	s.Equal(2, retriedTask.numExecuted())
+	s.Equal(1, retriedTask.numAcked())
+	s.Equal(1, otherTask.numAcked())
+}
+
+func (s *WeightedRoundRobinSchedulerSuite) TestProcessTask_Nack() {
+	waitgroup := &sync.WaitGroup{}
+	waitgroup.Add(1)
+	task := newTestDomainTaskImpl(waitgroup, "domain-a", 1, errors.New("non retryable error"))
+
+	s.scheduler.Start()
+	defer s.scheduler.Stop()
+	s.Nil(s.scheduler.Submit(task))
+	waitgroup.Wait()
+
+	s.Equal(1, task.numExecuted())
+	s.Equal(0, task.numAcked())
+	s.Equal(1, task.numNacked())
+}
+
+func (s *WeightedRoundRobinSchedulerSuite) TestStop_NackPendingTasks() {
+	waitgroup := &sync.WaitGroup{}
+	waitgroup.Add(1)
+	task := newTestDomainTaskImpl(waitgroup, "domain-a", 1)
+
+	s.scheduler = s.newScheduler(0)
+	s.scheduler.Start()
+	s.Nil(s.scheduler.Submit(task))
+	s.scheduler.Stop()
+	waitgroup.Wait()
+
+	s.Equal(0, task.numExecuted())
+	s.Equal(1, task.numNacked())
+	s.Equal(ErrSchedulerStopped, s.scheduler.Submit(newTestDomainTaskImpl(nil, "domain-a", 2)))
+}
+
+func (s *WeightedRoundRobinSchedulerSuite) TestStop_NackRetryingTasks() {
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Minute)
+	retryPolicy.SetExpirationInterval(backoff.NoInterval)
+	s.scheduler.options.RetryPolicy = retryPolicy
+
+	waitgroup := &sync.WaitGroup{}
+	waitgroup.Add(1)
+	task := newTestDomainTaskImpl(waitgroup, "domain-a", 1, errTestRetryable)
+
+	s.scheduler.Start()
+	s.Nil(s.scheduler.Submit(task))
+	for task.numExecuted() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	s.scheduler.Stop()
+	waitgroup.Wait()
+
+	s.Equal(1, task.numExecuted())
+	s.Equal(0, task.numAcked())
+	s.Equal(1, task.numNacked())
+}
+
+func (s *WeightedRoundRobinSchedulerSuite) TestProcessTask_Concurrent() {
+	numOfDomains := 10
+	numOfTasksPerDomain := 100
+	for i := 0; i < numOfDomains; i++ {
+		domain := fmt.Sprintf("domain-%v", i)
+		s.domainWeights[domain] = i + 1
+		s.domainMaxConcurrency[domain] = 3
+	}
+
+	waitgroup := &sync.WaitGroup{}
+	waitgroup.Add(numOfDomains * numOfTasksPerDomain)
+	var tasks []*testDomainTaskImpl
+	for i := 0; i < numOfDomains; i++ {
+		for j := 0; j < numOfTasksPerDomain; j++ {
+			tasks = append(tasks, newTestDomainTaskImpl(waitgroup, fmt.Sprintf("domain-%v", i), j, errTestRetryable))
+		}
+	}
+
+	s.scheduler = s.newScheduler(20)
+	s.scheduler.Start()
+	defer s.scheduler.Stop()
+	for _, task := range tasks {
+		s.Nil(s.scheduler.Submit(task))
+	}
+	waitgroup.Wait()
+
+	for _, task := range tasks {
+		s.Equal(2, task.numExecuted())
+		s.Equal(1, task.numAcked())
+		s.Equal(0, task.numNacked())
+	}
+}
+
+func newTestDomainTaskImpl(waitgroup *sync.WaitGroup, domain string, taskID int, errs ...error) *testDomainTaskImpl {
+	return &testDomainTaskImpl{
+		waitgroup: waitgroup,
+		domain:    domain,
+		taskID:    taskID,
+		errs:      errs,
+	}
+}
+
+func (t *testDomainTaskImpl) Domain() string {
+	return t.domain
+}
+
+func (t *testDomainTaskImpl) Execute() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.executed++
+	if t.executed <= len(t.errs) {
+		return t.errs[t.executed-1]
+	}
+	return nil
+}
+
+func (t *testDomainTaskImpl) HandleErr(err error) error {
+	return err
+}
+
+func (t *testDomainTaskImpl) RetryErr(err error) bool {
+	return err == errTestRetryable
+}
+
+func (t *testDomainTaskImpl) Ack() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.acked++
+	t.waitgroup.Done()
+}
+
+func (t *testDomainTaskImpl) Nack() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.nacked++
+	t.waitgroup.Done()
+}
+
+func (t *testDomainTaskImpl) String() string {
+	return fmt.Sprintf("%v-%v", t.domain, t.taskID)
+}
+
+func (t *testDomainTaskImpl) numExecuted() int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.executed
+}
+
+func (t *testDomainTaskImpl) numAcked() int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.acked
+}
+
+func (t *testDomainTaskImpl) numNacked() int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.nacked
+}
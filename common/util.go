@@ -68,6 +68,9 @@ const (
 	retryKafkaOperationMaxInterval        = 10 * time.Second
 	retryKafkaOperationExpirationInterval = 30 * time.Second
 
+	retryTaskProcessingInitialInterval = 50 * time.Millisecond
+	retryTaskProcessingMaxInterval     = 10 * time.Second
+
 	// FailureReasonCompleteResultExceedsLimit is failureReason for complete result exceeds limit
 	FailureReasonCompleteResultExceedsLimit = "COMPLETE_RESULT_EXCEEDS_LIMIT"
 	// FailureReasonFailureDetailsExceedsLimit is failureReason for failure details exceeds limit
@@ -173,6 +176,16 @@ func CreateKafkaOperationRetryPolicy() backoff.RetryPolicy {
 	return policy
 }
 
+// CreateTaskProcessingRetryPolicy creates a retry policy for the processing of history queue tasks,
+// which are retried until they are processed
+func CreateTaskProcessingRetryPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(retryTaskProcessingInitialInterval)
+	policy.SetMaximumInterval(retryTaskProcessingMaxInterval)
+	policy.SetExpirationInterval(backoff.NoInterval)
+
+	return policy
+}
+
 // IsPersistenceTransientError checks if the error is a transient persistence error
 func IsPersistenceTransientError(err error) bool {
 	switch err.(type) {
@@ -308,6 +321,7 @@ func CreateMatchingPollForDecisionTaskResponse(historyResponse *h.RecordDecision
 		BranchToken:               historyResponse.BranchToken,
 		ScheduledTimestamp:        historyResponse.ScheduledTimestamp,
 		StartedTimestamp:          historyResponse.StartedTimestamp,
+		Queries:                   historyResponse.Queries,
 	}
 	if historyResponse.GetPreviousStartedEventId() != EmptyEventID {
 		matchingResp.PreviousStartedEventId = historyResponse.PreviousStartedEventId
@@ -347,6 +361,14 @@ func MinInt(a, b int) int {
 	return b
 }
 
+// MaxInt returns the greater of two given integers
+func MaxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
 // ValidateRetryPolicy validates a retry policy
 func ValidateRetryPolicy(policy *workflow.RetryPolicy) error {
 	if policy == nil {
@@ -383,12 +405,17 @@ func CreateHistoryStartWorkflowRequest(domainID string, startRequest *workflow.S
 		DomainUUID:   StringPtr(domainID),
 		StartRequest: startRequest,
 	}
+	now := time.Now()
+	delayStartSeconds := startRequest.GetDelayStartSeconds()
 	if startRequest.RetryPolicy != nil && startRequest.RetryPolicy.GetExpirationIntervalInSeconds() > 0 {
-		expirationInSeconds := startRequest.RetryPolicy.GetExpirationIntervalInSeconds()
-		deadline := time.Now().Add(time.Second * time.Duration(expirationInSeconds))
+		expirationInSeconds := startRequest.RetryPolicy.GetExpirationIntervalInSeconds() + delayStartSeconds
+		deadline := now.Add(time.Second * time.Duration(expirationInSeconds))
 		histRequest.ExpirationTimestamp = Int64Ptr(deadline.Round(time.Millisecond).UnixNano())
 	}
-	histRequest.FirstDecisionTaskBackoffSeconds = Int32Ptr(backoff.GetBackoffForNextScheduleInSeconds(startRequest.GetCronSchedule(), time.Now()))
+	// the first run of a cron workflow is scheduled by the cron schedule after the start delay
+	delayedStartTime := now.Add(time.Second * time.Duration(delayStartSeconds))
+	cronBackoffSeconds := backoff.GetBackoffForNextScheduleInSeconds(startRequest.GetCronSchedule(), delayedStartTime)
+	histRequest.FirstDecisionTaskBackoffSeconds = Int32Ptr(delayStartSeconds + cronBackoffSeconds)
 	return histRequest
 }
 
@@ -56,6 +56,7 @@ func (s *integrationSuite) TestArchival_TimerQueueProcessor() {
 	s.True(s.isHistoryArchived(s.archivalDomainName, execution))
 	s.True(s.isHistoryDeleted(domainID, execution))
 	s.True(s.isMutableStateDeleted(domainID, execution))
+	s.True(s.isVisibilityArchived(s.archivalDomainName, execution))
 }
 
 func (s *integrationSuite) TestArchival_ContinueAsNew() {
@@ -123,6 +124,27 @@ func (s *integrationSuite) isHistoryArchived(domain string, execution *workflow.
 	return false
 }
 
+func (s *integrationSuite) isVisibilityArchived(domain string, execution *workflow.WorkflowExecution) bool {
+	request := &workflow.ListArchivedWorkflowExecutionsRequest{
+		Domain:           common.StringPtr(domain),
+		MaximumPageSize:  common.Int32Ptr(10),
+		WorkflowIDPrefix: execution.WorkflowId,
+	}
+
+	for i := 0; i < retryLimit; i++ {
+		resp, err := s.engine.ListArchivedWorkflowExecutions(createContext(), request)
+		if err == nil && resp != nil {
+			for _, executionInfo := range resp.Executions {
+				if executionInfo.Execution.GetRunId() == execution.GetRunId() {
+					return true
+				}
+			}
+		}
+		time.Sleep(retryBackoffTime)
+	}
+	return false
+}
+
 func (s *integrationSuite) isHistoryDeleted(domainID string, execution *workflow.WorkflowExecution) bool {
 	if !s.testClusterConfig.EnableEventsV2 {
 		request := &persistence.GetWorkflowExecutionHistoryRequest{
@@ -27,6 +27,7 @@ var TestFlags struct {
 	EnableEventsV2        bool
 	FrontendAddr          string
 	PersistenceType       string
+	SQLDriverName         string
 	TestClusterConfigFile string
 }
 
@@ -34,5 +35,6 @@ func init() {
 	flag.BoolVar(&TestFlags.EnableEventsV2, "eventsV2", false, "run integration tests with eventsV2")
 	flag.StringVar(&TestFlags.FrontendAddr, "frontendAddress", "", "host:port for cadence frontend service")
 	flag.StringVar(&TestFlags.PersistenceType, "persistenceType", "cassandra", "type of persistence store - [cassandra or sql]")
+	flag.StringVar(&TestFlags.SQLDriverName, "sqlDriver", "mysql", "sql driver to use when persistenceType is sql - [mysql, postgres or sqlite3]")
 	flag.StringVar(&TestFlags.TestClusterConfigFile, "TestClusterConfigFile", "", "test cluster config file location")
 }
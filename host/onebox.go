@@ -23,6 +23,7 @@ package host
 import (
 	"context"
 	"fmt"
+	"net"
 	"sync"
 
 	"github.com/pborman/uuid"
@@ -35,6 +36,7 @@ import (
 	"github.com/uber/cadence/common"
 	carchiver "github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/elasticsearch"
@@ -87,8 +89,10 @@ type (
 		shardMgr            persistence.ShardManager
 		historyMgr          persistence.HistoryManager
 		historyV2Mgr        persistence.HistoryV2Manager
+		taskDLQMgr          persistence.TaskDLQManager
 		taskMgr             persistence.TaskManager
 		visibilityMgr       persistence.VisibilityManager
+		auditMgr            persistence.AuditManager
 		executionMgrFactory persistence.ExecutionManagerFactory
 		shutdownCh          chan struct{}
 		shutdownWG          sync.WaitGroup
@@ -124,9 +128,11 @@ type (
 		ShardMgr                      persistence.ShardManager
 		HistoryMgr                    persistence.HistoryManager
 		HistoryV2Mgr                  persistence.HistoryV2Manager
+		TaskDLQMgr                    persistence.TaskDLQManager
 		ExecutionMgrFactory           persistence.ExecutionManagerFactory
 		TaskMgr                       persistence.TaskManager
 		VisibilityMgr                 persistence.VisibilityManager
+		AuditMgr                      persistence.AuditManager
 		Logger                        log.Logger
 		ClusterNo                     int
 		EnableEventsV2                bool
@@ -155,9 +161,11 @@ func NewCadence(params *CadenceParams) Cadence {
 		metadataMgr:         params.MetadataMgr,
 		metadataMgrV2:       params.MetadataMgrV2,
 		visibilityMgr:       params.VisibilityMgr,
+		auditMgr:            params.AuditMgr,
 		shardMgr:            params.ShardMgr,
 		historyMgr:          params.HistoryMgr,
 		historyV2Mgr:        params.HistoryV2Mgr,
+		taskDLQMgr:          params.TaskDLQMgr,
 		taskMgr:             params.TaskMgr,
 		executionMgrFactory: params.ExecutionMgrFactory,
 		shutdownCh:          make(chan struct{}),
@@ -416,7 +424,7 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 	c.frontEndService = service.New(params)
 
 	c.adminHandler = frontend.NewAdminHandler(
-		c.frontEndService, c.historyConfig.NumHistoryShards, c.metadataMgr, c.historyMgr, c.historyV2Mgr, params)
+		c.frontEndService, c.historyConfig.NumHistoryShards, c.metadataMgr, c.historyMgr, c.historyV2Mgr, c.auditMgr, params)
 	c.adminHandler.RegisterHandler()
 
 	dc := dynamicconfig.NewCollection(params.DynamicConfig, c.logger)
@@ -431,13 +439,21 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 		ClusterMetadata:  c.clusterMetadata,
 		DomainCache:      domainCache,
 	}
-	c.archiverProvider.RegisterBootstrapContainer(common.FrontendServiceName, historyArchiverBootstrapContainer, &carchiver.VisibilityBootstrapContainer{})
+	visibilityArchiverBootstrapContainer := &carchiver.VisibilityBootstrapContainer{
+		Logger:          c.logger,
+		MetricsClient:   c.frontEndService.GetMetricsClient(),
+		ClusterMetadata: c.clusterMetadata,
+		DomainCache:     domainCache,
+	}
+	c.archiverProvider.RegisterBootstrapContainer(common.FrontendServiceName, historyArchiverBootstrapContainer, visibilityArchiverBootstrapContainer)
 
 	c.frontendHandler = frontend.NewWorkflowHandler(
 		c.frontEndService, frontendConfig, c.metadataMgr, c.historyMgr, c.historyV2Mgr,
-		c.visibilityMgr, kafkaProducer, domainCache, c.archiverProvider)
+		c.visibilityMgr, kafkaProducer, domainCache, c.archiverProvider, c.auditMgr)
 	dcRedirectionHandler := frontend.NewDCRedirectionHandler(c.frontendHandler, params.DCRedirectionPolicy)
-	dcRedirectionHandler.RegisterHandler()
+	accessControlledHandler := frontend.NewAccessControlledWorkflowHandler(
+		c.frontendHandler, dcRedirectionHandler, authorization.NewNopAuthenticator(), authorization.NewNopAuthorizer())
+	accessControlledHandler.RegisterHandler()
 
 	// must start base service first
 	c.frontEndService.Start()
@@ -445,7 +461,7 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 	if err != nil {
 		c.logger.Fatal("Failed to start admin", tag.Error(err))
 	}
-	err = dcRedirectionHandler.Start()
+	err = accessControlledHandler.Start()
 	if err != nil {
 		c.logger.Fatal("Failed to start frontend", tag.Error(err))
 	}
@@ -502,10 +518,16 @@ func (c *cadenceImpl) startHistory(hosts map[string][]string, startWG *sync.Wait
 			ClusterMetadata:  c.clusterMetadata,
 			DomainCache:      domainCache,
 		}
-		c.archiverProvider.RegisterBootstrapContainer(common.HistoryServiceName, historyArchiverBootstrapContainer, &carchiver.VisibilityBootstrapContainer{})
+		visibilityArchiverBootstrapContainer := &carchiver.VisibilityBootstrapContainer{
+			Logger:          c.logger,
+			MetricsClient:   service.GetMetricsClient(),
+			ClusterMetadata: c.clusterMetadata,
+			DomainCache:     domainCache,
+		}
+		c.archiverProvider.RegisterBootstrapContainer(common.HistoryServiceName, historyArchiverBootstrapContainer, visibilityArchiverBootstrapContainer)
 
 		handler := history.NewHandler(service, historyConfig, c.shardMgr, c.metadataMgr,
-			c.visibilityMgr, c.historyMgr, c.historyV2Mgr, c.executionMgrFactory, domainCache, params.PublicClient, c.archiverProvider)
+			c.visibilityMgr, c.historyMgr, c.historyV2Mgr, c.taskDLQMgr, c.executionMgrFactory, domainCache, params.PublicClient, c.archiverProvider)
 		handler.RegisterHandler()
 
 		service.Start()
@@ -763,6 +785,10 @@ func (vm *versionMiddleware) Handle(ctx context.Context, req *transport.Request,
 	return h.Handle(ctx, req, resw)
 }
 
+func (c *rpcFactoryImpl) CreateHTTPListener() net.Listener {
+	return nil
+}
+
 func (c *rpcFactoryImpl) CreateDispatcherForOutbound(
 	callerName, serviceName, hostName string) *yarpc.Dispatcher {
 	// Setup dispatcher(outbound) for onebox
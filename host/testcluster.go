@@ -23,6 +23,7 @@ package host
 import (
 	"io/ioutil"
 	"os"
+	"path"
 
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/client"
@@ -57,6 +58,7 @@ type (
 		provider       provider.ArchiverProvider
 		storeDirectory string
 		historyURI     string
+		visibilityURI  string
 	}
 
 	// TestClusterConfig are config for a test cluster
@@ -113,6 +115,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 	}
 
 	options.Persistence.StoreType = TestFlags.PersistenceType
+	options.Persistence.DriverName = TestFlags.SQLDriverName
 	options.Persistence.ClusterMetadata = clusterMetadata
 	testBase := persistencetests.NewTestBase(&options.Persistence)
 	testBase.Setup()
@@ -139,7 +142,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 			ValidSearchAttributes:  dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
 		}
 		esVisibilityStore := pes.NewElasticSearchVisibilityStore(esClient, indexName, visProducer, visConfig, logger)
-		esVisibilityMgr = persistence.NewVisibilityManagerImpl(esVisibilityStore, logger)
+		esVisibilityMgr = persistence.NewVisibilityManagerImpl(esVisibilityStore, persistence.NewNoopPayloadEncryption(), logger)
 	}
 	visibilityMgr := persistence.NewVisibilityManagerWrapper(testBase.VisibilityMgr, esVisibilityMgr,
 		dynamicconfig.GetBoolPropertyFnFilteredByDomain(options.WorkerConfig.EnableIndexer))
@@ -149,16 +152,18 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 	cadenceParams := &CadenceParams{
 		ClusterMetadata:     clusterMetadata,
 		PersistenceConfig:   pConfig,
-		DispatcherProvider:  client.NewDNSYarpcDispatcherProvider(logger, 0),
+		DispatcherProvider:  client.NewDNSYarpcDispatcherProvider(logger, 0, nil),
 		MessagingClient:     messagingClient,
 		MetadataMgr:         testBase.MetadataProxy,
 		MetadataMgrV2:       testBase.MetadataManagerV2,
 		ShardMgr:            testBase.ShardMgr,
 		HistoryMgr:          testBase.HistoryMgr,
 		HistoryV2Mgr:        testBase.HistoryV2Mgr,
+		TaskDLQMgr:          testBase.TaskDLQMgr,
 		ExecutionMgrFactory: testBase.ExecutionMgrFactory,
 		TaskMgr:             testBase.TaskMgr,
 		VisibilityMgr:       visibilityMgr,
+		AuditMgr:            testBase.AuditMgr,
 		Logger:              logger,
 		ClusterNo:           options.ClusterNo,
 		EnableEventsV2:      options.EnableEventsV2,
@@ -195,13 +200,20 @@ func newArchiverBase(logger log.Logger) *ArchiverBase {
 		FileMode: "0700",
 		DirMode:  "0600",
 	}
+	visibilityCfg := &config.FilestoreVisibilityArchiver{
+		FileMode: "0600",
+		DirMode:  "0700",
+	}
 	provider := provider.NewArchiverProvider(&config.HistoryArchiverProvider{
 		Filestore: cfg,
-	}, nil)
+	}, &config.VisibilityArchiverProvider{
+		Filestore: visibilityCfg,
+	})
 	return &ArchiverBase{
 		provider:       provider,
 		storeDirectory: storeDirectory,
 		historyURI:     filestore.URIScheme + "://" + storeDirectory,
+		visibilityURI:  filestore.URIScheme + "://" + path.Join(storeDirectory, "visibility"),
 	}
 }
 
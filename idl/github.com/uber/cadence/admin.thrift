@@ -68,6 +68,106 @@ service AdminService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.ServiceBusyError serviceBusyError,
     )
+
+  /**
+  * ListDLQTasks lists the transfer or timer tasks of a shard which were moved to the dead letter queue
+  * after they kept failing.
+  **/
+  shared.ListDLQTasksResponse ListDLQTasks(1: shared.ListDLQTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * DescribeDLQTask returns a single task of the dead letter queue of a shard.
+  **/
+  shared.DescribeDLQTaskResponse DescribeDLQTask(1: shared.DescribeDLQTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * RequeueDLQTask processes a task of the dead letter queue of a shard again, the task is removed from the
+  * dead letter queue once it is processed successfully.
+  **/
+  void RequeueDLQTask(1: shared.RequeueDLQTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * PurgeDLQTasks removes tasks from the dead letter queue of a shard without processing them.
+  **/
+  void PurgeDLQTasks(1: shared.PurgeDLQTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * DescribeShardQueues returns the ack levels and read levels of the transfer, timer and replication queues
+  * of a shard.
+  **/
+  shared.DescribeShardQueuesResponse DescribeShardQueues(1: shared.DescribeShardQueuesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * ListShardQueueTasks lists the pending tasks of the transfer, timer or replication queue of a shard.
+  **/
+  shared.ListShardQueueTasksResponse ListShardQueueTasks(1: shared.ListShardQueueTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * DeleteShardQueueTask removes a task from the transfer, timer or replication queue of a shard without
+  * processing it.
+  **/
+  void DeleteShardQueueTask(1: shared.DeleteShardQueueTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * RefireShardQueueTask processes a task of the transfer, timer or replication queue of a shard immediately,
+  * the task stays in the queue and is acked by the queue processor as usual.
+  **/
+  void RefireShardQueueTask(1: shared.RefireShardQueueTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * ListAuditRecords lists the audit log of a domain newest first, the audit log records the mutating control
+  * plane operations issued against the cluster. Cluster level operations are listed when domain is not set.
+  **/
+  ListAuditRecordsResponse ListAuditRecords(1: ListAuditRecordsRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
 }
 
 struct DescribeWorkflowExecutionRequest {
@@ -101,3 +201,29 @@ struct GetWorkflowExecutionRawHistoryResponse {
 struct AddSearchAttributeRequest {
   10: optional map<string, shared.IndexedValueType> searchAttribute
 }
+
+struct AuditRecord {
+  10: optional string id
+  20: optional i64 (js.type = "Long") timestamp
+  30: optional string operation
+  40: optional string domain
+  50: optional string workflowId
+  60: optional string runId
+  70: optional string identity
+  80: optional string reason
+  90: optional string requestSummary
+  100: optional string result
+}
+
+struct ListAuditRecordsRequest {
+  10: optional string domain
+  20: optional i64 (js.type = "Long") earliestTime
+  30: optional i64 (js.type = "Long") latestTime
+  40: optional i32 maximumPageSize
+  50: optional binary nextPageToken
+}
+
+struct ListAuditRecordsResponse {
+  10: optional list<AuditRecord> records
+  20: optional binary nextPageToken
+}
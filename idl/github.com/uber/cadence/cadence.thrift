@@ -399,6 +399,23 @@ service WorkflowService {
       8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
     )
 
+  /**
+  * UpdateWorkflowExecution is used to send a named update to a running workflow execution and wait for its result.
+  * This results in WorkflowExecutionUpdateRequested event recorded in the history and a decision task being created
+  * for the execution. The worker accepts or rejects the update when completing the decision task, which records
+  * WorkflowExecutionUpdateCompleted event and returns the result of the worker to the caller.
+  **/
+  shared.UpdateWorkflowExecutionResponse UpdateWorkflowExecution(1: shared.UpdateWorkflowExecutionRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
   /**
     * ResetWorkflowExecution reset an existing workflow execution to DecisionTaskCompleted event(exclusive).
     * And it will immediately terminating the current execution instance.
@@ -429,6 +446,37 @@ service WorkflowService {
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
     )
 
+  /**
+  * PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event in the
+  * history. While paused no new decision or activity tasks are dispatched for the execution, timers keep firing and
+  * signals are accepted, but the decisions for them are held until the execution is resumed.
+  **/
+  void PauseWorkflowExecution(1: shared.PauseWorkflowExecutionRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * ResumeWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionResumed event in the
+  * history and dispatching the decision and activity tasks held while the execution was paused.
+  **/
+  void ResumeWorkflowExecution(1: shared.ResumeWorkflowExecutionRequest resumeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
   /**
   * ListOpenWorkflowExecutions is a visibility API to list the open executions in a specific domain.
   **/
@@ -454,6 +502,18 @@ service WorkflowService {
       5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
     )
 
+  /**
+  * ListArchivedWorkflowExecutions is a visibility API to list archived workflow executions in a specific domain.
+  **/
+  shared.ListArchivedWorkflowExecutionsResponse ListArchivedWorkflowExecutions(1: shared.ListArchivedWorkflowExecutionsRequest listRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
   /**
   * ListWorkflowExecutions is a visibility API to list workflow executions in a specific domain.
   **/
@@ -51,6 +51,15 @@ struct StartWorkflowExecutionRequest {
   60: optional i32 firstDecisionTaskBackoffSeconds
 }
 
+struct QueryWorkflowRequest {
+  10: optional string domainUUID
+  20: optional shared.QueryWorkflowRequest request
+}
+
+struct QueryWorkflowResponse {
+  10: optional shared.QueryWorkflowResponse response
+}
+
 struct DescribeMutableStateRequest{
   10: optional string domainUUID
   20: optional shared.WorkflowExecution execution
@@ -171,6 +180,7 @@ struct RecordDecisionTaskStartedResponse {
   110: optional binary branchToken
   120:  optional i64 (js.type = "Long") scheduledTimestamp
   130:  optional i64 (js.type = "Long") startedTimestamp
+  140:  optional map<string, shared.WorkflowQuery> queries
 }
 
 struct SignalWorkflowExecutionRequest {
@@ -185,6 +195,15 @@ struct SignalWithStartWorkflowExecutionRequest {
   20: optional shared.SignalWithStartWorkflowExecutionRequest signalWithStartRequest
 }
 
+struct UpdateWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.UpdateWorkflowExecutionRequest updateRequest
+}
+
+struct UpdateWorkflowExecutionResponse {
+  10: optional shared.UpdateWorkflowExecutionResponse response
+}
+
 struct RemoveSignalMutableStateRequest {
   10: optional string domainUUID
   20: optional shared.WorkflowExecution workflowExecution
@@ -194,6 +213,18 @@ struct RemoveSignalMutableStateRequest {
 struct TerminateWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.TerminateWorkflowExecutionRequest terminateRequest
+  30: optional shared.WorkflowExecution externalWorkflowExecution
+  40: optional bool childWorkflowOnly
+}
+
+struct PauseWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.PauseWorkflowExecutionRequest pauseRequest
+}
+
+struct ResumeWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.ResumeWorkflowExecutionRequest resumeRequest
 }
 
 struct ResetWorkflowExecutionRequest {
@@ -267,6 +298,11 @@ struct SyncShardStatusRequest {
   30: optional i64 (js.type = "Long") timestamp
 }
 
+struct HandoffShardRequest {
+  10: optional i32 shardId
+  20: optional string previousOwner
+}
+
 struct SyncActivityRequest {
   10: optional string domainId
   20: optional string workflowId
@@ -319,6 +355,21 @@ service HistoryService {
       6: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * QueryWorkflow queries a workflow. A strongly consistent query is buffered in the workflow and delivered
+  * together with the next decision task, so that the query result reflects all events in history.
+  **/
+  QueryWorkflowResponse QueryWorkflow(1: QueryWorkflowRequest queryRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.QueryFailedError queryFailedError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * Reset the sticky tasklist related information in mutable state of a given workflow.
   * Things cleared are:
@@ -512,6 +563,22 @@ service HistoryService {
       7: shared.WorkflowExecutionAlreadyStartedError workflowAlreadyStartedError,
     )
 
+  /**
+  * UpdateWorkflowExecution is used to send an update to a running workflow execution. This results in
+  * WorkflowExecutionUpdateRequested event recorded in the history and a decision task being created for the
+  * execution. The call blocks until the worker accepts or rejects the update with the next completed decision task.
+  **/
+  UpdateWorkflowExecutionResponse UpdateWorkflowExecution(1: UpdateWorkflowExecutionRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.ServiceBusyError serviceBusyError,
+      7: shared.LimitExceededError limitExceededError,
+    )
+
   /**
   * RemoveSignalMutableState is used to remove a signal request ID that was previously recorded.  This is currently
   * used to clean execution info when signal decision finished.
@@ -542,6 +609,36 @@ service HistoryService {
       7: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event in the
+  * history. Decision and activity tasks are not dispatched for the execution until it is resumed.
+  **/
+  void PauseWorkflowExecution(1: PauseWorkflowExecutionRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ResumeWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionResumed event in the
+  * history and dispatching the decision and activity tasks held while the execution was paused.
+  **/
+  void ResumeWorkflowExecution(1: ResumeWorkflowExecutionRequest resumeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * ResetWorkflowExecution reset an existing workflow execution by a firstEventID of a existing event batch
   * in the history and immediately terminating the current execution instance.
@@ -690,4 +787,111 @@ service HistoryService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.AccessDeniedError accessDeniedError,
     )
+
+  /**
+  * ListDLQTasks lists the transfer or timer tasks of a shard which were moved to the dead letter queue
+  * after they kept failing.
+  **/
+  shared.ListDLQTasksResponse ListDLQTasks(1: shared.ListDLQTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * DescribeDLQTask returns a single task of the dead letter queue of a shard.
+  **/
+  shared.DescribeDLQTaskResponse DescribeDLQTask(1: shared.DescribeDLQTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: shared.EntityNotExistsError entityNotExistError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * RequeueDLQTask processes a task of the dead letter queue of a shard again, the task is removed from the
+  * dead letter queue once it is processed successfully.
+  **/
+  void RequeueDLQTask(1: shared.RequeueDLQTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: shared.EntityNotExistsError entityNotExistError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * PurgeDLQTasks removes tasks from the dead letter queue of a shard without processing them.
+  **/
+  void PurgeDLQTasks(1: shared.PurgeDLQTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * DescribeShardQueues returns the ack levels and read levels of the transfer, timer and replication queues
+  * of a shard.
+  **/
+  shared.DescribeShardQueuesResponse DescribeShardQueues(1: shared.DescribeShardQueuesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * ListShardQueueTasks lists the pending tasks of the transfer, timer or replication queue of a shard.
+  **/
+  shared.ListShardQueueTasksResponse ListShardQueueTasks(1: shared.ListShardQueueTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * DeleteShardQueueTask removes a task from the transfer, timer or replication queue of a shard without
+  * processing it.
+  **/
+  void DeleteShardQueueTask(1: shared.DeleteShardQueueTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * RefireShardQueueTask processes a task of the transfer, timer or replication queue of a shard immediately,
+  * the task stays in the queue and is acked by the queue processor as usual.
+  **/
+  void RefireShardQueueTask(1: shared.RefireShardQueueTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: shared.EntityNotExistsError entityNotExistError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * HandoffShard is called by the previous owner of a shard once it has drained the shard, so that the new owner
+  * acquires the shard immediately instead of waiting for its next shard acquisition.
+  **/
+  void HandoffShard(1: HandoffShardRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+    )
 }
@@ -177,6 +177,10 @@ enum EventType {
   SignalExternalWorkflowExecutionFailed,
   ExternalWorkflowExecutionSignaled,
   UpsertWorkflowSearchAttributes,
+  WorkflowExecutionPaused,
+  WorkflowExecutionResumed,
+  WorkflowExecutionUpdateRequested,
+  WorkflowExecutionUpdateCompleted,
 }
 
 enum DecisionTaskFailedCause {
@@ -237,6 +241,30 @@ enum QueryTaskCompletedType {
   FAILED,
 }
 
+enum QueryResultType {
+  ANSWERED,
+  FAILED,
+}
+
+enum UpdateResultType {
+  ACCEPTED,
+  REJECTED,
+}
+
+enum QueryRejectCondition {
+  // NOT_OPEN indicates that query should be rejected if workflow is not open
+  NOT_OPEN,
+  // NOT_COMPLETED_CLEANLY indicates that query should be rejected if workflow did not complete cleanly
+  NOT_COMPLETED_CLEANLY,
+}
+
+enum QueryConsistencyLevel {
+  // EVENTUAL indicates that query should be eventually consistent
+  EVENTUAL,
+  // STRONG indicates that any events that came before query should be reflected in workflow state before running query
+  STRONG,
+}
+
 enum PendingActivityState {
   SCHEDULED,
   STARTED,
@@ -328,6 +356,7 @@ struct WorkflowExecutionInfo {
   100: optional Memo memo
   101: optional SearchAttributes searchAttributes
   110: optional ResetPoints autoResetPoints
+  120: optional bool paused
 }
 
 struct WorkflowExecutionConfiguration {
@@ -695,6 +724,32 @@ struct WorkflowExecutionTerminatedEventAttributes {
   30: optional string identity
 }
 
+struct WorkflowExecutionPausedEventAttributes {
+  10: optional string reason
+  20: optional string identity
+}
+
+struct WorkflowExecutionResumedEventAttributes {
+  10: optional string reason
+  20: optional string identity
+}
+
+struct WorkflowExecutionUpdateRequestedEventAttributes {
+  10: optional string updateId
+  20: optional string updateName
+  30: optional binary input
+  40: optional string identity
+}
+
+struct WorkflowExecutionUpdateCompletedEventAttributes {
+  10: optional string updateId
+  20: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  30: optional UpdateResultType resultType
+  40: optional binary result
+  50: optional string errorMessage
+  60: optional string identity
+}
+
 struct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
   10: optional i64 (js.type = "Long") decisionTaskCompletedEventId
   20: optional string domain
@@ -877,6 +932,10 @@ struct HistoryEvent {
   430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes
   440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes
   450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes
+  460: optional WorkflowExecutionPausedEventAttributes workflowExecutionPausedEventAttributes
+  470: optional WorkflowExecutionResumedEventAttributes workflowExecutionResumedEventAttributes
+  480: optional WorkflowExecutionUpdateRequestedEventAttributes workflowExecutionUpdateRequestedEventAttributes
+  490: optional WorkflowExecutionUpdateCompletedEventAttributes workflowExecutionUpdateCompletedEventAttributes
 }
 
 struct History {
@@ -1022,6 +1081,7 @@ struct StartWorkflowExecutionRequest {
   140: optional Memo memo
   141: optional SearchAttributes searchAttributes
   150: optional Header header
+  160: optional i32 delayStartSeconds
 }
 
 struct StartWorkflowExecutionResponse {
@@ -1049,6 +1109,7 @@ struct PollForDecisionTaskResponse {
   90: optional TaskList WorkflowExecutionTaskList
   100:  optional i64 (js.type = "Long") scheduledTimestamp
   110:  optional i64 (js.type = "Long") startedTimestamp
+  120:  optional map<string, WorkflowQuery> queries
 }
 
 struct StickyExecutionAttributes {
@@ -1065,6 +1126,8 @@ struct RespondDecisionTaskCompletedRequest {
   60: optional bool returnNewDecisionTask
   70: optional bool forceCreateNewDecisionTask
   80: optional string binaryChecksum
+  90: optional map<string, WorkflowQueryResult> queryResults
+  100: optional map<string, WorkflowUpdateResult> updateResults
 }
 
 struct RespondDecisionTaskCompletedResponse {
@@ -1202,6 +1265,26 @@ struct SignalWorkflowExecutionRequest {
   70: optional binary control
 }
 
+struct UpdateWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string updateName
+  40: optional binary input
+  50: optional string identity
+  60: optional string requestId
+}
+
+struct UpdateWorkflowExecutionResponse {
+  10: optional string updateId
+  20: optional WorkflowUpdateResult result
+}
+
+struct WorkflowUpdateResult {
+  10: optional UpdateResultType resultType
+  20: optional binary result
+  30: optional string errorMessage
+}
+
 struct SignalWithStartWorkflowExecutionRequest {
   10: optional string domain
   20: optional string workflowId
@@ -1221,6 +1304,7 @@ struct SignalWithStartWorkflowExecutionRequest {
   160: optional Memo memo
   161: optional SearchAttributes searchAttributes
   170: optional Header header
+  180: optional i32 delayStartSeconds
 }
 
 struct TerminateWorkflowExecutionRequest {
@@ -1231,6 +1315,20 @@ struct TerminateWorkflowExecutionRequest {
   50: optional string identity
 }
 
+struct PauseWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional string identity
+}
+
+struct ResumeWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional string identity
+}
+
 struct ResetWorkflowExecutionRequest {
   10: optional string domain
   20: optional WorkflowExecution workflowExecution
@@ -1272,6 +1370,21 @@ struct ListClosedWorkflowExecutionsResponse {
   20: optional binary nextPageToken
 }
 
+struct ListArchivedWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional i32 maximumPageSize
+  30: optional binary nextPageToken
+  40: optional StartTimeFilter closeTimeFilter
+  50: optional string workflowIDPrefix
+  60: optional WorkflowTypeFilter typeFilter
+  70: optional WorkflowExecutionCloseStatus statusFilter
+}
+
+struct ListArchivedWorkflowExecutionsResponse {
+  10: optional list<WorkflowExecutionInfo> executions
+  20: optional binary nextPageToken
+}
+
 struct ListWorkflowExecutionsRequest {
   10: optional string domain
   20: optional i32 pageSize
@@ -1301,10 +1414,18 @@ struct QueryWorkflowRequest {
   10: optional string domain
   20: optional WorkflowExecution execution
   30: optional WorkflowQuery query
+  // QueryRejectCondition can be used to reject the query if workflow state does not satisfy the condition
+  40: optional QueryRejectCondition queryRejectCondition
+  50: optional QueryConsistencyLevel queryConsistencyLevel
+}
+
+struct QueryRejected {
+  10: optional WorkflowExecutionCloseStatus closeStatus
 }
 
 struct QueryWorkflowResponse {
   10: optional binary queryResult
+  20: optional QueryRejected queryRejected
 }
 
 struct WorkflowQuery {
@@ -1312,6 +1433,12 @@ struct WorkflowQuery {
   20: optional binary queryArgs
 }
 
+struct WorkflowQueryResult {
+  10: optional QueryResultType resultType
+  20: optional binary answer
+  30: optional string errorMessage
+}
+
 struct ResetStickyTaskListRequest {
   10: optional string domain
   20: optional WorkflowExecution execution
@@ -1408,6 +1535,131 @@ struct DomainCacheInfo{
   20: optional i64 numOfItemsInCacheByName
 }
 
+enum DLQTaskCategory {
+  Transfer,
+  Timer,
+}
+
+struct DLQTaskInfo {
+  10: optional i32              shardId
+  20: optional DLQTaskCategory  category
+  30: optional i64              taskId
+  40: optional i32              taskType
+  50: optional string           domainId
+  60: optional string           workflowId
+  70: optional string           runId
+  80: optional i64              visibilityTimestamp
+  // JSON encoded transfer or timer task
+  90: optional binary           data
+  100: optional string          lastError
+  110: optional i32             attempt
+  120: optional i64             createdTimestamp
+}
+
+struct ListDLQTasksRequest {
+  10: optional i32              shardId
+  20: optional DLQTaskCategory  category
+  30: optional i32              maximumPageSize
+  40: optional binary           nextPageToken
+}
+
+struct ListDLQTasksResponse {
+  10: optional list<DLQTaskInfo>  tasks
+  20: optional binary             nextPageToken
+}
+
+struct DescribeDLQTaskRequest {
+  10: optional i32              shardId
+  20: optional DLQTaskCategory  category
+  30: optional i64              taskId
+}
+
+struct DescribeDLQTaskResponse {
+  10: optional DLQTaskInfo      task
+}
+
+struct RequeueDLQTaskRequest {
+  10: optional i32              shardId
+  20: optional DLQTaskCategory  category
+  30: optional i64              taskId
+}
+
+// Purges all the tasks of the category from the DLQ of the shard, or only taskId when it is set
+struct PurgeDLQTasksRequest {
+  10: optional i32              shardId
+  20: optional DLQTaskCategory  category
+  30: optional i64              taskId
+}
+
+enum ShardQueueType {
+  Transfer,
+  Timer,
+  Replication,
+}
+
+struct ShardQueueTaskInfo {
+  10: optional ShardQueueType   queueType
+  20: optional i64              taskId
+  30: optional i32              taskType
+  40: optional string           domainId
+  50: optional string           workflowId
+  60: optional string           runId
+  70: optional i64              visibilityTimestamp
+  80: optional i64              version
+  // JSON encoded transfer, timer or replication task
+  90: optional binary           data
+}
+
+struct DescribeShardQueuesRequest {
+  10: optional i32              shardId
+}
+
+// Transfer and replication levels are task IDs, timer levels are unix nano timestamps
+struct DescribeShardQueuesResponse {
+  10: optional i32              shardId
+  20: optional i64              transferAckLevel
+  30: optional i64              transferReadLevel
+  40: optional i64              transferMaxReadLevel
+  50: optional map<string, i64> transferClusterAckLevels
+  60: optional i64              timerAckLevel
+  70: optional i64              timerReadLevel
+  80: optional i64              timerMaxReadLevel
+  90: optional map<string, i64> timerClusterAckLevels
+  100: optional i64             replicationAckLevel
+  110: optional i64             replicationReadLevel
+}
+
+// Lists the tasks of the queue above its ack level, optionally only the tasks of a domain or workflow
+struct ListShardQueueTasksRequest {
+  10: optional i32              shardId
+  20: optional ShardQueueType   queueType
+  30: optional string           domainId
+  40: optional string           workflowId
+  50: optional i32              maximumPageSize
+  60: optional binary           nextPageToken
+}
+
+struct ListShardQueueTasksResponse {
+  10: optional list<ShardQueueTaskInfo> tasks
+  20: optional binary                   nextPageToken
+}
+
+// visibilityTimestamp is required for timer tasks, which are keyed by both visibility timestamp and task ID
+struct DeleteShardQueueTaskRequest {
+  10: optional i32              shardId
+  20: optional ShardQueueType   queueType
+  30: optional i64              taskId
+  40: optional i64              visibilityTimestamp
+}
+
+// visibilityTimestamp is required for timer tasks, which are keyed by both visibility timestamp and task ID
+struct RefireShardQueueTaskRequest {
+  10: optional i32              shardId
+  20: optional ShardQueueType   queueType
+  30: optional i64              taskId
+  40: optional i64              visibilityTimestamp
+}
+
 enum TaskListType {
   /*
    * Decision type of tasklist
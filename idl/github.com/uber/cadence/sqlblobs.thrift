@@ -126,6 +126,8 @@ struct WorkflowExecutionInfo {
   115: optional binary autoResetPoints
   116: optional string autoResetPointsEncoding
   118: optional map<string, binary> searchAttributes
+  120: optional bool paused
+  122: optional map<string, bool> requestedUpdates
 }
 
 struct ActivityInfo {
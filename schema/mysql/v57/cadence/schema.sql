@@ -245,4 +245,36 @@ CREATE TABLE history_tree (
   data           BLOB NOT NULL,
   data_encoding  VARCHAR(16) NOT NULL,
   PRIMARY KEY (shard_id, tree_id, branch_id)
-);
\ No newline at end of file
+);
+-- audit_log stores the mutating control plane operations issued against the cluster
+CREATE TABLE audit_log (
+  domain_name VARCHAR(255) NOT NULL,
+  created_time DATETIME(6) NOT NULL,
+  record_id VARCHAR(64) NOT NULL,
+  --
+  operation VARCHAR(255) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id VARCHAR(64) NOT NULL,
+  identity VARCHAR(255) NOT NULL,
+  reason TEXT NOT NULL,
+  request_summary TEXT NOT NULL,
+  result TEXT NOT NULL,
+  PRIMARY KEY (domain_name, created_time, record_id)
+);
+-- task_dlq stores the transfer and timer tasks of a shard that kept failing and were moved out of their queue
+CREATE TABLE task_dlq (
+  shard_id INTEGER NOT NULL,
+  category INTEGER NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  task_type INTEGER NOT NULL,
+  domain_id VARCHAR(64) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id VARCHAR(64) NOT NULL,
+  visibility_timestamp DATETIME(6) NOT NULL,
+  data BLOB NOT NULL,
+  last_error TEXT NOT NULL,
+  attempt INTEGER NOT NULL,
+  created_time DATETIME(6) NOT NULL,
+  PRIMARY KEY (shard_id, category, task_id)
+);
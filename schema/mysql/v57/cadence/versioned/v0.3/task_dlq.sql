@@ -0,0 +1,17 @@
+-- task_dlq stores the transfer and timer tasks of a shard that kept failing and were moved out of their queue
+CREATE TABLE task_dlq (
+  shard_id INTEGER NOT NULL,
+  category INTEGER NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  task_type INTEGER NOT NULL,
+  domain_id VARCHAR(64) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id VARCHAR(64) NOT NULL,
+  visibility_timestamp DATETIME(6) NOT NULL,
+  data BLOB NOT NULL,
+  last_error TEXT NOT NULL,
+  attempt INTEGER NOT NULL,
+  created_time DATETIME(6) NOT NULL,
+  PRIMARY KEY (shard_id, category, task_id)
+);
@@ -0,0 +1 @@
+CREATE DATABASE cadence ENCODING 'UTF8';
\ No newline at end of file
@@ -0,0 +1,15 @@
+-- audit_log stores the mutating control plane operations issued against the cluster
+CREATE TABLE audit_log (
+  domain_name VARCHAR(255) NOT NULL,
+  created_time TIMESTAMP NOT NULL,
+  record_id VARCHAR(64) NOT NULL,
+  --
+  operation VARCHAR(255) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id VARCHAR(64) NOT NULL,
+  identity VARCHAR(255) NOT NULL,
+  reason TEXT NOT NULL,
+  request_summary TEXT NOT NULL,
+  result TEXT NOT NULL,
+  PRIMARY KEY (domain_name, created_time, record_id)
+);
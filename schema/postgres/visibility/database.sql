@@ -0,0 +1 @@
+CREATE DATABASE cadence_visibility ENCODING 'UTF8';
\ No newline at end of file
@@ -0,0 +1,687 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	"github.com/uber/cadence/.gen/go/health"
+	"github.com/uber/cadence/.gen/go/health/metaserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/service/worker/batcher"
+)
+
+var _ workflowserviceserver.Interface = (*AccessControlledWorkflowHandler)(nil)
+
+type (
+	// AccessControlledWorkflowHandler is a wrapper over frontend service, which asks the authorizer
+	// whether the caller may call an API before handing the call over
+	AccessControlledWorkflowHandler struct {
+		service         service.Service
+		domainCache     cache.DomainCache
+		metricsClient   metrics.Client
+		tokenSerializer common.TaskTokenSerializer
+		authenticator   authorization.Authenticator
+		authorizer      authorization.Authorizer
+		auditLogger     *auditLogger
+		frontendHandler workflowserviceserver.Interface
+
+		startFn func() error
+		stopFn  func()
+	}
+)
+
+// NewAccessControlledWorkflowHandler creates a thrift handler for the cadence service, frontend,
+// which authenticates and authorizes calls before handing them over to the DC redirection handler
+func NewAccessControlledWorkflowHandler(
+	wfHandler *WorkflowHandler,
+	dcRedirectionHandler *DCRedirectionHandlerImpl,
+	authenticator authorization.Authenticator,
+	authorizer authorization.Authorizer,
+) *AccessControlledWorkflowHandler {
+
+	return &AccessControlledWorkflowHandler{
+		service:         wfHandler.Service,
+		domainCache:     wfHandler.domainCache,
+		metricsClient:   wfHandler.metricsClient,
+		tokenSerializer: common.NewJSONTaskTokenSerializer(),
+		authenticator:   authenticator,
+		authorizer:      authorizer,
+		auditLogger:     wfHandler.auditLogger,
+		frontendHandler: dcRedirectionHandler,
+		startFn:         func() error { return dcRedirectionHandler.Start() },
+		stopFn:          func() { dcRedirectionHandler.Stop() },
+	}
+}
+
+// RegisterHandler register this handler, must be called before Start()
+func (handler *AccessControlledWorkflowHandler) RegisterHandler() {
+	handler.service.GetDispatcher().Register(workflowserviceserver.New(handler))
+	handler.service.GetDispatcher().Register(metaserver.New(handler))
+}
+
+// Start starts the handler
+func (handler *AccessControlledWorkflowHandler) Start() error {
+	return handler.startFn()
+}
+
+// Stop stops the handler
+func (handler *AccessControlledWorkflowHandler) Stop() {
+	handler.stopFn()
+}
+
+// Health is for health check
+func (handler *AccessControlledWorkflowHandler) Health(ctx context.Context) (*health.HealthStatus, error) {
+	hs := &health.HealthStatus{Ok: true, Msg: common.StringPtr("access control good")}
+	return hs, nil
+}
+
+// CountWorkflowExecutions API call
+func (handler *AccessControlledWorkflowHandler) CountWorkflowExecutions(
+	ctx context.Context,
+	request *shared.CountWorkflowExecutionsRequest,
+) (*shared.CountWorkflowExecutionsResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendCountWorkflowExecutionsScope, "CountWorkflowExecutions", request.GetDomain(), "", authorization.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.CountWorkflowExecutions(ctx, request)
+}
+
+// DeprecateDomain API call
+func (handler *AccessControlledWorkflowHandler) DeprecateDomain(
+	ctx context.Context,
+	request *shared.DeprecateDomainRequest,
+) error {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendDeprecateDomainScope, "DeprecateDomain", request.GetName(), "", authorization.RoleAdmin)
+	if err != nil {
+		handler.auditLogger.record(ctx, newDeprecateDomainAuditEntry(request), err)
+		return err
+	}
+	return handler.frontendHandler.DeprecateDomain(ctx, request)
+}
+
+// DescribeDomain API call
+func (handler *AccessControlledWorkflowHandler) DescribeDomain(
+	ctx context.Context,
+	request *shared.DescribeDomainRequest,
+) (*shared.DescribeDomainResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendDescribeDomainScope, "DescribeDomain", request.GetName(), "", authorization.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.DescribeDomain(ctx, request)
+}
+
+// DescribeTaskList API call
+func (handler *AccessControlledWorkflowHandler) DescribeTaskList(
+	ctx context.Context,
+	request *shared.DescribeTaskListRequest,
+) (*shared.DescribeTaskListResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendDescribeTaskListScope, "DescribeTaskList", request.GetDomain(), "", authorization.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.DescribeTaskList(ctx, request)
+}
+
+// DescribeWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *shared.DescribeWorkflowExecutionRequest,
+) (*shared.DescribeWorkflowExecutionResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendDescribeWorkflowExecutionScope, "DescribeWorkflowExecution", request.GetDomain(), request.GetExecution().GetWorkflowId(), authorization.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.DescribeWorkflowExecution(ctx, request)
+}
+
+// GetSearchAttributes API call
+func (handler *AccessControlledWorkflowHandler) GetSearchAttributes(
+	ctx context.Context,
+) (*shared.GetSearchAttributesResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendGetSearchAttributesScope, "GetSearchAttributes", "", "", authorization.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.GetSearchAttributes(ctx)
+}
+
+// GetWorkflowExecutionHistory API call
+func (handler *AccessControlledWorkflowHandler) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *shared.GetWorkflowExecutionHistoryRequest,
+) (*shared.GetWorkflowExecutionHistoryResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendGetWorkflowExecutionHistoryScope, "GetWorkflowExecutionHistory", request.GetDomain(), request.GetExecution().GetWorkflowId(), authorization.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.GetWorkflowExecutionHistory(ctx, request)
+}
+
+// ListArchivedWorkflowExecutions API call
+func (handler *AccessControlledWorkflowHandler) ListArchivedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListArchivedWorkflowExecutionsRequest,
+) (*shared.ListArchivedWorkflowExecutionsResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendListArchivedWorkflowExecutionsScope, "ListArchivedWorkflowExecutions", request.GetDomain(), "", authorization.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ListArchivedWorkflowExecutions(ctx, request)
+}
+
+// ListClosedWorkflowExecutions API call
+func (handler *AccessControlledWorkflowHandler) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListClosedWorkflowExecutionsRequest,
+) (*shared.ListClosedWorkflowExecutionsResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendListClosedWorkflowExecutionsScope, "ListClosedWorkflowExecutions", request.GetDomain(), "", authorization.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ListClosedWorkflowExecutions(ctx, request)
+}
+
+// ListDomains API call
+func (handler *AccessControlledWorkflowHandler) ListDomains(
+	ctx context.Context,
+	request *shared.ListDomainsRequest,
+) (*shared.ListDomainsResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendListDomainsScope, "ListDomains", "", "", authorization.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ListDomains(ctx, request)
+}
+
+// ListOpenWorkflowExecutions API call
+func (handler *AccessControlledWorkflowHandler) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListOpenWorkflowExecutionsRequest,
+) (*shared.ListOpenWorkflowExecutionsResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendListOpenWorkflowExecutionsScope, "ListOpenWorkflowExecutions", request.GetDomain(), "", authorization.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ListOpenWorkflowExecutions(ctx, request)
+}
+
+// ListWorkflowExecutions API call
+func (handler *AccessControlledWorkflowHandler) ListWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionsRequest,
+) (*shared.ListWorkflowExecutionsResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendListWorkflowExecutionsScope, "ListWorkflowExecutions", request.GetDomain(), "", authorization.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ListWorkflowExecutions(ctx, request)
+}
+
+// PollForActivityTask API call
+func (handler *AccessControlledWorkflowHandler) PollForActivityTask(
+	ctx context.Context,
+	request *shared.PollForActivityTaskRequest,
+) (*shared.PollForActivityTaskResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendPollForActivityTaskScope, "PollForActivityTask", request.GetDomain(), "", authorization.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.PollForActivityTask(ctx, request)
+}
+
+// PollForDecisionTask API call
+func (handler *AccessControlledWorkflowHandler) PollForDecisionTask(
+	ctx context.Context,
+	request *shared.PollForDecisionTaskRequest,
+) (*shared.PollForDecisionTaskResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendPollForDecisionTaskScope, "PollForDecisionTask", request.GetDomain(), "", authorization.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.PollForDecisionTask(ctx, request)
+}
+
+// QueryWorkflow API call
+func (handler *AccessControlledWorkflowHandler) QueryWorkflow(
+	ctx context.Context,
+	request *shared.QueryWorkflowRequest,
+) (*shared.QueryWorkflowResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendQueryWorkflowScope, "QueryWorkflow", request.GetDomain(), request.GetExecution().GetWorkflowId(), authorization.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.QueryWorkflow(ctx, request)
+}
+
+// UpdateWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *shared.UpdateWorkflowExecutionRequest,
+) (*shared.UpdateWorkflowExecutionResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendUpdateWorkflowExecutionScope, "UpdateWorkflowExecution", request.GetDomain(), request.GetWorkflowExecution().GetWorkflowId(), authorization.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.UpdateWorkflowExecution(ctx, request)
+}
+
+// RecordActivityTaskHeartbeat API call
+func (handler *AccessControlledWorkflowHandler) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatRequest,
+) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	domain, workflowID := handler.getTaskTokenTarget(request.GetTaskToken())
+	ctx, err := handler.authorize(ctx, metrics.FrontendRecordActivityTaskHeartbeatScope, "RecordActivityTaskHeartbeat", domain, workflowID, authorization.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.RecordActivityTaskHeartbeat(ctx, request)
+}
+
+// RecordActivityTaskHeartbeatByID API call
+func (handler *AccessControlledWorkflowHandler) RecordActivityTaskHeartbeatByID(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatByIDRequest,
+) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendRecordActivityTaskHeartbeatByIDScope, "RecordActivityTaskHeartbeatByID", request.GetDomain(), request.GetWorkflowID(), authorization.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.RecordActivityTaskHeartbeatByID(ctx, request)
+}
+
+// RegisterDomain API call
+func (handler *AccessControlledWorkflowHandler) RegisterDomain(
+	ctx context.Context,
+	request *shared.RegisterDomainRequest,
+) error {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendRegisterDomainScope, "RegisterDomain", request.GetName(), "", authorization.RoleAdmin)
+	if err != nil {
+		handler.auditLogger.record(ctx, newRegisterDomainAuditEntry(request), err)
+		return err
+	}
+	return handler.frontendHandler.RegisterDomain(ctx, request)
+}
+
+// RequestCancelWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *shared.RequestCancelWorkflowExecutionRequest,
+) error {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendRequestCancelWorkflowExecutionScope, "RequestCancelWorkflowExecution", request.GetDomain(), request.GetWorkflowExecution().GetWorkflowId(), authorization.RoleWriter)
+	if err != nil {
+		handler.auditLogger.record(ctx, newRequestCancelAuditEntry(request), err)
+		return err
+	}
+	return handler.frontendHandler.RequestCancelWorkflowExecution(ctx, request)
+}
+
+// ResetStickyTaskList API call
+func (handler *AccessControlledWorkflowHandler) ResetStickyTaskList(
+	ctx context.Context,
+	request *shared.ResetStickyTaskListRequest,
+) (*shared.ResetStickyTaskListResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendResetStickyTaskListScope, "ResetStickyTaskList", request.GetDomain(), request.GetExecution().GetWorkflowId(), authorization.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ResetStickyTaskList(ctx, request)
+}
+
+// ResetWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) ResetWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResetWorkflowExecutionRequest,
+) (*shared.ResetWorkflowExecutionResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendResetWorkflowExecutionScope, "ResetWorkflowExecution", request.GetDomain(), request.GetWorkflowExecution().GetWorkflowId(), authorization.RoleWriter)
+	if err != nil {
+		handler.auditLogger.record(ctx, newResetAuditEntry(request), err)
+		return nil, err
+	}
+	return handler.frontendHandler.ResetWorkflowExecution(ctx, request)
+}
+
+// RespondActivityTaskCanceled API call
+func (handler *AccessControlledWorkflowHandler) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledRequest,
+) error {
+
+	domain, workflowID := handler.getTaskTokenTarget(request.GetTaskToken())
+	ctx, err := handler.authorize(ctx, metrics.FrontendRespondActivityTaskCanceledScope, "RespondActivityTaskCanceled", domain, workflowID, authorization.RoleWriter)
+	if err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondActivityTaskCanceled(ctx, request)
+}
+
+// RespondActivityTaskCanceledByID API call
+func (handler *AccessControlledWorkflowHandler) RespondActivityTaskCanceledByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledByIDRequest,
+) error {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendRespondActivityTaskCanceledByIDScope, "RespondActivityTaskCanceledByID", request.GetDomain(), request.GetWorkflowID(), authorization.RoleWriter)
+	if err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondActivityTaskCanceledByID(ctx, request)
+}
+
+// RespondActivityTaskCompleted API call
+func (handler *AccessControlledWorkflowHandler) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedRequest,
+) error {
+
+	domain, workflowID := handler.getTaskTokenTarget(request.GetTaskToken())
+	ctx, err := handler.authorize(ctx, metrics.FrontendRespondActivityTaskCompletedScope, "RespondActivityTaskCompleted", domain, workflowID, authorization.RoleWriter)
+	if err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondActivityTaskCompleted(ctx, request)
+}
+
+// RespondActivityTaskCompletedByID API call
+func (handler *AccessControlledWorkflowHandler) RespondActivityTaskCompletedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedByIDRequest,
+) error {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendRespondActivityTaskCompletedByIDScope, "RespondActivityTaskCompletedByID", request.GetDomain(), request.GetWorkflowID(), authorization.RoleWriter)
+	if err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondActivityTaskCompletedByID(ctx, request)
+}
+
+// RespondActivityTaskFailed API call
+func (handler *AccessControlledWorkflowHandler) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedRequest,
+) error {
+
+	domain, workflowID := handler.getTaskTokenTarget(request.GetTaskToken())
+	ctx, err := handler.authorize(ctx, metrics.FrontendRespondActivityTaskFailedScope, "RespondActivityTaskFailed", domain, workflowID, authorization.RoleWriter)
+	if err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondActivityTaskFailed(ctx, request)
+}
+
+// RespondActivityTaskFailedByID API call
+func (handler *AccessControlledWorkflowHandler) RespondActivityTaskFailedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedByIDRequest,
+) error {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendRespondActivityTaskFailedByIDScope, "RespondActivityTaskFailedByID", request.GetDomain(), request.GetWorkflowID(), authorization.RoleWriter)
+	if err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondActivityTaskFailedByID(ctx, request)
+}
+
+// RespondDecisionTaskCompleted API call
+func (handler *AccessControlledWorkflowHandler) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskCompletedRequest,
+) (*shared.RespondDecisionTaskCompletedResponse, error) {
+
+	domain, workflowID := handler.getTaskTokenTarget(request.GetTaskToken())
+	ctx, err := handler.authorize(ctx, metrics.FrontendRespondDecisionTaskCompletedScope, "RespondDecisionTaskCompleted", domain, workflowID, authorization.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.RespondDecisionTaskCompleted(ctx, request)
+}
+
+// RespondDecisionTaskFailed API call
+func (handler *AccessControlledWorkflowHandler) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskFailedRequest,
+) error {
+
+	domain, workflowID := handler.getTaskTokenTarget(request.GetTaskToken())
+	ctx, err := handler.authorize(ctx, metrics.FrontendRespondDecisionTaskFailedScope, "RespondDecisionTaskFailed", domain, workflowID, authorization.RoleWriter)
+	if err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondDecisionTaskFailed(ctx, request)
+}
+
+// RespondQueryTaskCompleted API call
+func (handler *AccessControlledWorkflowHandler) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondQueryTaskCompletedRequest,
+) error {
+
+	domain := handler.getQueryTaskTokenDomain(request.GetTaskToken())
+	ctx, err := handler.authorize(ctx, metrics.FrontendRespondQueryTaskCompletedScope, "RespondQueryTaskCompleted", domain, "", authorization.RoleWriter)
+	if err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondQueryTaskCompleted(ctx, request)
+}
+
+// ScanWorkflowExecutions API call
+func (handler *AccessControlledWorkflowHandler) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionsRequest,
+) (*shared.ListWorkflowExecutionsResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendScanWorkflowExecutionsScope, "ScanWorkflowExecutions", request.GetDomain(), "", authorization.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ScanWorkflowExecutions(ctx, request)
+}
+
+// SignalWithStartWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWithStartWorkflowExecutionRequest,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendSignalWithStartWorkflowExecutionScope, "SignalWithStartWorkflowExecution", request.GetDomain(), request.GetWorkflowId(), authorization.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.SignalWithStartWorkflowExecution(ctx, request)
+}
+
+// SignalWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) SignalWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWorkflowExecutionRequest,
+) error {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendSignalWorkflowExecutionScope, "SignalWorkflowExecution", request.GetDomain(), request.GetWorkflowExecution().GetWorkflowId(), authorization.RoleWriter)
+	if err != nil {
+		return err
+	}
+	return handler.frontendHandler.SignalWorkflowExecution(ctx, request)
+}
+
+// StartWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) StartWorkflowExecution(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionRequest,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendStartWorkflowExecutionScope, "StartWorkflowExecution", request.GetDomain(), request.GetWorkflowId(), authorization.RoleWriter)
+	if err != nil {
+		if request.GetWorkflowType().GetName() == batcher.BatchWFTypeName {
+			handler.auditLogger.record(ctx, newBatchJobAuditEntry(request, nil), err)
+		}
+		return nil, err
+	}
+	return handler.frontendHandler.StartWorkflowExecution(ctx, request)
+}
+
+// TerminateWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *shared.TerminateWorkflowExecutionRequest,
+) error {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendTerminateWorkflowExecutionScope, "TerminateWorkflowExecution", request.GetDomain(), request.GetWorkflowExecution().GetWorkflowId(), authorization.RoleWriter)
+	if err != nil {
+		handler.auditLogger.record(ctx, newTerminateAuditEntry(request), err)
+		return err
+	}
+	return handler.frontendHandler.TerminateWorkflowExecution(ctx, request)
+}
+
+// PauseWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.PauseWorkflowExecutionRequest,
+) error {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendPauseWorkflowExecutionScope, "PauseWorkflowExecution", request.GetDomain(), request.GetWorkflowExecution().GetWorkflowId(), authorization.RoleWriter)
+	if err != nil {
+		handler.auditLogger.record(ctx, newPauseAuditEntry(request), err)
+		return err
+	}
+	return handler.frontendHandler.PauseWorkflowExecution(ctx, request)
+}
+
+// ResumeWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResumeWorkflowExecutionRequest,
+) error {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendResumeWorkflowExecutionScope, "ResumeWorkflowExecution", request.GetDomain(), request.GetWorkflowExecution().GetWorkflowId(), authorization.RoleWriter)
+	if err != nil {
+		handler.auditLogger.record(ctx, newResumeAuditEntry(request), err)
+		return err
+	}
+	return handler.frontendHandler.ResumeWorkflowExecution(ctx, request)
+}
+
+// UpdateDomain API call
+func (handler *AccessControlledWorkflowHandler) UpdateDomain(
+	ctx context.Context,
+	request *shared.UpdateDomainRequest,
+) (*shared.UpdateDomainResponse, error) {
+
+	ctx, err := handler.authorize(ctx, metrics.FrontendUpdateDomainScope, "UpdateDomain", request.GetName(), "", authorization.RoleAdmin)
+	if err != nil {
+		handler.auditLogger.record(ctx, newUpdateDomainAuditEntry(request), err)
+		return nil, err
+	}
+	return handler.frontendHandler.UpdateDomain(ctx, request)
+}
+
+func (handler *AccessControlledWorkflowHandler) authorize(
+	ctx context.Context,
+	scope int,
+	apiName string,
+	domain string,
+	workflowID string,
+	role authorization.Role,
+) (context.Context, error) {
+
+	actor, err := handler.authenticator.Authenticate(ctx)
+	if err == nil {
+		// the handlers read the authenticated actor from the context, e.g. to audit the call,
+		// it is kept even if the call is denied so that the denial is audited with it
+		ctx = authorization.WithActor(ctx, actor)
+		err = handler.authorizer.Authorize(ctx, &authorization.Attributes{
+			Actor:      actor,
+			APIName:    apiName,
+			DomainName: domain,
+			WorkflowID: workflowID,
+			Role:       role,
+		})
+	}
+	switch err.(type) {
+	case nil:
+		return ctx, nil
+	case *shared.AccessDeniedError:
+		handler.metricsClient.IncCounter(scope, metrics.CadenceErrAccessDeniedCounter)
+	default:
+		handler.metricsClient.IncCounter(scope, metrics.CadenceFailures)
+	}
+	return ctx, err
+}
+
+// getTaskTokenTarget returns the domain name and workflow ID of a task token. Both are empty if the token
+// cannot be resolved, so that only callers allowed in every domain get through to the validation of the token.
+func (handler *AccessControlledWorkflowHandler) getTaskTokenTarget(token []byte) (string, string) {
+	taskToken, err := handler.tokenSerializer.Deserialize(token)
+	if err != nil {
+		return "", ""
+	}
+	return handler.getDomainName(taskToken.DomainID), taskToken.WorkflowID
+}
+
+func (handler *AccessControlledWorkflowHandler) getQueryTaskTokenDomain(token []byte) string {
+	queryTaskToken, err := handler.tokenSerializer.DeserializeQueryTaskToken(token)
+	if err != nil {
+		return ""
+	}
+	return handler.getDomainName(queryTaskToken.DomainID)
+}
+
+func (handler *AccessControlledWorkflowHandler) getDomainName(domainID string) string {
+	if domainID == "" {
+		return ""
+	}
+	domainEntry, err := handler.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return ""
+	}
+	return domainEntry.GetInfo().Name
+}
@@ -0,0 +1,346 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/service/worker/batcher"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
+)
+
+type (
+	accessControlledHandlerSuite struct {
+		suite.Suite
+		domainName string
+		domainID   string
+		tokenKey   []byte
+
+		mockDomainCache     *cache.DomainCacheMock
+		mockFrontendHandler *MockWorkflowHandler
+		mockAuditMgr        *mocks.AuditManager
+
+		handler *AccessControlledWorkflowHandler
+	}
+)
+
+func TestAccessControlledHandlerSuite(t *testing.T) {
+	s := new(accessControlledHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *accessControlledHandlerSuite) SetupTest() {
+	s.domainName = "some random domain name"
+	s.domainID = "some random domain ID"
+	s.tokenKey = []byte("some random token key")
+	s.mockDomainCache = &cache.DomainCacheMock{}
+	s.mockFrontendHandler = &MockWorkflowHandler{}
+	s.mockAuditMgr = &mocks.AuditManager{}
+
+	authorizer, err := authorization.NewRoleBasedAuthorizer(&config.Authorization{
+		Actors: map[string]map[string]string{
+			"worker":   {s.domainName: "writer"},
+			"observer": {s.domainName: "reader"},
+			"operator": {authorization.Wildcard: "admin"},
+		},
+	})
+	s.Require().NoError(err)
+
+	s.handler = &AccessControlledWorkflowHandler{
+		domainCache:     s.mockDomainCache,
+		metricsClient:   metrics.NewClient(tally.NoopScope, metrics.Frontend),
+		tokenSerializer: common.NewJSONTaskTokenSerializer(),
+		authenticator:   authorization.NewTokenAuthenticator(s.tokenKey),
+		authorizer:      authorizer,
+		auditLogger:     newAuditLogger(s.mockAuditMgr, loggerimpl.NewNopLogger()),
+		frontendHandler: s.mockFrontendHandler,
+	}
+}
+
+func (s *accessControlledHandlerSuite) TearDownTest() {
+	s.mockDomainCache.AssertExpectations(s.T())
+	s.mockFrontendHandler.AssertExpectations(s.T())
+	s.mockAuditMgr.AssertExpectations(s.T())
+}
+
+func (s *accessControlledHandlerSuite) TestStartWorkflowExecution() {
+	req := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr(s.domainName),
+		WorkflowId: common.StringPtr("some random workflow ID"),
+	}
+	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr("some random run ID")}
+	var actor string
+	s.mockFrontendHandler.On("StartWorkflowExecution", mock.Anything, req).
+		Run(func(args mock.Arguments) {
+			actor = authorization.GetActor(args.Get(0).(context.Context))
+		}).
+		Return(resp, nil).Once()
+
+	result, err := s.handler.StartWorkflowExecution(s.contextWithActor("worker"), req)
+	s.NoError(err)
+	s.Equal(resp, result)
+	s.Equal("worker", actor)
+
+	result, err = s.handler.StartWorkflowExecution(s.contextWithActor("observer"), req)
+	s.IsType(&shared.AccessDeniedError{}, err)
+	s.Nil(result)
+
+	result, err = s.handler.StartWorkflowExecution(context.Background(), req)
+	s.IsType(&shared.AccessDeniedError{}, err)
+	s.Nil(result)
+}
+
+func (s *accessControlledHandlerSuite) TestDescribeWorkflowExecution() {
+	req := &shared.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	s.mockFrontendHandler.On("DescribeWorkflowExecution", mock.Anything, req).
+		Return(&shared.DescribeWorkflowExecutionResponse{}, nil).Once()
+
+	_, err := s.handler.DescribeWorkflowExecution(s.contextWithActor("observer"), req)
+	s.NoError(err)
+}
+
+func (s *accessControlledHandlerSuite) TestRegisterDomain() {
+	req := &shared.RegisterDomainRequest{
+		Name: common.StringPtr(s.domainName),
+	}
+	record := s.captureAuditRecord()
+	err := s.handler.RegisterDomain(s.contextWithActor("worker"), req)
+	s.IsType(&shared.AccessDeniedError{}, err)
+	s.Equal("RegisterDomain", record().Operation)
+	s.Equal(s.domainName, record().DomainName)
+	s.Equal("worker", record().Identity)
+	s.Equal(err.Error(), record().Result)
+
+	s.mockFrontendHandler.On("RegisterDomain", mock.Anything, req).Return(nil).Once()
+	err = s.handler.RegisterDomain(s.contextWithActor("operator"), req)
+	s.NoError(err)
+}
+
+func (s *accessControlledHandlerSuite) TestTerminateWorkflowExecution_Unauthenticated() {
+	req := &shared.TerminateWorkflowExecutionRequest{
+		Domain:            common.StringPtr(s.domainName),
+		WorkflowExecution: &shared.WorkflowExecution{WorkflowId: common.StringPtr("some random workflow ID")},
+		Reason:            common.StringPtr("some random reason"),
+	}
+	record := s.captureAuditRecord()
+	err := s.handler.TerminateWorkflowExecution(context.Background(), req)
+	s.IsType(&shared.AccessDeniedError{}, err)
+	s.Equal("TerminateWorkflowExecution", record().Operation)
+	s.Equal("some random workflow ID", record().WorkflowID)
+	s.Equal("some random reason", record().Reason)
+	s.Empty(record().Identity)
+	s.Equal(err.Error(), record().Result)
+}
+
+func (s *accessControlledHandlerSuite) TestStartWorkflowExecution_BatchJobDenied() {
+	req := &shared.StartWorkflowExecutionRequest{
+		Domain:       common.StringPtr(s.domainName),
+		WorkflowId:   common.StringPtr("some random workflow ID"),
+		WorkflowType: &shared.WorkflowType{Name: common.StringPtr(batcher.BatchWFTypeName)},
+	}
+	record := s.captureAuditRecord()
+	_, err := s.handler.StartWorkflowExecution(s.contextWithActor("observer"), req)
+	s.IsType(&shared.AccessDeniedError{}, err)
+	s.Equal(auditOperationStartBatchJob, record().Operation)
+	s.Equal("observer", record().Identity)
+	s.Equal(err.Error(), record().Result)
+}
+
+func (s *accessControlledHandlerSuite) TestListDomains() {
+	req := &shared.ListDomainsRequest{}
+	_, err := s.handler.ListDomains(s.contextWithActor("observer"), req)
+	s.IsType(&shared.AccessDeniedError{}, err)
+
+	s.mockFrontendHandler.On("ListDomains", mock.Anything, req).Return(&shared.ListDomainsResponse{}, nil).Once()
+	_, err = s.handler.ListDomains(s.contextWithActor("operator"), req)
+	s.NoError(err)
+}
+
+func (s *accessControlledHandlerSuite) TestRespondActivityTaskCompleted() {
+	token, err := s.handler.tokenSerializer.Serialize(&common.TaskToken{
+		DomainID:   s.domainID,
+		WorkflowID: "some random workflow ID",
+	})
+	s.NoError(err)
+	s.mockDomainCache.On("GetDomainByID", s.domainID).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName}, &persistence.DomainConfig{}, "", nil,
+	), nil)
+
+	req := &shared.RespondActivityTaskCompletedRequest{TaskToken: token}
+	s.mockFrontendHandler.On("RespondActivityTaskCompleted", mock.Anything, req).Return(nil).Once()
+	s.NoError(s.handler.RespondActivityTaskCompleted(s.contextWithActor("worker"), req))
+
+	err = s.handler.RespondActivityTaskCompleted(s.contextWithActor("observer"), req)
+	s.IsType(&shared.AccessDeniedError{}, err)
+}
+
+func (s *accessControlledHandlerSuite) TestRespondActivityTaskCompleted_UnresolvedToken() {
+	s.mockDomainCache.On("GetDomainByID", s.domainID).Return(nil, &shared.EntityNotExistsError{})
+	token, err := s.handler.tokenSerializer.Serialize(&common.TaskToken{DomainID: s.domainID})
+	s.NoError(err)
+
+	for _, req := range []*shared.RespondActivityTaskCompletedRequest{
+		{TaskToken: []byte("some random bytes")},
+		{TaskToken: token},
+	} {
+		err := s.handler.RespondActivityTaskCompleted(s.contextWithActor("worker"), req)
+		s.IsType(&shared.AccessDeniedError{}, err)
+
+		s.mockFrontendHandler.On("RespondActivityTaskCompleted", mock.Anything, req).Return(nil).Once()
+		s.NoError(s.handler.RespondActivityTaskCompleted(s.contextWithActor("operator"), req))
+	}
+}
+
+func (s *accessControlledHandlerSuite) TestRespondQueryTaskCompleted() {
+	token, err := s.handler.tokenSerializer.SerializeQueryTaskToken(&common.QueryTaskToken{DomainID: s.domainID})
+	s.NoError(err)
+	s.mockDomainCache.On("GetDomainByID", s.domainID).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName}, &persistence.DomainConfig{}, "", nil,
+	), nil)
+
+	req := &shared.RespondQueryTaskCompletedRequest{TaskToken: token}
+	s.mockFrontendHandler.On("RespondQueryTaskCompleted", mock.Anything, req).Return(nil).Once()
+	s.NoError(s.handler.RespondQueryTaskCompleted(s.contextWithActor("worker"), req))
+}
+
+func (s *accessControlledHandlerSuite) TestUnauthenticated() {
+	req := &shared.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+
+	// the caller declared by the rpc does not authenticate it
+	ctx, call := encoding.NewInboundCall(context.Background())
+	s.Require().NoError(call.ReadFromRequest(&transport.Request{Caller: "operator"}))
+	_, err := s.handler.DescribeWorkflowExecution(ctx, req)
+	s.IsType(&shared.AccessDeniedError{}, err)
+
+	forgedToken := authorization.NewToken([]byte("some other key"), "operator", time.Now().Add(time.Hour))
+	_, err = s.handler.DescribeWorkflowExecution(s.contextWithToken(forgedToken), req)
+	s.IsType(&shared.AccessDeniedError{}, err)
+
+	expiredToken := authorization.NewToken(s.tokenKey, "operator", time.Now().Add(-time.Second))
+	_, err = s.handler.DescribeWorkflowExecution(s.contextWithToken(expiredToken), req)
+	s.IsType(&shared.AccessDeniedError{}, err)
+}
+
+func (s *accessControlledHandlerSuite) TestAuthorizerError() {
+	s.handler.authorizer = &errorAuthorizer{err: errors.New("some random error")}
+	err := s.handler.SignalWorkflowExecution(s.contextWithActor("worker"), &shared.SignalWorkflowExecutionRequest{})
+	s.EqualError(err, "some random error")
+}
+
+func (s *accessControlledHandlerSuite) TestServedOverGRPC() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	grpcPort := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+	listener, err = net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	rpcConfig := &config.RPC{Port: port, GRPCPort: grpcPort, BindOnLocalHost: true}
+	dispatcher := rpcConfig.NewFactory(common.FrontendServiceName, loggerimpl.NewNopLogger(), nil).CreateDispatcher()
+	dispatcher.Register(workflowserviceserver.New(s.handler))
+	s.Require().NoError(dispatcher.Start())
+	defer dispatcher.Stop()
+
+	outbound := grpc.NewTransport().NewSingleOutbound(fmt.Sprintf("127.0.0.1:%v", grpcPort))
+	clientDispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name:      "some-random-caller",
+		Outbounds: yarpc.Outbounds{common.FrontendServiceName: {Unary: outbound}},
+	})
+	s.Require().NoError(clientDispatcher.Start())
+	defer clientDispatcher.Stop()
+	client := workflowserviceclient.New(clientDispatcher.ClientConfig(common.FrontendServiceName))
+
+	req := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr(s.domainName),
+		WorkflowId: common.StringPtr("some random workflow ID"),
+	}
+	s.mockFrontendHandler.On("StartWorkflowExecution", mock.Anything, req).
+		Return(&shared.StartWorkflowExecutionResponse{RunId: common.StringPtr("some random run ID")}, nil).Once()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resp, err := client.StartWorkflowExecution(ctx, req,
+		yarpc.WithHeader(authorization.TokenHeaderName, authorization.NewToken(s.tokenKey, "worker", time.Now().Add(time.Hour))))
+	s.NoError(err)
+	s.Equal("some random run ID", resp.GetRunId())
+
+	_, err = client.StartWorkflowExecution(ctx, req,
+		yarpc.WithHeader(authorization.TokenHeaderName, authorization.NewToken(s.tokenKey, "observer", time.Now().Add(time.Hour))))
+	// the WorkflowService does not declare AccessDeniedError, so it reaches the caller as an rpc error
+	s.Error(err)
+	s.Contains(err.Error(), "is not allowed to call StartWorkflowExecution")
+}
+
+func (s *accessControlledHandlerSuite) captureAuditRecord() func() *persistence.AuditRecord {
+	var record *persistence.AuditRecord
+	s.mockAuditMgr.On("CreateAuditRecord", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		record = args.Get(0).(*persistence.CreateAuditRecordRequest).Record
+	}).Once()
+	return func() *persistence.AuditRecord { return record }
+}
+
+func (s *accessControlledHandlerSuite) contextWithActor(actor string) context.Context {
+	return s.contextWithToken(authorization.NewToken(s.tokenKey, actor, time.Now().Add(time.Hour)))
+}
+
+func (s *accessControlledHandlerSuite) contextWithToken(token string) context.Context {
+	ctx, call := encoding.NewInboundCall(context.Background())
+	s.Require().NoError(call.ReadFromRequest(&transport.Request{
+		Headers: transport.NewHeaders().With(authorization.TokenHeaderName, token),
+	}))
+	return ctx
+}
+
+type errorAuthorizer struct {
+	err error
+}
+
+func (a *errorAuthorizer) Authorize(ctx context.Context, attributes *authorization.Attributes) error {
+	return a.err
+}
@@ -37,6 +37,7 @@ import (
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
@@ -50,6 +51,12 @@ import (
 
 var _ adminserviceserver.Interface = (*AdminHandler)(nil)
 
+const (
+	defaultDLQTasksPageSize        = 100
+	defaultShardQueueTasksPageSize = 100
+	defaultAuditRecordsPageSize    = 100
+)
+
 type (
 	// AdminHandler - Thrift handler interface for admin service
 	AdminHandler struct {
@@ -61,8 +68,12 @@ type (
 		metricsClient metrics.Client
 		historyMgr    persistence.HistoryManager
 		historyV2Mgr  persistence.HistoryV2Manager
+		auditMgr      persistence.AuditManager
 		startWG       sync.WaitGroup
 		params        *service.BootstrapParams
+		authenticator authorization.Authenticator
+		authorizer    authorization.Authorizer
+		auditLogger   *auditLogger
 	}
 )
 
@@ -73,8 +84,17 @@ func NewAdminHandler(
 	metadataMgr persistence.MetadataManager,
 	historyMgr persistence.HistoryManager,
 	historyV2Mgr persistence.HistoryV2Manager,
+	auditMgr persistence.AuditManager,
 	params *service.BootstrapParams,
 ) *AdminHandler {
+	authenticator := params.Authenticator
+	if authenticator == nil {
+		authenticator = authorization.NewNopAuthenticator()
+	}
+	authorizer := params.Authorizer
+	if authorizer == nil {
+		authorizer = authorization.NewNopAuthorizer()
+	}
 	handler := &AdminHandler{
 		status:                common.DaemonStatusInitialized,
 		numberOfHistoryShards: numberOfHistoryShards,
@@ -82,7 +102,11 @@ func NewAdminHandler(
 		domainCache:           cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
 		historyMgr:            historyMgr,
 		historyV2Mgr:          historyV2Mgr,
+		auditMgr:              auditMgr,
 		params:                params,
+		authenticator:         authenticator,
+		authorizer:            authorizer,
+		auditLogger:           newAuditLogger(auditMgr, sVice.GetLogger()),
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -118,7 +142,19 @@ func (adh *AdminHandler) Stop() {
 }
 
 // AddSearchAttribute add search attribute to whitelist
-func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admin.AddSearchAttributeRequest) error {
+func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admin.AddSearchAttributeRequest) (retError error) {
+	defer func() {
+		adh.auditLogger.record(ctx, &auditEntry{
+			operation: "AddSearchAttribute",
+			request:   request,
+		}, retError)
+	}()
+
+	ctx, err := adh.authorize(ctx, metrics.AdminAddSearchAttributeScope, "AddSearchAttribute", "", "")
+	if err != nil {
+		return err
+	}
+
 	// validate request
 	if request == nil {
 		return &gen.BadRequestError{Message: "Request is not provided"}
@@ -131,7 +167,7 @@ func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admin.
 	currentValidAttr, _ := adh.params.DynamicConfig.GetMapValue(
 		dynamicconfig.ValidSearchAttributes, nil, definition.GetDefaultIndexedKeys())
 	for k, v := range searchAttr {
-		if definition.IsSystemIndexedKey(k) {
+		if definition.IsSystemIndexedKey(k) || definition.IsReservedSearchAttribute(k) {
 			return &gen.BadRequestError{Message: fmt.Sprintf("Key [%s] is reserverd by system", k)}
 		}
 		if _, exist := currentValidAttr[k]; exist {
@@ -142,7 +178,7 @@ func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admin.
 	}
 
 	// update dynamic config
-	err := adh.params.DynamicConfig.UpdateValue(dynamicconfig.ValidSearchAttributes, currentValidAttr)
+	err = adh.params.DynamicConfig.UpdateValue(dynamicconfig.ValidSearchAttributes, currentValidAttr)
 	if err != nil {
 		return &gen.InternalServiceError{Message: fmt.Sprintf("Failed to update dynamic config, err: %v", err)}
 	}
@@ -171,6 +207,11 @@ func (adh *AdminHandler) DescribeWorkflowExecution(ctx context.Context, request
 		return nil, adh.error(errRequestNotSet, scope)
 	}
 
+	ctx, err := adh.authorize(ctx, scope, "DescribeWorkflowExecution", request.GetDomain(), request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+
 	if err := validateExecution(request.Execution); err != nil {
 		return nil, adh.error(err, scope)
 	}
@@ -210,16 +251,335 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *gen.D
 		return nil, adh.error(errRequestNotSet, scope)
 	}
 
+	ctx, err := adh.authorize(ctx, scope, "DescribeHistoryHost", "", "")
+	if err != nil {
+		return nil, err
+	}
+
 	if request.ExecutionForHost != nil {
 		if err := validateExecution(request.ExecutionForHost); err != nil {
 			return nil, adh.error(err, scope)
 		}
 	}
 
-	resp, err := adh.history.DescribeHistoryHost(ctx, request)
+	resp, err = adh.history.DescribeHistoryHost(ctx, request)
 	return resp, err
 }
 
+// ListDLQTasks lists the transfer or timer tasks of a shard which were moved to the dead letter queue
+func (adh *AdminHandler) ListDLQTasks(ctx context.Context, request *gen.ListDLQTasksRequest) (resp *gen.ListDLQTasksResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminListDLQTasksScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	ctx, err := adh.authorize(ctx, scope, "ListDLQTasks", "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := adh.validateDLQRequest(request.ShardId, request.Category); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetMaximumPageSize() <= 0 {
+		request.MaximumPageSize = common.Int32Ptr(defaultDLQTasksPageSize)
+	}
+
+	resp, err = adh.history.ListDLQTasks(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// DescribeDLQTask returns a single task of the dead letter queue of a shard
+func (adh *AdminHandler) DescribeDLQTask(ctx context.Context, request *gen.DescribeDLQTaskRequest) (resp *gen.DescribeDLQTaskResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminDescribeDLQTaskScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	ctx, err := adh.authorize(ctx, scope, "DescribeDLQTask", "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := adh.validateDLQRequest(request.ShardId, request.Category); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.TaskId == nil {
+		return nil, adh.error(errDLQTaskIDNotSet, scope)
+	}
+
+	resp, err = adh.history.DescribeDLQTask(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// RequeueDLQTask processes a task of the dead letter queue of a shard again, the task is removed from the
+// dead letter queue once it is processed successfully
+func (adh *AdminHandler) RequeueDLQTask(ctx context.Context, request *gen.RequeueDLQTaskRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	defer func() {
+		adh.auditLogger.record(ctx, &auditEntry{
+			operation: "RequeueDLQTask",
+			request:   request,
+		}, retError)
+	}()
+	scope := metrics.AdminRequeueDLQTaskScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	ctx, err := adh.authorize(ctx, scope, "RequeueDLQTask", "", "")
+	if err != nil {
+		return err
+	}
+
+	if err := adh.validateDLQRequest(request.ShardId, request.Category); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.TaskId == nil {
+		return adh.error(errDLQTaskIDNotSet, scope)
+	}
+
+	if err := adh.history.RequeueDLQTask(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// PurgeDLQTasks removes tasks from the dead letter queue of a shard without processing them
+func (adh *AdminHandler) PurgeDLQTasks(ctx context.Context, request *gen.PurgeDLQTasksRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	defer func() {
+		adh.auditLogger.record(ctx, &auditEntry{
+			operation: "PurgeDLQTasks",
+			request:   request,
+		}, retError)
+	}()
+	scope := metrics.AdminPurgeDLQTasksScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	ctx, err := adh.authorize(ctx, scope, "PurgeDLQTasks", "", "")
+	if err != nil {
+		return err
+	}
+
+	if err := adh.validateDLQRequest(request.ShardId, request.Category); err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.PurgeDLQTasks(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// DescribeShardQueues returns the ack levels and read levels of the transfer, timer and replication queues of a shard
+func (adh *AdminHandler) DescribeShardQueues(ctx context.Context, request *gen.DescribeShardQueuesRequest) (resp *gen.DescribeShardQueuesResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminDescribeShardQueuesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	ctx, err := adh.authorize(ctx, scope, "DescribeShardQueues", "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := adh.validateShardID(request.ShardId); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err = adh.history.DescribeShardQueues(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ListShardQueueTasks lists the pending tasks of a transfer, timer or replication queue of a shard
+func (adh *AdminHandler) ListShardQueueTasks(ctx context.Context, request *gen.ListShardQueueTasksRequest) (resp *gen.ListShardQueueTasksResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminListShardQueueTasksScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	ctx, err := adh.authorize(ctx, scope, "ListShardQueueTasks", "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := adh.validateShardID(request.ShardId); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.QueueType == nil {
+		return nil, adh.error(errShardQueueTypeNotSet, scope)
+	}
+	if request.GetMaximumPageSize() <= 0 {
+		request.MaximumPageSize = common.Int32Ptr(defaultShardQueueTasksPageSize)
+	}
+
+	resp, err = adh.history.ListShardQueueTasks(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// DeleteShardQueueTask removes a task from a transfer, timer or replication queue of a shard without processing it
+func (adh *AdminHandler) DeleteShardQueueTask(ctx context.Context, request *gen.DeleteShardQueueTaskRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	defer func() {
+		adh.auditLogger.record(ctx, &auditEntry{
+			operation: "DeleteShardQueueTask",
+			request:   request,
+		}, retError)
+	}()
+	scope := metrics.AdminDeleteShardQueueTaskScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	ctx, err := adh.authorize(ctx, scope, "DeleteShardQueueTask", "", "")
+	if err != nil {
+		return err
+	}
+
+	if err := adh.validateShardQueueTaskRequest(request.ShardId, request.QueueType, request.TaskId, request.VisibilityTimestamp); err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.DeleteShardQueueTask(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// RefireShardQueueTask processes a pending task of a transfer, timer or replication queue of a shard once,
+// regardless of the read level of the queue
+func (adh *AdminHandler) RefireShardQueueTask(ctx context.Context, request *gen.RefireShardQueueTaskRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	defer func() {
+		adh.auditLogger.record(ctx, &auditEntry{
+			operation: "RefireShardQueueTask",
+			request:   request,
+		}, retError)
+	}()
+	scope := metrics.AdminRefireShardQueueTaskScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	ctx, err := adh.authorize(ctx, scope, "RefireShardQueueTask", "", "")
+	if err != nil {
+		return err
+	}
+
+	if err := adh.validateShardQueueTaskRequest(request.ShardId, request.QueueType, request.TaskId, request.VisibilityTimestamp); err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.RefireShardQueueTask(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ListAuditRecords lists the audit records of a domain newest first, or the cluster level records if no domain is given
+func (adh *AdminHandler) ListAuditRecords(ctx context.Context, request *admin.ListAuditRecordsRequest) (resp *admin.ListAuditRecordsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminListAuditRecordsScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	// listing the cluster level records requires to be an admin of every domain
+	ctx, err := adh.authorize(ctx, scope, "ListAuditRecords", request.GetDomain(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	latestTime := time.Now().UnixNano()
+	if request.LatestTime != nil {
+		latestTime = request.GetLatestTime()
+	}
+	if request.GetEarliestTime() > latestTime {
+		return nil, adh.error(errEarliestTimeIsGreaterThanLatestTime, scope)
+	}
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultAuditRecordsPageSize
+	}
+
+	listResp, err := adh.auditMgr.ListAuditRecords(&persistence.ListAuditRecordsRequest{
+		DomainName:    request.GetDomain(),
+		EarliestTime:  time.Unix(0, request.GetEarliestTime()),
+		LatestTime:    time.Unix(0, latestTime),
+		PageSize:      pageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp = &admin.ListAuditRecordsResponse{
+		Records:       make([]*admin.AuditRecord, 0, len(listResp.Records)),
+		NextPageToken: listResp.NextPageToken,
+	}
+	for _, record := range listResp.Records {
+		resp.Records = append(resp.Records, &admin.AuditRecord{
+			ID:             common.StringPtr(record.ID),
+			Timestamp:      common.Int64Ptr(record.Timestamp.UnixNano()),
+			Operation:      common.StringPtr(record.Operation),
+			Domain:         common.StringPtr(record.DomainName),
+			WorkflowId:     common.StringPtr(record.WorkflowID),
+			RunId:          common.StringPtr(record.RunID),
+			Identity:       common.StringPtr(record.Identity),
+			Reason:         common.StringPtr(record.Reason),
+			RequestSummary: common.StringPtr(record.RequestSummary),
+			Result:         common.StringPtr(record.Result),
+		})
+	}
+	return resp, nil
+}
+
 // GetWorkflowExecutionRawHistory - retrieves the history of workflow execution
 func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	ctx context.Context, request *admin.GetWorkflowExecutionRawHistoryRequest) (resp *admin.GetWorkflowExecutionRawHistoryResponse, retError error) {
@@ -231,6 +591,11 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	var err error
 	var size int
 
+	ctx, err = adh.authorize(ctx, scope, "GetWorkflowExecutionRawHistory", request.GetDomain(), request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+
 	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
 	if err != nil {
 		return nil, adh.error(err, scope)
@@ -390,6 +755,48 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	return result, nil
 }
 
+func (adh *AdminHandler) validateShardID(shardID *int32) error {
+	if shardID == nil {
+		return errShardIDNotSet
+	}
+	if *shardID < 0 || int(*shardID) >= adh.numberOfHistoryShards {
+		return errInvalidShardID
+	}
+	return nil
+}
+
+func (adh *AdminHandler) validateDLQRequest(shardID *int32, category *gen.DLQTaskCategory) error {
+	if err := adh.validateShardID(shardID); err != nil {
+		return err
+	}
+	if category == nil {
+		return errDLQTaskCategoryNotSet
+	}
+	return nil
+}
+
+func (adh *AdminHandler) validateShardQueueTaskRequest(
+	shardID *int32,
+	queueType *gen.ShardQueueType,
+	taskID *int64,
+	visibilityTimestamp *int64,
+) error {
+	if err := adh.validateShardID(shardID); err != nil {
+		return err
+	}
+	if queueType == nil {
+		return errShardQueueTypeNotSet
+	}
+	if taskID == nil {
+		return errShardQueueTaskIDNotSet
+	}
+	// timer tasks are keyed by visibility timestamp and task ID
+	if *queueType == gen.ShardQueueTypeTimer && visibilityTimestamp == nil {
+		return errVisibilityTimestampNotSet
+	}
+	return nil
+}
+
 // startRequestProfile initiates recording of request metrics
 func (adh *AdminHandler) startRequestProfile(scope int) tally.Stopwatch {
 	adh.startWG.Wait()
@@ -398,6 +805,31 @@ func (adh *AdminHandler) startRequestProfile(scope int) tally.Stopwatch {
 	return sw
 }
 
+// authorize checks that the caller is authenticated as an admin of domain, or of every domain if domain is empty,
+// and returns ctx carrying the authenticated actor
+func (adh *AdminHandler) authorize(ctx context.Context, scope int, apiName string, domain string, workflowID string) (context.Context, error) {
+	actor, err := adh.authenticator.Authenticate(ctx)
+	if err == nil {
+		// the actor is kept in the context even if the call is denied, so that the denial is audited with it
+		ctx = authorization.WithActor(ctx, actor)
+		err = adh.authorizer.Authorize(ctx, &authorization.Attributes{
+			Actor:      actor,
+			APIName:    apiName,
+			DomainName: domain,
+			WorkflowID: workflowID,
+			Role:       authorization.RoleAdmin,
+		})
+	}
+	if _, ok := err.(*gen.AccessDeniedError); ok {
+		adh.GetMetricsClient().IncCounter(scope, metrics.CadenceErrAccessDeniedCounter)
+		return ctx, err
+	}
+	if err != nil {
+		return ctx, adh.error(err, scope)
+	}
+	return ctx, nil
+}
+
 func (adh *AdminHandler) error(err error, scope int) error {
 	switch err.(type) {
 	case *gen.InternalServiceError:
@@ -409,6 +841,8 @@ func (adh *AdminHandler) error(err error, scope int) error {
 		return err
 	case *gen.EntityNotExistsError:
 		return err
+	case *gen.AccessDeniedError:
+		return err
 	default:
 		adh.Service.GetLogger().Error("Uncategorized error", tag.Error(err))
 		return &gen.InternalServiceError{Message: err.Error()}
@@ -0,0 +1,245 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pborman/uuid"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/batcher"
+)
+
+const (
+	// auditRequestSummaryMaxLength caps the size of the request kept in an audit record
+	auditRequestSummaryMaxLength = 4096
+
+	auditOperationStartBatchJob = "StartBatchJob"
+)
+
+type (
+	// auditLogger records the mutating control plane operations served by
+	// the frontend to the audit log
+	auditLogger struct {
+		auditMgr persistence.AuditManager
+		logger   log.Logger
+	}
+
+	// auditEntry describes an operation to be recorded, its result comes
+	// from the error returned by the operation
+	auditEntry struct {
+		operation  string
+		domain     string
+		workflowID string
+		runID      string
+		identity   string
+		reason     string
+		request    interface{}
+	}
+)
+
+func newAuditLogger(auditMgr persistence.AuditManager, logger log.Logger) *auditLogger {
+	return &auditLogger{
+		auditMgr: auditMgr,
+		logger:   logger,
+	}
+}
+
+// record appends the entry to the audit log. The identity defaults to the
+// authenticated caller when the request does not carry one. Requests rejected by rate
+// limiting are not recorded so that a flood of them does not turn into a flood
+// of writes. Failing to write the record is logged and does not fail the
+// operation, which has already taken effect at this point
+func (a *auditLogger) record(ctx context.Context, entry *auditEntry, opErr error) {
+	if a.auditMgr == nil {
+		return
+	}
+	if _, ok := opErr.(*gen.ServiceBusyError); ok {
+		return
+	}
+
+	identity := entry.identity
+	if identity == "" {
+		identity = authorization.GetActor(ctx)
+	}
+	result := persistence.AuditResultSuccess
+	if opErr != nil {
+		result = opErr.Error()
+	}
+	record := &persistence.AuditRecord{
+		ID:             uuid.New(),
+		Timestamp:      time.Now().UTC(),
+		Operation:      entry.operation,
+		DomainName:     entry.domain,
+		WorkflowID:     entry.workflowID,
+		RunID:          entry.runID,
+		Identity:       identity,
+		Reason:         entry.reason,
+		RequestSummary: getAuditRequestSummary(entry.request),
+		Result:         result,
+	}
+	if err := a.auditMgr.CreateAuditRecord(&persistence.CreateAuditRecordRequest{Record: record}); err != nil {
+		a.logger.Error("Failed to write audit record.",
+			tag.Error(err),
+			tag.WorkflowDomainName(entry.domain),
+			tag.WorkflowID(entry.workflowID),
+			tag.WorkflowRunID(entry.runID),
+			tag.Value(entry.operation))
+	}
+}
+
+// getAuditRequestSummary returns the request as json, without its
+// security token and truncated to auditRequestSummaryMaxLength
+func getAuditRequestSummary(request interface{}) string {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return ""
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err == nil {
+		if _, ok := fields["securityToken"]; ok {
+			delete(fields, "securityToken")
+			data, _ = json.Marshal(fields)
+		}
+	}
+	if len(data) > auditRequestSummaryMaxLength {
+		data = data[:auditRequestSummaryMaxLength]
+	}
+	return string(data)
+}
+
+// The audit entries of the audited operations are built by the functions below,
+// so that the workflow handler and the access controlled handler, which records
+// the requests it denies, describe an operation the same way
+
+func newRegisterDomainAuditEntry(request *gen.RegisterDomainRequest) *auditEntry {
+	return &auditEntry{
+		operation: "RegisterDomain",
+		domain:    request.GetName(),
+		request:   request,
+	}
+}
+
+func newUpdateDomainAuditEntry(request *gen.UpdateDomainRequest) *auditEntry {
+	return &auditEntry{
+		operation: "UpdateDomain",
+		domain:    request.GetName(),
+		request:   request,
+	}
+}
+
+func newDeprecateDomainAuditEntry(request *gen.DeprecateDomainRequest) *auditEntry {
+	return &auditEntry{
+		operation: "DeprecateDomain",
+		domain:    request.GetName(),
+		request:   request,
+	}
+}
+
+func newTerminateAuditEntry(request *gen.TerminateWorkflowExecutionRequest) *auditEntry {
+	return &auditEntry{
+		operation:  "TerminateWorkflowExecution",
+		domain:     request.GetDomain(),
+		workflowID: request.GetWorkflowExecution().GetWorkflowId(),
+		runID:      request.GetWorkflowExecution().GetRunId(),
+		identity:   request.GetIdentity(),
+		reason:     request.GetReason(),
+		request:    request,
+	}
+}
+
+func newPauseAuditEntry(request *gen.PauseWorkflowExecutionRequest) *auditEntry {
+	return &auditEntry{
+		operation:  "PauseWorkflowExecution",
+		domain:     request.GetDomain(),
+		workflowID: request.GetWorkflowExecution().GetWorkflowId(),
+		runID:      request.GetWorkflowExecution().GetRunId(),
+		identity:   request.GetIdentity(),
+		reason:     request.GetReason(),
+		request:    request,
+	}
+}
+
+func newResumeAuditEntry(request *gen.ResumeWorkflowExecutionRequest) *auditEntry {
+	return &auditEntry{
+		operation:  "ResumeWorkflowExecution",
+		domain:     request.GetDomain(),
+		workflowID: request.GetWorkflowExecution().GetWorkflowId(),
+		runID:      request.GetWorkflowExecution().GetRunId(),
+		identity:   request.GetIdentity(),
+		reason:     request.GetReason(),
+		request:    request,
+	}
+}
+
+func newResetAuditEntry(request *gen.ResetWorkflowExecutionRequest) *auditEntry {
+	return &auditEntry{
+		operation:  "ResetWorkflowExecution",
+		domain:     request.GetDomain(),
+		workflowID: request.GetWorkflowExecution().GetWorkflowId(),
+		runID:      request.GetWorkflowExecution().GetRunId(),
+		reason:     request.GetReason(),
+		request:    request,
+	}
+}
+
+func newRequestCancelAuditEntry(request *gen.RequestCancelWorkflowExecutionRequest) *auditEntry {
+	return &auditEntry{
+		operation:  "RequestCancelWorkflowExecution",
+		domain:     request.GetDomain(),
+		workflowID: request.GetWorkflowExecution().GetWorkflowId(),
+		runID:      request.GetWorkflowExecution().GetRunId(),
+		identity:   request.GetIdentity(),
+		request:    request,
+	}
+}
+
+// newBatchJobAuditEntry returns the audit entry of a batch job start, which is
+// recorded against the domain the batch job operates on rather than the system
+// domain the batch workflow runs in
+func newBatchJobAuditEntry(
+	startRequest *gen.StartWorkflowExecutionRequest,
+	resp *gen.StartWorkflowExecutionResponse,
+) *auditEntry {
+	entry := &auditEntry{
+		operation:  auditOperationStartBatchJob,
+		domain:     startRequest.GetDomain(),
+		workflowID: startRequest.GetWorkflowId(),
+		runID:      resp.GetRunId(),
+		identity:   startRequest.GetIdentity(),
+		request:    startRequest,
+	}
+	var params batcher.BatchParams
+	if err := json.Unmarshal(startRequest.Input, &params); err == nil {
+		if params.DomainName != "" {
+			entry.domain = params.DomainName
+		}
+		entry.reason = params.Reason
+		entry.request = params
+	}
+	return entry
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/batcher"
+)
+
+type (
+	auditLoggerSuite struct {
+		suite.Suite
+
+		mockAuditMgr *mocks.AuditManager
+		auditLogger  *auditLogger
+	}
+)
+
+func TestAuditLoggerSuite(t *testing.T) {
+	s := new(auditLoggerSuite)
+	suite.Run(t, s)
+}
+
+func (s *auditLoggerSuite) SetupTest() {
+	s.mockAuditMgr = &mocks.AuditManager{}
+	s.auditLogger = newAuditLogger(s.mockAuditMgr, loggerimpl.NewNopLogger())
+}
+
+func (s *auditLoggerSuite) TearDownTest() {
+	s.mockAuditMgr.AssertExpectations(s.T())
+}
+
+func (s *auditLoggerSuite) captureRecord() func() *persistence.AuditRecord {
+	var record *persistence.AuditRecord
+	s.mockAuditMgr.On("CreateAuditRecord", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		record = args.Get(0).(*persistence.CreateAuditRecordRequest).Record
+	}).Once()
+	return func() *persistence.AuditRecord { return record }
+}
+
+func (s *auditLoggerSuite) TestRecord_Success() {
+	record := s.captureRecord()
+	request := &shared.TerminateWorkflowExecutionRequest{
+		Domain:            common.StringPtr("some random domain"),
+		WorkflowExecution: &shared.WorkflowExecution{WorkflowId: common.StringPtr("some random workflow ID")},
+		Reason:            common.StringPtr("some random reason"),
+		Identity:          common.StringPtr("some random identity"),
+	}
+
+	s.auditLogger.record(context.Background(), &auditEntry{
+		operation:  "TerminateWorkflowExecution",
+		domain:     request.GetDomain(),
+		workflowID: request.WorkflowExecution.GetWorkflowId(),
+		runID:      "some random run ID",
+		identity:   request.GetIdentity(),
+		reason:     request.GetReason(),
+		request:    request,
+	}, nil)
+
+	s.NotNil(record())
+	s.NotEmpty(record().ID)
+	s.False(record().Timestamp.IsZero())
+	s.Equal("TerminateWorkflowExecution", record().Operation)
+	s.Equal("some random domain", record().DomainName)
+	s.Equal("some random workflow ID", record().WorkflowID)
+	s.Equal("some random run ID", record().RunID)
+	s.Equal("some random identity", record().Identity)
+	s.Equal("some random reason", record().Reason)
+	s.Equal(persistence.AuditResultSuccess, record().Result)
+	s.Contains(record().RequestSummary, "some random workflow ID")
+}
+
+func (s *auditLoggerSuite) TestRecord_Failure() {
+	record := s.captureRecord()
+
+	s.auditLogger.record(context.Background(), &auditEntry{
+		operation: "DeprecateDomain",
+		domain:    "some random domain",
+	}, &shared.EntityNotExistsError{Message: "domain does not exist"})
+
+	s.NotNil(record())
+	s.Equal((&shared.EntityNotExistsError{Message: "domain does not exist"}).Error(), record().Result)
+}
+
+func (s *auditLoggerSuite) TestRecord_ServiceBusy() {
+	s.auditLogger.record(context.Background(), &auditEntry{
+		operation: "RegisterDomain",
+		domain:    "some random domain",
+	}, &shared.ServiceBusyError{Message: "busy"})
+
+	s.mockAuditMgr.AssertNotCalled(s.T(), "CreateAuditRecord", mock.Anything)
+}
+
+func (s *auditLoggerSuite) TestRecord_CallerIdentity() {
+	record := s.captureRecord()
+	ctx := authorization.WithActor(context.Background(), "some random caller")
+
+	s.auditLogger.record(ctx, &auditEntry{
+		operation: "AddSearchAttribute",
+	}, nil)
+
+	s.NotNil(record())
+	s.Equal("some random caller", record().Identity)
+	s.Empty(record().DomainName)
+}
+
+func (s *auditLoggerSuite) TestRecord_WriteFailure() {
+	s.mockAuditMgr.On("CreateAuditRecord", mock.Anything).Return(errors.New("some random error")).Once()
+
+	s.NotPanics(func() {
+		s.auditLogger.record(context.Background(), &auditEntry{operation: "UpdateDomain"}, nil)
+	})
+}
+
+func (s *auditLoggerSuite) TestRecord_NoAuditManager() {
+	auditLogger := newAuditLogger(nil, loggerimpl.NewNopLogger())
+
+	s.NotPanics(func() {
+		auditLogger.record(context.Background(), &auditEntry{operation: "UpdateDomain"}, nil)
+	})
+}
+
+func (s *auditLoggerSuite) TestGetAuditRequestSummary_SecurityToken() {
+	summary := getAuditRequestSummary(&shared.RegisterDomainRequest{
+		Name:          common.StringPtr("some random domain"),
+		SecurityToken: common.StringPtr("some random token"),
+	})
+
+	s.Contains(summary, "some random domain")
+	s.NotContains(summary, "some random token")
+}
+
+func (s *auditLoggerSuite) TestGetAuditRequestSummary_Truncated() {
+	summary := getAuditRequestSummary(&shared.UpdateDomainRequest{
+		Name: common.StringPtr(strings.Repeat("a", 2*auditRequestSummaryMaxLength)),
+	})
+
+	s.Len(summary, auditRequestSummaryMaxLength)
+}
+
+func (s *auditLoggerSuite) TestNewBatchJobAuditEntry() {
+	params := batcher.BatchParams{
+		DomainName: "some random target domain",
+		Query:      "WorkflowType='some random type'",
+		Reason:     "some random reason",
+		BatchType:  batcher.BatchTypeTerminate,
+	}
+	input, err := json.Marshal(params)
+	s.Require().NoError(err)
+
+	entry := newBatchJobAuditEntry(&shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr(common.SystemLocalDomainName),
+		WorkflowId: common.StringPtr("some random batch job ID"),
+		Identity:   common.StringPtr("some random identity"),
+		Input:      input,
+	}, &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr("some random run ID")})
+
+	s.Equal(auditOperationStartBatchJob, entry.operation)
+	s.Equal("some random target domain", entry.domain)
+	s.Equal("some random batch job ID", entry.workflowID)
+	s.Equal("some random run ID", entry.runID)
+	s.Equal("some random identity", entry.identity)
+	s.Equal("some random reason", entry.reason)
+	s.Equal(params, entry.request)
+}
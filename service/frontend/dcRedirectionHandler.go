@@ -280,6 +280,36 @@ func (handler *DCRedirectionHandlerImpl) GetWorkflowExecutionHistory(
 	return resp, err
 }
 
+// ListArchivedWorkflowExecutions API call
+func (handler *DCRedirectionHandlerImpl) ListArchivedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListArchivedWorkflowExecutionsRequest,
+) (resp *shared.ListArchivedWorkflowExecutionsResponse, retError error) {
+
+	var apiName = "ListArchivedWorkflowExecutions"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionListArchivedWorkflowExecutionsScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.ListArchivedWorkflowExecutions(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.ListArchivedWorkflowExecutions(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
 // ListClosedWorkflowExecutions API call
 func (handler *DCRedirectionHandlerImpl) ListClosedWorkflowExecutions(
 	ctx context.Context,
@@ -534,6 +564,36 @@ func (handler *DCRedirectionHandlerImpl) QueryWorkflow(
 	return resp, err
 }
 
+// UpdateWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *shared.UpdateWorkflowExecutionRequest,
+) (resp *shared.UpdateWorkflowExecutionResponse, retError error) {
+
+	var apiName = "UpdateWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.UpdateWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.UpdateWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
 // RecordActivityTaskHeartbeat API call
 func (handler *DCRedirectionHandlerImpl) RecordActivityTaskHeartbeat(
 	ctx context.Context,
@@ -1108,6 +1168,66 @@ func (handler *DCRedirectionHandlerImpl) TerminateWorkflowExecution(
 	return err
 }
 
+// PauseWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.PauseWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "PauseWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.PauseWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// ResumeWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResumeWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "ResumeWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionResumeWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.ResumeWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.ResumeWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *DCRedirectionHandlerImpl) beforeCall(
 	scope int,
 ) (metrics.Scope, time.Time) {
@@ -103,7 +103,7 @@ func (s *dcRedirectionHandlerSuite) SetupTest() {
 	s.mockArchiverProvider.On("RegisterBootstrapContainer", common.FrontendServiceName, mock.Anything, mock.Anything)
 
 	s.domainCache = cache.NewDomainCache(s.mockMetadataMgr, s.service.GetClusterMetadata(), s.service.GetMetricsClient(), s.service.GetLogger())
-	frontendHandler := NewWorkflowHandler(s.service, s.config, s.mockMetadataMgr, nil, nil, nil, nil, s.domainCache, s.mockArchiverProvider)
+	frontendHandler := NewWorkflowHandler(s.service, s.config, s.mockMetadataMgr, nil, nil, nil, nil, s.domainCache, s.mockArchiverProvider, nil)
 	frontendHandler.metricsClient = metricsClient
 	frontendHandler.startWG.Done()
 
@@ -191,6 +191,29 @@ func (s *dcRedirectionHandlerSuite) TestGetWorkflowExecutionHistory() {
 	s.Nil(err)
 }
 
+func (s *dcRedirectionHandlerSuite) TestListArchivedWorkflowExecutions() {
+	apiName := "ListArchivedWorkflowExecutions"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Once()
+
+	req := &shared.ListArchivedWorkflowExecutionsRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	resp, err := s.handler.ListArchivedWorkflowExecutions(context.Background(), req)
+	s.Nil(err)
+	// the resp is initialized to nil, since inner function is not called
+	s.Nil(resp)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.On(apiName, mock.Anything, req).Return(&shared.ListArchivedWorkflowExecutionsResponse{}, nil).Once()
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.On(apiName, mock.Anything, req).Return(&shared.ListArchivedWorkflowExecutionsResponse{}, nil).Once()
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
 func (s *dcRedirectionHandlerSuite) TestListClosedWorkflowExecutions() {
 	apiName := "ListClosedWorkflowExecutions"
 
@@ -375,6 +398,29 @@ func (s *dcRedirectionHandlerSuite) TestQueryWorkflow() {
 	s.Nil(err)
 }
 
+func (s *dcRedirectionHandlerSuite) TestUpdateWorkflowExecution() {
+	apiName := "UpdateWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Once()
+
+	req := &shared.UpdateWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	resp, err := s.handler.UpdateWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+	// the resp is initialized to nil, since inner function is not called
+	s.Nil(resp)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.On(apiName, mock.Anything, req).Return(&shared.UpdateWorkflowExecutionResponse{}, nil).Once()
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.On(apiName, mock.Anything, req).Return(&shared.UpdateWorkflowExecutionResponse{}, nil).Once()
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
 func (s *dcRedirectionHandlerSuite) TestRecordActivityTaskHeartbeat() {
 	apiName := "RecordActivityTaskHeartbeat"
 
@@ -793,3 +839,45 @@ func (s *dcRedirectionHandlerSuite) TestTerminateWorkflowExecution() {
 	err = callFn(s.alternativeClusterName)
 	s.Nil(err)
 }
+
+func (s *dcRedirectionHandlerSuite) TestPauseWorkflowExecution() {
+	apiName := "PauseWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Once()
+
+	req := &shared.PauseWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.PauseWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestResumeWorkflowExecution() {
+	apiName := "ResumeWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Once()
+
+	req := &shared.ResumeWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.ResumeWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
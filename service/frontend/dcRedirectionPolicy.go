@@ -40,6 +40,9 @@ const (
 	// 3. SignalWorkflowExecution
 	// 4. RequestCancelWorkflowExecution
 	// 5. TerminateWorkflowExecution
+	// 6. PauseWorkflowExecution
+	// 7. ResumeWorkflowExecution
+	// 8. UpdateWorkflowExecution
 	// please also reference selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs
 	DCRedirectionPolicySelectedAPIsForwarding = "selected-apis-forwarding"
 )
@@ -72,6 +75,9 @@ var selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs = map[string]struct{}
 	"SignalWorkflowExecution":          {},
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
+	"PauseWorkflowExecution":           {},
+	"ResumeWorkflowExecution":          {},
+	"UpdateWorkflowExecution":          {},
 }
 
 // RedirectionPolicyGenerator generate corresponding redirection policy
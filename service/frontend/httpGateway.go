@@ -0,0 +1,264 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+const (
+	// HTTPGatewayPathPrefix is the path prefix of the APIs served by the HTTP gateway,
+	// each API is called with a POST of its JSON encoded request to the prefix followed by the API name
+	HTTPGatewayPathPrefix = "/WorkflowService/"
+
+	// headers follow the conventions of the yarpc http transport
+	httpCallerHeader    = "Rpc-Caller"
+	httpHeaderPrefix    = "Rpc-Header-"
+	httpAuthHeader      = "Authorization"
+	httpBearerPrefix    = "Bearer "
+	httpTimeoutHeader   = "Context-Ttl-Ms"
+	httpJSONContentType = "application/json"
+	httpProcedurePrefix = "WorkflowService::"
+)
+
+type (
+	// HTTPGateway serves the WorkflowService as JSON over HTTP, for callers which can use neither tchannel
+	// nor gRPC. The gRPC inbound needs no gateway: it is an inbound of the dispatcher, see RPC.GRPCPort.
+	HTTPGateway struct {
+		config *Config
+		logger log.Logger
+		server *http.Server
+		apis   map[string]reflect.Value
+	}
+
+	httpGatewayError struct {
+		Type    string      `json:"type"`
+		Message string      `json:"message"`
+		Details interface{} `json:"details,omitempty"`
+	}
+)
+
+// thriftErrorPkgPath is the package of the errors declared in the IDL
+var thriftErrorPkgPath = reflect.TypeOf(gen.BadRequestError{}).PkgPath()
+
+// NewHTTPGateway creates an HTTP gateway handing the calls over to handler
+func NewHTTPGateway(handler workflowserviceserver.Interface, config *Config, logger log.Logger) *HTTPGateway {
+	gateway := &HTTPGateway{
+		config: config,
+		logger: logger,
+		apis:   make(map[string]reflect.Value),
+	}
+	apiType := reflect.TypeOf((*workflowserviceserver.Interface)(nil)).Elem()
+	handlerValue := reflect.ValueOf(handler)
+	for i := 0; i < apiType.NumMethod(); i++ {
+		name := apiType.Method(i).Name
+		gateway.apis[name] = handlerValue.MethodByName(name)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(HTTPGatewayPathPrefix, gateway)
+	gateway.server = &http.Server{Handler: mux}
+	return gateway
+}
+
+// Serve starts serving the calls accepted by listener
+func (g *HTTPGateway) Serve(listener net.Listener) {
+	go func() {
+		if err := g.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			g.logger.Error("HTTP gateway stopped serving", tag.Error(err))
+		}
+	}()
+	g.logger.Info("HTTP gateway started", tag.Address(listener.Addr().String()))
+}
+
+// Stop stops the gateway and closes its connections
+func (g *HTTPGateway) Stop() {
+	if err := g.server.Close(); err != nil {
+		g.logger.Warn("failed to close HTTP gateway", tag.Error(err))
+	}
+}
+
+// ServeHTTP decodes the request of the API named in the path of r, calls the API and encodes its response
+func (g *HTTPGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		g.writeError(w, http.StatusMethodNotAllowed, &gen.BadRequestError{Message: "only POST is supported"})
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, HTTPGatewayPathPrefix)
+	api, ok := g.apis[name]
+	if !ok {
+		g.writeError(w, http.StatusNotFound, &gen.BadRequestError{Message: fmt.Sprintf("unknown API %q", name)})
+		return
+	}
+
+	ctx, cancel, err := g.newContext(r, name)
+	if err != nil {
+		g.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	defer cancel()
+
+	args := []reflect.Value{reflect.ValueOf(ctx)}
+	if api.Type().NumIn() == 2 {
+		request := reflect.New(api.Type().In(1).Elem())
+		maxRequestSize := g.getMaxRequestSize()
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+		if err != nil {
+			status := http.StatusBadRequest
+			if int64(len(body)) >= maxRequestSize {
+				status = http.StatusRequestEntityTooLarge
+			}
+			g.writeError(w, status, &gen.BadRequestError{Message: err.Error()})
+			return
+		}
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, request.Interface()); err != nil {
+				g.writeError(w, http.StatusBadRequest, &gen.BadRequestError{Message: fmt.Sprintf("invalid request: %v", err)})
+				return
+			}
+		}
+		args = append(args, request)
+	}
+
+	results := api.Call(args)
+	if err, ok := results[len(results)-1].Interface().(error); ok && err != nil {
+		g.writeError(w, getHTTPStatus(err), err)
+		return
+	}
+	var response interface{} = struct{}{}
+	if len(results) == 2 && !results[0].IsNil() {
+		response = results[0].Interface()
+	}
+	g.writeJSON(w, http.StatusOK, response)
+}
+
+// newContext creates the context of the call, carrying the caller and headers of r the way the
+// tchannel inbound does, so that the handler sees the same call metadata whichever way it is called.
+// The caller is only informational, the handler authenticates the token carried by the headers, which
+// may also be given as a bearer token in the Authorization header.
+func (g *HTTPGateway) newContext(r *http.Request, name string) (context.Context, context.CancelFunc, error) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if ttl := r.Header.Get(httpTimeoutHeader); ttl != "" {
+		ttlMs, err := strconv.Atoi(ttl)
+		if err != nil || ttlMs <= 0 {
+			return nil, nil, &gen.BadRequestError{Message: fmt.Sprintf("invalid %v header: %q", httpTimeoutHeader, ttl)}
+		}
+		ctx, cancel = context.WithTimeout(r.Context(), time.Duration(ttlMs)*time.Millisecond)
+	} else {
+		ctx, cancel = context.WithCancel(r.Context())
+	}
+
+	headers := transport.NewHeaders()
+	for key, values := range r.Header {
+		if strings.HasPrefix(key, httpHeaderPrefix) && len(values) > 0 {
+			headers = headers.With(strings.TrimPrefix(key, httpHeaderPrefix), values[0])
+		}
+	}
+	if auth := r.Header.Get(httpAuthHeader); strings.HasPrefix(auth, httpBearerPrefix) {
+		headers = headers.With(authorization.TokenHeaderName, strings.TrimPrefix(auth, httpBearerPrefix))
+	}
+	ctx, call := encoding.NewInboundCall(ctx)
+	if err := call.ReadFromRequest(&transport.Request{
+		Caller:    r.Header.Get(httpCallerHeader),
+		Service:   common.FrontendServiceName,
+		Procedure: httpProcedurePrefix + name,
+		Encoding:  transport.Encoding("json"),
+		Headers:   headers,
+	}); err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	return ctx, cancel, nil
+}
+
+// getMaxRequestSize returns the limit of the request body. The domain of the request is only known once
+// the body is decoded, so the limit derives from the default blob size limit: the blobs are base64 encoded
+// in JSON, which makes them a third larger, and the rest leaves room for the other fields of the request.
+func (g *HTTPGateway) getMaxRequestSize() int64 {
+	return 2 * int64(g.config.BlobSizeLimitError(""))
+}
+
+func (g *HTTPGateway) writeError(w http.ResponseWriter, status int, err error) {
+	response := &httpGatewayError{
+		Type:    "InternalServiceError",
+		Message: err.Error(),
+	}
+	if errType := reflect.TypeOf(err); errType.Kind() == reflect.Ptr && errType.Elem().PkgPath() == thriftErrorPkgPath {
+		// thrift errors carry fields callers may need, e.g. the run ID of an already started workflow
+		response.Type = errType.Elem().Name()
+		response.Details = err
+	}
+	g.writeJSON(w, status, response)
+}
+
+func (g *HTTPGateway) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		g.logger.Error("failed to encode HTTP gateway response", tag.Error(err))
+		status = http.StatusInternalServerError
+		payload, _ = json.Marshal(&httpGatewayError{Type: "InternalServiceError", Message: err.Error()})
+	}
+	w.Header().Set("Content-Type", httpJSONContentType)
+	w.WriteHeader(status)
+	if _, err := w.Write(payload); err != nil {
+		g.logger.Warn("failed to write HTTP gateway response", tag.Error(err))
+	}
+}
+
+func getHTTPStatus(err error) int {
+	switch err := err.(type) {
+	case *gen.BadRequestError, *gen.DomainNotActiveError, *gen.ClientVersionNotSupportedError, *gen.QueryFailedError:
+		return http.StatusBadRequest
+	case *gen.AccessDeniedError:
+		return http.StatusForbidden
+	case *gen.EntityNotExistsError:
+		return http.StatusNotFound
+	case *gen.WorkflowExecutionAlreadyStartedError, *gen.DomainAlreadyExistsError, *gen.CancellationAlreadyRequestedError:
+		return http.StatusConflict
+	case *gen.ServiceBusyError, *gen.LimitExceededError:
+		return http.StatusTooManyRequests
+	case *yarpcerrors.Status:
+		if err.Code() == yarpcerrors.CodeDeadlineExceeded {
+			return http.StatusGatewayTimeout
+		}
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/yarpc"
+)
+
+type (
+	httpGatewaySuite struct {
+		suite.Suite
+
+		mockFrontendHandler *MockWorkflowHandler
+		server              *httptest.Server
+	}
+)
+
+func TestHTTPGatewaySuite(t *testing.T) {
+	s := new(httpGatewaySuite)
+	suite.Run(t, s)
+}
+
+func (s *httpGatewaySuite) SetupTest() {
+	s.mockFrontendHandler = &MockWorkflowHandler{}
+	config := &Config{
+		BlobSizeLimitError: dynamicconfig.GetIntPropertyFilteredByDomain(1024),
+	}
+	s.server = httptest.NewServer(NewHTTPGateway(s.mockFrontendHandler, config, loggerimpl.NewNopLogger()))
+}
+
+func (s *httpGatewaySuite) TearDownTest() {
+	s.server.Close()
+	s.mockFrontendHandler.AssertExpectations(s.T())
+}
+
+func (s *httpGatewaySuite) TestStartWorkflowExecution() {
+	req := &shared.StartWorkflowExecutionRequest{
+		Domain:                common.StringPtr("some random domain name"),
+		WorkflowId:            common.StringPtr("some random workflow ID"),
+		Input:                 []byte("some random input"),
+		WorkflowIdReusePolicy: shared.WorkflowIdReusePolicyRejectDuplicate.Ptr(),
+	}
+	var caller, clientName string
+	s.mockFrontendHandler.On("StartWorkflowExecution", mock.Anything, req).
+		Run(func(args mock.Arguments) {
+			call := yarpc.CallFromContext(args.Get(0).(context.Context))
+			caller = call.Caller()
+			clientName = call.Header(common.ClientImplHeaderName)
+		}).
+		Return(&shared.StartWorkflowExecutionResponse{RunId: common.StringPtr("some random run ID")}, nil).Once()
+
+	status, body := s.post("StartWorkflowExecution", req, map[string]string{
+		"Rpc-Caller": "some random caller",
+		"Rpc-Header-" + common.ClientImplHeaderName: "some random client",
+	})
+	s.Equal(http.StatusOK, status)
+	resp := &shared.StartWorkflowExecutionResponse{}
+	s.NoError(json.Unmarshal(body, resp))
+	s.Equal("some random run ID", resp.GetRunId())
+	s.Equal("some random caller", caller)
+	s.Equal("some random client", clientName)
+}
+
+func (s *httpGatewaySuite) TestGetSearchAttributes() {
+	s.mockFrontendHandler.On("GetSearchAttributes", mock.Anything).
+		Return(&shared.GetSearchAttributesResponse{Keys: map[string]shared.IndexedValueType{
+			"CustomKeywordField": shared.IndexedValueTypeKeyword,
+		}}, nil).Once()
+
+	status, body := s.post("GetSearchAttributes", nil, nil)
+	s.Equal(http.StatusOK, status)
+	resp := &shared.GetSearchAttributesResponse{}
+	s.NoError(json.Unmarshal(body, resp))
+	s.Equal(shared.IndexedValueTypeKeyword, resp.Keys["CustomKeywordField"])
+}
+
+func (s *httpGatewaySuite) TestSignalWorkflowExecution() {
+	req := &shared.SignalWorkflowExecutionRequest{
+		Domain:     common.StringPtr("some random domain name"),
+		SignalName: common.StringPtr("some random signal name"),
+	}
+	s.mockFrontendHandler.On("SignalWorkflowExecution", mock.Anything, req).Return(nil).Once()
+
+	status, body := s.post("SignalWorkflowExecution", req, nil)
+	s.Equal(http.StatusOK, status)
+	s.JSONEq("{}", string(body))
+}
+
+func (s *httpGatewaySuite) TestBearerToken() {
+	req := &shared.SignalWorkflowExecutionRequest{
+		Domain:     common.StringPtr("some random domain name"),
+		SignalName: common.StringPtr("some random signal name"),
+	}
+	var token string
+	s.mockFrontendHandler.On("SignalWorkflowExecution", mock.Anything, req).
+		Run(func(args mock.Arguments) {
+			token = yarpc.CallFromContext(args.Get(0).(context.Context)).Header(authorization.TokenHeaderName)
+		}).
+		Return(nil).Once()
+
+	status, _ := s.post("SignalWorkflowExecution", req, map[string]string{
+		"Authorization": "Bearer some random token",
+	})
+	s.Equal(http.StatusOK, status)
+	s.Equal("some random token", token)
+}
+
+func (s *httpGatewaySuite) TestError() {
+	req := &shared.StartWorkflowExecutionRequest{
+		Domain: common.StringPtr("some random domain name"),
+	}
+	s.mockFrontendHandler.On("StartWorkflowExecution", mock.Anything, req).
+		Return(nil, &shared.WorkflowExecutionAlreadyStartedError{
+			Message: common.StringPtr("already started"),
+			RunId:   common.StringPtr("some random run ID"),
+		}).Once()
+
+	status, body := s.post("StartWorkflowExecution", req, nil)
+	s.Equal(http.StatusConflict, status)
+	var resp struct {
+		Type    string                                       `json:"type"`
+		Message string                                       `json:"message"`
+		Details *shared.WorkflowExecutionAlreadyStartedError `json:"details"`
+	}
+	s.NoError(json.Unmarshal(body, &resp))
+	s.Equal("WorkflowExecutionAlreadyStartedError", resp.Type)
+	s.Equal("some random run ID", resp.Details.GetRunId())
+}
+
+func (s *httpGatewaySuite) TestBadRequest() {
+	status, _ := s.post("SomeRandomAPI", nil, nil)
+	s.Equal(http.StatusNotFound, status)
+
+	httpResp, err := http.Post(s.server.URL+HTTPGatewayPathPrefix+"StartWorkflowExecution", httpJSONContentType, bytes.NewReader([]byte("{")))
+	s.NoError(err)
+	httpResp.Body.Close()
+	s.Equal(http.StatusBadRequest, httpResp.StatusCode)
+
+	status, _ = s.post("DescribeDomain", &shared.DescribeDomainRequest{}, map[string]string{"Context-Ttl-Ms": "soon"})
+	s.Equal(http.StatusBadRequest, status)
+
+	httpResp, err = http.Get(s.server.URL + HTTPGatewayPathPrefix + "DescribeDomain")
+	s.NoError(err)
+	httpResp.Body.Close()
+	s.Equal(http.StatusMethodNotAllowed, httpResp.StatusCode)
+}
+
+func (s *httpGatewaySuite) TestRequestTooLarge() {
+	req := &shared.SignalWorkflowExecutionRequest{
+		Domain:     common.StringPtr("some random domain name"),
+		SignalName: common.StringPtr("some random signal name"),
+		Input:      make([]byte, 2048),
+	}
+
+	status, body := s.post("SignalWorkflowExecution", req, nil)
+	s.Equal(http.StatusRequestEntityTooLarge, status)
+	var resp httpGatewayError
+	s.NoError(json.Unmarshal(body, &resp))
+	s.Equal("BadRequestError", resp.Type)
+}
+
+func (s *httpGatewaySuite) TestGetHTTPStatus() {
+	s.Equal(http.StatusForbidden, getHTTPStatus(&shared.AccessDeniedError{}))
+	s.Equal(http.StatusNotFound, getHTTPStatus(&shared.EntityNotExistsError{}))
+	s.Equal(http.StatusTooManyRequests, getHTTPStatus(&shared.ServiceBusyError{}))
+	s.Equal(http.StatusBadRequest, getHTTPStatus(&shared.DomainNotActiveError{}))
+	s.Equal(http.StatusInternalServerError, getHTTPStatus(&shared.InternalServiceError{}))
+}
+
+func (s *httpGatewaySuite) post(api string, request interface{}, headers map[string]string) (int, []byte) {
+	var payload []byte
+	if request != nil {
+		var err error
+		payload, err = json.Marshal(request)
+		s.Require().NoError(err)
+	}
+	httpReq, err := http.NewRequest(http.MethodPost, s.server.URL+HTTPGatewayPathPrefix+api, bytes.NewReader(payload))
+	s.Require().NoError(err)
+	httpReq.Header.Set("Content-Type", httpJSONContentType)
+	for key, value := range headers {
+		httpReq.Header.Set(key, value)
+	}
+	httpResp, err := http.DefaultClient.Do(httpReq)
+	s.Require().NoError(err)
+	defer httpResp.Body.Close()
+	body, err := ioutil.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	return httpResp.StatusCode, body
+}
@@ -23,6 +23,7 @@ package frontend
 import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log/loggerimpl"
@@ -155,6 +156,7 @@ func (s *Service) Start() {
 		VisibilityListMaxQPS:            s.config.VisibilityListMaxQPS,
 		EnableSampling:                  s.config.EnableVisibilitySampling,
 		EnableReadFromClosedExecutionV2: s.config.EnableReadFromClosedExecutionV2,
+		ValidSearchAttributes:           s.config.ValidSearchAttributes,
 	}
 	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
 
@@ -178,7 +180,7 @@ func (s *Service) Start() {
 			ValidSearchAttributes:  s.config.ValidSearchAttributes,
 		}
 		visibilityFromES = espersistence.NewESVisibilityManager(visibilityIndexName, params.ESClient, visibilityConfigForES,
-			nil, base.GetMetricsClient(), log)
+			nil, persistencefactory.NewPayloadEncryption(&pConfig, log), base.GetMetricsClient(), log)
 	}
 	visibility := persistence.NewVisibilityManagerWrapper(visibilityFromDB, visibilityFromES, s.config.EnableReadVisibilityFromES)
 
@@ -212,25 +214,50 @@ func (s *Service) Start() {
 		ClusterMetadata:  base.GetClusterMetadata(),
 		DomainCache:      domainCache,
 	}
-	params.ArchiverProvider.RegisterBootstrapContainer(common.FrontendServiceName, historyArchiverBootstrapContainer, &archiver.VisibilityBootstrapContainer{})
+	visibilityArchiverBootstrapContainer := &archiver.VisibilityBootstrapContainer{
+		Logger:          base.GetLogger(),
+		MetricsClient:   base.GetMetricsClient(),
+		ClusterMetadata: base.GetClusterMetadata(),
+		DomainCache:     domainCache,
+	}
+	params.ArchiverProvider.RegisterBootstrapContainer(common.FrontendServiceName, historyArchiverBootstrapContainer, visibilityArchiverBootstrapContainer)
+
+	audit, err := pFactory.NewAuditManager()
+	if err != nil {
+		log.Fatal("Creating audit manager persistence failed", tag.Error(err))
+	}
 
-	wfHandler := NewWorkflowHandler(base, s.config, metadata, history, historyV2, visibility, kafkaProducer, domainCache, params.ArchiverProvider)
+	wfHandler := NewWorkflowHandler(base, s.config, metadata, history, historyV2, visibility, kafkaProducer, domainCache,
+		params.ArchiverProvider, audit)
 	dcRedirectionHandler := NewDCRedirectionHandler(wfHandler, params.DCRedirectionPolicy)
-	dcRedirectionHandler.RegisterHandler()
+	authenticator := params.Authenticator
+	if authenticator == nil {
+		authenticator = authorization.NewNopAuthenticator()
+	}
+	authorizer := params.Authorizer
+	if authorizer == nil {
+		authorizer = authorization.NewNopAuthorizer()
+	}
+	accessControlledHandler := NewAccessControlledWorkflowHandler(wfHandler, dcRedirectionHandler, authenticator, authorizer)
+	accessControlledHandler.RegisterHandler()
 
-	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, history, historyV2, s.params)
+	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, history, historyV2, audit, s.params)
 	adminHandler.RegisterHandler()
 
 	// must start base service first
 	base.Start()
-	err = dcRedirectionHandler.Start()
+	err = accessControlledHandler.Start()
 	if err != nil {
-		log.Fatal("DC redirection handler failed to start", tag.Error(err))
+		log.Fatal("Access controlled handler failed to start", tag.Error(err))
 	}
 	err = adminHandler.Start()
 	if err != nil {
 		log.Fatal("Admin handler failed to start", tag.Error(err))
 	}
+	httpGateway := NewHTTPGateway(accessControlledHandler, s.config, log)
+	if listener := params.RPCFactory.CreateHTTPListener(); listener != nil {
+		httpGateway.Serve(listener)
+	}
 
 	// base (service is not started in frontend or admin handler) in case of race condition in yarpc registration function
 
@@ -238,6 +265,7 @@ func (s *Service) Start() {
 
 	<-s.stopC
 
+	httpGateway.Stop()
 	base.Stop()
 }
 
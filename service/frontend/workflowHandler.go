@@ -42,7 +42,6 @@ import (
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
-	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -51,6 +50,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/service/worker/batcher"
 	"go.uber.org/yarpc/yarpcerrors"
 )
 
@@ -77,6 +77,8 @@ type (
 		visibilityQueryValidator  *validator.VisibilityQueryValidator
 		searchAttributesValidator *validator.SearchAttributesValidator
 		archiverProvider          provider.ArchiverProvider
+		auditMgr                  persistence.AuditManager
+		auditLogger               *auditLogger
 		service.Service
 	}
 
@@ -119,17 +121,30 @@ var (
 	errWorkflowTypeNotSet                         = &gen.BadRequestError{Message: "WorkflowType is not set on request."}
 	errInvalidExecutionStartToCloseTimeoutSeconds = &gen.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
 	errInvalidTaskStartToCloseTimeoutSeconds      = &gen.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
+	errInvalidDelayStartSeconds                   = &gen.BadRequestError{Message: "A valid DelayStartSeconds is not set on request."}
 	errClientVersionNotSet                        = &gen.BadRequestError{Message: "Client version is not set on request."}
 	errInvalidRetentionPeriod                     = &gen.BadRequestError{Message: "A valid retention period is not set on request."}
+	errShardIDNotSet                              = &gen.BadRequestError{Message: "ShardId is not set on request."}
+	errInvalidShardID                             = &gen.BadRequestError{Message: "Invalid ShardId."}
+	errDLQTaskCategoryNotSet                      = &gen.BadRequestError{Message: "DLQ task category is not set on request."}
+	errDLQTaskIDNotSet                            = &gen.BadRequestError{Message: "DLQ TaskId is not set on request."}
+	errShardQueueTypeNotSet                       = &gen.BadRequestError{Message: "Shard QueueType is not set on request."}
+	errShardQueueTaskIDNotSet                     = &gen.BadRequestError{Message: "Shard queue TaskId is not set on request."}
+	errVisibilityTimestampNotSet                  = &gen.BadRequestError{Message: "VisibilityTimestamp is not set on request for timer task."}
+	errEarliestTimeIsGreaterThanLatestTime        = &gen.BadRequestError{Message: "EarliestTime is greater than LatestTime."}
 
 	// err for archival
-	errHistoryHasPassedRetentionPeriod = &gen.BadRequestError{Message: "Requested workflow history has passed retention period."}
+	errHistoryHasPassedRetentionPeriod            = &gen.BadRequestError{Message: "Requested workflow history has passed retention period."}
+	errClusterIsNotConfiguredForVisibility        = &gen.BadRequestError{Message: "Cluster is not configured for visibility archival."}
+	errClusterIsNotConfiguredForReadingVisibility = &gen.BadRequestError{Message: "Cluster is not configured for reading archived visibility records."}
+	errDomainIsNotConfiguredForVisibility         = &gen.BadRequestError{Message: "Domain is not configured for visibility archival."}
 
 	// err for string too long
 	errDomainTooLong       = &gen.BadRequestError{Message: "Domain length exceeds limit."}
 	errWorkflowTypeTooLong = &gen.BadRequestError{Message: "WorkflowType length exceeds limit."}
 	errWorkflowIDTooLong   = &gen.BadRequestError{Message: "WorkflowID length exceeds limit."}
 	errSignalNameTooLong   = &gen.BadRequestError{Message: "SignalName length exceeds limit."}
+	errUpdateNameTooLong   = &gen.BadRequestError{Message: "UpdateName length exceeds limit."}
 	errTaskListTooLong     = &gen.BadRequestError{Message: "TaskList length exceeds limit."}
 	errRequestIDTooLong    = &gen.BadRequestError{Message: "RequestID length exceeds limit."}
 	errIdentityTooLong     = &gen.BadRequestError{Message: "Identity length exceeds limit."}
@@ -148,7 +163,8 @@ var (
 func NewWorkflowHandler(sVice service.Service, config *Config, metadataMgr persistence.MetadataManager,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
 	visibilityMgr persistence.VisibilityManager, kafkaProducer messaging.Producer,
-	domainCache cache.DomainCache, archiverProvider provider.ArchiverProvider) *WorkflowHandler {
+	domainCache cache.DomainCache, archiverProvider provider.ArchiverProvider,
+	auditMgr persistence.AuditManager) *WorkflowHandler {
 	handler := &WorkflowHandler{
 		Service:         sVice,
 		config:          config,
@@ -156,6 +172,8 @@ func NewWorkflowHandler(sVice service.Service, config *Config, metadataMgr persi
 		historyMgr:      historyMgr,
 		historyV2Mgr:    historyV2Mgr,
 		visibilityMgr:   visibilityMgr,
+		auditMgr:        auditMgr,
+		auditLogger:     newAuditLogger(auditMgr, sVice.GetLogger()),
 		tokenSerializer: common.NewJSONTaskTokenSerializer(),
 		metricsClient:   sVice.GetMetricsClient(),
 		domainCache:     domainCache,
@@ -184,7 +202,13 @@ func NewWorkflowHandler(sVice service.Service, config *Config, metadataMgr persi
 		ClusterMetadata:  sVice.GetClusterMetadata(),
 		DomainCache:      handler.domainCache,
 	}
-	archiverProvider.RegisterBootstrapContainer(common.FrontendServiceName, historyArchiverBootstrapContainer, &archiver.VisibilityBootstrapContainer{})
+	visibilityArchiverBootstrapContainer := &archiver.VisibilityBootstrapContainer{
+		Logger:          sVice.GetLogger(),
+		MetricsClient:   handler.metricsClient,
+		ClusterMetadata: sVice.GetClusterMetadata(),
+		DomainCache:     handler.domainCache,
+	}
+	archiverProvider.RegisterBootstrapContainer(common.FrontendServiceName, historyArchiverBootstrapContainer, visibilityArchiverBootstrapContainer)
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
 	return handler
@@ -215,6 +239,9 @@ func (wh *WorkflowHandler) Stop() {
 	wh.metadataMgr.Close()
 	wh.visibilityMgr.Close()
 	wh.historyMgr.Close()
+	if wh.auditMgr != nil {
+		wh.auditMgr.Close()
+	}
 	wh.Service.Stop()
 }
 
@@ -231,6 +258,9 @@ func (wh *WorkflowHandler) Health(ctx context.Context) (*health.HealthStatus, er
 // acts as a sandbox and provides isolation for all resources within the domain.  All resources belongs to exactly one
 // domain.
 func (wh *WorkflowHandler) RegisterDomain(ctx context.Context, registerRequest *gen.RegisterDomainRequest) (retError error) {
+	defer func() {
+		wh.auditLogger.record(ctx, newRegisterDomainAuditEntry(registerRequest), retError)
+	}()
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendRegisterDomainScope)
@@ -294,6 +324,9 @@ func (wh *WorkflowHandler) UpdateDomain(
 	ctx context.Context,
 	updateRequest *gen.UpdateDomainRequest,
 ) (resp *gen.UpdateDomainResponse, retError error) {
+	defer func() {
+		wh.auditLogger.record(ctx, newUpdateDomainAuditEntry(updateRequest), retError)
+	}()
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendUpdateDomainScope)
@@ -314,6 +347,9 @@ func (wh *WorkflowHandler) UpdateDomain(
 // it cannot be used to start new workflow executions.  Existing workflow executions will continue to run on
 // deprecated domains.
 func (wh *WorkflowHandler) DeprecateDomain(ctx context.Context, deprecateRequest *gen.DeprecateDomainRequest) (retError error) {
+	defer func() {
+		wh.auditLogger.record(ctx, newDeprecateDomainAuditEntry(deprecateRequest), retError)
+	}()
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendDeprecateDomainScope)
@@ -1496,6 +1532,11 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	ctx context.Context,
 	startRequest *gen.StartWorkflowExecutionRequest,
 ) (resp *gen.StartWorkflowExecutionResponse, retError error) {
+	defer func() {
+		if startRequest.GetWorkflowType().GetName() == batcher.BatchWFTypeName {
+			wh.auditLogger.record(ctx, newBatchJobAuditEntry(startRequest, resp), retError)
+		}
+	}()
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendStartWorkflowExecutionScope, startRequest)
@@ -1562,6 +1603,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errInvalidTaskStartToCloseTimeoutSeconds, scope)
 	}
 
+	if startRequest.GetDelayStartSeconds() < 0 {
+		return nil, wh.error(errInvalidDelayStartSeconds, scope)
+	}
+
 	if startRequest.GetRequestId() == "" {
 		return nil, wh.error(errRequestIDNotSet, scope)
 	}
@@ -1916,6 +1961,90 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 	return nil
 }
 
+// UpdateWorkflowExecution is used to send a named update to a running workflow execution and wait for its result.
+// This results in WorkflowExecutionUpdateRequested event recorded in the history and a decision task being created
+// for the execution. The call returns once the worker accepts or rejects the update with the decision task.
+func (wh *WorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	updateRequest *gen.UpdateWorkflowExecutionRequest,
+) (resp *gen.UpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendUpdateWorkflowExecutionScope, updateRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if updateRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(updateRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if updateRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if len(updateRequest.GetDomain()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errDomainTooLong, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(updateRequest.WorkflowExecution, scope); err != nil {
+		return nil, err
+	}
+
+	if updateRequest.GetUpdateName() == "" {
+		return nil, wh.error(&gen.BadRequestError{Message: "UpdateName is not set on request."}, scope)
+	}
+
+	if len(updateRequest.GetUpdateName()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errUpdateNameTooLong, scope)
+	}
+
+	if len(updateRequest.GetRequestId()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errRequestIDTooLong, scope)
+	}
+
+	if updateRequest.GetRequestId() == "" {
+		// generate the request ID here so retries to the history service are deduplicated
+		updateRequest.RequestId = common.StringPtr(uuid.New())
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(updateRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	sizeLimitError := wh.config.BlobSizeLimitError(updateRequest.GetDomain())
+	sizeLimitWarn := wh.config.BlobSizeLimitWarn(updateRequest.GetDomain())
+	if err := common.CheckEventBlobSizeLimit(
+		len(updateRequest.Input),
+		sizeLimitWarn,
+		sizeLimitError,
+		domainID,
+		updateRequest.GetWorkflowExecution().GetWorkflowId(),
+		updateRequest.GetWorkflowExecution().GetRunId(),
+		scope,
+		wh.GetThrottledLogger(),
+	); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	hResponse, err := wh.history.UpdateWorkflowExecution(ctx, &h.UpdateWorkflowExecutionRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		UpdateRequest: updateRequest,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return hResponse.GetResponse(), nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -1993,6 +2122,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 			Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}, scope)
 	}
 
+	if signalWithStartRequest.GetDelayStartSeconds() < 0 {
+		return nil, wh.error(errInvalidDelayStartSeconds, scope)
+	}
+
 	if err := common.ValidateRetryPolicy(signalWithStartRequest.RetryPolicy); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2085,6 +2218,9 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 	ctx context.Context,
 	terminateRequest *gen.TerminateWorkflowExecutionRequest,
 ) (retError error) {
+	defer func() {
+		wh.auditLogger.record(ctx, newTerminateAuditEntry(terminateRequest), retError)
+	}()
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendTerminateWorkflowExecutionScope, terminateRequest)
@@ -2126,12 +2262,115 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 	return nil
 }
 
+// PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event
+// in the history. Decision and activity tasks are not dispatched until the execution is resumed.
+func (wh *WorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *gen.PauseWorkflowExecutionRequest,
+) (retError error) {
+	defer func() {
+		wh.auditLogger.record(ctx, newPauseAuditEntry(pauseRequest), retError)
+	}()
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendPauseWorkflowExecutionScope, pauseRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(pauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if pauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(pauseRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(pauseRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.PauseWorkflowExecution(ctx, &h.PauseWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		PauseRequest: pauseRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// ResumeWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionResumed event
+// in the history and dispatching any decision and activity tasks held while paused.
+func (wh *WorkflowHandler) ResumeWorkflowExecution(
+	ctx context.Context,
+	resumeRequest *gen.ResumeWorkflowExecutionRequest,
+) (retError error) {
+	defer func() {
+		wh.auditLogger.record(ctx, newResumeAuditEntry(resumeRequest), retError)
+	}()
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendResumeWorkflowExecutionScope, resumeRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if resumeRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(resumeRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if resumeRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(resumeRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(resumeRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.ResumeWorkflowExecution(ctx, &h.ResumeWorkflowExecutionRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		ResumeRequest: resumeRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution to the nextFirstEventID
 // in the history and immediately terminating the current execution instance.
 func (wh *WorkflowHandler) ResetWorkflowExecution(
 	ctx context.Context,
 	resetRequest *gen.ResetWorkflowExecutionRequest,
 ) (resp *gen.ResetWorkflowExecutionResponse, retError error) {
+	defer func() {
+		wh.auditLogger.record(ctx, newResetAuditEntry(resetRequest), retError)
+	}()
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendResetWorkflowExecutionScope, resetRequest)
@@ -2178,6 +2417,9 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 	ctx context.Context,
 	cancelRequest *gen.RequestCancelWorkflowExecutionRequest,
 ) (retError error) {
+	defer func() {
+		wh.auditLogger.record(ctx, newRequestCancelAuditEntry(cancelRequest), retError)
+	}()
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendRequestCancelWorkflowExecutionScope, cancelRequest)
@@ -2456,6 +2698,97 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(
 	return resp, nil
 }
 
+// ListArchivedWorkflowExecutions - retrieves archived info for closed workflow executions in a domain
+func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(
+	ctx context.Context,
+	listRequest *gen.ListArchivedWorkflowExecutionsRequest,
+) (resp *gen.ListArchivedWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendListArchivedWorkflowExecutionsScope, listRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if listRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(listRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if listRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if listRequest.CloseTimeFilter != nil &&
+		listRequest.CloseTimeFilter.EarliestTime != nil &&
+		listRequest.CloseTimeFilter.LatestTime != nil &&
+		listRequest.CloseTimeFilter.GetEarliestTime() > listRequest.CloseTimeFilter.GetLatestTime() {
+		return nil, wh.error(&gen.BadRequestError{Message: "EarliestTime in CloseTimeFilter should not be larger than LatestTime"}, scope)
+	}
+
+	if listRequest.GetMaximumPageSize() <= 0 {
+		listRequest.MaximumPageSize = common.Int32Ptr(int32(wh.config.VisibilityMaxPageSize(listRequest.GetDomain())))
+	}
+
+	if !wh.GetClusterMetadata().VisibilityArchivalConfig().ClusterConfiguredForArchival() {
+		return nil, wh.error(errClusterIsNotConfiguredForVisibility, scope)
+	}
+
+	if !wh.GetClusterMetadata().VisibilityArchivalConfig().EnableReadFromArchival {
+		return nil, wh.error(errClusterIsNotConfiguredForReadingVisibility, scope)
+	}
+
+	entry, err := wh.domainCache.GetDomain(listRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	archivalURI := entry.GetConfig().VisibilityArchivalURI
+	if archivalURI == "" {
+		return nil, wh.error(errDomainIsNotConfiguredForVisibility, scope)
+	}
+
+	scheme, err := common.GetArchivalScheme(archivalURI)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	visibilityArchiver, err := wh.archiverProvider.GetVisibilityArchiver(scheme, common.FrontendServiceName)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	getRequest := &archiver.GetVisibilityRequest{
+		DomainID:         entry.GetInfo().ID,
+		WorkflowIDPrefix: listRequest.WorkflowIDPrefix,
+		CloseStatus:      listRequest.StatusFilter,
+		PageSize:         int(listRequest.GetMaximumPageSize()),
+		NextPageToken:    listRequest.NextPageToken,
+	}
+	if listRequest.TypeFilter != nil {
+		getRequest.WorkflowTypeName = listRequest.TypeFilter.Name
+	}
+	if listRequest.CloseTimeFilter != nil {
+		getRequest.EarliestCloseTime = listRequest.CloseTimeFilter.EarliestTime
+		getRequest.LatestCloseTime = listRequest.CloseTimeFilter.LatestTime
+	}
+
+	archiverResp, err := visibilityArchiver.Get(ctx, archivalURI, getRequest)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return &gen.ListArchivedWorkflowExecutionsResponse{
+		Executions:    archiverResp.Executions,
+		NextPageToken: archiverResp.NextPageToken,
+	}, nil
+}
+
 // ListWorkflowExecutions - retrieves info for workflow executions in a domain
 func (wh *WorkflowHandler) ListWorkflowExecutions(
 	ctx context.Context,
@@ -2732,60 +3065,10 @@ func (wh *WorkflowHandler) QueryWorkflow(
 		return nil, wh.error(err, scope)
 	}
 
-	matchingRequest := &m.QueryWorkflowRequest{
-		DomainUUID:   common.StringPtr(domainID),
-		QueryRequest: queryRequest,
-	}
-
-	// we should always use the mutable state, since it contains the sticky tasklist information
-	response, err := wh.history.GetMutableState(ctx, &h.GetMutableStateRequest{
+	hResponse, err := wh.history.QueryWorkflow(ctx, &h.QueryWorkflowRequest{
 		DomainUUID: common.StringPtr(domainID),
-		Execution:  queryRequest.Execution,
+		Request:    queryRequest,
 	})
-	if err != nil {
-		return nil, wh.error(err, scope)
-	}
-	clientFeature := client.NewFeatureImpl(
-		response.GetClientLibraryVersion(),
-		response.GetClientFeatureVersion(),
-		response.GetClientImpl(),
-	)
-
-	queryRequest.Execution.RunId = response.Execution.RunId
-	if len(response.StickyTaskList.GetName()) != 0 && clientFeature.SupportStickyQuery() {
-		matchingRequest.TaskList = response.StickyTaskList
-		stickyDecisionTimeout := response.GetStickyTaskListScheduleToStartTimeout()
-		// using a clean new context in case customer provide a context which has
-		// a really short deadline, causing we clear the stickyness
-		stickyContext, cancel := context.WithTimeout(context.Background(), time.Duration(stickyDecisionTimeout)*time.Second)
-		matchingResp, err := wh.matchingRawClient.QueryWorkflow(stickyContext, matchingRequest)
-		cancel()
-		if err == nil {
-			return matchingResp, nil
-		}
-		if yarpcError, ok := err.(*yarpcerrors.Status); !ok || yarpcError.Code() != yarpcerrors.CodeDeadlineExceeded {
-			wh.Service.GetLogger().Info("QueryWorkflowFailed.",
-				tag.WorkflowDomainName(queryRequest.GetDomain()),
-				tag.WorkflowID(queryRequest.Execution.GetWorkflowId()),
-				tag.WorkflowRunID(queryRequest.Execution.GetRunId()),
-				tag.WorkflowQueryType(queryRequest.Query.GetQueryType()))
-			return nil, wh.error(err, scope)
-		}
-		// this means sticky timeout, should try using the normal tasklist
-		// we should clear the stickyness of this workflow
-		resetContext, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		_, err = wh.history.ResetStickyTaskList(resetContext, &h.ResetStickyTaskListRequest{
-			DomainUUID: common.StringPtr(domainID),
-			Execution:  queryRequest.Execution,
-		})
-		cancel()
-		if err != nil {
-			return nil, wh.error(err, scope)
-		}
-	}
-
-	matchingRequest.TaskList = response.TaskList
-	matchingResp, err := wh.matching.QueryWorkflow(ctx, matchingRequest)
 	if err != nil {
 		wh.Service.GetLogger().Info("QueryWorkflowFailed.",
 			tag.WorkflowDomainName(queryRequest.GetDomain()),
@@ -2795,7 +3078,7 @@ func (wh *WorkflowHandler) QueryWorkflow(
 		return nil, wh.error(err, scope)
 	}
 
-	return matchingResp, nil
+	return hResponse.GetResponse(), nil
 }
 
 // DescribeWorkflowExecution returns information about the specified workflow execution.
@@ -3045,6 +3328,9 @@ func (wh *WorkflowHandler) error(err error, scope metrics.Scope) error {
 	case *gen.ClientVersionNotSupportedError:
 		scope.IncCounter(metrics.CadenceErrClientVersionNotSupportedCounter)
 		return err
+	case *gen.AccessDeniedError:
+		scope.IncCounter(metrics.CadenceErrAccessDeniedCounter)
+		return err
 	case *yarpcerrors.Status:
 		if err.Code() == yarpcerrors.CodeDeadlineExceeded {
 			scope.IncCounter(metrics.CadenceErrContextTimeoutCounter)
@@ -3200,6 +3486,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		WorkflowExecutionTaskList: matchingResp.WorkflowExecutionTaskList,
 		ScheduledTimestamp:        matchingResp.ScheduledTimestamp,
 		StartedTimestamp:          matchingResp.StartedTimestamp,
+		Queries:                   matchingResp.Queries,
 	}
 
 	return resp, nil
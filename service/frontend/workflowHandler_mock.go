@@ -184,6 +184,29 @@ func (_m *MockWorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context,
 	return r0, r1
 }
 
+// ListArchivedWorkflowExecutions provides a mock function with given fields: ctx, ListRequest
+func (_m *MockWorkflowHandler) ListArchivedWorkflowExecutions(ctx context.Context, ListRequest *shared.ListArchivedWorkflowExecutionsRequest) (*shared.ListArchivedWorkflowExecutionsResponse, error) {
+	ret := _m.Called(ctx, ListRequest)
+
+	var r0 *shared.ListArchivedWorkflowExecutionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ListArchivedWorkflowExecutionsRequest) *shared.ListArchivedWorkflowExecutionsResponse); ok {
+		r0 = rf(ctx, ListRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListArchivedWorkflowExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ListArchivedWorkflowExecutionsRequest) error); ok {
+		r1 = rf(ctx, ListRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListClosedWorkflowExecutions provides a mock function with given fields: ctx, ListRequest
 func (_m *MockWorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context, ListRequest *shared.ListClosedWorkflowExecutionsRequest) (*shared.ListClosedWorkflowExecutionsResponse, error) {
 	ret := _m.Called(ctx, ListRequest)
@@ -345,6 +368,29 @@ func (_m *MockWorkflowHandler) QueryWorkflow(ctx context.Context, QueryRequest *
 	return r0, r1
 }
 
+// UpdateWorkflowExecution provides a mock function with given fields: ctx, UpdateRequest
+func (_m *MockWorkflowHandler) UpdateWorkflowExecution(ctx context.Context, UpdateRequest *shared.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, UpdateRequest)
+
+	var r0 *shared.UpdateWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, UpdateRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.UpdateWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.UpdateWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, UpdateRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RecordActivityTaskHeartbeat provides a mock function with given fields: ctx, HeartbeatRequest
 func (_m *MockWorkflowHandler) RecordActivityTaskHeartbeat(ctx context.Context, HeartbeatRequest *shared.RecordActivityTaskHeartbeatRequest) (*shared.RecordActivityTaskHeartbeatResponse, error) {
 	ret := _m.Called(ctx, HeartbeatRequest)
@@ -697,6 +743,34 @@ func (_m *MockWorkflowHandler) TerminateWorkflowExecution(ctx context.Context, T
 	return r0
 }
 
+// PauseWorkflowExecution provides a mock function with given fields: ctx, PauseRequest
+func (_m *MockWorkflowHandler) PauseWorkflowExecution(ctx context.Context, PauseRequest *shared.PauseWorkflowExecutionRequest) error {
+	ret := _m.Called(ctx, PauseRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.PauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, PauseRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeWorkflowExecution provides a mock function with given fields: ctx, ResumeRequest
+func (_m *MockWorkflowHandler) ResumeWorkflowExecution(ctx context.Context, ResumeRequest *shared.ResumeWorkflowExecutionRequest) error {
+	ret := _m.Called(ctx, ResumeRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ResumeWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, ResumeRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateDomain provides a mock function with given fields: ctx, UpdateRequest
 func (_m *MockWorkflowHandler) UpdateDomain(ctx context.Context, UpdateRequest *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse, error) {
 	ret := _m.Called(ctx, UpdateRequest)
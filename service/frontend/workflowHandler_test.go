@@ -131,7 +131,7 @@ func (s *workflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandl
 		s.mockService.GetLogger(),
 	)
 	return NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr,
-		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, domainCache, s.mockArchiverProvider)
+		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, domainCache, s.mockArchiverProvider, nil)
 }
 
 func (s *workflowHandlerSuite) getWorkflowHandlerHelper() *WorkflowHandler {
@@ -480,7 +480,7 @@ func (s *workflowHandlerSuite) getWorkflowHandlerWithParams(mService cs.Service,
 	s.mockArchiverProvider.On("RegisterBootstrapContainer", common.FrontendServiceName, mock.Anything, mock.Anything)
 	domainCache := cache.NewDomainCache(mMetadataManager, mService.GetClusterMetadata(), mService.GetMetricsClient(), mService.GetLogger())
 	return NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, domainCache, s.mockArchiverProvider)
+		s.mockVisibilityMgr, s.mockProducer, domainCache, s.mockArchiverProvider, nil)
 }
 
 func (s *workflowHandlerSuite) TestRegisterDomain_Failure_InvalidArchivalURI() {
@@ -1117,6 +1117,90 @@ func (s *workflowHandlerSuite) TestGetArchivedHistory_Success_GetFirstPage() {
 	s.True(resp.GetArchived())
 }
 
+func (s *workflowHandlerSuite) TestListArchivedVisibility_Failure_InvalidRequest() {
+	wh := s.getWorkflowHandlerHelper()
+
+	resp, err := wh.ListArchivedWorkflowExecutions(context.Background(), nil)
+	s.Nil(resp)
+	s.Equal(errRequestNotSet, err)
+
+	resp, err = wh.ListArchivedWorkflowExecutions(context.Background(), &shared.ListArchivedWorkflowExecutionsRequest{})
+	s.Nil(resp)
+	s.Equal(errDomainNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestListArchivedVisibility_Failure_ClusterNotConfiguredForArchival() {
+	s.mockClusterMetadata.On("VisibilityArchivalConfig").Return(cluster.NewArchivalConfig(cluster.ArchivalDisabled, false, shared.ArchivalStatusDisabled, ""))
+	wh := s.getWorkflowHandlerHelper()
+
+	resp, err := wh.ListArchivedWorkflowExecutions(context.Background(), listArchivedWorkflowExecutionsTestRequest())
+	s.Nil(resp)
+	s.Equal(errClusterIsNotConfiguredForVisibility, err)
+}
+
+func (s *workflowHandlerSuite) TestListArchivedVisibility_Failure_ClusterNotConfiguredForRead() {
+	s.mockClusterMetadata.On("VisibilityArchivalConfig").Return(cluster.NewArchivalConfig(cluster.ArchivalEnabled, false, shared.ArchivalStatusDisabled, ""))
+	wh := s.getWorkflowHandlerHelper()
+
+	resp, err := wh.ListArchivedWorkflowExecutions(context.Background(), listArchivedWorkflowExecutionsTestRequest())
+	s.Nil(resp)
+	s.Equal(errClusterIsNotConfiguredForReadingVisibility, err)
+}
+
+func (s *workflowHandlerSuite) TestListArchivedVisibility_Failure_DomainNotConfiguredForArchival() {
+	s.mockClusterMetadata.On("VisibilityArchivalConfig").Return(cluster.NewArchivalConfig(cluster.ArchivalEnabled, true, shared.ArchivalStatusDisabled, ""))
+	s.mockDomainCache.On("GetDomain", mock.Anything).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.testDomainID, Name: s.testDomain},
+		&persistence.DomainConfig{
+			VisibilityArchivalStatus: shared.ArchivalStatusDisabled,
+		},
+		"",
+		nil,
+	), nil)
+	wh := s.getWorkflowHandlerHelper()
+
+	resp, err := wh.ListArchivedWorkflowExecutions(context.Background(), listArchivedWorkflowExecutionsTestRequest())
+	s.Nil(resp)
+	s.Equal(errDomainIsNotConfiguredForVisibility, err)
+}
+
+func (s *workflowHandlerSuite) TestListArchivedVisibility_Success() {
+	s.mockClusterMetadata.On("VisibilityArchivalConfig").Return(cluster.NewArchivalConfig(cluster.ArchivalEnabled, true, shared.ArchivalStatusDisabled, ""))
+	s.mockDomainCache.On("GetDomain", mock.Anything).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.testDomainID, Name: s.testDomain},
+		&persistence.DomainConfig{
+			VisibilityArchivalStatus: shared.ArchivalStatusEnabled,
+			VisibilityArchivalURI:    testVisibilityArchivalURI,
+		},
+		"",
+		nil,
+	), nil)
+	mVisibilityArchiver := &archiver.VisibilityArchiverMock{}
+	nextPageToken := []byte{'1', '2', '3'}
+	executions := []*shared.WorkflowExecutionInfo{
+		{Execution: &shared.WorkflowExecution{WorkflowId: common.StringPtr(testWorkflowID), RunId: common.StringPtr(testRunID)}},
+	}
+	listRequest := listArchivedWorkflowExecutionsTestRequest()
+	mVisibilityArchiver.On("Get", mock.Anything, testVisibilityArchivalURI, &archiver.GetVisibilityRequest{
+		DomainID:          s.testDomainID,
+		WorkflowIDPrefix:  listRequest.WorkflowIDPrefix,
+		EarliestCloseTime: listRequest.CloseTimeFilter.EarliestTime,
+		LatestCloseTime:   listRequest.CloseTimeFilter.LatestTime,
+		PageSize:          int(listRequest.GetMaximumPageSize()),
+	}).Return(&archiver.GetVisibilityResponse{
+		Executions:    executions,
+		NextPageToken: nextPageToken,
+	}, nil)
+	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(mVisibilityArchiver, nil)
+	wh := s.getWorkflowHandlerHelper()
+
+	resp, err := wh.ListArchivedWorkflowExecutions(context.Background(), listRequest)
+	s.NoError(err)
+	s.Equal(executions, resp.Executions)
+	s.Equal(nextPageToken, resp.NextPageToken)
+	mVisibilityArchiver.AssertExpectations(s.T())
+}
+
 func (s *workflowHandlerSuite) TestGetHistory() {
 	config := s.newConfig()
 	domainID := uuid.New()
@@ -1373,6 +1457,18 @@ func registerDomainRequest(
 	}
 }
 
+func listArchivedWorkflowExecutionsTestRequest() *shared.ListArchivedWorkflowExecutionsRequest {
+	return &shared.ListArchivedWorkflowExecutionsRequest{
+		Domain:          common.StringPtr("some random domain name"),
+		MaximumPageSize: common.Int32Ptr(10),
+		CloseTimeFilter: &shared.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(0),
+			LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+		},
+		WorkflowIDPrefix: common.StringPtr("some random workflowID prefix"),
+	}
+}
+
 func getHistoryRequest(nextPageToken []byte) *shared.GetWorkflowExecutionHistoryRequest {
 	return &shared.GetWorkflowExecutionHistoryRequest{
 		Execution: &shared.WorkflowExecution{
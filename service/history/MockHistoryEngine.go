@@ -112,6 +112,52 @@ func (_m *MockHistoryEngine) GetMutableState(ctx context.Context, request *gohis
 	return r0, r1
 }
 
+// QueryWorkflow is mock implementation for QueryWorkflow of HistoryEngine
+func (_m *MockHistoryEngine) QueryWorkflow(ctx context.Context, request *gohistory.QueryWorkflowRequest) (*gohistory.QueryWorkflowResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *gohistory.QueryWorkflowResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.QueryWorkflowRequest) *gohistory.QueryWorkflowResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.QueryWorkflowResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.QueryWorkflowRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWorkflowExecution is mock implementation for UpdateWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) UpdateWorkflowExecution(ctx context.Context, request *gohistory.UpdateWorkflowExecutionRequest) (*gohistory.UpdateWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *gohistory.UpdateWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.UpdateWorkflowExecutionRequest) *gohistory.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.UpdateWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.UpdateWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResetStickyTaskList is mock implementation for ResetStickyTaskList of HistoryEngine
 func (_m *MockHistoryEngine) ResetStickyTaskList(ctx context.Context, request *gohistory.ResetStickyTaskListRequest) (*gohistory.ResetStickyTaskListResponse, error) {
 	ret := _m.Called(request)
@@ -386,6 +432,34 @@ func (_m *MockHistoryEngine) TerminateWorkflowExecution(ctx context.Context, req
 	return r0
 }
 
+// PauseWorkflowExecution is mock implementation for PauseWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) PauseWorkflowExecution(ctx context.Context, request *gohistory.PauseWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.PauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeWorkflowExecution is mock implementation for ResumeWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) ResumeWorkflowExecution(ctx context.Context, request *gohistory.ResumeWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.ResumeWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResetWorkflowExecution is mock implementation for TerminateWorkflowExecution of HistoryEngine
 func (_m *MockHistoryEngine) ResetWorkflowExecution(ctx context.Context, request *gohistory.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
 	ret := _m.Called(request)
@@ -493,4 +567,152 @@ func (_m *MockHistoryEngine) SyncActivity(ctx context.Context, request *gohistor
 	return r0
 }
 
+// ListDLQTasks is mock implementation for ListDLQTasks of HistoryEngine
+func (_m *MockHistoryEngine) ListDLQTasks(ctx context.Context, request *shared.ListDLQTasksRequest) (*shared.ListDLQTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ListDLQTasksResponse
+	if rf, ok := ret.Get(0).(func(*shared.ListDLQTasksRequest) *shared.ListDLQTasksResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListDLQTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.ListDLQTasksRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDLQTask is mock implementation for DescribeDLQTask of HistoryEngine
+func (_m *MockHistoryEngine) DescribeDLQTask(ctx context.Context, request *shared.DescribeDLQTaskRequest) (*shared.DescribeDLQTaskResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeDLQTaskResponse
+	if rf, ok := ret.Get(0).(func(*shared.DescribeDLQTaskRequest) *shared.DescribeDLQTaskResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeDLQTaskResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.DescribeDLQTaskRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequeueDLQTask is mock implementation for RequeueDLQTask of HistoryEngine
+func (_m *MockHistoryEngine) RequeueDLQTask(ctx context.Context, request *shared.RequeueDLQTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.RequeueDLQTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeDLQTasks is mock implementation for PurgeDLQTasks of HistoryEngine
+func (_m *MockHistoryEngine) PurgeDLQTasks(ctx context.Context, request *shared.PurgeDLQTasksRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.PurgeDLQTasksRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListShardQueueTasks is mock implementation for ListShardQueueTasks of HistoryEngine
+func (_m *MockHistoryEngine) ListShardQueueTasks(ctx context.Context, request *shared.ListShardQueueTasksRequest) (*shared.ListShardQueueTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ListShardQueueTasksResponse
+	if rf, ok := ret.Get(0).(func(*shared.ListShardQueueTasksRequest) *shared.ListShardQueueTasksResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListShardQueueTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.ListShardQueueTasksRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeShardQueues is mock implementation for DescribeShardQueues of HistoryEngine
+func (_m *MockHistoryEngine) DescribeShardQueues(ctx context.Context, request *shared.DescribeShardQueuesRequest) (*shared.DescribeShardQueuesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeShardQueuesResponse
+	if rf, ok := ret.Get(0).(func(*shared.DescribeShardQueuesRequest) *shared.DescribeShardQueuesResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeShardQueuesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.DescribeShardQueuesRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefireShardQueueTask is mock implementation for RefireShardQueueTask of HistoryEngine
+func (_m *MockHistoryEngine) RefireShardQueueTask(ctx context.Context, request *shared.RefireShardQueueTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.RefireShardQueueTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteShardQueueTask is mock implementation for DeleteShardQueueTask of HistoryEngine
+func (_m *MockHistoryEngine) DeleteShardQueueTask(ctx context.Context, request *shared.DeleteShardQueueTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.DeleteShardQueueTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 var _ Engine = (*MockHistoryEngine)(nil)
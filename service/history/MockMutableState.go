@@ -1103,6 +1103,98 @@ func (_m *mockMutableState) AddWorkflowExecutionTerminatedEvent(_a0 string, _a1
 	return r0, r1
 }
 
+// AddWorkflowExecutionPausedEvent provides a mock function with given fields: reason, identity
+func (_m *mockMutableState) AddWorkflowExecutionPausedEvent(reason string, identity string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(reason, identity)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string, string) *shared.HistoryEvent); ok {
+		r0 = rf(reason, identity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(reason, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddWorkflowExecutionResumedEvent provides a mock function with given fields: reason, identity
+func (_m *mockMutableState) AddWorkflowExecutionResumedEvent(reason string, identity string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(reason, identity)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string, string) *shared.HistoryEvent); ok {
+		r0 = rf(reason, identity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(reason, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddWorkflowExecutionUpdateRequestedEvent provides a mock function with given fields: updateID, updateName, input, identity
+func (_m *mockMutableState) AddWorkflowExecutionUpdateRequestedEvent(updateID string, updateName string, input []byte, identity string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(updateID, updateName, input, identity)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string, string, []byte, string) *shared.HistoryEvent); ok {
+		r0 = rf(updateID, updateName, input, identity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, []byte, string) error); ok {
+		r1 = rf(updateID, updateName, input, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent provides a mock function with given fields: decisionCompletedEventID, updateID, result, identity
+func (_m *mockMutableState) AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID int64, updateID string, result *shared.WorkflowUpdateResult, identity string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(decisionCompletedEventID, updateID, result, identity)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(int64, string, *shared.WorkflowUpdateResult, string) *shared.HistoryEvent); ok {
+		r0 = rf(decisionCompletedEventID, updateID, result, identity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, string, *shared.WorkflowUpdateResult, string) error); ok {
+		r1 = rf(decisionCompletedEventID, updateID, result, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CheckResettable provides a mock function with given fields:
 func (_m *mockMutableState) CheckResettable() error {
 	ret := _m.Called()
@@ -1670,6 +1762,20 @@ func (_m *mockMutableState) GetPendingTimerInfos() map[string]*persistence.Timer
 	return r0
 }
 
+// GetPendingUpdateCount provides a mock function with given fields:
+func (_m *mockMutableState) GetPendingUpdateCount() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // GetPreviousStartedEventID provides a mock function with given fields:
 func (_m *mockMutableState) GetPreviousStartedEventID() int64 {
 	ret := _m.Called()
@@ -1841,6 +1947,38 @@ func (_m *mockMutableState) GetUserTimer(_a0 string) (bool, *persistence.TimerIn
 	return r0, r1
 }
 
+// GetQueryRegistry provides a mock function with given fields:
+func (_m *mockMutableState) GetQueryRegistry() queryRegistry {
+	ret := _m.Called()
+
+	var r0 queryRegistry
+	if rf, ok := ret.Get(0).(func() queryRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queryRegistry)
+		}
+	}
+
+	return r0
+}
+
+// GetUpdateRegistry provides a mock function with given fields:
+func (_m *mockMutableState) GetUpdateRegistry() updateRegistry {
+	ret := _m.Called()
+
+	var r0 updateRegistry
+	if rf, ok := ret.Get(0).(func() updateRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(updateRegistry)
+		}
+	}
+
+	return r0
+}
+
 // GetWorkflowType provides a mock function with given fields:
 func (_m *mockMutableState) GetWorkflowType() *shared.WorkflowType {
 	ret := _m.Called()
@@ -1976,6 +2114,34 @@ func (_m *mockMutableState) IsStickyTaskListEnabled() bool {
 	return r0
 }
 
+// IsUpdatePending provides a mock function with given fields: updateID
+func (_m *mockMutableState) IsUpdatePending(updateID string) bool {
+	ret := _m.Called(updateID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(updateID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsUpdateRequested provides a mock function with given fields: updateID
+func (_m *mockMutableState) IsUpdateRequested(updateID string) bool {
+	ret := _m.Called(updateID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(updateID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IsWorkflowExecutionRunning provides a mock function with given fields:
 func (_m *mockMutableState) IsWorkflowExecutionRunning() bool {
 	ret := _m.Called()
@@ -1990,6 +2156,20 @@ func (_m *mockMutableState) IsWorkflowExecutionRunning() bool {
 	return r0
 }
 
+// IsWorkflowExecutionPaused provides a mock function with given fields:
+func (_m *mockMutableState) IsWorkflowExecutionPaused() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // Load provides a mock function with given fields: _a0
 func (_m *mockMutableState) Load(_a0 *persistence.WorkflowMutableState) {
 	_m.Called(_a0)
@@ -2590,6 +2770,62 @@ func (_m *mockMutableState) ReplicateWorkflowExecutionSignaled(_a0 *shared.Histo
 	return r0
 }
 
+// ReplicateWorkflowExecutionPausedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionPausedEvent(_a0 *shared.HistoryEvent) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.HistoryEvent) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplicateWorkflowExecutionResumedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionResumedEvent(_a0 *shared.HistoryEvent) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.HistoryEvent) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplicateWorkflowExecutionUpdateRequestedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionUpdateRequestedEvent(_a0 *shared.HistoryEvent) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.HistoryEvent) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplicateWorkflowExecutionUpdateCompletedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionUpdateCompletedEvent(_a0 *shared.HistoryEvent) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.HistoryEvent) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ReplicateWorkflowExecutionStartedEvent provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
 func (_m *mockMutableState) ReplicateWorkflowExecutionStartedEvent(_a0 *cache.DomainCacheEntry, _a1 *string, _a2 shared.WorkflowExecution, _a3 string, _a4 *shared.HistoryEvent) error {
 	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
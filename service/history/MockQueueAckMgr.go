@@ -113,3 +113,8 @@ func (_m *MockQueueAckMgr) getQueueReadLevel() int64 {
 func (_m *MockQueueAckMgr) updateQueueAckLevel() {
 	_m.Called()
 }
+
+// flushQueueAckLevel is mock implementation for flushQueueAckLevel of QueueAckMgr
+func (_m *MockQueueAckMgr) flushQueueAckLevel() {
+	_m.Called()
+}
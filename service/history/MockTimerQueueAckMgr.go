@@ -124,6 +124,10 @@ func (_m *MockTimerQueueAckMgr) updateAckLevel() {
 	_m.Called()
 }
 
+func (_m *MockTimerQueueAckMgr) flushAckLevel() {
+	_m.Called()
+}
+
 func (_m *MockTimerQueueAckMgr) isProcessNow(expiryTime time.Time) bool {
 	ret := _m.Called(expiryTime)
 
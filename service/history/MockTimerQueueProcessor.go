@@ -61,3 +61,45 @@ func (_m *MockTimerQueueProcessor) LockTaskPrrocessing() {
 func (_m *MockTimerQueueProcessor) UnlockTaskPrrocessing() {
 	_m.Called()
 }
+
+// ProcessTaskOnce is mock implementation for ProcessTaskOnce of Processor
+func (_m *MockTimerQueueProcessor) ProcessTaskOnce(task *persistence.TimerTaskInfo) error {
+	ret := _m.Called(task)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.TimerTaskInfo) error); ok {
+		r0 = rf(task)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IsTaskLoaded is mock implementation for IsTaskLoaded of Processor
+func (_m *MockTimerQueueProcessor) IsTaskLoaded(visibilityTimestamp time.Time, taskID int64) bool {
+	ret := _m.Called(visibilityTimestamp, taskID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(time.Time, int64) bool); ok {
+		r0 = rf(visibilityTimestamp, taskID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// GetReadLevel is mock implementation for GetReadLevel of Processor
+func (_m *MockTimerQueueProcessor) GetReadLevel() time.Time {
+	ret := _m.Called()
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	return r0
+}
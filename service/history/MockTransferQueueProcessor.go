@@ -59,3 +59,45 @@ func (_m *MockTransferQueueProcessor) LockTaskPrrocessing() {
 func (_m *MockTransferQueueProcessor) UnlockTaskPrrocessing() {
 	_m.Called()
 }
+
+// ProcessTaskOnce is mock implementation for ProcessTaskOnce of Processor
+func (_m *MockTransferQueueProcessor) ProcessTaskOnce(task *persistence.TransferTaskInfo) error {
+	ret := _m.Called(task)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.TransferTaskInfo) error); ok {
+		r0 = rf(task)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IsTaskLoaded is mock implementation for IsTaskLoaded of Processor
+func (_m *MockTransferQueueProcessor) IsTaskLoaded(taskID int64) bool {
+	ret := _m.Called(taskID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(int64) bool); ok {
+		r0 = rf(taskID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// GetReadLevel is mock implementation for GetReadLevel of Processor
+func (_m *MockTransferQueueProcessor) GetReadLevel() int64 {
+	ret := _m.Called()
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
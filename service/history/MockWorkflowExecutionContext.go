@@ -42,6 +42,10 @@ func (_m *mockWorkflowExecutionContext) clear() {
 	_m.Called()
 }
 
+func (_m *mockWorkflowExecutionContext) unload() {
+	_m.Called()
+}
+
 func (_m *mockWorkflowExecutionContext) getDomainID() string {
 	ret := _m.Called()
 
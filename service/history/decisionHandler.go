@@ -23,6 +23,7 @@ package history
 import (
 	ctx "context"
 	"fmt"
+	"sort"
 
 	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -200,6 +201,11 @@ func (handler *decisionHandlerImpl) handleDecisionTaskStarted(
 				return nil, &h.EventAlreadyStartedError{Message: "Decision task already started."}
 			}
 
+			if msBuilder.IsWorkflowExecutionPaused() {
+				// the decision task is dispatched again when the execution is resumed
+				return nil, ErrWorkflowPaused
+			}
+
 			_, di, err = msBuilder.AddDecisionTaskStartedEvent(scheduleID, requestID, req.PollRequest)
 			if err != nil {
 				// Unable to add DecisionTaskStarted event to history
@@ -396,6 +402,11 @@ Update_History_Loop:
 				handler.throttledLogger,
 			)
 
+			// the updates handled by the worker are recorded before the decisions, which may close the workflow
+			if err := handler.handleUpdateResults(msBuilder, completedEvent.GetEventId(), request); err != nil {
+				return nil, err
+			}
+
 			decisionTaskHandler := newDecisionTaskHandler(
 				request.GetIdentity(),
 				completedEvent.GetEventId(),
@@ -463,8 +474,10 @@ Update_History_Loop:
 		}
 
 		// Schedule another decision task if new events came in during this decision or if request forced to
+		// The new decision task is held if the execution is paused, it is scheduled once the execution is resumed
 		createNewDecisionTask := !isComplete && (hasUnhandledEvents ||
-			request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
+			request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled) &&
+			!msBuilder.IsWorkflowExecutionPaused()
 		var newDecisionTaskScheduledID int64
 		if createNewDecisionTask {
 			di, err := msBuilder.AddDecisionTaskScheduledEvent()
@@ -583,6 +596,22 @@ Update_History_Loop:
 		// Inform timer about the new ones.
 		handler.timerProcessor.NotifyNewTimers(handler.currentClusterName, handler.shard.GetCurrentTime(handler.currentClusterName), timerTasks)
 
+		queryRegistry := msBuilder.GetQueryRegistry()
+		if !failDecision {
+			// the worker answers the queries delivered with this decision task, after applying the events before it
+			queryRegistry.completeStartedQueries(request.GetQueryResults())
+
+			// the update results are persisted, so the waiting callers can be answered
+			updateRegistry := msBuilder.GetUpdateRegistry()
+			for updateID, result := range request.GetUpdateResults() {
+				updateRegistry.completeUpdate(updateID, result)
+			}
+		}
+		if !createNewDecisionTask {
+			// no new events for the worker to catch up with, so the remaining queries can be dispatched directly
+			queryRegistry.unblockQueries()
+		}
+
 		resp = &h.RespondDecisionTaskCompletedResponse{}
 		if request.GetReturnNewDecisionTask() && createNewDecisionTask {
 			di, _ := msBuilder.GetPendingDecision(newDecisionTaskScheduledID)
@@ -597,6 +626,46 @@ Update_History_Loop:
 	return nil, ErrMaxAttemptsExceeded
 }
 
+// handleUpdateResults records the results of the updates accepted or rejected by the worker
+func (handler *decisionHandlerImpl) handleUpdateResults(
+	msBuilder mutableState,
+	decisionCompletedEventID int64,
+	request *workflow.RespondDecisionTaskCompletedRequest,
+) error {
+
+	updateResults := request.GetUpdateResults()
+	// sort the update IDs so that the events are recorded in a deterministic order
+	updateIDs := make([]string, 0, len(updateResults))
+	for updateID := range updateResults {
+		updateIDs = append(updateIDs, updateID)
+	}
+	sort.Strings(updateIDs)
+
+	// the results are validated before any of them is recorded, the worker can only answer the updates
+	// which are requested on the workflow and not completed yet
+	for _, updateID := range updateIDs {
+		result := updateResults[updateID]
+		if updateID == "" || result == nil || result.ResultType == nil {
+			return &workflow.BadRequestError{Message: "Update result is not set on request."}
+		}
+		if !msBuilder.IsUpdatePending(updateID) {
+			return ErrUpdateNotPending
+		}
+	}
+
+	for _, updateID := range updateIDs {
+		if _, err := msBuilder.AddWorkflowExecutionUpdateCompletedEvent(
+			decisionCompletedEventID,
+			updateID,
+			updateResults[updateID],
+			request.GetIdentity(),
+		); err != nil {
+			return &workflow.InternalServiceError{Message: "Unable to add WorkflowExecutionUpdateCompleted event to history."}
+		}
+	}
+	return nil
+}
+
 func (handler *decisionHandlerImpl) createRecordDecisionTaskStartedResponse(
 	domainID string,
 	msBuilder mutableState,
@@ -636,5 +705,10 @@ func (handler *decisionHandlerImpl) createRecordDecisionTaskStartedResponse(
 	response.EventStoreVersion = common.Int32Ptr(msBuilder.GetEventStoreVersion())
 	response.BranchToken = msBuilder.GetCurrentBranch()
 
+	// deliver the buffered strongly consistent queries together with the decision task
+	if queries := msBuilder.GetQueryRegistry().startBufferedQueries(); len(queries) > 0 {
+		response.Queries = queries
+	}
+
 	return response
 }
@@ -44,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/task"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/yarpc/yarpcerrors"
 )
@@ -56,6 +57,8 @@ type (
 		visibilityMgr         persistence.VisibilityManager
 		historyMgr            persistence.HistoryManager
 		historyV2Mgr          persistence.HistoryV2Manager
+		taskDLQMgr            persistence.TaskDLQManager
+		taskScheduler         task.Scheduler
 		executionMgrFactory   persistence.ExecutionManagerFactory
 		domainCache           cache.DomainCache
 		historyServiceClient  hc.Client
@@ -87,13 +90,14 @@ var (
 	errSourceClusterNotSet     = &gen.BadRequestError{Message: "Source Cluster not set on request."}
 	errShardIDNotSet           = &gen.BadRequestError{Message: "Shard ID not set on request."}
 	errTimestampNotSet         = &gen.BadRequestError{Message: "Timestamp not set on request."}
+	errPreviousOwnerNotSet     = &gen.BadRequestError{Message: "Previous owner not set on request."}
 	errHistoryHostThrottle     = &gen.ServiceBusyError{Message: "History host rps exceeded"}
 )
 
 // NewHandler creates a thrift handler for the history service
 func NewHandler(sVice service.Service, config *Config, shardManager persistence.ShardManager,
 	metadataMgr persistence.MetadataManager, visibilityMgr persistence.VisibilityManager,
-	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
+	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, taskDLQMgr persistence.TaskDLQManager,
 	executionMgrFactory persistence.ExecutionManagerFactory, domainCache cache.DomainCache,
 	publicClient workflowserviceclient.Interface, archiverProvider provider.ArchiverProvider) *Handler {
 	handler := &Handler{
@@ -103,6 +107,7 @@ func NewHandler(sVice service.Service, config *Config, shardManager persistence.
 		metadataMgr:         metadataMgr,
 		historyMgr:          historyMgr,
 		historyV2Mgr:        historyV2Mgr,
+		taskDLQMgr:          taskDLQMgr,
 		visibilityMgr:       visibilityMgr,
 		executionMgrFactory: executionMgrFactory,
 		domainCache:         domainCache,
@@ -159,8 +164,21 @@ func (h *Handler) Start() error {
 	}
 
 	h.domainCache.Start()
+	if h.config.EnableTaskScheduler() {
+		h.taskScheduler = task.NewWeightedRoundRobinScheduler(
+			&task.WeightedRoundRobinSchedulerOptions{
+				WorkerCount:          h.config.TaskSchedulerWorkerCount(),
+				DomainWeight:         h.config.TaskSchedulerDomainWeight,
+				DomainMaxConcurrency: h.config.TaskSchedulerDomainMaxConcurrency,
+				RetryPolicy:          common.CreateTaskProcessingRetryPolicy(),
+			},
+			h.GetMetricsClient(),
+			h.GetLogger(),
+		)
+		h.taskScheduler.Start()
+	}
 	h.controller = newShardController(h.Service, h.GetHostInfo(), hServiceResolver, h.shardManager, h.historyMgr, h.historyV2Mgr,
-		h.domainCache, h.executionMgrFactory, h, h.config, h.GetLogger(), h.GetMetricsClient())
+		h.taskDLQMgr, h.taskScheduler, h.domainCache, h.executionMgrFactory, h, h.config, h.GetLogger(), h.GetMetricsClient())
 	h.metricsClient = h.GetMetricsClient()
 	h.historyEventNotifier = newHistoryEventNotifier(h.Service.GetTimeSource(), h.GetMetricsClient(), h.config.GetShardID)
 	// events notifier must starts before controller
@@ -172,13 +190,20 @@ func (h *Handler) Start() error {
 
 // Stop stops the handler
 func (h *Handler) Stop() {
+	if h.config.EnableGracefulShardHandoff() {
+		h.controller.drainShards()
+	}
 	h.domainCache.Stop()
 	h.controller.Stop()
+	if h.taskScheduler != nil {
+		h.taskScheduler.Stop()
+	}
 	h.shardManager.Close()
 	h.historyMgr.Close()
 	if h.historyV2Mgr != nil {
 		h.historyV2Mgr.Close()
 	}
+	h.taskDLQMgr.Close()
 	h.executionMgrFactory.Close()
 	h.metadataMgr.Close()
 	h.visibilityMgr.Close()
@@ -188,7 +213,7 @@ func (h *Handler) Stop() {
 
 // CreateEngine is implementation for HistoryEngineFactory used for creating the engine instance for shard
 func (h *Handler) CreateEngine(context ShardContext) Engine {
-	return NewEngineWithShardContext(context, h.visibilityMgr, h.matchingServiceClient, h.historyServiceClient,
+	return NewEngineWithShardContext(context, h.visibilityMgr, h.matchingServiceClient, h.GetClientBean().GetMatchingClient(), h.historyServiceClient,
 		h.publicClient, h.historyEventNotifier, h.publisher, h.config, h.archiverProvider)
 }
 
@@ -709,6 +734,39 @@ func (h *Handler) GetMutableState(ctx context.Context,
 	return resp, nil
 }
 
+// QueryWorkflow queries a workflow.
+func (h *Handler) QueryWorkflow(ctx context.Context,
+	request *hist.QueryWorkflowRequest) (resp *hist.QueryWorkflowResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryQueryWorkflowScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.QueryWorkflow(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+	return resp, nil
+}
+
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (h *Handler) DescribeWorkflowExecution(ctx context.Context, request *hist.DescribeWorkflowExecutionRequest) (resp *gen.DescribeWorkflowExecutionResponse, retError error) {
 	defer log.CapturePanic(h.GetLogger(), &retError)
@@ -819,6 +877,42 @@ func (h *Handler) SignalWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// UpdateWorkflowExecution is used to send an update to a running workflow execution. This results in
+// WorkflowExecutionUpdateRequested event recorded in the history and a decision task being created for the
+// execution, the call returns once the worker accepts or rejects the update.
+func (h *Handler) UpdateWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.UpdateWorkflowExecutionRequest) (resp *hist.UpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUpdateWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.UpdateRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.UpdateWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+	return resp, nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -930,6 +1024,78 @@ func (h *Handler) TerminateWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event
+// in the history.
+func (h *Handler) PauseWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.PauseWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPauseWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.PauseRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.PauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// ResumeWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionResumed event
+// in the history and dispatching the tasks held while the execution was paused.
+func (h *Handler) ResumeWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.ResumeWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryResumeWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.ResumeRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.ResumeWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution
 // in the history and immediately terminating the execution instance.
 func (h *Handler) ResetWorkflowExecution(ctx context.Context,
@@ -1234,6 +1400,248 @@ func (h *Handler) SyncActivity(ctx context.Context, syncActivityRequest *hist.Sy
 	return nil
 }
 
+// ListDLQTasks lists the transfer or timer tasks of a shard which were moved to the DLQ
+func (h *Handler) ListDLQTasks(ctx context.Context, request *gen.ListDLQTasksRequest) (resp *gen.ListDLQTasksResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryListDLQTasksScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.ListDLQTasks(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	return resp, nil
+}
+
+// DescribeDLQTask returns a single task of the DLQ of a shard
+func (h *Handler) DescribeDLQTask(ctx context.Context, request *gen.DescribeDLQTaskRequest) (resp *gen.DescribeDLQTaskResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDescribeDLQTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.DescribeDLQTask(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	return resp, nil
+}
+
+// RequeueDLQTask processes a task of the DLQ of a shard again and removes it from the DLQ on success
+func (h *Handler) RequeueDLQTask(ctx context.Context, request *gen.RequeueDLQTaskRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRequeueDLQTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	err = engine.RequeueDLQTask(ctx, request)
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	return nil
+}
+
+// PurgeDLQTasks removes tasks from the DLQ of a shard without processing them
+func (h *Handler) PurgeDLQTasks(ctx context.Context, request *gen.PurgeDLQTasksRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPurgeDLQTasksScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	err = engine.PurgeDLQTasks(ctx, request)
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	return nil
+}
+
+// DescribeShardQueues returns the ack levels and read levels of the transfer, timer and replication queues of a shard
+func (h *Handler) DescribeShardQueues(ctx context.Context, request *gen.DescribeShardQueuesRequest) (resp *gen.DescribeShardQueuesResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDescribeShardQueuesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.DescribeShardQueues(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	return resp, nil
+}
+
+// ListShardQueueTasks lists the pending tasks of a transfer, timer or replication queue of a shard
+func (h *Handler) ListShardQueueTasks(ctx context.Context, request *gen.ListShardQueueTasksRequest) (resp *gen.ListShardQueueTasksResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryListShardQueueTasksScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.ListShardQueueTasks(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	return resp, nil
+}
+
+// DeleteShardQueueTask removes a task from a transfer, timer or replication queue of a shard without processing it
+func (h *Handler) DeleteShardQueueTask(ctx context.Context, request *gen.DeleteShardQueueTaskRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDeleteShardQueueTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	err = engine.DeleteShardQueueTask(ctx, request)
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	return nil
+}
+
+// RefireShardQueueTask processes a pending task of a transfer, timer or replication queue of a shard once
+func (h *Handler) RefireShardQueueTask(ctx context.Context, request *gen.RefireShardQueueTaskRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRefireShardQueueTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	err = engine.RefireShardQueueTask(ctx, request)
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	return nil
+}
+
+// HandoffShard acquires a shard right away, once its previous owner has drained the shard
+func (h *Handler) HandoffShard(ctx context.Context, request *hist.HandoffShardRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryHandoffShardScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if request.PreviousOwner == nil {
+		return h.error(errPreviousOwnerNotSet, scope, "", "")
+	}
+
+	err := h.controller.acquireHandedOffShard(int(request.GetShardId()), request.GetPreviousOwner())
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	return nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
@@ -1291,6 +1699,8 @@ func (h *Handler) updateErrorMetric(scope int, domainID, workflowID string, err
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrRetryTaskCounter)
 	case *gen.ServiceBusyError:
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrServiceBusyCounter)
+	case *gen.QueryFailedError:
+		h.metricsClient.IncCounter(scope, metrics.CadenceErrQueryFailedCounter)
 	case *yarpcerrors.Status:
 		if err.Code() == yarpcerrors.CodeDeadlineExceeded {
 			h.metricsClient.IncCounter(scope, metrics.CadenceErrContextTimeoutCounter)
@@ -382,6 +382,32 @@ func (b *historyBuilder) AddWorkflowExecutionSignaledEvent(
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddWorkflowExecutionPausedEvent(reason string, identity string) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionPausedEvent(reason, identity)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionResumedEvent(reason string, identity string) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionResumedEvent(reason, identity)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionUpdateRequestedEvent(
+	updateID string, updateName string, input []byte, identity string) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionUpdateRequestedEvent(updateID, updateName, input, identity)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID int64,
+	updateID string, result *workflow.WorkflowUpdateResult, identity string) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID, updateID, result, identity)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddStartChildWorkflowExecutionInitiatedEvent(decisionCompletedEventID int64,
 	attributes *workflow.StartChildWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
 	event := b.newStartChildWorkflowExecutionInitiatedEvent(decisionCompletedEventID, attributes)
@@ -686,6 +712,54 @@ func (b *historyBuilder) newWorkflowExecutionSignaledEvent(
 	return historyEvent
 }
 
+func (b *historyBuilder) newWorkflowExecutionPausedEvent(reason string, identity string) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionPaused)
+	attributes := &workflow.WorkflowExecutionPausedEventAttributes{}
+	attributes.Reason = common.StringPtr(reason)
+	attributes.Identity = common.StringPtr(identity)
+	historyEvent.WorkflowExecutionPausedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *historyBuilder) newWorkflowExecutionResumedEvent(reason string, identity string) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionResumed)
+	attributes := &workflow.WorkflowExecutionResumedEventAttributes{}
+	attributes.Reason = common.StringPtr(reason)
+	attributes.Identity = common.StringPtr(identity)
+	historyEvent.WorkflowExecutionResumedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *historyBuilder) newWorkflowExecutionUpdateRequestedEvent(
+	updateID string, updateName string, input []byte, identity string) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionUpdateRequested)
+	attributes := &workflow.WorkflowExecutionUpdateRequestedEventAttributes{}
+	attributes.UpdateId = common.StringPtr(updateID)
+	attributes.UpdateName = common.StringPtr(updateName)
+	attributes.Input = input
+	attributes.Identity = common.StringPtr(identity)
+	historyEvent.WorkflowExecutionUpdateRequestedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *historyBuilder) newWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID int64,
+	updateID string, result *workflow.WorkflowUpdateResult, identity string) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionUpdateCompleted)
+	attributes := &workflow.WorkflowExecutionUpdateCompletedEventAttributes{}
+	attributes.UpdateId = common.StringPtr(updateID)
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionCompletedEventID)
+	attributes.ResultType = result.ResultType
+	attributes.Result = result.Result
+	attributes.ErrorMessage = result.ErrorMessage
+	attributes.Identity = common.StringPtr(identity)
+	historyEvent.WorkflowExecutionUpdateCompletedEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *historyBuilder) newWorkflowExecutionTerminatedEvent(
 	reason string, details []byte, identity string) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionTerminated)
@@ -61,6 +61,9 @@ func newHistoryCache(shard ShardContext) *historyCache {
 	opts.InitialCapacity = config.HistoryCacheInitialSize()
 	opts.TTL = config.HistoryCacheTTL()
 	opts.Pin = true
+	opts.RemovedFunc = func(value interface{}) {
+		value.(workflowExecutionContext).unload()
+	}
 
 	return &historyCache{
 		Cache:            cache.New(config.HistoryCacheMaxSize(), opts),
@@ -260,6 +263,16 @@ func (c *historyCache) makeReleaseFunc(
 	}
 }
 
+// unloadAll unloads all the cached contexts, for example when the shard is closed and the cache is dropped
+func (c *historyCache) unloadAll() {
+	it := c.Iterator()
+	defer it.Close()
+
+	for it.HasNext() {
+		it.Next().Value().(workflowExecutionContext).unload()
+	}
+}
+
 func (c *historyCache) getCurrentExecutionWithRetry(
 	request *persistence.GetCurrentExecutionRequest,
 ) (*persistence.GetCurrentExecutionResponse, error) {
@@ -24,6 +24,7 @@ import (
 	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
@@ -173,6 +174,66 @@ func (s *historyCacheSuite) TestHistoryCachePinning() {
 	release(err4)
 }
 
+func (s *historyCacheSuite) TestHistoryCacheEviction_FailsWaitingUpdates() {
+	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(2)
+	domainID := "test_domain_id"
+	s.cache = newHistoryCache(s.mockShard)
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wf-cache-test-eviction"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	context, release, err := s.cache.getOrCreateWorkflowExecutionForBackground(domainID, we)
+	s.Nil(err)
+	registry := context.(*workflowExecutionContextImpl).updateRegistry
+	termCh := registry.registerUpdate("update-id")
+	release(nil)
+
+	// the released context is evicted by the next workflow
+	we2 := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wf-cache-test-eviction"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	_, release2, err := s.cache.getOrCreateWorkflowExecutionForBackground(domainID, we2)
+	s.Nil(err)
+	release2(nil)
+
+	select {
+	case <-termCh:
+	case <-time.After(5 * time.Second):
+		s.FailNow("update was not terminated when the context was evicted")
+	}
+	_, err = registry.getResult("update-id")
+	s.Equal(errUpdateEvicted, err)
+}
+
+func (s *historyCacheSuite) TestHistoryCacheUnloadAll() {
+	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(20)
+	domainID := "test_domain_id"
+	s.cache = newHistoryCache(s.mockShard)
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wf-cache-test-unload"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	context, release, err := s.cache.getOrCreateWorkflowExecutionForBackground(domainID, we)
+	s.Nil(err)
+	registry := context.(*workflowExecutionContextImpl).updateRegistry
+	termCh := registry.registerUpdate("update-id")
+	queryRegistry := context.(*workflowExecutionContextImpl).queryRegistry
+	queryID, queryTermCh := queryRegistry.bufferQuery(&workflow.WorkflowQuery{})
+	release(nil)
+
+	s.cache.unloadAll()
+	<-termCh
+	_, err = registry.getResult("update-id")
+	s.Equal(errUpdateEvicted, err)
+	<-queryTermCh
+	termination, err := queryRegistry.getTermination(queryID)
+	s.Nil(err)
+	s.Equal(errQueryEvicted, termination.err)
+}
+
 func (s *historyCacheSuite) TestHistoryCacheClear() {
 	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(20)
 	domainID := "test_domain_id"
@@ -30,6 +30,7 @@ import (
 
 	"github.com/pborman/uuid"
 	h "github.com/uber/cadence/.gen/go/history"
+	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
@@ -37,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/definition"
@@ -48,6 +50,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	warchiver "github.com/uber/cadence/service/worker/archiver"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/yarpc/yarpcerrors"
 )
 
 const (
@@ -67,11 +70,13 @@ type (
 		historyV2Mgr         persistence.HistoryV2Manager
 		executionManager     persistence.ExecutionManager
 		visibilityMgr        persistence.VisibilityManager
+		matchingClient       matching.Client
+		rawMatchingClient    matching.Client
 		txProcessor          transferQueueProcessor
 		timerProcessor       timerQueueProcessor
 		taskAllocator        taskAllocator
 		replicator           *historyReplicator
-		replicatorProcessor  queueProcessor
+		replicatorProcessor  replicatorQueueProcessor
 		historyEventNotifier historyEventNotifier
 		tokenSerializer      common.TaskTokenSerializer
 		historyCache         *historyCache
@@ -89,7 +94,7 @@ type (
 		currentClusterName string
 		ShardContext
 		txProcessor          transferQueueProcessor
-		replicatorProcessor  queueProcessor
+		replicatorProcessor  replicatorQueueProcessor
 		historyEventNotifier historyEventNotifier
 	}
 )
@@ -113,6 +118,18 @@ var (
 	ErrActivityTaskNotFound = &workflow.EntityNotExistsError{Message: "Activity task not found."}
 	// ErrWorkflowCompleted is the error to indicate workflow execution already completed
 	ErrWorkflowCompleted = &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
+	// ErrWorkflowPaused is the error to indicate no task can be started while workflow execution is paused
+	ErrWorkflowPaused = &workflow.EntityNotExistsError{Message: "Workflow execution is paused."}
+	// ErrWorkflowAlreadyPaused is the error to indicate workflow execution is already paused
+	ErrWorkflowAlreadyPaused = &workflow.BadRequestError{Message: "Workflow execution is already paused."}
+	// ErrWorkflowNotPaused is the error to indicate workflow execution is not paused
+	ErrWorkflowNotPaused = &workflow.BadRequestError{Message: "Workflow execution is not paused."}
+	// ErrUpdateNotHandled is the error to indicate workflow execution is closed before the update is handled by the worker
+	ErrUpdateNotHandled = &workflow.EntityNotExistsError{Message: "Workflow execution closed before the update was handled."}
+	// ErrUpdateAlreadyCompleted is the error to indicate the update with the same request ID is already completed
+	ErrUpdateAlreadyCompleted = &workflow.BadRequestError{Message: "Update with the same request ID is already completed."}
+	// ErrUpdateNotPending is the error to indicate the worker responded to an update which is not pending
+	ErrUpdateNotPending = &workflow.BadRequestError{Message: "Update result is for an update which is not pending."}
 	// ErrWorkflowParent is the error to parent execution is given and mismatch
 	ErrWorkflowParent = &workflow.EntityNotExistsError{Message: "Workflow parent does not match."}
 	// ErrDeserializingToken is the error to indicate task token is invalid
@@ -123,6 +140,8 @@ var (
 	ErrCancellationAlreadyRequested = &workflow.CancellationAlreadyRequestedError{Message: "Cancellation already requested for this workflow execution."}
 	// ErrSignalsLimitExceeded is the error indicating limit reached for maximum number of signal events
 	ErrSignalsLimitExceeded = &workflow.LimitExceededError{Message: "Exceeded workflow execution limit for signal events"}
+	// ErrUpdatesLimitExceeded is the error indicating limit reached for maximum number of updates waiting for the worker
+	ErrUpdatesLimitExceeded = &workflow.LimitExceededError{Message: "Exceeded workflow execution limit for pending updates"}
 	// ErrEventsAterWorkflowFinish is the error indicating server error trying to write events after workflow finish event
 	ErrEventsAterWorkflowFinish = &workflow.InternalServiceError{Message: "error validating last event being workflow finish event."}
 
@@ -141,6 +160,7 @@ func NewEngineWithShardContext(
 	shard ShardContext,
 	visibilityMgr persistence.VisibilityManager,
 	matching matching.Client,
+	rawMatchingClient matching.Client,
 	historyClient hc.Client,
 	publicClient workflowserviceclient.Interface,
 	historyEventNotifier historyEventNotifier,
@@ -168,6 +188,8 @@ func NewEngineWithShardContext(
 		historyV2Mgr:         historyV2Manager,
 		executionManager:     executionManager,
 		visibilityMgr:        visibilityMgr,
+		matchingClient:       matching,
+		rawMatchingClient:    rawMatchingClient,
 		tokenSerializer:      common.NewJSONTaskTokenSerializer(),
 		historyCache:         historyCache,
 		logger:               logger.WithTags(tag.ComponentHistoryEngine),
@@ -226,6 +248,9 @@ func (e *historyEngineImpl) Stop() {
 
 	// unset the failover callback
 	e.shard.GetDomainCache().UnregisterDomainChangeCallback(e.shard.GetShardID())
+
+	// the cache is dropped together with the engine, callers waiting for the workflows retry on the new owner
+	e.historyCache.unloadAll()
 }
 
 func (e *historyEngineImpl) registerDomainFailoverCallback() {
@@ -369,6 +394,51 @@ func (e *historyEngineImpl) generateFirstDecisionTask(
 	return transferTasks, di, nil
 }
 
+// hasPendingFirstDecisionTaskBackoff returns true if the first decision task of the workflow is yet to be
+// scheduled after the backoff of a delayed start, cron schedule or retry
+func hasPendingFirstDecisionTaskBackoff(msBuilder mutableState) bool {
+	if msBuilder.HasProcessedOrPendingDecisionTask() {
+		return false
+	}
+	// the first decision task of a child workflow is scheduled once its parent has recorded the start,
+	// unless the child is cron
+	executionInfo := msBuilder.GetExecutionInfo()
+	return executionInfo.CronSchedule != "" || executionInfo.ParentWorkflowID == ""
+}
+
+// getWorkflowBackoffTimeoutType returns the timeout type of the backoff timer scheduling the first decision task,
+// the backoff of a retry is a retry backoff while the start delay and the cron schedule are cron backoffs
+func getWorkflowBackoffTimeoutType(startAttributes *workflow.WorkflowExecutionStartedEventAttributes) int {
+	if startAttributes.GetInitiator() == workflow.ContinueAsNewInitiatorRetryPolicy {
+		return persistence.WorkflowBackoffTimeoutTypeRetry
+	}
+	return persistence.WorkflowBackoffTimeoutTypeCron
+}
+
+func (e *historyEngineImpl) generateFirstTimerTasks(
+	request *workflow.StartWorkflowExecutionRequest,
+	parentInfo *h.ParentExecutionInfo,
+	backoffSeconds int32,
+) []persistence.Task {
+
+	now := e.shard.GetTimeSource().Now()
+	backoffDuration := time.Duration(backoffSeconds) * time.Second
+	timeoutDuration := time.Duration(request.GetExecutionStartToCloseTimeoutSeconds())*time.Second + backoffDuration
+	timerTasks := []persistence.Task{&persistence.WorkflowTimeoutTask{
+		VisibilityTimestamp: now.Add(timeoutDuration),
+	}}
+
+	// Only schedule the backoff timer task if not child WF and there's first decision task backoff,
+	// which is the start delay followed by the wait for the first cron schedule
+	if backoffSeconds != 0 && parentInfo == nil {
+		timerTasks = append(timerTasks, &persistence.WorkflowBackoffTimerTask{
+			VisibilityTimestamp: now.Add(backoffDuration),
+			TimeoutType:         persistence.WorkflowBackoffTimeoutTypeCron,
+		})
+	}
+	return timerTasks
+}
+
 // StartWorkflowExecution starts a workflow execution
 func (e *historyEngineImpl) StartWorkflowExecution(
 	ctx ctx.Context,
@@ -422,27 +492,15 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	}
 
 	taskList := request.TaskList.GetName()
-	cronBackoffSeconds := startRequest.GetFirstDecisionTaskBackoffSeconds()
+	backoffSeconds := startRequest.GetFirstDecisionTaskBackoffSeconds()
 	// Generate first decision task event if not child WF and no first decision task backoff
-	transferTasks, _, err := e.generateFirstDecisionTask(domainID, msBuilder, startRequest.ParentExecutionInfo, taskList, cronBackoffSeconds)
+	transferTasks, _, err := e.generateFirstDecisionTask(domainID, msBuilder, startRequest.ParentExecutionInfo, taskList, backoffSeconds)
 	if err != nil {
 		return nil, err
 	}
 
-	// Generate first timer task : WF timeout task
-	cronBackoffDuration := time.Duration(cronBackoffSeconds) * time.Second
-	timeoutDuration := time.Duration(*request.ExecutionStartToCloseTimeoutSeconds)*time.Second + cronBackoffDuration
-	timerTasks := []persistence.Task{&persistence.WorkflowTimeoutTask{
-		VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(timeoutDuration),
-	}}
-
-	// Only schedule the backoff timer task if not child WF and there's first decision task backoff
-	if cronBackoffSeconds != 0 && startRequest.ParentExecutionInfo == nil {
-		timerTasks = append(timerTasks, &persistence.WorkflowBackoffTimerTask{
-			VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(cronBackoffDuration),
-			TimeoutType:         persistence.WorkflowBackoffTimeoutTypeCron,
-		})
-	}
+	// Generate first timer task : WF timeout task, and the backoff timer task if there's first decision task backoff
+	timerTasks := e.generateFirstTimerTasks(request, startRequest.ParentExecutionInfo, backoffSeconds)
 
 	context := newWorkflowExecutionContext(domainID, execution, e.shard, e.executionManager, e.logger)
 	msBuilder.AddTransferTasks(transferTasks...)
@@ -714,6 +772,149 @@ func (e *historyEngineImpl) ResetStickyTaskList(
 	return &h.ResetStickyTaskListResponse{}, nil
 }
 
+// QueryWorkflow queries the workflow execution. A strongly consistent query is buffered in the workflow
+// when the worker has not caught up with the history yet, and is delivered together with the next decision task.
+func (e *historyEngineImpl) QueryWorkflow(
+	ctx ctx.Context,
+	request *h.QueryWorkflowRequest,
+) (*h.QueryWorkflowResponse, error) {
+
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	queryRequest := request.Request
+	execution := workflow.WorkflowExecution{
+		WorkflowId: queryRequest.Execution.WorkflowId,
+		RunId:      queryRequest.Execution.RunId,
+	}
+
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	msBuilder, err := context.loadWorkflowExecution()
+	if err != nil {
+		release(err)
+		return nil, err
+	}
+	execution.RunId = context.getExecution().RunId
+
+	if rejected := getQueryRejected(msBuilder, queryRequest.QueryRejectCondition); rejected != nil {
+		release(nil)
+		return &h.QueryWorkflowResponse{
+			Response: &workflow.QueryWorkflowResponse{QueryRejected: rejected},
+		}, nil
+	}
+
+	// the worker has caught up with the history if there is no decision task to process new events
+	caughtUp := msBuilder.HasProcessedOrPendingDecisionTask() && !msBuilder.HasPendingDecisionTask()
+	// no decision task is started while the execution is paused, so the query cannot wait for one
+	if queryRequest.GetQueryConsistencyLevel() != workflow.QueryConsistencyLevelStrong || caughtUp ||
+		msBuilder.IsWorkflowExecutionPaused() {
+		release(nil)
+		return e.queryDirectlyThroughMatching(ctx, domainID, execution, queryRequest)
+	}
+
+	queryRegistry := msBuilder.GetQueryRegistry()
+	queryID, termCh := queryRegistry.bufferQuery(queryRequest.Query)
+	release(nil)
+	defer queryRegistry.removeQuery(queryID)
+
+	select {
+	case <-termCh:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	termination, err := queryRegistry.getTermination(queryID)
+	if err != nil {
+		return nil, err
+	}
+	if termination.err != nil {
+		return nil, termination.err
+	}
+	result := termination.result
+	if result == nil {
+		// the query was not answered with the decision task, the worker has caught up so query it directly
+		return e.queryDirectlyThroughMatching(ctx, domainID, execution, queryRequest)
+	}
+	if result.GetResultType() != workflow.QueryResultTypeAnswered {
+		return nil, &workflow.QueryFailedError{Message: result.GetErrorMessage()}
+	}
+	return &h.QueryWorkflowResponse{
+		Response: &workflow.QueryWorkflowResponse{QueryResult: result.Answer},
+	}, nil
+}
+
+func (e *historyEngineImpl) queryDirectlyThroughMatching(
+	queryCtx ctx.Context,
+	domainID string,
+	execution workflow.WorkflowExecution,
+	queryRequest *workflow.QueryWorkflowRequest,
+) (*h.QueryWorkflowResponse, error) {
+
+	// we should always use the mutable state, since it contains the sticky tasklist information
+	response, err := e.getMutableState(queryCtx, domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	clientFeature := client.NewFeatureImpl(
+		response.GetClientLibraryVersion(),
+		response.GetClientFeatureVersion(),
+		response.GetClientImpl(),
+	)
+
+	queryRequest.Execution.RunId = response.Execution.RunId
+	matchingRequest := &m.QueryWorkflowRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		QueryRequest: queryRequest,
+	}
+	logger := e.logger.WithTags(
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowID(queryRequest.Execution.GetWorkflowId()),
+		tag.WorkflowRunID(queryRequest.Execution.GetRunId()),
+		tag.WorkflowQueryType(queryRequest.Query.GetQueryType()),
+	)
+
+	if len(response.StickyTaskList.GetName()) != 0 && clientFeature.SupportStickyQuery() {
+		matchingRequest.TaskList = response.StickyTaskList
+		stickyDecisionTimeout := response.GetStickyTaskListScheduleToStartTimeout()
+		// using a clean new context in case customer provide a context which has
+		// a really short deadline, causing we clear the stickyness
+		stickyContext, cancel := ctx.WithTimeout(ctx.Background(), time.Duration(stickyDecisionTimeout)*time.Second)
+		matchingResp, err := e.rawMatchingClient.QueryWorkflow(stickyContext, matchingRequest)
+		cancel()
+		if err == nil {
+			return &h.QueryWorkflowResponse{Response: matchingResp}, nil
+		}
+		if yarpcError, ok := err.(*yarpcerrors.Status); !ok || yarpcError.Code() != yarpcerrors.CodeDeadlineExceeded {
+			logger.Info("QueryWorkflowFailed.")
+			return nil, err
+		}
+		// this means sticky timeout, should try using the normal tasklist
+		// we should clear the stickyness of this workflow
+		resetContext, cancel := ctx.WithTimeout(ctx.Background(), 5*time.Second)
+		_, err = e.ResetStickyTaskList(resetContext, &h.ResetStickyTaskListRequest{
+			DomainUUID: common.StringPtr(domainID),
+			Execution:  queryRequest.Execution,
+		})
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	matchingRequest.TaskList = response.TaskList
+	matchingResp, err := e.matchingClient.QueryWorkflow(queryCtx, matchingRequest)
+	if err != nil {
+		logger.Info("QueryWorkflowFailed.")
+		return nil, err
+	}
+	return &h.QueryWorkflowResponse{Response: matchingResp}, nil
+}
+
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (e *historyEngineImpl) DescribeWorkflowExecution(
 	ctx ctx.Context,
@@ -756,6 +957,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			HistoryLength:    common.Int64Ptr(msBuilder.GetNextEventID() - common.FirstEventID),
 			AutoResetPoints:  executionInfo.AutoResetPoints,
 			SearchAttributes: &workflow.SearchAttributes{IndexedFields: executionInfo.SearchAttributes},
+			Paused:           common.BoolPtr(executionInfo.Paused),
 		},
 	}
 
@@ -916,6 +1118,11 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return nil, &h.EventAlreadyStartedError{Message: "Activity task already started."}
 			}
 
+			if msBuilder.IsWorkflowExecutionPaused() {
+				// the activity task is dispatched again when the execution is resumed
+				return nil, ErrWorkflowPaused
+			}
+
 			if _, err := msBuilder.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
@@ -1322,11 +1529,9 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 
 	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution, func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
 		executionInfo := msBuilder.GetExecutionInfo()
-		createDecisionTask := true
-		// Do not create decision task when the workflow is cron and the cron has not been started yet
-		if msBuilder.GetExecutionInfo().CronSchedule != "" && !msBuilder.HasProcessedOrPendingDecisionTask() {
-			createDecisionTask = false
-		}
+		// Do not create decision task when the workflow has not been started yet, the signal is
+		// buffered until the first decision task backoff fires
+		createDecisionTask := !hasPendingFirstDecisionTaskBackoff(msBuilder)
 		postActions := &updateWorkflowAction{
 			deleteWorkflow: false,
 			createDecision: createDecisionTask,
@@ -1374,6 +1579,104 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 	})
 }
 
+// UpdateWorkflowExecution records WorkflowExecutionUpdateRequested event, and waits for the worker to accept or
+// reject the update with the next completed decision task
+func (e *historyEngineImpl) UpdateWorkflowExecution(
+	ctx ctx.Context,
+	updateRequest *h.UpdateWorkflowExecutionRequest,
+) (*h.UpdateWorkflowExecutionResponse, error) {
+
+	domainEntry, err := e.getActiveDomainEntry(updateRequest.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := updateRequest.UpdateRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	// the request ID identifies the update, so that retries of the request wait for the same update
+	updateID := request.GetRequestId()
+	if updateID == "" {
+		updateID = uuid.New()
+	}
+	var registry updateRegistry
+	var termCh <-chan struct{}
+	err = e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			// register the update before it is persisted, so the result of the worker cannot be missed
+			if registry == nil {
+				registry = msBuilder.GetUpdateRegistry()
+				termCh = registry.registerUpdate(updateID)
+			}
+
+			// deduplicate by request id, a retried request waits for the result of the update already requested
+			if msBuilder.IsUpdatePending(updateID) {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+			if msBuilder.IsUpdateRequested(updateID) {
+				return nil, ErrUpdateAlreadyCompleted
+			}
+
+			maxPendingUpdates := e.config.MaximumPendingUpdatesPerExecution(domainEntry.GetInfo().Name)
+			if maxPendingUpdates > 0 && msBuilder.GetPendingUpdateCount() >= maxPendingUpdates {
+				e.logger.Info("Execution limit reached for maximum pending updates",
+					tag.WorkflowID(execution.GetWorkflowId()),
+					tag.WorkflowRunID(execution.GetRunId()),
+					tag.WorkflowDomainID(domainID))
+				return nil, ErrUpdatesLimitExceeded
+			}
+
+			if _, err := msBuilder.AddWorkflowExecutionUpdateRequestedEvent(
+				updateID,
+				request.GetUpdateName(),
+				request.GetInput(),
+				request.GetIdentity(),
+			); err != nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to update workflow execution."}
+			}
+
+			// Do not create decision task when the workflow has not been started yet, the update is
+			// delivered with the first decision task once the backoff fires
+			return &updateWorkflowAction{
+				createDecision: !hasPendingFirstDecisionTaskBackoff(msBuilder),
+			}, nil
+		})
+	if registry != nil {
+		defer registry.removeUpdate(updateID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-termCh:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	result, err := registry.getResult(updateID)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrUpdateNotHandled
+	}
+	return &h.UpdateWorkflowExecutionResponse{
+		Response: &workflow.UpdateWorkflowExecutionResponse{
+			UpdateId: common.StringPtr(updateID),
+			Result:   result,
+		},
+	}, nil
+}
+
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	ctx ctx.Context,
 	signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest,
@@ -1432,8 +1735,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 			var transferTasks []persistence.Task
 			var timerTasks []persistence.Task
-			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecisionTask() {
+			// Create a transfer task to schedule a decision task, unless the workflow has not been started yet
+			if !msBuilder.HasPendingDecisionTask() && !hasPendingFirstDecisionTaskBackoff(msBuilder) &&
+				!msBuilder.IsWorkflowExecutionPaused() {
 				di, err := msBuilder.AddDecisionTaskScheduledEvent()
 				if err != nil {
 					return nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
@@ -1544,18 +1848,16 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 		sRequest.GetIdentity()); err != nil {
 		return nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution signaled event."}
 	}
-	// first decision task
+	// first decision task, the signal is buffered until the first decision task backoff fires
+	backoffSeconds := startRequest.GetFirstDecisionTaskBackoffSeconds()
 	var transferTasks []persistence.Task
-	transferTasks, _, err = e.generateFirstDecisionTask(domainID, msBuilder, startRequest.ParentExecutionInfo, taskList, 0)
+	transferTasks, _, err = e.generateFirstDecisionTask(domainID, msBuilder, startRequest.ParentExecutionInfo, taskList, backoffSeconds)
 	if err != nil {
 		return nil, err
 	}
 
 	// first timer task
-	duration := time.Duration(*request.ExecutionStartToCloseTimeoutSeconds) * time.Second
-	timerTasks := []persistence.Task{&persistence.WorkflowTimeoutTask{
-		VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(duration),
-	}}
+	timerTasks := e.generateFirstTimerTasks(request, startRequest.ParentExecutionInfo, backoffSeconds)
 
 	context = newWorkflowExecutionContext(domainID, execution, e.shard, e.executionManager, e.logger)
 	msBuilder.AddTransferTasks(transferTasks...)
@@ -1648,6 +1950,8 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 	domainID := domainEntry.GetInfo().ID
 
 	request := terminateRequest.TerminateRequest
+	parentExecution := terminateRequest.ExternalWorkflowExecution
+	childWorkflowOnly := terminateRequest.GetChildWorkflowOnly()
 	execution := workflow.WorkflowExecution{
 		WorkflowId: request.WorkflowExecution.WorkflowId,
 		RunId:      request.WorkflowExecution.RunId,
@@ -1659,6 +1963,14 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 				return nil, ErrWorkflowCompleted
 			}
 
+			if childWorkflowOnly {
+				executionInfo := msBuilder.GetExecutionInfo()
+				if parentExecution.GetWorkflowId() != executionInfo.ParentWorkflowID ||
+					parentExecution.GetRunId() != executionInfo.ParentRunID {
+					return nil, ErrWorkflowParent
+				}
+			}
+
 			if _, err := msBuilder.AddWorkflowExecutionTerminatedEvent(
 				request.GetReason(),
 				request.GetDetails(),
@@ -1671,6 +1983,151 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 		})
 }
 
+// PauseWorkflowExecution records WorkflowExecutionPaused event, while the execution is paused decision and
+// activity tasks are held instead of dispatched
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx ctx.Context,
+	pauseRequest *h.PauseWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(pauseRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := pauseRequest.PauseRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if msBuilder.IsWorkflowExecutionPaused() {
+				return nil, ErrWorkflowAlreadyPaused
+			}
+
+			if _, err := msBuilder.AddWorkflowExecutionPausedEvent(
+				request.GetReason(),
+				request.GetIdentity(),
+			); err != nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to pause workflow execution."}
+			}
+
+			return &updateWorkflowAction{
+				transferTasks: []persistence.Task{&persistence.UpsertWorkflowSearchAttributesTask{}},
+			}, nil
+		})
+}
+
+// ResumeWorkflowExecution records WorkflowExecutionResumed event and dispatches the decision and activity tasks
+// held while the execution was paused
+func (e *historyEngineImpl) ResumeWorkflowExecution(
+	ctx ctx.Context,
+	resumeRequest *h.ResumeWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(resumeRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := resumeRequest.ResumeRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if !msBuilder.IsWorkflowExecutionPaused() {
+				return nil, ErrWorkflowNotPaused
+			}
+
+			if _, err := msBuilder.AddWorkflowExecutionResumedEvent(
+				request.GetReason(),
+				request.GetIdentity(),
+			); err != nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to resume workflow execution."}
+			}
+
+			transferTasks, err := e.getHeldTransferTasks(msBuilder)
+			if err != nil {
+				return nil, err
+			}
+			transferTasks = append(transferTasks, &persistence.UpsertWorkflowSearchAttributesTask{})
+
+			// the first decision task is scheduled by the backoff timer, unless the timer fired while paused
+			createDecision := !hasPendingFirstDecisionTaskBackoff(msBuilder)
+			if !createDecision {
+				startEvent, found := msBuilder.GetStartEvent()
+				if !found {
+					return nil, &workflow.InternalServiceError{Message: "Failed to load start event."}
+				}
+				executionTimestamp := getWorkflowExecutionTimestamp(msBuilder, startEvent)
+				createDecision = !executionTimestamp.After(e.shard.GetTimeSource().Now())
+			}
+
+			return &updateWorkflowAction{
+				createDecision: createDecision,
+				transferTasks:  transferTasks,
+			}, nil
+		})
+}
+
+// getHeldTransferTasks regenerates the transfer tasks of the scheduled decision and activities which are not
+// dispatched while the execution is paused
+func (e *historyEngineImpl) getHeldTransferTasks(
+	msBuilder mutableState,
+) ([]persistence.Task, error) {
+
+	var transferTasks []persistence.Task
+	executionInfo := msBuilder.GetExecutionInfo()
+	if di, ok := msBuilder.GetPendingDecision(executionInfo.DecisionScheduleID); ok && di.StartedID == common.EmptyEventID {
+		transferTasks = append(transferTasks, &persistence.DecisionTask{
+			DomainID:   executionInfo.DomainID,
+			TaskList:   executionInfo.TaskList,
+			ScheduleID: di.ScheduleID,
+		})
+	}
+
+	now := e.shard.GetTimeSource().Now()
+	for _, ai := range msBuilder.GetPendingActivityInfos() {
+		// activities waiting for their retry backoff are dispatched by the retry timer
+		if ai.StartedID != common.EmptyEventID || ai.ScheduledTime.After(now) {
+			continue
+		}
+
+		targetDomainID := executionInfo.DomainID
+		scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(ai.ScheduleID)
+		if !ok {
+			return nil, &workflow.InternalServiceError{Message: "Unable to get activity schedule event."}
+		}
+		if scheduledEvent.ActivityTaskScheduledEventAttributes.Domain != nil {
+			domainEntry, err := e.shard.GetDomainCache().GetDomain(scheduledEvent.ActivityTaskScheduledEventAttributes.GetDomain())
+			if err != nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to re-schedule activity across domain."}
+			}
+			targetDomainID = domainEntry.GetInfo().ID
+		}
+
+		transferTasks = append(transferTasks, &persistence.ActivityTask{
+			DomainID:   targetDomainID,
+			TaskList:   ai.TaskList,
+			ScheduleID: ai.ScheduleID,
+		})
+	}
+	return transferTasks, nil
+}
+
 // RecordChildExecutionCompleted records the completion of child execution into parent execution history
 func (e *historyEngineImpl) RecordChildExecutionCompleted(
 	ctx ctx.Context,
@@ -1769,6 +2226,367 @@ func (e *historyEngineImpl) SyncActivity(
 	return e.replicator.SyncActivity(ctx, request)
 }
 
+func (e *historyEngineImpl) ListDLQTasks(
+	ctx ctx.Context,
+	request *workflow.ListDLQTasksRequest,
+) (*workflow.ListDLQTasksResponse, error) {
+
+	category, err := toPersistenceDLQTaskCategory(request.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := e.shard.GetTaskDLQManager().ListDLQTasks(&persistence.ListDLQTasksRequest{
+		ShardID:       e.shard.GetShardID(),
+		Category:      category,
+		PageSize:      int(request.GetMaximumPageSize()),
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*workflow.DLQTaskInfo, 0, len(response.Tasks))
+	for _, task := range response.Tasks {
+		tasks = append(tasks, toThriftDLQTaskInfo(task))
+	}
+	return &workflow.ListDLQTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+func (e *historyEngineImpl) DescribeDLQTask(
+	ctx ctx.Context,
+	request *workflow.DescribeDLQTaskRequest,
+) (*workflow.DescribeDLQTaskResponse, error) {
+
+	task, err := e.getDLQTask(request.Category, request.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	return &workflow.DescribeDLQTaskResponse{
+		Task: toThriftDLQTaskInfo(task),
+	}, nil
+}
+
+// RequeueDLQTask processes a task of the DLQ once, the task is removed from the DLQ only if processing succeeds
+func (e *historyEngineImpl) RequeueDLQTask(
+	ctx ctx.Context,
+	request *workflow.RequeueDLQTaskRequest,
+) error {
+
+	task, err := e.getDLQTask(request.Category, request.TaskId)
+	if err != nil {
+		return err
+	}
+
+	switch task.Category {
+	case persistence.DLQTaskCategoryTransfer:
+		transferTask := &persistence.TransferTaskInfo{}
+		if err := json.Unmarshal(task.Data, transferTask); err != nil {
+			return err
+		}
+		err = e.txProcessor.ProcessTaskOnce(transferTask)
+	case persistence.DLQTaskCategoryTimer:
+		timerTask := &persistence.TimerTaskInfo{}
+		if err := json.Unmarshal(task.Data, timerTask); err != nil {
+			return err
+		}
+		err = e.timerProcessor.ProcessTaskOnce(timerTask)
+	default:
+		err = errUnknownDLQTask
+	}
+	if err != nil {
+		return err
+	}
+
+	return e.shard.GetTaskDLQManager().DeleteDLQTask(&persistence.DeleteDLQTaskRequest{
+		ShardID:  task.ShardID,
+		Category: task.Category,
+		TaskID:   task.TaskID,
+	})
+}
+
+// PurgeDLQTasks removes the task with the requested ID, or all the tasks of the category, from the DLQ
+func (e *historyEngineImpl) PurgeDLQTasks(
+	ctx ctx.Context,
+	request *workflow.PurgeDLQTasksRequest,
+) error {
+
+	category, err := toPersistenceDLQTaskCategory(request.Category)
+	if err != nil {
+		return err
+	}
+
+	if request.TaskId != nil {
+		return e.shard.GetTaskDLQManager().DeleteDLQTask(&persistence.DeleteDLQTaskRequest{
+			ShardID:  e.shard.GetShardID(),
+			Category: category,
+			TaskID:   request.GetTaskId(),
+		})
+	}
+	return e.shard.GetTaskDLQManager().RangeDeleteDLQTasks(&persistence.RangeDeleteDLQTasksRequest{
+		ShardID:  e.shard.GetShardID(),
+		Category: category,
+	})
+}
+
+func (e *historyEngineImpl) getDLQTask(
+	dlqTaskCategory *workflow.DLQTaskCategory,
+	taskID *int64,
+) (*persistence.DLQTask, error) {
+
+	category, err := toPersistenceDLQTaskCategory(dlqTaskCategory)
+	if err != nil {
+		return nil, err
+	}
+	if taskID == nil {
+		return nil, errDLQTaskIDNotSet
+	}
+
+	response, err := e.shard.GetTaskDLQManager().GetDLQTask(&persistence.GetDLQTaskRequest{
+		ShardID:  e.shard.GetShardID(),
+		Category: category,
+		TaskID:   *taskID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Task, nil
+}
+
+// DescribeShardQueues returns the ack levels and read levels of the transfer, timer and replication queues of the shard
+func (e *historyEngineImpl) DescribeShardQueues(
+	ctx ctx.Context,
+	request *workflow.DescribeShardQueuesRequest,
+) (*workflow.DescribeShardQueuesResponse, error) {
+
+	transferClusterAckLevels := make(map[string]int64)
+	timerClusterAckLevels := make(map[string]int64)
+	for clusterName, info := range e.shard.GetService().GetClusterMetadata().GetAllClusterInfo() {
+		if !info.Enabled {
+			continue
+		}
+		transferClusterAckLevels[clusterName] = e.shard.GetTransferClusterAckLevel(clusterName)
+		timerClusterAckLevels[clusterName] = e.shard.GetTimerClusterAckLevel(clusterName).UnixNano()
+	}
+
+	response := &workflow.DescribeShardQueuesResponse{
+		ShardId:                  common.Int32Ptr(int32(e.shard.GetShardID())),
+		TransferAckLevel:         common.Int64Ptr(e.shard.GetTransferAckLevel()),
+		TransferReadLevel:        common.Int64Ptr(e.txProcessor.GetReadLevel()),
+		TransferMaxReadLevel:     common.Int64Ptr(e.shard.GetTransferMaxReadLevel()),
+		TransferClusterAckLevels: transferClusterAckLevels,
+		TimerAckLevel:            common.Int64Ptr(e.shard.GetTimerAckLevel().UnixNano()),
+		TimerReadLevel:           common.Int64Ptr(e.timerProcessor.GetReadLevel().UnixNano()),
+		TimerMaxReadLevel:        common.Int64Ptr(e.shard.GetTimerMaxReadLevel(e.currentClusterName).UnixNano()),
+		TimerClusterAckLevels:    timerClusterAckLevels,
+		ReplicationAckLevel:      common.Int64Ptr(e.shard.GetReplicatorAckLevel()),
+	}
+	if e.replicatorProcessor != nil {
+		response.ReplicationReadLevel = common.Int64Ptr(e.replicatorProcessor.GetReadLevel())
+	}
+	return response, nil
+}
+
+// ListShardQueueTasks returns a page of the pending tasks of a queue of the shard, optionally filtered by domain and workflow,
+// filtering is applied to each page read from persistence so a page may contain less tasks than requested
+func (e *historyEngineImpl) ListShardQueueTasks(
+	ctx ctx.Context,
+	request *workflow.ListShardQueueTasksRequest,
+) (*workflow.ListShardQueueTasksResponse, error) {
+
+	if request.QueueType == nil {
+		return nil, errShardQueueTypeNotSet
+	}
+
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultShardQueueTasksPageSize
+	}
+	domainID := request.GetDomainId()
+	workflowID := request.GetWorkflowId()
+
+	var tasks []*workflow.ShardQueueTaskInfo
+	var nextPageToken []byte
+	appendTask := func(task queueTaskInfo, taskDomainID, taskWorkflowID, taskRunID string) error {
+		if !shardQueueTaskMatches(domainID, workflowID, taskDomainID, taskWorkflowID) {
+			return nil
+		}
+		taskInfo, err := toThriftShardQueueTaskInfo(request.GetQueueType(), task, taskDomainID, taskWorkflowID, taskRunID)
+		if err != nil {
+			return err
+		}
+		tasks = append(tasks, taskInfo)
+		return nil
+	}
+
+	switch request.GetQueueType() {
+	case workflow.ShardQueueTypeTransfer:
+		response, err := e.shard.GetExecutionManager().GetTransferTasks(&persistence.GetTransferTasksRequest{
+			ReadLevel:     e.shard.GetTransferAckLevel(),
+			MaxReadLevel:  e.shard.GetTransferMaxReadLevel(),
+			BatchSize:     pageSize,
+			NextPageToken: request.NextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range response.Tasks {
+			if err := appendTask(task, task.DomainID, task.WorkflowID, task.RunID); err != nil {
+				return nil, err
+			}
+		}
+		nextPageToken = response.NextPageToken
+	case workflow.ShardQueueTypeTimer:
+		response, err := e.shard.GetExecutionManager().GetTimerIndexTasks(&persistence.GetTimerIndexTasksRequest{
+			MinTimestamp:  e.shard.GetTimerAckLevel(),
+			MaxTimestamp:  maximumTime,
+			BatchSize:     pageSize,
+			NextPageToken: request.NextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range response.Timers {
+			if err := appendTask(task, task.DomainID, task.WorkflowID, task.RunID); err != nil {
+				return nil, err
+			}
+		}
+		nextPageToken = response.NextPageToken
+	case workflow.ShardQueueTypeReplication:
+		response, err := e.shard.GetExecutionManager().GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+			ReadLevel:     e.shard.GetReplicatorAckLevel(),
+			MaxReadLevel:  e.shard.GetTransferMaxReadLevel(),
+			BatchSize:     pageSize,
+			NextPageToken: request.NextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range response.Tasks {
+			if err := appendTask(task, task.DomainID, task.WorkflowID, task.RunID); err != nil {
+				return nil, err
+			}
+		}
+		nextPageToken = response.NextPageToken
+	default:
+		return nil, errUnknownShardQueueType
+	}
+
+	return &workflow.ListShardQueueTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// DeleteShardQueueTask removes a task from a queue of the shard without processing it
+func (e *historyEngineImpl) DeleteShardQueueTask(
+	ctx ctx.Context,
+	request *workflow.DeleteShardQueueTaskRequest,
+) error {
+
+	if err := validateShardQueueTaskRequest(request.QueueType, request.TaskId, request.VisibilityTimestamp); err != nil {
+		return err
+	}
+
+	// tasks at or below the read level of a queue processor are tracked in memory by its ack manager,
+	// deleting only the persisted row would leave them outstanding and the ack level stuck
+	taskID := request.GetTaskId()
+	switch request.GetQueueType() {
+	case workflow.ShardQueueTypeTransfer:
+		if e.txProcessor.IsTaskLoaded(taskID) {
+			return errShardQueueTaskLoaded
+		}
+		return e.shard.GetExecutionManager().CompleteTransferTask(&persistence.CompleteTransferTaskRequest{
+			TaskID: taskID,
+		})
+	case workflow.ShardQueueTypeTimer:
+		visibilityTimestamp := time.Unix(0, request.GetVisibilityTimestamp())
+		if e.timerProcessor.IsTaskLoaded(visibilityTimestamp, taskID) {
+			return errShardQueueTaskLoaded
+		}
+		return e.shard.GetExecutionManager().CompleteTimerTask(&persistence.CompleteTimerTaskRequest{
+			VisibilityTimestamp: visibilityTimestamp,
+			TaskID:              taskID,
+		})
+	case workflow.ShardQueueTypeReplication:
+		if e.replicatorProcessor != nil && e.replicatorProcessor.IsTaskLoaded(taskID) {
+			return errShardQueueTaskLoaded
+		}
+		return e.shard.GetExecutionManager().CompleteReplicationTask(&persistence.CompleteReplicationTaskRequest{
+			TaskID: taskID,
+		})
+	default:
+		return errUnknownShardQueueType
+	}
+}
+
+// RefireShardQueueTask processes a pending task of a queue of the shard once, regardless of the queue read level
+func (e *historyEngineImpl) RefireShardQueueTask(
+	ctx ctx.Context,
+	request *workflow.RefireShardQueueTaskRequest,
+) error {
+
+	if err := validateShardQueueTaskRequest(request.QueueType, request.TaskId, request.VisibilityTimestamp); err != nil {
+		return err
+	}
+
+	taskID := request.GetTaskId()
+	switch request.GetQueueType() {
+	case workflow.ShardQueueTypeTransfer:
+		response, err := e.shard.GetExecutionManager().GetTransferTasks(&persistence.GetTransferTasksRequest{
+			ReadLevel:    taskID - 1,
+			MaxReadLevel: taskID,
+			BatchSize:    1,
+		})
+		if err != nil {
+			return err
+		}
+		for _, task := range response.Tasks {
+			if task.TaskID == taskID {
+				return e.txProcessor.ProcessTaskOnce(task)
+			}
+		}
+	case workflow.ShardQueueTypeTimer:
+		visibilityTimestamp := time.Unix(0, request.GetVisibilityTimestamp())
+		response, err := e.shard.GetExecutionManager().GetTimerIndexTasks(&persistence.GetTimerIndexTasksRequest{
+			MinTimestamp: visibilityTimestamp,
+			MaxTimestamp: visibilityTimestamp.Add(shardQueueTimerTaskLookupInterval),
+			BatchSize:    defaultShardQueueTasksPageSize,
+		})
+		if err != nil {
+			return err
+		}
+		for _, task := range response.Timers {
+			if task.TaskID == taskID {
+				return e.timerProcessor.ProcessTaskOnce(task)
+			}
+		}
+	case workflow.ShardQueueTypeReplication:
+		if e.replicatorProcessor == nil {
+			return errReplicatorQueueNotEnabled
+		}
+		response, err := e.shard.GetExecutionManager().GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+			ReadLevel:    taskID - 1,
+			MaxReadLevel: taskID,
+			BatchSize:    1,
+		})
+		if err != nil {
+			return err
+		}
+		for _, task := range response.Tasks {
+			if task.TaskID == taskID {
+				return e.replicatorProcessor.ProcessTaskOnce(task)
+			}
+		}
+	default:
+		return errUnknownShardQueueType
+	}
+	return errShardQueueTaskNotFound
+}
+
 func (e *historyEngineImpl) ResetWorkflowExecution(
 	ctx ctx.Context,
 	resetRequest *h.ResetWorkflowExecutionRequest,
@@ -1930,8 +2748,8 @@ Update_History_Loop:
 		}
 
 		if postActions.createDecision {
-			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecisionTask() {
+			// Create a transfer task to schedule a decision task, decisions are held while the execution is paused
+			if !msBuilder.HasPendingDecisionTask() && !msBuilder.IsWorkflowExecutionPaused() {
 				di, err := msBuilder.AddDecisionTaskScheduledEvent()
 				if err != nil {
 					return &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
@@ -2128,6 +2946,30 @@ func (s *shardContextWrapper) NotifyNewHistoryEvent(
 	return err
 }
 
+func getQueryRejected(
+	msBuilder mutableState,
+	rejectCondition *workflow.QueryRejectCondition,
+) *workflow.QueryRejected {
+
+	closeStatus := msBuilder.GetExecutionInfo().CloseStatus
+	if rejectCondition == nil || msBuilder.IsWorkflowExecutionRunning() || closeStatus == persistence.WorkflowCloseStatusNone {
+		return nil
+	}
+
+	rejected := &workflow.QueryRejected{
+		CloseStatus: getWorkflowExecutionCloseStatus(closeStatus).Ptr(),
+	}
+	switch *rejectCondition {
+	case workflow.QueryRejectConditionNotOpen:
+		return rejected
+	case workflow.QueryRejectConditionNotCompletedCleanly:
+		if closeStatus != persistence.WorkflowCloseStatusCompleted {
+			return rejected
+		}
+	}
+	return nil
+}
+
 func validateStartWorkflowExecutionRequest(
 	request *workflow.StartWorkflowExecutionRequest,
 	maxIDLengthLimit int,
@@ -2142,6 +2984,9 @@ func validateStartWorkflowExecutionRequest(
 	if request.TaskStartToCloseTimeoutSeconds == nil || request.GetTaskStartToCloseTimeoutSeconds() <= 0 {
 		return &workflow.BadRequestError{Message: "Missing or invalid TaskStartToCloseTimeoutSeconds."}
 	}
+	if request.GetDelayStartSeconds() < 0 {
+		return &workflow.BadRequestError{Message: "Invalid DelayStartSeconds."}
+	}
 	if request.TaskList == nil || request.TaskList.Name == nil || request.TaskList.GetName() == "" {
 		return &workflow.BadRequestError{Message: "Missing Tasklist."}
 	}
@@ -2239,9 +3084,12 @@ func getStartRequest(
 		Memo:                                request.Memo,
 		SearchAttributes:                    request.SearchAttributes,
 		Header:                              request.Header,
+		DelayStartSeconds:                   request.DelayStartSeconds,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req)
+	// the signal starts the first run of a cron workflow right away, only the start delay is applied
+	startRequest.FirstDecisionTaskBackoffSeconds = common.Int32Ptr(request.GetDelayStartSeconds())
 	return startRequest
 }
 
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/mock"
@@ -32,6 +33,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 	h "github.com/uber/cadence/.gen/go/history"
+	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
@@ -46,6 +48,7 @@ import (
 	"github.com/uber/cadence/common/mocks"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
 )
 
@@ -157,6 +160,8 @@ func (s *engine2Suite) SetupTest() {
 		config:             s.config,
 		archivalClient:     s.mockArchivalClient,
 		timeSource:         mockShard.timeSource,
+		matchingClient:     s.mockMatchingClient,
+		rawMatchingClient:  s.mockMatchingClient,
 	}
 	h.txProcessor = newTransferQueueProcessor(mockShard, h, s.mockVisibilityMgr, s.mockMatchingClient, s.mockHistoryClient, s.logger)
 	h.timerProcessor = newTimerQueueProcessor(mockShard, h, s.mockMatchingClient, s.logger)
@@ -938,6 +943,251 @@ func (s *engine2Suite) TestRequestCancelWorkflowExecutionFail() {
 	s.IsType(&workflow.EntityNotExistsError{}, err)
 }
 
+func (s *engine2Suite) TestTerminateWorkflowExecution_ChildWorkflowOnly_ParentMismatch() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, "testTaskList", "testIdentity", false)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&p.GetCurrentExecutionResponse{RunID: validRunID}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockActiveDomain(domainID)
+
+	err := s.historyEngine.TerminateWorkflowExecution(context.Background(), &h.TerminateWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		TerminateRequest: &workflow.TerminateWorkflowExecutionRequest{
+			WorkflowExecution: &workflow.WorkflowExecution{
+				WorkflowId: workflowExecution.WorkflowId,
+			},
+			Identity: common.StringPtr("identity"),
+		},
+		ExternalWorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("parent wId"),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+		ChildWorkflowOnly: common.BoolPtr(true),
+	})
+	s.Equal(ErrWorkflowParent, err)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything)
+}
+
+func (s *engine2Suite) TestPauseWorkflowExecution() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, "testTaskList", "testIdentity", false)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(input *p.UpdateWorkflowExecutionRequest) bool {
+		return input.UpdateWorkflowMutation.ExecutionInfo.Paused
+	})).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+	s.mockActiveDomain(domainID)
+
+	err := s.historyEngine.PauseWorkflowExecution(context.Background(), &h.PauseWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PauseRequest: &workflow.PauseWorkflowExecutionRequest{
+			WorkflowExecution: &workflowExecution,
+			Reason:            common.StringPtr("reason"),
+			Identity:          common.StringPtr("identity"),
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(domainID, workflowExecution)
+	s.True(executionBuilder.IsWorkflowExecutionPaused())
+	s.Equal(int64(4), executionBuilder.GetNextEventID())
+}
+
+func (s *engine2Suite) TestPauseWorkflowExecution_AlreadyPaused() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, "testTaskList", "testIdentity", false)
+	_, err := msBuilder.AddWorkflowExecutionPausedEvent("reason", "identity")
+	s.Nil(err)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockActiveDomain(domainID)
+
+	err = s.historyEngine.PauseWorkflowExecution(context.Background(), &h.PauseWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PauseRequest: &workflow.PauseWorkflowExecutionRequest{
+			WorkflowExecution: &workflowExecution,
+			Identity:          common.StringPtr("identity"),
+		},
+	})
+	s.Equal(ErrWorkflowAlreadyPaused, err)
+}
+
+func (s *engine2Suite) TestRecordDecisionTaskStartedWhilePaused() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+	_, err := msBuilder.AddWorkflowExecutionPausedEvent("reason", identity)
+	s.Nil(err)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockActiveDomain(domainID)
+
+	response, err := s.historyEngine.RecordDecisionTaskStarted(context.Background(), &h.RecordDecisionTaskStartedRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &workflowExecution,
+		ScheduleId:        common.Int64Ptr(2),
+		TaskId:            common.Int64Ptr(100),
+		RequestId:         common.StringPtr("reqId"),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{
+				Name: common.StringPtr(tl),
+			},
+			Identity: common.StringPtr(identity),
+		},
+	})
+	s.Nil(response)
+	s.Equal(ErrWorkflowPaused, err)
+}
+
+func (s *engine2Suite) TestRecordActivityTaskStartedWhilePaused() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, int64(2), int64(3), nil, identity)
+	scheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	_, err := msBuilder.AddWorkflowExecutionPausedEvent("reason", identity)
+	s.Nil(err)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockActiveDomain(domainID)
+	s.mockEventsCache.On("getEvent", domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(),
+		decisionCompletedEvent.GetEventId(), scheduledEvent.GetEventId(), mock.Anything, mock.Anything).Return(scheduledEvent, nil)
+
+	response, err := s.historyEngine.RecordActivityTaskStarted(context.Background(), &h.RecordActivityTaskStartedRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &workflowExecution,
+		ScheduleId:        common.Int64Ptr(scheduledEvent.GetEventId()),
+		TaskId:            common.Int64Ptr(100),
+		RequestId:         common.StringPtr("reqId"),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList: &workflow.TaskList{
+				Name: common.StringPtr(tl),
+			},
+			Identity: common.StringPtr(identity),
+		},
+	})
+	s.Nil(response)
+	s.Equal(ErrWorkflowPaused, err)
+}
+
+func (s *engine2Suite) TestResumeWorkflowExecution() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, int64(2), int64(3), nil, identity)
+	scheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	_, err := msBuilder.AddWorkflowExecutionPausedEvent("reason", identity)
+	s.Nil(err)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(input *p.UpdateWorkflowExecutionRequest) bool {
+		// the activity held while paused must be dispatched again
+		for _, task := range input.UpdateWorkflowMutation.TransferTasks {
+			if activityTask, ok := task.(*p.ActivityTask); ok {
+				return !input.UpdateWorkflowMutation.ExecutionInfo.Paused &&
+					activityTask.ScheduleID == scheduledEvent.GetEventId()
+			}
+		}
+		return false
+	})).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+	s.mockActiveDomain(domainID)
+	s.mockEventsCache.On("getEvent", domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(),
+		decisionCompletedEvent.GetEventId(), scheduledEvent.GetEventId(), mock.Anything, mock.Anything).Return(scheduledEvent, nil)
+
+	err = s.historyEngine.ResumeWorkflowExecution(context.Background(), &h.ResumeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		ResumeRequest: &workflow.ResumeWorkflowExecutionRequest{
+			WorkflowExecution: &workflowExecution,
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(domainID, workflowExecution)
+	s.False(executionBuilder.IsWorkflowExecutionPaused())
+}
+
+func (s *engine2Suite) TestResumeWorkflowExecution_NotPaused() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, "testTaskList", "testIdentity", false)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockActiveDomain(domainID)
+
+	err := s.historyEngine.ResumeWorkflowExecution(context.Background(), &h.ResumeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		ResumeRequest: &workflow.ResumeWorkflowExecutionRequest{
+			WorkflowExecution: &workflowExecution,
+			Identity:          common.StringPtr("identity"),
+		},
+	})
+	s.Equal(ErrWorkflowNotPaused, err)
+}
+
 func (s *engine2Suite) createExecutionStartedState(we workflow.WorkflowExecution, tl, identity string,
 	startDecision bool) mutableState {
 	msBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache,
@@ -947,53 +1197,577 @@ func (s *engine2Suite) createExecutionStartedState(we workflow.WorkflowExecution
 	if startDecision {
 		addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
 	}
-	_ = msBuilder.SetHistoryTree(we.GetRunId())
+	_ = msBuilder.SetHistoryTree(we.GetRunId())
+
+	return msBuilder
+}
+
+func (s *engine2Suite) printHistory(builder mutableState) string {
+	return builder.GetHistoryBuilder().GetHistory().String()
+}
+
+func (s *engine2Suite) TestRespondDecisionTaskCompletedRecordMarkerDecision() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      we.GetRunId(),
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+	markerDetails := []byte("marker details")
+	markerName := "marker name"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeRecordMarker),
+		RecordMarkerDecisionAttributes: &workflow.RecordMarkerDecisionAttributes{
+			MarkerName: common.StringPtr(markerName),
+			Details:    markerDetails,
+		},
+	}}
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&p.GetDomainResponse{
+			Info:   &p.DomainInfo{ID: domainID},
+			Config: &p.DomainConfig{Retention: 1},
+			ReplicationConfig: &p.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*p.ClusterReplicationConfig{
+					&p.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: p.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	_, err := s.historyEngine.RespondDecisionTaskCompleted(context.Background(), &h.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: nil,
+			Identity:         &identity,
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(6), executionBuilder.GetExecutionInfo().NextEventID)
+	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
+	s.Equal(p.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+	s.False(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engine2Suite) TestQueryWorkflow_RejectCondition() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := s.createExecutionStartedState(we, tl, identity, true)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.State = p.WorkflowStateCompleted
+	ms.ExecutionInfo.CloseStatus = p.WorkflowCloseStatusFailed
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockActiveDomain(domainID)
+
+	response, err := s.historyEngine.QueryWorkflow(context.Background(), &h.QueryWorkflowRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &workflow.QueryWorkflowRequest{
+			Execution:            &we,
+			Query:                &workflow.WorkflowQuery{QueryType: common.StringPtr("query-type")},
+			QueryRejectCondition: workflow.QueryRejectConditionNotCompletedCleanly.Ptr(),
+		},
+	})
+	s.Nil(err)
+	s.NotNil(response.Response.QueryRejected)
+	s.Equal(workflow.WorkflowExecutionCloseStatusFailed, response.Response.QueryRejected.GetCloseStatus())
+	s.Nil(response.Response.QueryResult)
+}
+
+func (s *engine2Suite) TestQueryWorkflow_DirectlyThroughMatching() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := s.createExecutionStartedState(we, tl, identity, false)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockActiveDomain(domainID)
+	s.mockMatchingClient.On("QueryWorkflow", mock.Anything, mock.MatchedBy(func(request *m.QueryWorkflowRequest) bool {
+		return request.GetDomainUUID() == domainID && request.TaskList.GetName() == tl
+	})).Return(&workflow.QueryWorkflowResponse{QueryResult: []byte("result")}, nil).Once()
+
+	response, err := s.historyEngine.QueryWorkflow(context.Background(), &h.QueryWorkflowRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &workflow.QueryWorkflowRequest{
+			Execution:             &we,
+			Query:                 &workflow.WorkflowQuery{QueryType: common.StringPtr("query-type")},
+			QueryConsistencyLevel: workflow.QueryConsistencyLevelEventual.Ptr(),
+		},
+	})
+	s.Nil(err)
+	s.Equal([]byte("result"), response.Response.QueryResult)
+}
+
+func (s *engine2Suite) TestQueryWorkflow_StrongConsistency_AnsweredByDecision() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 2,
+	})
+
+	msBuilder := s.createExecutionStartedState(we, tl, identity, false)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Twice()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Twice()
+	s.mockActiveDomain(domainID)
+
+	type queryResult struct {
+		response *h.QueryWorkflowResponse
+		err      error
+	}
+	resultCh := make(chan queryResult, 1)
+	go func() {
+		response, err := s.historyEngine.QueryWorkflow(context.Background(), &h.QueryWorkflowRequest{
+			DomainUUID: common.StringPtr(domainID),
+			Request: &workflow.QueryWorkflowRequest{
+				Execution:             &we,
+				Query:                 &workflow.WorkflowQuery{QueryType: common.StringPtr("query-type")},
+				QueryConsistencyLevel: workflow.QueryConsistencyLevelStrong.Ptr(),
+			},
+		})
+		resultCh <- queryResult{response: response, err: err}
+	}()
+
+	for i := 0; i < 500 && !s.hasBufferedQuery(domainID, we); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	s.True(s.hasBufferedQuery(domainID, we))
+
+	startedResponse, err := s.historyEngine.RecordDecisionTaskStarted(context.Background(), &h.RecordDecisionTaskStartedRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &we,
+		ScheduleId:        common.Int64Ptr(2),
+		TaskId:            common.Int64Ptr(100),
+		RequestId:         common.StringPtr("reqId"),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{Name: common.StringPtr(tl)},
+			Identity: common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	s.Len(startedResponse.Queries, 1)
+
+	queryResults := make(map[string]*workflow.WorkflowQueryResult)
+	for queryID := range startedResponse.Queries {
+		queryResults[queryID] = &workflow.WorkflowQueryResult{
+			ResultType: workflow.QueryResultTypeAnswered.Ptr(),
+			Answer:     []byte("answer"),
+		}
+	}
+	_, err = s.historyEngine.RespondDecisionTaskCompleted(context.Background(), &h.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:    taskToken,
+			Identity:     common.StringPtr(identity),
+			QueryResults: queryResults,
+		},
+	})
+	s.Nil(err)
+
+	select {
+	case result := <-resultCh:
+		s.Nil(result.err)
+		s.Equal([]byte("answer"), result.response.Response.QueryResult)
+	case <-time.After(5 * time.Second):
+		s.Fail("query was not answered by the decision task")
+	}
+}
+
+func (s *engine2Suite) TestUpdateWorkflowExecution_Accepted() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 2,
+	})
+
+	msBuilder := s.createExecutionStartedState(we, tl, identity, false)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	updateIDCh := make(chan string, 1)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.MatchedBy(func(request *p.AppendHistoryNodesRequest) bool {
+		for _, event := range request.Events {
+			if event.GetEventType() == workflow.EventTypeWorkflowExecutionUpdateRequested {
+				select {
+				case updateIDCh <- event.WorkflowExecutionUpdateRequestedEventAttributes.GetUpdateId():
+				default:
+				}
+			}
+		}
+		return true
+	})).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Times(3)
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Times(3)
+	s.mockActiveDomain(domainID)
+
+	type updateResult struct {
+		response *h.UpdateWorkflowExecutionResponse
+		err      error
+	}
+	resultCh := make(chan updateResult, 1)
+	go func() {
+		response, err := s.historyEngine.UpdateWorkflowExecution(context.Background(), &h.UpdateWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(domainID),
+			UpdateRequest: &workflow.UpdateWorkflowExecutionRequest{
+				WorkflowExecution: &we,
+				UpdateName:        common.StringPtr("update-name"),
+				Input:             []byte("input"),
+				Identity:          common.StringPtr(identity),
+			},
+		})
+		resultCh <- updateResult{response: response, err: err}
+	}()
+
+	var updateID string
+	select {
+	case updateID = <-updateIDCh:
+	case <-time.After(5 * time.Second):
+		s.FailNow("update requested event was not recorded")
+	}
 
-	return msBuilder
-}
+	_, err := s.historyEngine.RecordDecisionTaskStarted(context.Background(), &h.RecordDecisionTaskStartedRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &we,
+		ScheduleId:        common.Int64Ptr(2),
+		TaskId:            common.Int64Ptr(100),
+		RequestId:         common.StringPtr("reqId"),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{Name: common.StringPtr(tl)},
+			Identity: common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
 
-func (s *engine2Suite) printHistory(builder mutableState) string {
-	return builder.GetHistoryBuilder().GetHistory().String()
+	_, err = s.historyEngine.RespondDecisionTaskCompleted(context.Background(), &h.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Identity:  common.StringPtr(identity),
+			UpdateResults: map[string]*workflow.WorkflowUpdateResult{
+				updateID: {
+					ResultType: workflow.UpdateResultTypeAccepted.Ptr(),
+					Result:     []byte("result"),
+				},
+			},
+		},
+	})
+	s.Nil(err)
+
+	select {
+	case result := <-resultCh:
+		s.Nil(result.err)
+		s.Equal(updateID, result.response.Response.GetUpdateId())
+		s.Equal(workflow.UpdateResultTypeAccepted, result.response.Response.Result.GetResultType())
+		s.Equal([]byte("result"), result.response.Response.Result.Result)
+	case <-time.After(5 * time.Second):
+		s.Fail("update was not handled by the decision task")
+	}
 }
 
-func (s *engine2Suite) TestRespondDecisionTaskCompletedRecordMarkerDecision() {
+func (s *engine2Suite) TestUpdateWorkflowExecution_InvalidResult() {
 	domainID := validDomainID
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
 		RunId:      common.StringPtr(validRunID),
 	}
 	tl := "testTaskList"
+	identity := "testIdentity"
 	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: "wId",
+		WorkflowID: we.GetWorkflowId(),
 		RunID:      we.GetRunId(),
 		ScheduleID: 2,
 	})
-	identity := "testIdentity"
-	markerDetails := []byte("marker details")
-	markerName := "marker name"
 
-	msBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache,
-		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
-	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
-	di := addDecisionTaskScheduledEvent(msBuilder)
-	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	msBuilder := s.createExecutionStartedState(we, tl, identity, true)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockActiveDomain(domainID)
 
-	decisions := []*workflow.Decision{{
-		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeRecordMarker),
-		RecordMarkerDecisionAttributes: &workflow.RecordMarkerDecisionAttributes{
-			MarkerName: common.StringPtr(markerName),
-			Details:    markerDetails,
+	_, err := s.historyEngine.RespondDecisionTaskCompleted(context.Background(), &h.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Identity:  common.StringPtr(identity),
+			UpdateResults: map[string]*workflow.WorkflowUpdateResult{
+				"update-id": {},
+			},
 		},
-	}}
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engine2Suite) TestUpdateWorkflowExecution_WorkflowCompleted() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
 
+	msBuilder := s.createExecutionStartedState(we, tl, identity, false)
+	msBuilder.GetExecutionInfo().State = p.WorkflowStateCompleted
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockActiveDomain(domainID)
+
+	_, err := s.historyEngine.UpdateWorkflowExecution(context.Background(), &h.UpdateWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		UpdateRequest: &workflow.UpdateWorkflowExecutionRequest{
+			WorkflowExecution: &we,
+			UpdateName:        common.StringPtr("update-name"),
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Equal(ErrWorkflowCompleted, err)
+}
+
+func (s *engine2Suite) TestUpdateWorkflowExecution_DuplicateRequestPending() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	requestID := "request-id"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 2,
+	})
 
+	msBuilder := s.createExecutionStartedState(we, tl, identity, true)
+	msBuilder.GetExecutionInfo().RequestedUpdates = map[string]bool{requestID: false}
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	// only the completion of the decision task is persisted, the retried update adds no event
 	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{
 		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
 	}, nil).Once()
+	s.mockActiveDomain(domainID)
+
+	wfContext, release, err := s.historyEngine.historyCache.getOrCreateWorkflowExecution(context.Background(), domainID, we)
+	s.Nil(err)
+	registry := wfContext.(*workflowExecutionContextImpl).updateRegistry
+	release(nil)
+
+	type updateResult struct {
+		response *h.UpdateWorkflowExecutionResponse
+		err      error
+	}
+	resultCh := make(chan updateResult, 1)
+	go func() {
+		response, err := s.historyEngine.UpdateWorkflowExecution(context.Background(), &h.UpdateWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(domainID),
+			UpdateRequest: &workflow.UpdateWorkflowExecutionRequest{
+				WorkflowExecution: &we,
+				UpdateName:        common.StringPtr("update-name"),
+				Input:             []byte("input"),
+				Identity:          common.StringPtr(identity),
+				RequestId:         common.StringPtr(requestID),
+			},
+		})
+		resultCh <- updateResult{response: response, err: err}
+	}()
+
+	// wait for the retried request to wait for the pending update
+	for i := 0; ; i++ {
+		if _, err := registry.getResult(requestID); err == nil {
+			break
+		}
+		if i == 500 {
+			s.FailNow("update was not registered")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	_, err = s.historyEngine.RespondDecisionTaskCompleted(context.Background(), &h.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Identity:  common.StringPtr(identity),
+			UpdateResults: map[string]*workflow.WorkflowUpdateResult{
+				requestID: {
+					ResultType: workflow.UpdateResultTypeAccepted.Ptr(),
+					Result:     []byte("result"),
+				},
+			},
+		},
+	})
+	s.Nil(err)
+
+	select {
+	case result := <-resultCh:
+		s.Nil(result.err)
+		s.Equal(requestID, result.response.Response.GetUpdateId())
+		s.Equal(workflow.UpdateResultTypeAccepted, result.response.Response.Result.GetResultType())
+		s.Equal([]byte("result"), result.response.Response.Result.Result)
+	case <-time.After(5 * time.Second):
+		s.Fail("update was not handled by the decision task")
+	}
+}
+
+func (s *engine2Suite) TestUpdateWorkflowExecution_DuplicateRequestCompleted() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := s.createExecutionStartedState(we, tl, identity, false)
+	msBuilder.GetExecutionInfo().RequestedUpdates = map[string]bool{"request-id": true}
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockActiveDomain(domainID)
+
+	_, err := s.historyEngine.UpdateWorkflowExecution(context.Background(), &h.UpdateWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		UpdateRequest: &workflow.UpdateWorkflowExecutionRequest{
+			WorkflowExecution: &we,
+			UpdateName:        common.StringPtr("update-name"),
+			Identity:          common.StringPtr(identity),
+			RequestId:         common.StringPtr("request-id"),
+		},
+	})
+	s.Equal(ErrUpdateAlreadyCompleted, err)
+}
+
+func (s *engine2Suite) TestUpdateWorkflowExecution_PendingUpdatesLimitExceeded() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	s.config.MaximumPendingUpdatesPerExecution = dynamicconfig.GetIntPropertyFilteredByDomain(1)
+
+	msBuilder := s.createExecutionStartedState(we, tl, identity, false)
+	msBuilder.GetExecutionInfo().RequestedUpdates = map[string]bool{"pending-id": false}
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockActiveDomain(domainID)
+
+	_, err := s.historyEngine.UpdateWorkflowExecution(context.Background(), &h.UpdateWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		UpdateRequest: &workflow.UpdateWorkflowExecutionRequest{
+			WorkflowExecution: &we,
+			UpdateName:        common.StringPtr("update-name"),
+			Identity:          common.StringPtr(identity),
+			RequestId:         common.StringPtr("request-id"),
+		},
+	})
+	s.Equal(ErrUpdatesLimitExceeded, err)
+}
+
+func (s *engine2Suite) TestUpdateWorkflowExecution_ResultNotPending() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 2,
+	})
+
+	msBuilder := s.createExecutionStartedState(we, tl, identity, true)
+	msBuilder.GetExecutionInfo().RequestedUpdates = map[string]bool{"completed-id": true}
+	s.mockActiveDomain(domainID)
+
+	for _, updateID := range []string{"unknown-id", "completed-id"} {
+		// the mutable state is reloaded after each rejected decision completion
+		ms := createMutableState(msBuilder)
+		gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+		_, err := s.historyEngine.RespondDecisionTaskCompleted(context.Background(), &h.RespondDecisionTaskCompletedRequest{
+			DomainUUID: common.StringPtr(domainID),
+			CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+				TaskToken: taskToken,
+				Identity:  common.StringPtr(identity),
+				UpdateResults: map[string]*workflow.WorkflowUpdateResult{
+					updateID: {
+						ResultType: workflow.UpdateResultTypeAccepted.Ptr(),
+					},
+				},
+			},
+		})
+		s.Equal(ErrUpdateNotPending, err)
+	}
+}
+
+func (s *engine2Suite) mockActiveDomain(domainID string) {
 	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
 		&p.GetDomainResponse{
 			Info:   &p.DomainInfo{ID: domainID},
@@ -1008,22 +1782,6 @@ func (s *engine2Suite) TestRespondDecisionTaskCompletedRecordMarkerDecision() {
 		},
 		nil,
 	)
-
-	_, err := s.historyEngine.RespondDecisionTaskCompleted(context.Background(), &h.RespondDecisionTaskCompletedRequest{
-		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
-			TaskToken:        taskToken,
-			Decisions:        decisions,
-			ExecutionContext: nil,
-			Identity:         &identity,
-		},
-	})
-	s.Nil(err)
-	executionBuilder := s.getBuilder(domainID, we)
-	s.Equal(int64(6), executionBuilder.GetExecutionInfo().NextEventID)
-	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
-	s.Equal(p.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
-	s.False(executionBuilder.HasPendingDecisionTask())
 }
 
 func (s *engine2Suite) TestStartWorkflowExecution_BrandNew() {
@@ -1067,6 +1825,62 @@ func (s *engine2Suite) TestStartWorkflowExecution_BrandNew() {
 	s.NotNil(resp.RunId)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_DelayStart() {
+	domainID := validDomainID
+	workflowID := "workflowID"
+	workflowType := "workflowType"
+	taskList := "testTaskList"
+	identity := "testIdentity"
+
+	var createRequest *p.CreateWorkflowExecutionRequest
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(&p.CreateWorkflowExecutionResponse{}, nil).Run(func(args mock.Arguments) {
+		createRequest = args.Get(0).(*p.CreateWorkflowExecutionRequest)
+	}).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&p.GetDomainResponse{
+			Info:   &p.DomainInfo{ID: domainID},
+			Config: &p.DomainConfig{Retention: 1},
+			ReplicationConfig: &p.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*p.ClusterReplicationConfig{
+					&p.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: p.DomainTableVersionV1,
+		},
+		nil,
+	)
+	requestID := uuid.New()
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), common.CreateHistoryStartWorkflowRequest(domainID, &workflow.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(domainID),
+		WorkflowId:                          common.StringPtr(workflowID),
+		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+		TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskList)},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+		Identity:                            common.StringPtr(identity),
+		RequestId:                           common.StringPtr(requestID),
+		DelayStartSeconds:                   common.Int32Ptr(10),
+	}))
+	s.Nil(err)
+	s.NotNil(resp.RunId)
+
+	// the first decision task is not scheduled until the start delay has elapsed
+	s.NotNil(createRequest)
+	for _, task := range createRequest.NewWorkflowSnapshot.TransferTasks {
+		s.NotEqual(p.TransferTaskTypeDecisionTask, task.GetType())
+	}
+	var backoffTimerTask *p.WorkflowBackoffTimerTask
+	for _, task := range createRequest.NewWorkflowSnapshot.TimerTasks {
+		if t, ok := task.(*p.WorkflowBackoffTimerTask); ok {
+			backoffTimerTask = t
+		}
+	}
+	s.NotNil(backoffTimerTask)
+	s.Equal(p.WorkflowBackoffTimeoutTypeCron, backoffTimerTask.TimeoutType)
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_Dedup() {
 	domainID := validDomainID
 	workflowID := "workflowID"
@@ -1511,6 +2325,52 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotExist() {
 	s.NotNil(resp.GetRunId())
 }
 
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotExist_CronSchedule() {
+	domainID := validDomainID
+	requestID := uuid.New()
+	sRequest := &h.SignalWithStartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalWithStartRequest: &workflow.SignalWithStartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(domainID),
+			WorkflowId:                          common.StringPtr("wId"),
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("workflowType")},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr("testTaskList")},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            common.StringPtr("testIdentity"),
+			SignalName:                          common.StringPtr("my signal name"),
+			RequestId:                           common.StringPtr(requestID),
+			CronSchedule:                        common.StringPtr("0 0 1 1 *"),
+		},
+	}
+
+	notExistErr := &workflow.EntityNotExistsError{Message: "Workflow not exist"}
+	var createRequest *p.CreateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(nil, notExistErr).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(&p.CreateWorkflowExecutionResponse{}, nil).Run(func(args mock.Arguments) {
+		createRequest = args.Get(0).(*p.CreateWorkflowExecutionRequest)
+	}).Once()
+	s.mockActiveDomain(domainID)
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
+	s.Nil(err)
+	s.NotNil(resp.GetRunId())
+
+	// the signal starts the first run of the cron workflow right away, without waiting for the cron schedule
+	s.NotNil(createRequest)
+	hasDecisionTask := false
+	for _, task := range createRequest.NewWorkflowSnapshot.TransferTasks {
+		if task.GetType() == p.TransferTaskTypeDecisionTask {
+			hasDecisionTask = true
+		}
+	}
+	s.True(hasDecisionTask)
+	for _, task := range createRequest.NewWorkflowSnapshot.TimerTasks {
+		s.IsType(&p.WorkflowTimeoutTask{}, task)
+	}
+}
+
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_CreateTimeout() {
 	sRequest := &h.SignalWithStartWorkflowExecutionRequest{}
 	_, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
@@ -1761,6 +2621,18 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_Start_WorkflowAlread
 	s.NotNil(err)
 }
 
+func (s *engine2Suite) hasBufferedQuery(domainID string, we workflow.WorkflowExecution) bool {
+	builder := s.getBuilder(domainID, we)
+	if builder == nil {
+		return false
+	}
+
+	registry := builder.GetQueryRegistry().(*queryRegistryImpl)
+	registry.Lock()
+	defer registry.Unlock()
+	return len(registry.queries) > 0
+}
+
 func (s *engine2Suite) getBuilder(domainID string, we workflow.WorkflowExecution) mutableState {
 	context, release, err := s.historyEngine.historyCache.getOrCreateWorkflowExecutionForBackground(domainID, we)
 	if err != nil {
@@ -49,6 +49,7 @@ type (
 			error)
 		GetMutableState(ctx context.Context, request *h.GetMutableStateRequest) (*h.GetMutableStateResponse, error)
 		DescribeMutableState(ctx context.Context, request *h.DescribeMutableStateRequest) (*h.DescribeMutableStateResponse, error)
+		QueryWorkflow(ctx context.Context, request *h.QueryWorkflowRequest) (*h.QueryWorkflowResponse, error)
 		ResetStickyTaskList(ctx context.Context, resetRequest *h.ResetStickyTaskListRequest) (*h.ResetStickyTaskListResponse, error)
 		DescribeWorkflowExecution(ctx context.Context,
 			request *h.DescribeWorkflowExecutionRequest) (*workflow.DescribeWorkflowExecutionResponse, error)
@@ -62,10 +63,13 @@ type (
 		RecordActivityTaskHeartbeat(ctx context.Context, request *h.RecordActivityTaskHeartbeatRequest) (*workflow.RecordActivityTaskHeartbeatResponse, error)
 		RequestCancelWorkflowExecution(ctx context.Context, request *h.RequestCancelWorkflowExecutionRequest) error
 		SignalWorkflowExecution(ctx context.Context, request *h.SignalWorkflowExecutionRequest) error
+		UpdateWorkflowExecution(ctx context.Context, request *h.UpdateWorkflowExecutionRequest) (*h.UpdateWorkflowExecutionResponse, error)
 		SignalWithStartWorkflowExecution(ctx context.Context, request *h.SignalWithStartWorkflowExecutionRequest) (
 			*workflow.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(ctx context.Context, request *h.RemoveSignalMutableStateRequest) error
 		TerminateWorkflowExecution(ctx context.Context, request *h.TerminateWorkflowExecutionRequest) error
+		PauseWorkflowExecution(ctx context.Context, request *h.PauseWorkflowExecutionRequest) error
+		ResumeWorkflowExecution(ctx context.Context, request *h.ResumeWorkflowExecutionRequest) error
 		ResetWorkflowExecution(ctx context.Context, request *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error)
 		ScheduleDecisionTask(ctx context.Context, request *h.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(ctx context.Context, request *h.RecordChildExecutionCompletedRequest) error
@@ -73,6 +77,14 @@ type (
 		ReplicateRawEvents(ctx context.Context, request *h.ReplicateRawEventsRequest) error
 		SyncShardStatus(ctx context.Context, request *h.SyncShardStatusRequest) error
 		SyncActivity(ctx context.Context, request *h.SyncActivityRequest) error
+		ListDLQTasks(ctx context.Context, request *workflow.ListDLQTasksRequest) (*workflow.ListDLQTasksResponse, error)
+		DescribeDLQTask(ctx context.Context, request *workflow.DescribeDLQTaskRequest) (*workflow.DescribeDLQTaskResponse, error)
+		RequeueDLQTask(ctx context.Context, request *workflow.RequeueDLQTaskRequest) error
+		PurgeDLQTasks(ctx context.Context, request *workflow.PurgeDLQTasksRequest) error
+		DescribeShardQueues(ctx context.Context, request *workflow.DescribeShardQueuesRequest) (*workflow.DescribeShardQueuesResponse, error)
+		ListShardQueueTasks(ctx context.Context, request *workflow.ListShardQueueTasksRequest) (*workflow.ListShardQueueTasksResponse, error)
+		DeleteShardQueueTask(ctx context.Context, request *workflow.DeleteShardQueueTaskRequest) error
+		RefireShardQueueTask(ctx context.Context, request *workflow.RefireShardQueueTaskRequest) error
 	}
 
 	// EngineFactory is used to create an instance of sharded history engine
@@ -85,6 +97,13 @@ type (
 		notifyNewTask()
 	}
 
+	replicatorQueueProcessor interface {
+		queueProcessor
+		GetReadLevel() int64
+		IsTaskLoaded(taskID int64) bool
+		ProcessTaskOnce(task *persistence.ReplicationTaskInfo) error
+	}
+
 	queueAckMgr interface {
 		getFinishedChan() <-chan struct{}
 		readQueueTasks() ([]queueTaskInfo, bool, error)
@@ -92,6 +111,7 @@ type (
 		getQueueAckLevel() int64
 		getQueueReadLevel() int64
 		updateQueueAckLevel()
+		flushQueueAckLevel()
 	}
 
 	queueTaskInfo interface {
@@ -115,6 +135,9 @@ type (
 		NotifyNewTask(clusterName string, transferTasks []persistence.Task)
 		LockTaskPrrocessing()
 		UnlockTaskPrrocessing()
+		ProcessTaskOnce(task *persistence.TransferTaskInfo) error
+		GetReadLevel() int64
+		IsTaskLoaded(taskID int64) bool
 	}
 
 	// TODO the timer queue processor and the one below, timer processor
@@ -126,6 +149,9 @@ type (
 		NotifyNewTimers(clusterName string, currentTime time.Time, timerTask []persistence.Task)
 		LockTaskPrrocessing()
 		UnlockTaskPrrocessing()
+		ProcessTaskOnce(task *persistence.TimerTaskInfo) error
+		GetReadLevel() time.Time
+		IsTaskLoaded(visibilityTimestamp time.Time, taskID int64) bool
 	}
 
 	timerProcessor interface {
@@ -141,6 +167,7 @@ type (
 		getAckLevel() TimerSequenceID
 		getReadLevel() TimerSequenceID
 		updateAckLevel()
+		flushAckLevel()
 	}
 
 	historyEventNotifier interface {
@@ -5231,6 +5231,8 @@ func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		EventStoreVersion:            sourceInfo.EventStoreVersion,
 		BranchToken:                  sourceInfo.BranchToken,
 		ExpirationSeconds:            sourceInfo.ExpirationSeconds,
+		Paused:                       sourceInfo.Paused,
+		RequestedUpdates:             sourceInfo.RequestedUpdates,
 	}
 }
 
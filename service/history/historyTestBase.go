@@ -42,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/service"
 	cconfig "github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/common/task"
 )
 
 var (
@@ -70,6 +71,8 @@ type (
 		transferSequenceNumber int64
 		historyMgr             persistence.HistoryManager
 		historyV2Mgr           persistence.HistoryV2Manager
+		taskDLQMgr             persistence.TaskDLQManager
+		taskScheduler          task.Scheduler
 		executionMgr           persistence.ExecutionManager
 		domainCache            cache.DomainCache
 		clusterMetadata        cluster.Metadata
@@ -145,6 +148,11 @@ func (s *TestShardContext) GetShardID() int {
 	return s.shardID
 }
 
+// IsDraining test implementation
+func (s *TestShardContext) IsDraining() bool {
+	return false
+}
+
 // GetService test implementation
 func (s *TestShardContext) GetService() service.Service {
 	return s.service
@@ -165,6 +173,16 @@ func (s *TestShardContext) GetHistoryV2Manager() persistence.HistoryV2Manager {
 	return s.historyV2Mgr
 }
 
+// GetTaskDLQManager test implementation
+func (s *TestShardContext) GetTaskDLQManager() persistence.TaskDLQManager {
+	return s.taskDLQMgr
+}
+
+// GetTaskScheduler test implementation
+func (s *TestShardContext) GetTaskScheduler() task.Scheduler {
+	return s.taskScheduler
+}
+
 // GetDomainCache test implementation
 func (s *TestShardContext) GetDomainCache() cache.DomainCache {
 	return s.domainCache
@@ -87,6 +87,10 @@ type (
 		AddUpsertWorkflowSearchAttributesEvent(int64, *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionCancelRequestedEvent(string, *h.RequestCancelWorkflowExecutionRequest) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *workflow.CancelWorkflowExecutionDecisionAttributes) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionPausedEvent(reason string, identity string) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionResumedEvent(reason string, identity string) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionUpdateRequestedEvent(updateID string, updateName string, input []byte, identity string) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID int64, updateID string, result *workflow.WorkflowUpdateResult, identity string) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionStartedEvent(*cache.DomainCacheEntry, workflow.WorkflowExecution, *h.StartWorkflowExecutionRequest) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionTerminatedEvent(reason string, details []byte, identity string) (*workflow.HistoryEvent, error)
@@ -127,6 +131,9 @@ type (
 		GetPendingActivityInfos() map[int64]*persistence.ActivityInfo
 		GetPendingTimerInfos() map[string]*persistence.TimerInfo
 		GetPendingChildExecutionInfos() map[int64]*persistence.ChildExecutionInfo
+		GetPendingUpdateCount() int
+		GetQueryRegistry() queryRegistry
+		GetUpdateRegistry() updateRegistry
 		GetReplicationState() *persistence.ReplicationState
 		GetRequestCancelInfo(int64) (*persistence.RequestCancelInfo, bool)
 		GetRetryBackoffDuration(errReason string) time.Duration
@@ -146,6 +153,9 @@ type (
 		IsCancelRequested() (bool, string)
 		IsSignalRequested(requestID string) bool
 		IsStickyTaskListEnabled() bool
+		IsUpdatePending(updateID string) bool
+		IsUpdateRequested(updateID string) bool
+		IsWorkflowExecutionPaused() bool
 		IsWorkflowExecutionRunning() bool
 		Load(*persistence.WorkflowMutableState)
 		ReplicateActivityInfo(*h.SyncActivityRequest, bool) error
@@ -185,6 +195,10 @@ type (
 		ReplicateWorkflowExecutionCompletedEvent(int64, *workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionContinuedAsNewEvent(int64, string, *workflow.HistoryEvent, *workflow.HistoryEvent, *decisionInfo, mutableState, int32) error
 		ReplicateWorkflowExecutionFailedEvent(int64, *workflow.HistoryEvent) error
+		ReplicateWorkflowExecutionPausedEvent(*workflow.HistoryEvent) error
+		ReplicateWorkflowExecutionResumedEvent(*workflow.HistoryEvent) error
+		ReplicateWorkflowExecutionUpdateRequestedEvent(*workflow.HistoryEvent) error
+		ReplicateWorkflowExecutionUpdateCompletedEvent(*workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionSignaled(*workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionStartedEvent(*cache.DomainCacheEntry, *string, workflow.WorkflowExecution, string, *workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionTerminatedEvent(int64, *workflow.HistoryEvent) error
@@ -44,6 +44,9 @@ const (
 
 	mutableStateInvalidHistoryActionMsg         = "invalid history builder state for action"
 	mutableStateInvalidHistoryActionMsgTemplate = mutableStateInvalidHistoryActionMsg + ": %v"
+
+	// maxCompletedUpdatesPerExecution is the number of completed update IDs kept to deduplicate retried update requests
+	maxCompletedUpdatesPerExecution = 100
 )
 
 var (
@@ -96,6 +99,10 @@ type (
 		condition int64
 		// indicate whether can do replication
 		replicationPolicy cache.ReplicationPolicy
+		// strongly consistent queries waiting for the next decision task
+		queryRegistry queryRegistry
+		// callers waiting for the result of workflow updates
+		updateRegistry updateRegistry
 
 		insertTransferTasks    []persistence.Task
 		insertReplicationTasks []persistence.Task
@@ -146,6 +153,8 @@ func newMutableStateBuilder(
 
 		hasBufferedEventsInPersistence: false,
 		condition:                      0,
+		queryRegistry:                  newQueryRegistry(),
+		updateRegistry:                 newUpdateRegistry(),
 
 		clusterMetadata: shard.GetClusterMetadata(),
 		eventsCache:     eventsCache,
@@ -653,7 +662,8 @@ func (e *mutableStateBuilder) shouldBufferEvent(eventType workflow.EventType) bo
 		workflow.EventTypeMarkerRecorded,
 		workflow.EventTypeStartChildWorkflowExecutionInitiated,
 		workflow.EventTypeSignalExternalWorkflowExecutionInitiated,
-		workflow.EventTypeUpsertWorkflowSearchAttributes:
+		workflow.EventTypeUpsertWorkflowSearchAttributes,
+		workflow.EventTypeWorkflowExecutionUpdateCompleted:
 		// do not buffer event if event is directly generated from a corresponding decision
 
 		// sanity check there is no decision on the fly
@@ -668,6 +678,14 @@ func (e *mutableStateBuilder) shouldBufferEvent(eventType workflow.EventType) bo
 	}
 }
 
+func (e *mutableStateBuilder) GetQueryRegistry() queryRegistry {
+	return e.queryRegistry
+}
+
+func (e *mutableStateBuilder) GetUpdateRegistry() updateRegistry {
+	return e.updateRegistry
+}
+
 func (e *mutableStateBuilder) GetWorkflowType() *workflow.WorkflowType {
 	wType := &workflow.WorkflowType{}
 	wType.Name = common.StringPtr(e.executionInfo.WorkflowTypeName)
@@ -1098,6 +1116,33 @@ func (e *mutableStateBuilder) IsWorkflowExecutionRunning() bool {
 	return e.executionInfo.State != persistence.WorkflowStateCompleted
 }
 
+func (e *mutableStateBuilder) IsWorkflowExecutionPaused() bool {
+	return e.executionInfo.Paused
+}
+
+// IsUpdateRequested returns whether the update was requested on the workflow, whether or not it is completed
+func (e *mutableStateBuilder) IsUpdateRequested(updateID string) bool {
+	_, ok := e.executionInfo.RequestedUpdates[updateID]
+	return ok
+}
+
+// IsUpdatePending returns whether the update was requested on the workflow and is not completed by the worker yet
+func (e *mutableStateBuilder) IsUpdatePending(updateID string) bool {
+	completed, ok := e.executionInfo.RequestedUpdates[updateID]
+	return ok && !completed
+}
+
+// GetPendingUpdateCount returns the number of updates requested on the workflow and not completed by the worker yet
+func (e *mutableStateBuilder) GetPendingUpdateCount() int {
+	count := 0
+	for _, completed := range e.executionInfo.RequestedUpdates {
+		if !completed {
+			count++
+		}
+	}
+	return count
+}
+
 func (e *mutableStateBuilder) IsCancelRequested() (bool, string) {
 	if e.executionInfo.CancelRequested {
 		return e.executionInfo.CancelRequested, e.executionInfo.CancelRequestID
@@ -2835,6 +2880,137 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(event *workflow
 	return nil
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionPausedEvent(
+	reason string,
+	identity string,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if e.executionInfo.Paused {
+		e.logger.Warn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction)
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionPausedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionPausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionPausedEvent(event *workflow.HistoryEvent) error {
+	e.executionInfo.Paused = true
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionResumedEvent(
+	reason string,
+	identity string,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowResumed
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if !e.executionInfo.Paused {
+		e.logger.Warn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction)
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionResumedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionResumedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionResumedEvent(event *workflow.HistoryEvent) error {
+	e.executionInfo.Paused = false
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateRequestedEvent(
+	updateID string,
+	updateName string,
+	input []byte,
+	identity string,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateRequested
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUpdateRequestedEvent(updateID, updateName, input, identity)
+	if err := e.ReplicateWorkflowExecutionUpdateRequestedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateRequestedEvent(event *workflow.HistoryEvent) error {
+	// the update is delivered to the worker through the history, only its ID is tracked to deduplicate
+	// the requests and to validate the results of the worker
+	if e.executionInfo.RequestedUpdates == nil {
+		e.executionInfo.RequestedUpdates = make(map[string]bool)
+	}
+	e.executionInfo.RequestedUpdates[event.WorkflowExecutionUpdateRequestedEventAttributes.GetUpdateId()] = false
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateCompletedEvent(
+	decisionCompletedEventID int64,
+	updateID string,
+	result *workflow.WorkflowUpdateResult,
+	identity string,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateCompleted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID, updateID, result, identity)
+	if err := e.ReplicateWorkflowExecutionUpdateCompletedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateCompletedEvent(event *workflow.HistoryEvent) error {
+	// the result is returned to the caller by the update registry of the active cluster
+	if e.executionInfo.RequestedUpdates == nil {
+		e.executionInfo.RequestedUpdates = make(map[string]bool)
+	}
+
+	// the completed updates are only kept to deduplicate retried requests, so they are dropped all at once
+	// when the dedup window is full, the map is not ordered and the same updates must be dropped by every cluster
+	completedCount := 0
+	for _, completed := range e.executionInfo.RequestedUpdates {
+		if completed {
+			completedCount++
+		}
+	}
+	if completedCount >= maxCompletedUpdatesPerExecution {
+		for updateID, completed := range e.executionInfo.RequestedUpdates {
+			if completed {
+				delete(e.executionInfo.RequestedUpdates, updateID)
+			}
+		}
+	}
+	e.executionInfo.RequestedUpdates[event.WorkflowExecutionUpdateCompletedEventAttributes.GetUpdateId()] = true
+	return nil
+}
+
 func (e *mutableStateBuilder) AddContinueAsNewEvent(
 	firstEventID int64,
 	decisionCompletedEventID int64,
@@ -2974,11 +3150,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(
 	} else {
 		backoffTimer := &persistence.WorkflowBackoffTimerTask{
 			VisibilityTimestamp: newStartedTime.Add(time.Second * time.Duration(newStartAttr.GetFirstDecisionTaskBackoffSeconds())),
-		}
-		if newStartAttr.GetInitiator() == workflow.ContinueAsNewInitiatorRetryPolicy {
-			backoffTimer.TimeoutType = persistence.WorkflowBackoffTimeoutTypeRetry
-		} else if newStartAttr.GetInitiator() == workflow.ContinueAsNewInitiatorCronSchedule {
-			backoffTimer.TimeoutType = persistence.WorkflowBackoffTimeoutTypeCron
+			TimeoutType:         getWorkflowBackoffTimeoutType(newStartAttr),
 		}
 		newStateBuilder.AddTimerTasks(backoffTimer)
 
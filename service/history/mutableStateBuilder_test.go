@@ -192,6 +192,11 @@ func (s *mutableStateSuite) TestShouldBufferEvent() {
 		workflow.EventTypeUpsertWorkflowSearchAttributes:                  true,
 	}
 
+	// events corresponding to update results from client will be assign event ID immediately
+	updateResultEvents := map[workflow.EventType]bool{
+		workflow.EventTypeWorkflowExecutionUpdateCompleted: true,
+	}
+
 	// other events will not be assign event ID immediately
 	otherEvents := map[workflow.EventType]bool{}
 OtherEventsLoop:
@@ -205,10 +210,13 @@ OtherEventsLoop:
 		if _, ok := decisionEvents[eventType]; ok {
 			continue OtherEventsLoop
 		}
+		if _, ok := updateResultEvents[eventType]; ok {
+			continue OtherEventsLoop
+		}
 		otherEvents[eventType] = true
 	}
 
-	// test workflowEvents, decisionTaskEvents, decisionEvents will return true
+	// test workflowEvents, decisionTaskEvents, decisionEvents, updateResultEvents will return true
 	for eventType := range workflowEvents {
 		s.False(s.msBuilder.shouldBufferEvent(eventType))
 	}
@@ -218,6 +226,9 @@ OtherEventsLoop:
 	for eventType := range decisionEvents {
 		s.False(s.msBuilder.shouldBufferEvent(eventType))
 	}
+	for eventType := range updateResultEvents {
+		s.False(s.msBuilder.shouldBufferEvent(eventType))
+	}
 	// other events will return false
 	for eventType := range otherEvents {
 		s.True(s.msBuilder.shouldBufferEvent(eventType))
@@ -229,6 +240,24 @@ OtherEventsLoop:
 		"This assertaion will be broken a new decision is added and no corresponding logic added to shouldBufferEvent()")
 }
 
+func (s *mutableStateSuite) TestReplicateWorkflowExecutionUpdateCompletedEvent_DropsCompletedUpdates() {
+	s.msBuilder.executionInfo.RequestedUpdates = map[string]bool{"pending-id": false}
+	for i := 0; i < maxCompletedUpdatesPerExecution; i++ {
+		s.msBuilder.executionInfo.RequestedUpdates[uuid.New()] = true
+	}
+
+	err := s.msBuilder.ReplicateWorkflowExecutionUpdateCompletedEvent(&workflow.HistoryEvent{
+		EventType: workflow.EventTypeWorkflowExecutionUpdateCompleted.Ptr(),
+		WorkflowExecutionUpdateCompletedEventAttributes: &workflow.WorkflowExecutionUpdateCompletedEventAttributes{
+			UpdateId: common.StringPtr("pending-id"),
+		},
+	})
+	s.NoError(err)
+	// the completed updates are dropped once the dedup window is full, only the new one is kept
+	s.Equal(map[string]bool{"pending-id": true}, s.msBuilder.executionInfo.RequestedUpdates)
+	s.Equal(0, s.msBuilder.GetPendingUpdateCount())
+}
+
 func (s *mutableStateSuite) TestReorderEvents() {
 	domainID := validDomainID
 	we := workflow.WorkflowExecution{
@@ -0,0 +1,172 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+
+	"github.com/pborman/uuid"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	// queryRegistry keeps track of the strongly consistent queries of a workflow, which are buffered
+	// in history and dispatched to the worker together with the next decision task
+	queryRegistry interface {
+		bufferQuery(query *workflow.WorkflowQuery) (string, <-chan struct{})
+		startBufferedQueries() map[string]*workflow.WorkflowQuery
+		completeStartedQueries(results map[string]*workflow.WorkflowQueryResult)
+		unblockQueries()
+		failQueries(err error)
+		getTermination(id string) (*queryTermination, error)
+		removeQuery(id string)
+	}
+
+	// queryTermination is the final state of a query
+	queryTermination struct {
+		// result is the answer of the worker to the query delivered with the decision task, if the result
+		// is not set, the query is unblocked and should be dispatched directly to the worker
+		result *workflow.WorkflowQueryResult
+		// err is set when the query is failed before it is answered
+		err error
+	}
+
+	queryRegistryImpl struct {
+		sync.Mutex
+		queries map[string]*bufferedQuery
+	}
+
+	bufferedQuery struct {
+		request     *workflow.WorkflowQuery
+		started     bool
+		termCh      chan struct{}
+		termination *queryTermination
+	}
+)
+
+var _ queryRegistry = (*queryRegistryImpl)(nil)
+
+var (
+	errQueryNotExists = &workflow.InternalServiceError{Message: "query does not exist"}
+	// errQueryEvicted is retryable, the retried query is buffered by the new context of the workflow
+	errQueryEvicted = &workflow.InternalServiceError{Message: "Workflow execution was unloaded before the query was answered, retry the query."}
+)
+
+func newQueryRegistry() queryRegistry {
+	return &queryRegistryImpl{
+		queries: make(map[string]*bufferedQuery),
+	}
+}
+
+// bufferQuery adds a query to the registry, the returned channel is closed once the query is terminated
+func (r *queryRegistryImpl) bufferQuery(query *workflow.WorkflowQuery) (string, <-chan struct{}) {
+	r.Lock()
+	defer r.Unlock()
+
+	id := uuid.New()
+	q := &bufferedQuery{
+		request: query,
+		termCh:  make(chan struct{}),
+	}
+	r.queries[id] = q
+	return id, q.termCh
+}
+
+// startBufferedQueries returns all queries which are not yet terminated, so they can be delivered with
+// a decision task. Queries delivered with a decision task which failed or timed out are delivered again.
+func (r *queryRegistryImpl) startBufferedQueries() map[string]*workflow.WorkflowQuery {
+	r.Lock()
+	defer r.Unlock()
+
+	result := make(map[string]*workflow.WorkflowQuery)
+	for id, q := range r.queries {
+		if q.termination != nil {
+			continue
+		}
+		q.started = true
+		result[id] = q.request
+	}
+	return result
+}
+
+// completeStartedQueries terminates all queries delivered with the completed decision task. Queries without
+// a result are unblocked, since the worker state already caught up with the history.
+func (r *queryRegistryImpl) completeStartedQueries(results map[string]*workflow.WorkflowQueryResult) {
+	r.Lock()
+	defer r.Unlock()
+
+	for id, q := range r.queries {
+		if q.termination != nil || !q.started {
+			continue
+		}
+		q.terminate(&queryTermination{result: results[id]})
+	}
+}
+
+// unblockQueries terminates all queries without a result, for example when the workflow is closed
+func (r *queryRegistryImpl) unblockQueries() {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, q := range r.queries {
+		if q.termination == nil {
+			q.terminate(&queryTermination{})
+		}
+	}
+}
+
+// failQueries terminates all queries with the error, for example when the registry is evicted from the
+// history cache and the queries would not be delivered with the decision tasks anymore
+func (r *queryRegistryImpl) failQueries(err error) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, q := range r.queries {
+		if q.termination == nil {
+			q.terminate(&queryTermination{err: err})
+		}
+	}
+}
+
+// getTermination returns the termination of the query, or nil if the query is not yet terminated
+func (r *queryRegistryImpl) getTermination(id string) (*queryTermination, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	q, ok := r.queries[id]
+	if !ok {
+		return nil, errQueryNotExists
+	}
+	return q.termination, nil
+}
+
+// removeQuery removes the query from the registry
+func (r *queryRegistryImpl) removeQuery(id string) {
+	r.Lock()
+	defer r.Unlock()
+
+	delete(r.queries, id)
+}
+
+func (q *bufferedQuery) terminate(termination *queryTermination) {
+	q.termination = termination
+	close(q.termCh)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	queryRegistrySuite struct {
+		suite.Suite
+		queryRegistry queryRegistry
+	}
+)
+
+func TestQueryRegistrySuite(t *testing.T) {
+	s := new(queryRegistrySuite)
+	suite.Run(t, s)
+}
+
+func (s *queryRegistrySuite) SetupTest() {
+	s.queryRegistry = newQueryRegistry()
+}
+
+func (s *queryRegistrySuite) TestBufferAndStartQueries() {
+	s.Empty(s.queryRegistry.startBufferedQueries())
+
+	query := &workflow.WorkflowQuery{QueryType: common.StringPtr("query-type")}
+	id, termCh := s.queryRegistry.bufferQuery(query)
+	s.assertNotTerminated(id, termCh)
+
+	started := s.queryRegistry.startBufferedQueries()
+	s.Equal(map[string]*workflow.WorkflowQuery{id: query}, started)
+	s.assertNotTerminated(id, termCh)
+
+	// queries delivered with a decision task which is not completed are delivered again
+	s.Equal(started, s.queryRegistry.startBufferedQueries())
+}
+
+func (s *queryRegistrySuite) TestCompleteStartedQueries() {
+	answeredID, answeredCh := s.queryRegistry.bufferQuery(&workflow.WorkflowQuery{})
+	unansweredID, unansweredCh := s.queryRegistry.bufferQuery(&workflow.WorkflowQuery{})
+	s.Len(s.queryRegistry.startBufferedQueries(), 2)
+	bufferedID, bufferedCh := s.queryRegistry.bufferQuery(&workflow.WorkflowQuery{})
+
+	result := &workflow.WorkflowQueryResult{
+		ResultType: workflow.QueryResultTypeAnswered.Ptr(),
+		Answer:     []byte("answer"),
+	}
+	s.queryRegistry.completeStartedQueries(map[string]*workflow.WorkflowQueryResult{answeredID: result})
+
+	<-answeredCh
+	termination, err := s.queryRegistry.getTermination(answeredID)
+	s.NoError(err)
+	s.Equal(result, termination.result)
+
+	<-unansweredCh
+	termination, err = s.queryRegistry.getTermination(unansweredID)
+	s.NoError(err)
+	s.Nil(termination.result)
+
+	// the query buffered after the decision task is started waits for the next decision task
+	s.assertNotTerminated(bufferedID, bufferedCh)
+	s.Len(s.queryRegistry.startBufferedQueries(), 1)
+}
+
+func (s *queryRegistrySuite) TestUnblockQueries() {
+	startedID, startedCh := s.queryRegistry.bufferQuery(&workflow.WorkflowQuery{})
+	s.queryRegistry.startBufferedQueries()
+	bufferedID, bufferedCh := s.queryRegistry.bufferQuery(&workflow.WorkflowQuery{})
+
+	s.queryRegistry.unblockQueries()
+	for id, termCh := range map[string]<-chan struct{}{startedID: startedCh, bufferedID: bufferedCh} {
+		<-termCh
+		termination, err := s.queryRegistry.getTermination(id)
+		s.NoError(err)
+		s.Nil(termination.result)
+	}
+	s.Empty(s.queryRegistry.startBufferedQueries())
+
+	// terminated queries are not terminated again
+	s.queryRegistry.unblockQueries()
+}
+
+func (s *queryRegistrySuite) TestFailQueries() {
+	answeredID, answeredCh := s.queryRegistry.bufferQuery(&workflow.WorkflowQuery{})
+	s.queryRegistry.startBufferedQueries()
+	result := &workflow.WorkflowQueryResult{ResultType: workflow.QueryResultTypeAnswered.Ptr()}
+	s.queryRegistry.completeStartedQueries(map[string]*workflow.WorkflowQueryResult{answeredID: result})
+	bufferedID, bufferedCh := s.queryRegistry.bufferQuery(&workflow.WorkflowQuery{})
+
+	s.queryRegistry.failQueries(errQueryEvicted)
+	<-answeredCh
+	termination, err := s.queryRegistry.getTermination(answeredID)
+	s.NoError(err)
+	s.Equal(result, termination.result)
+	s.NoError(termination.err)
+
+	<-bufferedCh
+	termination, err = s.queryRegistry.getTermination(bufferedID)
+	s.NoError(err)
+	s.Nil(termination.result)
+	s.Equal(errQueryEvicted, termination.err)
+	s.Empty(s.queryRegistry.startBufferedQueries())
+}
+
+func (s *queryRegistrySuite) TestRemoveQuery() {
+	id, _ := s.queryRegistry.bufferQuery(&workflow.WorkflowQuery{})
+	s.queryRegistry.removeQuery(id)
+	s.Empty(s.queryRegistry.startBufferedQueries())
+
+	_, err := s.queryRegistry.getTermination(id)
+	s.Equal(errQueryNotExists, err)
+}
+
+func (s *queryRegistrySuite) assertNotTerminated(id string, termCh <-chan struct{}) {
+	select {
+	case <-termCh:
+		s.Fail("query should not be terminated")
+	default:
+	}
+	termination, err := s.queryRegistry.getTermination(id)
+	s.NoError(err)
+	s.Nil(termination)
+}
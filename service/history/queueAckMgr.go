@@ -219,3 +219,12 @@ MoveAckLevelLoop:
 		a.logger.Error("Error updating ack level for shard", tag.Error(err), tag.OperationFailed)
 	}
 }
+
+// flushQueueAckLevel persists the ack level when the processor stops while the shard is being handed off,
+// failover ack managers are skipped since they shut down on their own once all the failover tasks are acked
+func (a *queueAckMgrImpl) flushQueueAckLevel() {
+	if a.isFailover {
+		return
+	}
+	a.updateQueueAckLevel()
+}
@@ -466,9 +466,16 @@ func (s *queueFailoverAckMgrSuite) TestReadCompleteQueueTasks() {
 	s.Equal(map[int64]bool{taskID1: true, taskID2: true}, s.queueFailoverAckMgr.outstandingTasks)
 	s.mockProcessor.On("queueShutdown").Return(nil)
 	s.queueFailoverAckMgr.updateQueueAckLevel()
+	// failover ack manager is not flushed when its processor stops, the finished channel is only notified once
+	s.queueFailoverAckMgr.flushQueueAckLevel()
 	select {
 	case <-s.queueFailoverAckMgr.getFinishedChan():
 	default:
 		s.Fail("finished channel should fire")
 	}
+	select {
+	case <-s.queueFailoverAckMgr.getFinishedChan():
+		s.Fail("finished channel should not fire again")
+	default:
+	}
 }
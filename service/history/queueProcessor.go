@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/common/task"
 )
 
 type (
@@ -70,6 +71,10 @@ type (
 		// worker coroutines notification
 		workerNotificationChans []chan struct{}
 
+		// host level task scheduler, tasks are submitted to it instead of the worker coroutines if set
+		scheduler   task.Scheduler
+		taskTracker *queueTaskTracker
+
 		lastPollTime time.Time
 
 		notifyCh   chan struct{}
@@ -77,6 +82,19 @@ type (
 		shutdownWG sync.WaitGroup
 		shutdownCh chan struct{}
 	}
+
+	// queueProcessorTaskExecutor makes the processing attempts of a transfer or replication task
+	// executed by the host level task scheduler
+	queueProcessorTaskExecutor struct {
+		processor         *queueProcessorBase
+		task              queueTaskInfo
+		logger            log.Logger
+		startTime         time.Time
+		scope             int
+		attempt           int
+		filtered          bool
+		shouldProcessTask bool
+	}
 )
 
 var (
@@ -114,6 +132,11 @@ func newQueueProcessorBase(clusterName string, shard ShardContext, options *Queu
 		lastPollTime:            time.Time{},
 	}
 
+	if scheduler := shard.GetTaskScheduler(); scheduler != nil {
+		p.scheduler = scheduler
+		p.taskTracker = newQueueTaskTracker(options.BatchSize())
+	}
+
 	return p
 }
 
@@ -161,7 +184,7 @@ func (p *queueProcessorBase) processorPump() {
 	tasksCh := make(chan queueTaskInfo, p.options.BatchSize())
 
 	var workerWG sync.WaitGroup
-	for i := 0; i < p.options.WorkerCount(); i++ {
+	for i := 0; p.scheduler == nil && i < p.options.WorkerCount(); i++ {
 		workerWG.Add(1)
 		notificationChan := p.workerNotificationChans[i]
 		go p.taskWorker(tasksCh, notificationChan, &workerWG)
@@ -213,6 +236,16 @@ processorPumpLoop:
 	if success := common.AwaitWaitGroup(&workerWG, 10*time.Second); !success {
 		p.logger.Warn("Queue processor timedout on worker shutdown.")
 	}
+	if p.taskTracker != nil {
+		if success := p.taskTracker.wait(10 * time.Second); !success {
+			p.logger.Warn("Queue processor timedout on scheduled tasks shutdown.")
+		}
+	}
+	if p.shard.IsDraining() {
+		// flush the ack level of the tasks completed since the last update, so the new owner
+		// of the shard does not process them again
+		p.ackMgr.flushQueueAckLevel()
+	}
 
 }
 
@@ -240,6 +273,12 @@ func (p *queueProcessorBase) processBatch(tasksCh chan<- queueTaskInfo) {
 	}
 
 	for _, task := range tasks {
+		if p.scheduler != nil {
+			if !p.submitTask(task) {
+				return
+			}
+			continue
+		}
 		select {
 		case tasksCh <- task:
 		case <-p.shutdownCh:
@@ -272,6 +311,16 @@ func (p *queueProcessorBase) taskWorker(tasksCh <-chan queueTaskInfo, notificati
 	}
 }
 
+func (p *queueProcessorBase) submitTask(task queueTaskInfo) bool {
+	executor := &queueProcessorTaskExecutor{
+		processor: p,
+		task:      task,
+		logger:    p.initializeLoggerForTask(task),
+		startTime: p.timeSource.Now(),
+	}
+	return submitQueueTask(p.shard, p.scheduler, p.taskTracker, getQueueTaskDomainID(task), executor, p.shutdownCh, p.logger)
+}
+
 func (p *queueProcessorBase) retryTasks() {
 	for _, workerNotificationChan := range p.workerNotificationChans {
 		select {
@@ -291,15 +340,7 @@ func (p *queueProcessorBase) processTaskAndAck(notificationChan <-chan struct{},
 	attempt := 0
 	incAttempt := func() {
 		attempt++
-		if attempt >= p.options.MaxRetryCount() {
-			p.metricsClient.RecordTimer(scope, metrics.TaskAttemptTimer, time.Duration(attempt))
-			switch task.(type) {
-			case *persistence.TransferTaskInfo:
-				logger.Error("Critical error processing transfer task, retrying.", tag.Error(err), tag.OperationCritical)
-			case *persistence.ReplicationTaskInfo:
-				logger.Error("Critical error processing replication task, retrying.", tag.Error(err), tag.OperationCritical)
-			}
-		}
+		p.reportTaskAttempt(task, scope, attempt, err, logger)
 	}
 
 FilterLoop:
@@ -343,10 +384,47 @@ FilterLoop:
 				return
 			}
 			incAttempt()
+			if p.shouldMoveTaskToDLQ(task, attempt, err) && p.moveTaskToDLQ(task, scope, attempt, err, logger) {
+				p.ackTaskOnce(task, scope, shouldProcessTask, startTime, attempt)
+				return
+			}
 		}
 	}
 }
 
+func (p *queueProcessorBase) reportTaskAttempt(task queueTaskInfo, scope int, attempt int, err error, logger log.Logger) {
+	if attempt < p.options.MaxRetryCount() {
+		return
+	}
+	p.metricsClient.RecordTimer(scope, metrics.TaskAttemptTimer, time.Duration(attempt))
+	switch task.(type) {
+	case *persistence.TransferTaskInfo:
+		logger.Error("Critical error processing transfer task, retrying.", tag.Error(err), tag.OperationCritical)
+	case *persistence.ReplicationTaskInfo:
+		logger.Error("Critical error processing replication task, retrying.", tag.Error(err), tag.OperationCritical)
+	}
+}
+
+// shouldMoveTaskToDLQ returns whether a transfer task exhausted its retries with a non transient error,
+// replication tasks are never moved to the DLQ
+func (p *queueProcessorBase) shouldMoveTaskToDLQ(task queueTaskInfo, attempt int, err error) bool {
+	if _, ok := task.(*persistence.TransferTaskInfo); !ok {
+		return false
+	}
+	return attempt >= p.options.MaxRetryCount() && p.shard.GetConfig().EnableTaskDLQ() && canMoveTaskToDLQ(err)
+}
+
+// moveTaskToDLQ moves a task to the DLQ, return false if the task is still to be retried
+func (p *queueProcessorBase) moveTaskToDLQ(task queueTaskInfo, scope int, attempt int, err error, logger log.Logger) bool {
+	if dlqErr := moveTaskToDLQ(p.shard, task, err, attempt); dlqErr != nil {
+		logger.Error("Fail to move transfer task to DLQ.", tag.Error(dlqErr))
+		return false
+	}
+	p.metricsClient.IncCounter(scope, metrics.TaskMovedToDLQCounter)
+	logger.Error("Transfer task moved to DLQ.", tag.Error(err), tag.Attempt(int32(attempt)))
+	return true
+}
+
 func (p *queueProcessorBase) processTaskOnce(notificationChan <-chan struct{}, task queueTaskInfo, shouldProcessTask bool, logger log.Logger) (int, error) {
 	select {
 	case <-notificationChan:
@@ -376,7 +454,10 @@ func (p *queueProcessorBase) handleTaskError(scope int, startTime time.Time,
 	// this is a transient error
 	if err == ErrTaskRetry {
 		p.metricsClient.IncCounter(scope, metrics.TaskStandbyRetryCounter)
-		<-notificationChan
+		// tasks executed by the task scheduler have no notification channel, they are retried with backoff
+		if notificationChan != nil {
+			<-notificationChan
+		}
 		return err
 	}
 
@@ -445,3 +526,32 @@ func (p *queueProcessorBase) initializeLoggerForTask(task queueTaskInfo) log.Log
 
 	return logger
 }
+
+func (e *queueProcessorTaskExecutor) execute() error {
+	if !e.filtered {
+		shouldProcessTask, err := e.processor.processor.getTaskFilter()(e.task)
+		if err != nil {
+			return err
+		}
+		e.shouldProcessTask = shouldProcessTask
+		e.filtered = true
+	}
+
+	var err error
+	e.scope, err = e.processor.processTaskOnce(nil, e.task, e.shouldProcessTask, e.logger)
+	return e.processor.handleTaskError(e.scope, e.startTime, nil, err, e.logger)
+}
+
+func (e *queueProcessorTaskExecutor) handleErr(err error) error {
+	e.attempt++
+	e.processor.reportTaskAttempt(e.task, e.scope, e.attempt, err, e.logger)
+	if e.filtered && e.processor.shouldMoveTaskToDLQ(e.task, e.attempt, err) &&
+		e.processor.moveTaskToDLQ(e.task, e.scope, e.attempt, err, e.logger) {
+		return nil
+	}
+	return err
+}
+
+func (e *queueProcessorTaskExecutor) ack() {
+	e.processor.ackTaskOnce(e.task, e.scope, e.shouldProcessTask, e.startTime, e.attempt)
+}
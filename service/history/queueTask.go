@@ -0,0 +1,175 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/task"
+)
+
+type (
+	// queueTask is a transfer, timer or replication task submitted to the host level task scheduler,
+	// each execution is a single processing attempt, failed attempts are retried by the task scheduler
+	// with backoff until the task is processed or the queue processor shuts down
+	queueTask struct {
+		domain     string
+		executor   queueTaskExecutor
+		tracker    *queueTaskTracker
+		shutdownCh <-chan struct{}
+	}
+
+	// queueTaskExecutor makes the processing attempts of a queue task on behalf of its queue processor
+	queueTaskExecutor interface {
+		// execute makes a single attempt at processing the task
+		execute() error
+		// handleErr handles the error of a failed attempt, return nil if the task should not be retried
+		handleErr(err error) error
+		// ack completes the task in its queue
+		ack()
+	}
+
+	// queueTaskTracker bounds the number of tasks a queue processor has outstanding in the task scheduler,
+	// and allows the queue processor to wait for those tasks
+	queueTaskTracker struct {
+		slots         chan struct{}
+		outstandingWG sync.WaitGroup
+	}
+)
+
+var _ task.DomainTask = (*queueTask)(nil)
+
+var errQueueProcessorShutdown = errors.New("queue processor is shutting down")
+
+func newQueueTaskTracker(maxOutstandingTasks int) *queueTaskTracker {
+	return &queueTaskTracker{
+		slots: make(chan struct{}, common.MaxInt(maxOutstandingTasks, 1)),
+	}
+}
+
+// submitQueueTask submits a task to the task scheduler, blocking while the processor has too many outstanding tasks,
+// return false if the processor is shutting down before the task could be submitted
+func submitQueueTask(shard ShardContext, scheduler task.Scheduler, tracker *queueTaskTracker, domainID string,
+	executor queueTaskExecutor, shutdownCh <-chan struct{}, logger log.Logger) bool {
+
+	select {
+	case tracker.slots <- struct{}{}:
+	case <-shutdownCh:
+		return false
+	}
+
+	t := &queueTask{
+		domain:     getQueueTaskDomainName(shard, domainID),
+		executor:   executor,
+		tracker:    tracker,
+		shutdownCh: shutdownCh,
+	}
+	tracker.register()
+	if err := scheduler.Submit(t); err != nil {
+		logger.Warn("Fail to submit task to task scheduler.", tag.Error(err), tag.WorkflowDomainID(domainID))
+		t.Nack()
+		return false
+	}
+	return true
+}
+
+// getQueueTaskDomainName returns the name of the domain used for scheduling the task,
+// the domain ID is used if the domain cannot be loaded
+func getQueueTaskDomainName(shard ShardContext, domainID string) string {
+	domainEntry, err := shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		return domainID
+	}
+	return domainEntry.GetInfo().Name
+}
+
+// getQueueTaskDomainID returns the ID of the domain a queue task belongs to
+func getQueueTaskDomainID(task queueTaskInfo) string {
+	switch task := task.(type) {
+	case *persistence.TransferTaskInfo:
+		return task.DomainID
+	case *persistence.TimerTaskInfo:
+		return task.DomainID
+	case *persistence.ReplicationTaskInfo:
+		return task.DomainID
+	default:
+		return ""
+	}
+}
+
+func (t *queueTask) Domain() string {
+	return t.domain
+}
+
+func (t *queueTask) Execute() error {
+	select {
+	case <-t.shutdownCh:
+		// the task must be released without ack
+		return errQueueProcessorShutdown
+	default:
+		return t.executor.execute()
+	}
+}
+
+func (t *queueTask) HandleErr(err error) error {
+	if err == nil || err == errQueueProcessorShutdown {
+		return err
+	}
+	return t.executor.handleErr(err)
+}
+
+func (t *queueTask) RetryErr(err error) bool {
+	select {
+	case <-t.shutdownCh:
+		return false
+	default:
+		return true
+	}
+}
+
+func (t *queueTask) Ack() {
+	t.executor.ack()
+	t.tracker.release()
+}
+
+func (t *queueTask) Nack() {
+	t.tracker.release()
+}
+
+func (t *queueTaskTracker) register() {
+	t.outstandingWG.Add(1)
+}
+
+func (t *queueTaskTracker) release() {
+	<-t.slots
+	t.outstandingWG.Done()
+}
+
+// wait waits for all outstanding tasks to be released, return false if timed out
+func (t *queueTaskTracker) wait(timeout time.Duration) bool {
+	return common.AwaitWaitGroup(&t.outstandingWG, timeout)
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/common/task"
+)
+
+type (
+	queueTaskSuite struct {
+		suite.Suite
+
+		mockDomainCache *cache.DomainCacheMock
+		mockScheduler   *task.MockScheduler
+		shard           *shardContextImpl
+		logger          log.Logger
+	}
+
+	testQueueTaskExecutor struct {
+		sync.Mutex
+		// errors returned by the consecutive attempts of the task
+		errs        []error
+		dlqErr      error
+		executed    int
+		handledErrs int
+		acked       int
+	}
+)
+
+func TestQueueTaskSuite(t *testing.T) {
+	s := new(queueTaskSuite)
+	suite.Run(t, s)
+}
+
+func (s *queueTaskSuite) SetupTest() {
+	s.mockDomainCache = &cache.DomainCacheMock{}
+	s.mockScheduler = &task.MockScheduler{}
+	s.shard = &shardContextImpl{
+		shardID:     1,
+		domainCache: s.mockDomainCache,
+	}
+	s.logger = loggerimpl.NewDevelopmentForTest(s.Suite)
+}
+
+func (s *queueTaskSuite) TearDownTest() {
+	s.mockDomainCache.AssertExpectations(s.T())
+	s.mockScheduler.AssertExpectations(s.T())
+}
+
+func (s *queueTaskSuite) TestGetQueueTaskDomainName() {
+	s.mockDomainCache.On("GetDomainByID", "some random domain ID").Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "some random domain ID", Name: "some random domain name"}, &persistence.DomainConfig{}, "", nil,
+	), nil).Once()
+	s.mockDomainCache.On("GetDomainByID", "some other domain ID").Return(nil, &workflow.EntityNotExistsError{}).Once()
+
+	s.Equal("some random domain name", getQueueTaskDomainName(s.shard, "some random domain ID"))
+	s.Equal("some other domain ID", getQueueTaskDomainName(s.shard, "some other domain ID"))
+}
+
+func (s *queueTaskSuite) TestGetQueueTaskDomainID() {
+	s.Equal("transfer domain ID", getQueueTaskDomainID(&persistence.TransferTaskInfo{DomainID: "transfer domain ID"}))
+	s.Equal("timer domain ID", getQueueTaskDomainID(&persistence.TimerTaskInfo{DomainID: "timer domain ID"}))
+	s.Equal("replication domain ID", getQueueTaskDomainID(&persistence.ReplicationTaskInfo{DomainID: "replication domain ID"}))
+}
+
+func (s *queueTaskSuite) TestSubmitQueueTask_Processed() {
+	s.mockDomainCache.On("GetDomainByID", "some random domain ID").Return(nil, &workflow.EntityNotExistsError{})
+	scheduler := s.newScheduler()
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	tracker := newQueueTaskTracker(2)
+	shutdownCh := make(chan struct{})
+	var executors []*testQueueTaskExecutor
+	for i := 0; i < 5; i++ {
+		executor := &testQueueTaskExecutor{}
+		executors = append(executors, executor)
+		s.True(submitQueueTask(s.shard, scheduler, tracker, "some random domain ID", executor, shutdownCh, s.logger))
+	}
+
+	s.True(tracker.wait(time.Second))
+	for _, executor := range executors {
+		s.Equal(1, executor.numExecuted())
+		s.Equal(1, executor.numAcked())
+	}
+	s.Equal(0, len(tracker.slots))
+}
+
+func (s *queueTaskSuite) TestSubmitQueueTask_RetriedByScheduler() {
+	s.mockDomainCache.On("GetDomainByID", "some random domain ID").Return(nil, &workflow.EntityNotExistsError{})
+	scheduler := s.newScheduler()
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	tracker := newQueueTaskTracker(1)
+	executor := &testQueueTaskExecutor{errs: []error{errors.New("some random error"), ErrTaskRetry}}
+	s.True(submitQueueTask(s.shard, scheduler, tracker, "some random domain ID", executor, make(chan struct{}), s.logger))
+
+	s.True(tracker.wait(time.Second))
+	s.Equal(3, executor.numExecuted())
+	s.Equal(2, executor.numHandledErrs())
+	s.Equal(1, executor.numAcked())
+}
+
+func (s *queueTaskSuite) TestSubmitQueueTask_MovedToDLQ() {
+	s.mockDomainCache.On("GetDomainByID", "some random domain ID").Return(nil, &workflow.EntityNotExistsError{})
+	scheduler := s.newScheduler()
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	tracker := newQueueTaskTracker(1)
+	err := errors.New("some random error")
+	executor := &testQueueTaskExecutor{errs: []error{err}, dlqErr: err}
+	s.True(submitQueueTask(s.shard, scheduler, tracker, "some random domain ID", executor, make(chan struct{}), s.logger))
+
+	s.True(tracker.wait(time.Second))
+	s.Equal(1, executor.numExecuted())
+	s.Equal(1, executor.numHandledErrs())
+	s.Equal(1, executor.numAcked())
+}
+
+func (s *queueTaskSuite) TestQueueTask_Shutdown() {
+	tracker := newQueueTaskTracker(1)
+	tracker.slots <- struct{}{}
+	tracker.register()
+	shutdownCh := make(chan struct{})
+	executor := &testQueueTaskExecutor{errs: []error{errors.New("some random error")}}
+	queueTask := &queueTask{
+		domain:     "some random domain",
+		executor:   executor,
+		tracker:    tracker,
+		shutdownCh: shutdownCh,
+	}
+
+	err := queueTask.HandleErr(queueTask.Execute())
+	s.Error(err)
+	s.True(queueTask.RetryErr(err))
+
+	close(shutdownCh)
+	err = queueTask.HandleErr(queueTask.Execute())
+	s.Equal(errQueueProcessorShutdown, err)
+	s.False(queueTask.RetryErr(err))
+	queueTask.Nack()
+
+	s.True(tracker.wait(time.Second))
+	s.Equal(1, executor.numExecuted())
+	s.Equal(1, executor.numHandledErrs())
+	s.Equal(0, executor.numAcked())
+}
+
+func (s *queueTaskSuite) TestSubmitQueueTask_SubmitFailed() {
+	s.mockDomainCache.On("GetDomainByID", "some random domain ID").Return(nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockScheduler.On("Submit", mock.Anything).Return(task.ErrSchedulerStopped).Once()
+
+	tracker := newQueueTaskTracker(1)
+	executor := &testQueueTaskExecutor{}
+	s.False(submitQueueTask(s.shard, s.mockScheduler, tracker, "some random domain ID", executor, make(chan struct{}), s.logger))
+	s.True(tracker.wait(time.Second))
+	s.Equal(0, len(tracker.slots))
+	s.Equal(0, executor.numExecuted())
+}
+
+func (s *queueTaskSuite) TestSubmitQueueTask_Shutdown() {
+	s.mockDomainCache.On("GetDomainByID", "some random domain ID").Return(nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockScheduler.On("Submit", mock.Anything).Return(nil).Once()
+
+	tracker := newQueueTaskTracker(1)
+	shutdownCh := make(chan struct{})
+	s.True(submitQueueTask(s.shard, s.mockScheduler, tracker, "some random domain ID", &testQueueTaskExecutor{}, shutdownCh, s.logger))
+
+	// the only slot is taken by the outstanding task, so the next submission blocks until shutdown
+	close(shutdownCh)
+	s.False(submitQueueTask(s.shard, s.mockScheduler, tracker, "some random domain ID", &testQueueTaskExecutor{}, shutdownCh, s.logger))
+	s.False(tracker.wait(10 * time.Millisecond))
+}
+
+func (s *queueTaskSuite) newScheduler() task.Scheduler {
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	retryPolicy.SetExpirationInterval(backoff.NoInterval)
+	return task.NewWeightedRoundRobinScheduler(
+		&task.WeightedRoundRobinSchedulerOptions{
+			WorkerCount:          2,
+			DomainWeight:         dynamicconfig.GetIntPropertyFilteredByDomain(1),
+			DomainMaxConcurrency: dynamicconfig.GetIntPropertyFilteredByDomain(10),
+			RetryPolicy:          retryPolicy,
+		},
+		metrics.NewClient(tally.NoopScope, metrics.History),
+		s.logger,
+	)
+}
+
+func (e *testQueueTaskExecutor) execute() error {
+	e.Lock()
+	defer e.Unlock()
+
+	e.executed++
+	if e.executed <= len(e.errs) {
+		return e.errs[e.executed-1]
+	}
+	return nil
+}
+
+func (e *testQueueTaskExecutor) handleErr(err error) error {
+	e.Lock()
+	defer e.Unlock()
+
+	e.handledErrs++
+	if err == e.dlqErr {
+		return nil
+	}
+	return err
+}
+
+func (e *testQueueTaskExecutor) ack() {
+	e.Lock()
+	defer e.Unlock()
+
+	e.acked++
+}
+
+func (e *testQueueTaskExecutor) numExecuted() int {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.executed
+}
+
+func (e *testQueueTaskExecutor) numHandledErrs() int {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.handledErrs
+}
+
+func (e *testQueueTaskExecutor) numAcked() int {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.acked
+}
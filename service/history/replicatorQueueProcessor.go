@@ -62,7 +62,7 @@ var (
 )
 
 func newReplicatorQueueProcessor(shard ShardContext, historyCache *historyCache, replicator messaging.Producer,
-	executionMgr persistence.ExecutionManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, logger log.Logger) queueProcessor {
+	executionMgr persistence.ExecutionManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, logger log.Logger) replicatorQueueProcessor {
 
 	currentClusterNamer := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 
@@ -141,6 +141,22 @@ func (p *replicatorQueueProcessorImpl) process(qTask queueTaskInfo, shouldProces
 	}
 }
 
+// GetReadLevel returns the read level of the replicator queue
+func (p *replicatorQueueProcessorImpl) GetReadLevel() int64 {
+	return p.getQueueReadLevel()
+}
+
+// IsTaskLoaded returns whether the replication task has already been read by the replicator queue
+func (p *replicatorQueueProcessorImpl) IsTaskLoaded(taskID int64) bool {
+	return taskID <= p.getQueueReadLevel()
+}
+
+// ProcessTaskOnce processes a replication task once outside of the regular queue processing
+func (p *replicatorQueueProcessorImpl) ProcessTaskOnce(task *persistence.ReplicationTaskInfo) error {
+	_, err := p.process(task, true)
+	return processTaskOnceError(err)
+}
+
 func (p *replicatorQueueProcessorImpl) queueShutdown() error {
 	// there is no shutdown specific behavior for replication queue
 	return nil
@@ -66,8 +66,10 @@ type Config struct {
 	EventsCacheTTL         dynamicconfig.DurationPropertyFn
 
 	// ShardController settings
-	RangeSizeBits        uint
-	AcquireShardInterval dynamicconfig.DurationPropertyFn
+	RangeSizeBits              uint
+	AcquireShardInterval       dynamicconfig.DurationPropertyFn
+	EnableGracefulShardHandoff dynamicconfig.BoolPropertyFn
+	ShardHandoffTimeout        dynamicconfig.DurationPropertyFn
 
 	// the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay dynamicconfig.DurationPropertyFn
@@ -106,6 +108,15 @@ type Config struct {
 	TransferProcessorUpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 	TransferProcessorCompleteTransferInterval           dynamicconfig.DurationPropertyFn
 
+	// EnableTaskDLQ moves transfer and timer tasks exceeding their max retry count to the shard's DLQ
+	EnableTaskDLQ dynamicconfig.BoolPropertyFn
+
+	// TaskScheduler settings
+	EnableTaskScheduler               dynamicconfig.BoolPropertyFn
+	TaskSchedulerWorkerCount          dynamicconfig.IntPropertyFn
+	TaskSchedulerDomainWeight         dynamicconfig.IntPropertyFnWithDomainFilter
+	TaskSchedulerDomainMaxConcurrency dynamicconfig.IntPropertyFnWithDomainFilter
+
 	// ReplicatorQueueProcessor settings
 	ReplicatorTaskBatchSize                               dynamicconfig.IntPropertyFn
 	ReplicatorTaskWorkerCount                             dynamicconfig.IntPropertyFn
@@ -122,8 +133,9 @@ type Config struct {
 	HistoryMgrNumConns   dynamicconfig.IntPropertyFn
 
 	// System Limits
-	MaximumBufferedEventsBatch dynamicconfig.IntPropertyFn
-	MaximumSignalsPerExecution dynamicconfig.IntPropertyFnWithDomainFilter
+	MaximumBufferedEventsBatch        dynamicconfig.IntPropertyFn
+	MaximumSignalsPerExecution        dynamicconfig.IntPropertyFnWithDomainFilter
+	MaximumPendingUpdatesPerExecution dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// ShardUpdateMinInterval the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
@@ -138,6 +150,8 @@ type Config struct {
 	EventEncodingType dynamicconfig.StringPropertyFnWithDomainFilter
 	// whether or not using eventsV2
 	EnableEventsV2 dynamicconfig.BoolPropertyFnWithDomainFilter
+	// whether or not to apply the child policy of running children when their parent closes
+	EnableParentClosePolicy dynamicconfig.BoolPropertyFnWithDomainFilter
 
 	NumArchiveSystemWorkflows dynamicconfig.IntPropertyFn
 	ArchiveRequestRPS         dynamicconfig.IntPropertyFn
@@ -184,6 +198,8 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		EventsCacheTTL:                                        dc.GetDurationProperty(dynamicconfig.EventsCacheTTL, time.Hour),
 		RangeSizeBits:                                         20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                                  dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, time.Minute),
+		EnableGracefulShardHandoff:                            dc.GetBoolProperty(dynamicconfig.EnableGracefulShardHandoff, false),
+		ShardHandoffTimeout:                                   dc.GetDurationProperty(dynamicconfig.ShardHandoffTimeout, 10*time.Second),
 		StandbyClusterDelay:                                   dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, 5*time.Minute),
 		TimerTaskBatchSize:                                    dc.GetIntProperty(dynamicconfig.TimerTaskBatchSize, 100),
 		TimerTaskWorkerCount:                                  dc.GetIntProperty(dynamicconfig.TimerTaskWorkerCount, 10),
@@ -215,6 +231,11 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		TransferProcessorUpdateAckInterval:                    dc.GetDurationProperty(dynamicconfig.TransferProcessorUpdateAckInterval, 30*time.Second),
 		TransferProcessorUpdateAckIntervalJitterCoefficient:   dc.GetFloat64Property(dynamicconfig.TransferProcessorUpdateAckIntervalJitterCoefficient, 0.15),
 		TransferProcessorCompleteTransferInterval:             dc.GetDurationProperty(dynamicconfig.TransferProcessorCompleteTransferInterval, 60*time.Second),
+		EnableTaskDLQ:                                         dc.GetBoolProperty(dynamicconfig.EnableTaskDLQ, true),
+		EnableTaskScheduler:                                   dc.GetBoolProperty(dynamicconfig.EnableTaskScheduler, false),
+		TaskSchedulerWorkerCount:                              dc.GetIntProperty(dynamicconfig.TaskSchedulerWorkerCount, 512),
+		TaskSchedulerDomainWeight:                             dc.GetIntPropertyFilteredByDomain(dynamicconfig.TaskSchedulerDomainWeight, 1),
+		TaskSchedulerDomainMaxConcurrency:                     dc.GetIntPropertyFilteredByDomain(dynamicconfig.TaskSchedulerDomainMaxConcurrency, 128),
 		ReplicatorTaskBatchSize:                               dc.GetIntProperty(dynamicconfig.ReplicatorTaskBatchSize, 100),
 		ReplicatorTaskWorkerCount:                             dc.GetIntProperty(dynamicconfig.ReplicatorTaskWorkerCount, 10),
 		ReplicatorTaskMaxRetryCount:                           dc.GetIntProperty(dynamicconfig.ReplicatorTaskMaxRetryCount, 100),
@@ -228,6 +249,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		HistoryMgrNumConns:                                    dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns, 50),
 		MaximumBufferedEventsBatch:                            dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch, 100),
 		MaximumSignalsPerExecution:                            dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumSignalsPerExecution, 0),
+		MaximumPendingUpdatesPerExecution:                     dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumPendingUpdatesPerExecution, 100),
 		ShardUpdateMinInterval:                                dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval, 5*time.Minute),
 		ShardSyncMinInterval:                                  dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval, 5*time.Minute),
 
@@ -235,6 +257,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		LongPollExpirationInterval: dc.GetDurationPropertyFilteredByDomain(dynamicconfig.HistoryLongPollExpirationInterval, time.Second*20),
 		EventEncodingType:          dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.DefaultEventEncoding, string(common.EncodingTypeThriftRW)),
 		EnableEventsV2:             dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableEventsV2, true),
+		EnableParentClosePolicy:    dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableParentClosePolicy, true),
 
 		NumArchiveSystemWorkflows: dc.GetIntProperty(dynamicconfig.NumArchiveSystemWorkflows, 1000),
 		ArchiveRequestRPS:         dc.GetIntProperty(dynamicconfig.ArchiveRequestRPS, 300), // should be much smaller than frontend RPS
@@ -306,6 +329,7 @@ func (s *Service) Start() {
 		VisibilityClosedMaxQPS:          s.config.VisibilityClosedMaxQPS,
 		EnableSampling:                  s.config.EnableVisibilitySampling,
 		EnableReadFromClosedExecutionV2: s.config.EnableReadFromClosedExecutionV2,
+		ValidSearchAttributes:           s.config.ValidSearchAttributes,
 	}
 	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, log)
 
@@ -331,7 +355,7 @@ func (s *Service) Start() {
 			log.Fatal("Creating visibility producer failed", tag.Error(err))
 		}
 		esVisibility = espersistence.NewESVisibilityManager("", nil, nil, visibilityProducer,
-			s.metricsClient, log)
+			persistencefactory.NewPayloadEncryption(&pConfig, log), s.metricsClient, log)
 	}
 	visibility = persistence.NewVisibilityManagerWrapper(visibility, esVisibility, dynamicconfig.GetBoolPropertyFnFilteredByDomain(false))
 
@@ -345,6 +369,11 @@ func (s *Service) Start() {
 		log.Fatal("Creating historyV2 manager persistence failed", tag.Error(err))
 	}
 
+	taskDLQ, err := pFactory.NewTaskDLQManager()
+	if err != nil {
+		log.Fatal("Creating task DLQ manager persistence failed", tag.Error(err))
+	}
+
 	domainCache := cache.NewDomainCache(metadata, base.GetClusterMetadata(), base.GetMetricsClient(), base.GetLogger())
 
 	historyArchiverBootstrapContainer := &archiver.HistoryBootstrapContainer{
@@ -355,9 +384,15 @@ func (s *Service) Start() {
 		ClusterMetadata:  base.GetClusterMetadata(),
 		DomainCache:      domainCache,
 	}
-	params.ArchiverProvider.RegisterBootstrapContainer(common.HistoryServiceName, historyArchiverBootstrapContainer, &archiver.VisibilityBootstrapContainer{})
+	visibilityArchiverBootstrapContainer := &archiver.VisibilityBootstrapContainer{
+		Logger:          base.GetLogger(),
+		MetricsClient:   base.GetMetricsClient(),
+		ClusterMetadata: base.GetClusterMetadata(),
+		DomainCache:     domainCache,
+	}
+	params.ArchiverProvider.RegisterBootstrapContainer(common.HistoryServiceName, historyArchiverBootstrapContainer, visibilityArchiverBootstrapContainer)
 
-	handler := NewHandler(base, s.config, shardMgr, metadata, visibility, history, historyV2, pFactory, domainCache, params.PublicClient, params.ArchiverProvider)
+	handler := NewHandler(base, s.config, shardMgr, metadata, visibility, history, historyV2, taskDLQ, pFactory, domainCache, params.PublicClient, params.ArchiverProvider)
 	handler.RegisterHandler()
 
 	// must start base service first
@@ -37,16 +37,20 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/task"
 )
 
 type (
 	// ShardContext represents a history engine shard
 	ShardContext interface {
 		GetShardID() int
+		IsDraining() bool
 		GetService() service.Service
 		GetExecutionManager() persistence.ExecutionManager
 		GetHistoryManager() persistence.HistoryManager
 		GetHistoryV2Manager() persistence.HistoryV2Manager
+		GetTaskDLQManager() persistence.TaskDLQManager
+		GetTaskScheduler() task.Scheduler
 		GetDomainCache() cache.DomainCache
 		GetClusterMetadata() cluster.Metadata
 		GetNextTransferTaskID() (int64, error)
@@ -99,11 +103,14 @@ type (
 		shardManager     persistence.ShardManager
 		historyMgr       persistence.HistoryManager
 		historyV2Mgr     persistence.HistoryV2Manager
+		taskDLQMgr       persistence.TaskDLQManager
+		taskScheduler    task.Scheduler
 		executionManager persistence.ExecutionManager
 		domainCache      cache.DomainCache
 		eventsCache      eventsCache
 		closeCh          chan<- int
 		isClosed         bool
+		isDraining       bool
 		config           *Config
 		logger           log.Logger
 		throttledLogger  log.Logger
@@ -135,6 +142,13 @@ func (s *shardContextImpl) GetShardID() int {
 	return s.shardID
 }
 
+// IsDraining returns true if the shard is being handed off to another host and is still owned by this host
+func (s *shardContextImpl) IsDraining() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.isDraining && !s.isClosed
+}
+
 func (s *shardContextImpl) GetService() service.Service {
 	return s.service
 }
@@ -151,6 +165,15 @@ func (s *shardContextImpl) GetHistoryV2Manager() persistence.HistoryV2Manager {
 	return s.historyV2Mgr
 }
 
+func (s *shardContextImpl) GetTaskDLQManager() persistence.TaskDLQManager {
+	return s.taskDLQMgr
+}
+
+// GetTaskScheduler returns the task scheduler shared by the shards of the host, or nil if it is disabled
+func (s *shardContextImpl) GetTaskScheduler() task.Scheduler {
+	return s.taskScheduler
+}
+
 func (s *shardContextImpl) GetDomainCache() cache.DomainCache {
 	return s.domainCache
 }
@@ -872,6 +895,36 @@ func (s *shardContextImpl) closeShard() {
 	}
 }
 
+// startDraining marks the shard as being handed off, the queue processors flush their ack levels
+// to the shard when they are stopped after this point
+func (s *shardContextImpl) startDraining() {
+	s.Lock()
+	defer s.Unlock()
+	s.isDraining = true
+}
+
+// drain persists the shard info with the new owner of the shard, then fails any writes that may start after this point.
+// Unlike closeShard, the shard controller is not notified since it is the one draining the shard.
+func (s *shardContextImpl) drain(newOwner string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.isClosed {
+		return nil
+	}
+
+	s.isClosed = true
+
+	// persist the shard info regardless of the last update time, so the ack levels are not lost
+	s.shardInfo.Owner = newOwner
+	s.lastUpdated = time.Time{}
+	err := s.updateShardInfoLocked()
+
+	s.shardInfo.RangeID = -1
+	atomic.StoreInt64(&s.rangeID, s.shardInfo.RangeID)
+	return err
+}
+
 func (s *shardContextImpl) getNextTransferTaskIDLocked() (int64, error) {
 	if err := s.updateRangeIfNeededLocked(); err != nil {
 		return -1, err
@@ -1136,7 +1189,7 @@ func (s *shardContextImpl) GetCurrentTime(cluster string) time.Time {
 	return s.GetTimeSource().Now()
 }
 
-func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (ShardContext,
+func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (*shardContextImpl,
 	error) {
 
 	var shardInfo *persistence.ShardInfo
@@ -1213,6 +1266,8 @@ func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (ShardContex
 		shardManager:              shardItem.shardMgr,
 		historyMgr:                shardItem.historyMgr,
 		historyV2Mgr:              shardItem.historyV2Mgr,
+		taskDLQMgr:                shardItem.taskDLQMgr,
+		taskScheduler:             shardItem.taskScheduler,
 		executionManager:          shardItem.executionMgr,
 		domainCache:               shardItem.domainCache,
 		shardInfo:                 updatedShardInfo,
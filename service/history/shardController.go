@@ -21,11 +21,13 @@
 package history
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	hist "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
@@ -34,10 +36,13 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/task"
 )
 
 const (
 	shardControllerMembershipUpdateListenerName = "ShardController"
+	// max number of shards handed off concurrently
+	shardHandoffConcurrency = 32
 )
 
 type (
@@ -49,6 +54,8 @@ type (
 		shardMgr            persistence.ShardManager
 		historyMgr          persistence.HistoryManager
 		historyV2Mgr        persistence.HistoryV2Manager
+		taskDLQMgr          persistence.TaskDLQManager
+		taskScheduler       task.Scheduler
 		executionMgrFactory persistence.ExecutionManagerFactory
 		domainCache         cache.DomainCache
 		engineFactory       EngineFactory
@@ -65,6 +72,16 @@ type (
 		sync.RWMutex
 		historyShards map[int]*historyShardsItem
 		isStopping    bool
+		// shards being drained and handed off by this host
+		shardsInHandoff map[int]struct{}
+		// shards handed off to this host by their previous owner, which are kept
+		// by this host until its ring membership converges
+		handedOffShards map[int]*shardHandoff
+	}
+
+	shardHandoff struct {
+		previousOwner string
+		handoffTime   time.Time
 	}
 
 	historyShardsItemStatus int
@@ -77,26 +94,35 @@ type (
 		shardMgr        persistence.ShardManager
 		historyMgr      persistence.HistoryManager
 		historyV2Mgr    persistence.HistoryV2Manager
+		taskDLQMgr      persistence.TaskDLQManager
+		taskScheduler   task.Scheduler
 		executionMgr    persistence.ExecutionManager
 		domainCache     cache.DomainCache
 		engineFactory   EngineFactory
 		host            *membership.HostInfo
 		engine          Engine
+		shard           *shardContextImpl
 		config          *Config
 		logger          log.Logger
 		throttledLogger log.Logger
 		metricsClient   metrics.Client
+
+		// set while the shard is draining
+		newOwner  string
+		drainedCh chan struct{}
 	}
 )
 
 const (
 	historyShardsItemStatusInitialized = iota
 	historyShardsItemStatusStarted
+	historyShardsItemStatusDraining
 	historyShardsItemStatusStopped
 )
 
 func newShardController(svc service.Service, host *membership.HostInfo, resolver membership.ServiceResolver,
-	shardMgr persistence.ShardManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, domainCache cache.DomainCache,
+	shardMgr persistence.ShardManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
+	taskDLQMgr persistence.TaskDLQManager, taskScheduler task.Scheduler, domainCache cache.DomainCache,
 	executionMgrFactory persistence.ExecutionManagerFactory, factory EngineFactory,
 	config *Config, logger log.Logger, metricsClient metrics.Client) *shardController {
 	logger = logger.WithTags(tag.ComponentShardController)
@@ -108,10 +134,14 @@ func newShardController(svc service.Service, host *membership.HostInfo, resolver
 		shardMgr:            shardMgr,
 		historyMgr:          historyMgr,
 		historyV2Mgr:        historyV2Mgr,
+		taskDLQMgr:          taskDLQMgr,
+		taskScheduler:       taskScheduler,
 		executionMgrFactory: executionMgrFactory,
 		domainCache:         domainCache,
 		engineFactory:       factory,
 		historyShards:       make(map[int]*historyShardsItem),
+		shardsInHandoff:     make(map[int]struct{}),
+		handedOffShards:     make(map[int]*shardHandoff),
 		shardClosedCh:       make(chan int, config.NumberOfShards),
 		shutdownCh:          make(chan struct{}),
 		logger:              logger,
@@ -122,8 +152,8 @@ func newShardController(svc service.Service, host *membership.HostInfo, resolver
 }
 
 func newHistoryShardsItem(shardID int, svc service.Service, shardMgr persistence.ShardManager,
-	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, domainCache cache.DomainCache,
-	executionMgrFactory persistence.ExecutionManagerFactory, factory EngineFactory, host *membership.HostInfo,
+	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, taskDLQMgr persistence.TaskDLQManager,
+	taskScheduler task.Scheduler, domainCache cache.DomainCache, executionMgrFactory persistence.ExecutionManagerFactory, factory EngineFactory, host *membership.HostInfo,
 	config *Config, logger log.Logger, throttledLog log.Logger, metricsClient metrics.Client) (*historyShardsItem, error) {
 
 	executionMgr, err := executionMgrFactory.NewExecutionManager(shardID)
@@ -138,6 +168,8 @@ func newHistoryShardsItem(shardID int, svc service.Service, shardMgr persistence
 		shardMgr:        shardMgr,
 		historyMgr:      historyMgr,
 		historyV2Mgr:    historyV2Mgr,
+		taskDLQMgr:      taskDLQMgr,
+		taskScheduler:   taskScheduler,
 		executionMgr:    executionMgr,
 		domainCache:     domainCache,
 		engineFactory:   factory,
@@ -204,12 +236,118 @@ func (c *shardController) getEngineForShard(shardID int) (Engine, error) {
 func (c *shardController) removeEngineForShard(shardID int) {
 	sw := c.metricsClient.StartTimer(metrics.HistoryShardControllerScope, metrics.RemoveEngineForShardLatency)
 	defer sw.Stop()
-	item, _ := c.removeHistoryShardItem(shardID)
+	item, _ := c.removeHistoryShardItem(shardID, nil)
 	if item != nil {
 		item.stopEngine()
 	}
 }
 
+// handoffEngineForShard drains the shard, then notifies the new owner of the shard that it can acquire the shard
+func (c *shardController) handoffEngineForShard(shardID int, newOwner *membership.HostInfo) {
+	c.Lock()
+	item, ok := c.historyShards[shardID]
+	if _, inHandoff := c.shardsInHandoff[shardID]; !ok || inHandoff {
+		c.Unlock()
+		return
+	}
+	c.shardsInHandoff[shardID] = struct{}{}
+	c.Unlock()
+
+	defer func() {
+		c.Lock()
+		delete(c.shardsInHandoff, shardID)
+		c.Unlock()
+	}()
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryShardControllerScope, metrics.ShardHandoffLatency)
+	drained := item.drainEngine(newOwner.Identity())
+	sw.Stop()
+	c.removeHistoryShardItem(shardID, item)
+	if !drained {
+		return
+	}
+
+	c.metricsClient.IncCounter(metrics.HistoryShardControllerScope, metrics.ShardHandoffCounter)
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.ShardHandoffTimeout())
+	defer cancel()
+	err := c.service.GetClientBean().GetHistoryClient().HandoffShard(ctx, &hist.HandoffShardRequest{
+		ShardId:       common.Int32Ptr(int32(shardID)),
+		PreviousOwner: common.StringPtr(c.host.Identity()),
+	})
+	if err != nil {
+		// the new owner acquires the shard on its next shard acquisition anyway
+		c.logger.Warn("Fail to notify new owner of shard handoff.", tag.Error(err), tag.ShardID(shardID), tag.Address(newOwner.Identity()))
+	}
+}
+
+// handoffEnginesForShards hands off the given shards to their new owners concurrently
+func (c *shardController) handoffEnginesForShards(newOwners map[int]*membership.HostInfo) {
+	var handoffWG sync.WaitGroup
+	handoffSlots := make(chan struct{}, shardHandoffConcurrency)
+	for shardID, newOwner := range newOwners {
+		handoffSlots <- struct{}{}
+		handoffWG.Add(1)
+		go func(shardID int, newOwner *membership.HostInfo) {
+			defer handoffWG.Done()
+			defer func() { <-handoffSlots }()
+			c.handoffEngineForShard(shardID, newOwner)
+		}(shardID, newOwner)
+	}
+	handoffWG.Wait()
+}
+
+// drainShards marks this host as draining in the ring membership, so the shards of this host are moved
+// to other hosts, then hands off the shards to their new owners
+func (c *shardController) drainShards() {
+	c.logger.Info("Draining shards.", tag.Address(c.host.Identity()))
+	if err := c.service.GetMembershipMonitor().SetDraining(true); err != nil {
+		c.logger.Error("Error marking host as draining", tag.Error(err), tag.OperationFailed)
+		return
+	}
+	c.acquireShards()
+}
+
+// acquireHandedOffShard acquires a shard handed off to this host by its previous owner. The shard is acquired
+// even if the ring of this host still maps the shard to the previous owner, since the ring may not have converged yet.
+func (c *shardController) acquireHandedOffShard(shardID int, previousOwner string) error {
+	info, err := c.hServiceResolver.Lookup(string(shardID))
+	if err != nil {
+		return err
+	}
+
+	if info.Identity() != c.host.Identity() {
+		if info.Identity() != previousOwner {
+			return createShardOwnershipLostError(c.host.Identity(), info.GetAddress())
+		}
+		c.Lock()
+		c.handedOffShards[shardID] = &shardHandoff{
+			previousOwner: previousOwner,
+			handoffTime:   time.Now(),
+		}
+		c.Unlock()
+	}
+
+	_, err = c.getEngineForShard(shardID)
+	return err
+}
+
+// isShardOwner returns whether this host owns the shard, given the owner of the shard in the ring of this host
+func (c *shardController) isShardOwner(shardID int, info *membership.HostInfo) bool {
+	c.RLock()
+	defer c.RUnlock()
+	return c.isShardOwnerLocked(shardID, info)
+}
+
+func (c *shardController) isShardOwnerLocked(shardID int, info *membership.HostInfo) bool {
+	if info.Identity() == c.host.Identity() {
+		return true
+	}
+
+	handoff, ok := c.handedOffShards[shardID]
+	return ok && handoff.previousOwner == info.Identity() &&
+		time.Since(handoff.handoffTime) < c.config.ShardHandoffTimeout()
+}
+
 func (c *shardController) getOrCreateHistoryShardItem(shardID int) (*historyShardsItem, error) {
 	c.RLock()
 	if item, ok := c.historyShards[shardID]; ok {
@@ -239,8 +377,8 @@ func (c *shardController) getOrCreateHistoryShardItem(shardID int) (*historyShar
 		return nil, err
 	}
 
-	if info.Identity() == c.host.Identity() {
-		shardItem, err := newHistoryShardsItem(shardID, c.service, c.shardMgr, c.historyMgr, c.historyV2Mgr, c.domainCache,
+	if c.isShardOwnerLocked(shardID, info) {
+		shardItem, err := newHistoryShardsItem(shardID, c.service, c.shardMgr, c.historyMgr, c.historyV2Mgr, c.taskDLQMgr, c.taskScheduler, c.domainCache,
 			c.executionMgrFactory, c.engineFactory, c.host, c.config, c.logger, c.throttledLoggger, c.metricsClient)
 		if err != nil {
 			return nil, err
@@ -255,11 +393,13 @@ func (c *shardController) getOrCreateHistoryShardItem(shardID int) (*historyShar
 	return nil, createShardOwnershipLostError(c.host.Identity(), info.GetAddress())
 }
 
-func (c *shardController) removeHistoryShardItem(shardID int) (*historyShardsItem, error) {
+// removeHistoryShardItem removes the item of the shard, if shardItem is not nil the item is only removed
+// if it is still the item of the shard
+func (c *shardController) removeHistoryShardItem(shardID int, shardItem *historyShardsItem) (*historyShardsItem, error) {
 	nShards := 0
 	c.Lock()
 	item, ok := c.historyShards[shardID]
-	if !ok {
+	if !ok || (shardItem != nil && item != shardItem) {
 		c.Unlock()
 		return nil, fmt.Errorf("No item found to remove for shard: %v", shardID)
 	}
@@ -326,6 +466,7 @@ func (c *shardController) acquireShards() {
 	sw := c.metricsClient.StartTimer(metrics.HistoryShardControllerScope, metrics.AcquireShardsLatency)
 	defer sw.Stop()
 
+	handoffs := make(map[int]*membership.HostInfo)
 AcquireLoop:
 	for shardID := 0; shardID < c.config.NumberOfShards; shardID++ {
 		info, err := c.hServiceResolver.Lookup(string(shardID))
@@ -334,17 +475,21 @@ AcquireLoop:
 			continue AcquireLoop
 		}
 
-		if info.Identity() == c.host.Identity() {
+		if c.isShardOwner(shardID, info) {
 			_, err1 := c.getEngineForShard(shardID)
 			if err1 != nil {
 				c.metricsClient.IncCounter(metrics.HistoryShardControllerScope, metrics.GetEngineForShardErrorCounter)
 				c.logger.Error("Unable to create history shard engine", tag.Error(err1), tag.OperationFailed, tag.ShardID(shardID))
 				continue AcquireLoop
 			}
+		} else if c.config.EnableGracefulShardHandoff() && c.hasShard(shardID) {
+			handoffs[shardID] = info
 		} else {
 			c.removeEngineForShard(shardID)
 		}
 	}
+	c.handoffEnginesForShards(handoffs)
+	c.expireHandedOffShards()
 
 	c.metricsClient.UpdateGauge(metrics.HistoryShardControllerScope, metrics.NumShardsGauge, float64(c.numShards()))
 }
@@ -372,6 +517,24 @@ func (c *shardController) processShardClosedEvents() {
 	}
 }
 
+func (c *shardController) hasShard(shardID int) bool {
+	c.RLock()
+	defer c.RUnlock()
+	_, ok := c.historyShards[shardID]
+	return ok
+}
+
+// expireHandedOffShards forgets the shards handed off to this host once the ring membership had time to converge
+func (c *shardController) expireHandedOffShards() {
+	c.Lock()
+	defer c.Unlock()
+	for shardID, handoff := range c.handedOffShards {
+		if time.Since(handoff.handoffTime) >= c.config.ShardHandoffTimeout() {
+			delete(c.handedOffShards, shardID)
+		}
+	}
+}
+
 func (c *shardController) numShards() int {
 	nShards := 0
 	c.RLock()
@@ -397,6 +560,11 @@ func (i *historyShardsItem) getOrCreateEngine(shardClosedCh chan<- int) (Engine,
 		defer i.RUnlock()
 		return i.engine, nil
 	}
+	if i.status == historyShardsItemStatusDraining {
+		drainedCh, newOwner := i.drainedCh, i.newOwner
+		i.RUnlock()
+		return nil, i.waitForHandoff(drainedCh, newOwner)
+	}
 	i.RUnlock()
 
 	i.Lock()
@@ -408,6 +576,7 @@ func (i *historyShardsItem) getOrCreateEngine(shardClosedCh chan<- int) (Engine,
 		if err != nil {
 			return nil, err
 		}
+		i.shard = context
 		i.engine = i.engineFactory.CreateEngine(context)
 		i.engine.Start()
 		i.logger.Info("", tag.LifeCycleStarted, tag.ComponentShardEngine, tag.ShardID(i.shardID), tag.Address(i.host.Identity()))
@@ -415,6 +584,8 @@ func (i *historyShardsItem) getOrCreateEngine(shardClosedCh chan<- int) (Engine,
 		return i.engine, nil
 	case historyShardsItemStatusStarted:
 		return i.engine, nil
+	case historyShardsItemStatusDraining:
+		return nil, createShardOwnershipLostError(i.host.GetAddress(), i.newOwner)
 	case historyShardsItemStatusStopped:
 		return nil, fmt.Errorf("shard %v for host '%v' is shut down", i.shardID, i.host.Identity())
 	default:
@@ -433,21 +604,71 @@ func (i *historyShardsItem) stopEngine() {
 		i.logger.Info("", tag.LifeCycleStopping, tag.ComponentShardEngine, tag.ShardID(i.shardID), tag.Address(i.host.Identity()))
 		i.engine.Stop()
 		i.engine = nil
+		i.shard = nil
 		i.logger.Info("", tag.LifeCycleStopped, tag.ComponentShardEngine, tag.ShardID(i.shardID), tag.Address(i.host.Identity()))
 		i.status = historyShardsItemStatusStopped
-	case historyShardsItemStatusStopped:
-		// no op
+	case historyShardsItemStatusDraining, historyShardsItemStatusStopped:
+		// no op, a draining shard is stopped once drained
 	default:
 		panic(i.logInvalidStatus())
 	}
 }
 
+// drainEngine stops the engine of the shard before the shard is handed off to its new owner. Requests for
+// the shard are held while the shard drains, and are redirected to the new owner once the shard is drained.
+// Returns whether the shard was drained, a shard which was not started has nothing to hand off.
+func (i *historyShardsItem) drainEngine(newOwner string) bool {
+	i.Lock()
+	if i.status != historyShardsItemStatusStarted {
+		i.Unlock()
+		i.stopEngine()
+		return false
+	}
+
+	i.logger.Info("Draining shard.", tag.ComponentShardEngine, tag.ShardID(i.shardID), tag.Address(newOwner))
+	i.status = historyShardsItemStatusDraining
+	i.newOwner = newOwner
+	i.drainedCh = make(chan struct{})
+	engine, shard := i.engine, i.shard
+	i.Unlock()
+
+	// stopping the queue processors flushes their ack levels to the shard while it is still owned by this host
+	shard.startDraining()
+	engine.Stop()
+	if err := shard.drain(newOwner); err != nil {
+		i.logger.Warn("Fail to persist shard info while draining shard.", tag.Error(err), tag.ShardID(i.shardID))
+	}
+
+	i.Lock()
+	defer i.Unlock()
+	i.engine = nil
+	i.shard = nil
+	i.status = historyShardsItemStatusStopped
+	close(i.drainedCh)
+	i.logger.Info("", tag.LifeCycleStopped, tag.ComponentShardEngine, tag.ShardID(i.shardID), tag.Address(i.host.Identity()))
+	return true
+}
+
+// waitForHandoff holds a request for a draining shard until the shard is drained, the request is then
+// redirected to the new owner of the shard
+func (i *historyShardsItem) waitForHandoff(drainedCh <-chan struct{}, newOwner string) error {
+	timer := time.NewTimer(i.config.ShardHandoffTimeout())
+	defer timer.Stop()
+
+	select {
+	case <-drainedCh:
+	case <-timer.C:
+		i.metricsClient.IncCounter(metrics.HistoryShardControllerScope, metrics.ShardHandoffTimeoutCounter)
+	}
+	return createShardOwnershipLostError(i.host.GetAddress(), newOwner)
+}
+
 func (i *historyShardsItem) isValid() bool {
 	i.RLock()
 	defer i.RUnlock()
 
 	switch i.status {
-	case historyShardsItemStatusInitialized, historyShardsItemStatusStarted:
+	case historyShardsItemStatusInitialized, historyShardsItemStatusStarted, historyShardsItemStatusDraining:
 		return true
 	case historyShardsItemStatusStopped:
 		return false
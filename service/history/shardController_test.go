@@ -30,7 +30,9 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
@@ -42,6 +44,7 @@ import (
 	mmocks "github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -53,6 +56,7 @@ type (
 		mockExecutionMgrFactory *mmocks.ExecutionManagerFactory
 		mockHistoryMgr          *mmocks.HistoryManager
 		mockHistoryV2Mgr        *mmocks.HistoryV2Manager
+		mockTaskDLQMgr          *mmocks.TaskDLQManager
 		mockMetadaraMgr         *mmocks.MetadataManager
 		mockServiceResolver     *mmocks.ServiceResolver
 		mockMessaging           *mmocks.KafkaProducer
@@ -82,6 +86,7 @@ func (s *shardControllerSuite) SetupTest() {
 	s.mockExecutionMgrFactory = &mmocks.ExecutionManagerFactory{}
 	s.mockHistoryMgr = &mmocks.HistoryManager{}
 	s.mockHistoryV2Mgr = &mmocks.HistoryV2Manager{}
+	s.mockTaskDLQMgr = &mmocks.TaskDLQManager{}
 	s.mockMetadaraMgr = &mmocks.MetadataManager{}
 	s.mockServiceResolver = &mmocks.ServiceResolver{}
 	s.mockEngineFactory = &MockHistoryEngineFactory{}
@@ -92,7 +97,7 @@ func (s *shardControllerSuite) SetupTest() {
 	s.mockService = service.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, s.metricsClient, s.mockClientBean)
 	s.domainCache = cache.NewDomainCache(s.mockMetadaraMgr, s.mockClusterMetadata, s.metricsClient, s.logger)
 	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager,
-		s.mockHistoryMgr, s.mockHistoryV2Mgr, s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
+		s.mockHistoryMgr, s.mockHistoryV2Mgr, s.mockTaskDLQMgr, nil, s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
 }
 
 func (s *shardControllerSuite) TearDownTest() {
@@ -351,7 +356,7 @@ func (s *shardControllerSuite) TestAcquireShardRenewLookupFailed() {
 func (s *shardControllerSuite) TestHistoryEngineClosed() {
 	numShards := 4
 	s.config.NumberOfShards = numShards
-	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
+	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager, s.mockHistoryMgr, s.mockHistoryV2Mgr, s.mockTaskDLQMgr, nil,
 		s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
 	historyEngines := make(map[int]*MockHistoryEngine)
 	for shardID := 0; shardID < numShards; shardID++ {
@@ -444,7 +449,7 @@ func (s *shardControllerSuite) TestHistoryEngineClosed() {
 func (s *shardControllerSuite) TestRingUpdated() {
 	numShards := 4
 	s.config.NumberOfShards = numShards
-	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
+	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager, s.mockHistoryMgr, s.mockHistoryV2Mgr, s.mockTaskDLQMgr, nil,
 		s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
 	historyEngines := make(map[int]*MockHistoryEngine)
 	for shardID := 0; shardID < numShards; shardID++ {
@@ -524,7 +529,7 @@ func (s *shardControllerSuite) TestRingUpdated() {
 func (s *shardControllerSuite) TestShardControllerClosed() {
 	numShards := 4
 	s.config.NumberOfShards = numShards
-	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
+	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager, s.mockHistoryMgr, s.mockHistoryV2Mgr, s.mockTaskDLQMgr, nil,
 		s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
 	historyEngines := make(map[int]*MockHistoryEngine)
 	for shardID := 0; shardID < numShards; shardID++ {
@@ -571,6 +576,87 @@ func (s *shardControllerSuite) TestShardControllerClosed() {
 	workerWG.Wait()
 }
 
+func (s *shardControllerSuite) TestHandoffShard() {
+	numShards := 1
+	s.config.NumberOfShards = numShards
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(true)
+	shardID := 0
+	mockEngine := &MockHistoryEngine{}
+	s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6)
+
+	// when shard is initialized, it will use the 2 mock function below to initialize the "current" time of each cluster
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestSingleDCClusterInfo)
+	s.controller.acquireShards()
+	s.Equal(1, s.controller.numShards())
+
+	newOwner := membership.NewHostInfo("shardController-host-new-owner", nil)
+	s.mockServiceResolver.On("Lookup", string(shardID)).Return(newOwner, nil).Twice()
+	mockEngine.On("Stop").Return().Once()
+	s.mockShardManager.On("UpdateShard", mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.Owner == newOwner.Identity() && request.PreviousRangeID == 6
+	})).Return(nil).Once()
+	mockHistoryClient := &mmocks.HistoryClient{}
+	mockHistoryClient.On("HandoffShard", mock.Anything, &h.HandoffShardRequest{
+		ShardId:       common.Int32Ptr(int32(shardID)),
+		PreviousOwner: common.StringPtr(s.hostInfo.Identity()),
+	}).Return(nil).Once()
+	s.mockClientBean.On("GetHistoryClient").Return(mockHistoryClient).Once()
+
+	s.controller.acquireShards()
+	s.Equal(0, s.controller.numShards())
+	mockEngine.AssertExpectations(s.T())
+	mockHistoryClient.AssertExpectations(s.T())
+
+	_, err := s.controller.getEngineForShard(shardID)
+	s.IsType(&h.ShardOwnershipLostError{}, err)
+}
+
+func (s *shardControllerSuite) TestAcquireHandedOffShard() {
+	numShards := 1
+	s.config.NumberOfShards = numShards
+	shardID := 0
+	previousOwner := membership.NewHostInfo("shardController-host-previous-owner", nil)
+
+	mockExecutionMgr := &mmocks.ExecutionManager{}
+	s.mockExecutionMgrFactory.On("NewExecutionManager", shardID).Return(mockExecutionMgr, nil).Once()
+	mockEngine := &MockHistoryEngine{}
+	mockEngine.On("Start").Return().Once()
+	s.mockEngineFactory.On("CreateEngine", mock.Anything).Return(mockEngine).Once()
+	// the ring of this host has not converged yet, and still maps the shard to its previous owner
+	s.mockServiceResolver.On("Lookup", string(shardID)).Return(previousOwner, nil).Twice()
+	s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: shardID}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistence.ShardInfo{
+				ShardID: shardID,
+				Owner:   s.hostInfo.Identity(),
+				RangeID: 5,
+			},
+		}, nil).Once()
+	s.mockShardManager.On("UpdateShard", mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.Owner == s.hostInfo.Identity() && request.ShardInfo.RangeID == 6
+	})).Return(nil).Once()
+
+	// when shard is initialized, it will use the 2 mock function below to initialize the "current" time of each cluster
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestSingleDCClusterInfo)
+	err := s.controller.acquireHandedOffShard(shardID, previousOwner.Identity())
+	s.NoError(err)
+	s.Equal(1, s.controller.numShards())
+	s.True(s.controller.isShardOwner(shardID, previousOwner))
+	mockEngine.AssertExpectations(s.T())
+}
+
+func (s *shardControllerSuite) TestAcquireHandedOffShard_UnknownPreviousOwner() {
+	shardID := 0
+	owner := membership.NewHostInfo("shardController-host-owner", nil)
+	s.mockServiceResolver.On("Lookup", string(shardID)).Return(owner, nil).Once()
+
+	err := s.controller.acquireHandedOffShard(shardID, "shardController-host-previous-owner")
+	s.IsType(&h.ShardOwnershipLostError{}, err)
+	s.Equal(0, s.controller.numShards())
+}
+
 func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int, mockEngine *MockHistoryEngine, currentRangeID,
 	newRangeID int64) {
 
@@ -0,0 +1,99 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/uber/cadence/common"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+var (
+	errShardQueueTypeNotSet           = &workflow.BadRequestError{Message: "Shard queue type not set on request."}
+	errShardQueueTaskIDNotSet         = &workflow.BadRequestError{Message: "Shard queue task ID not set on request."}
+	errShardQueueTimestampNotSet      = &workflow.BadRequestError{Message: "Visibility timestamp not set on request for timer task."}
+	errUnknownShardQueueType          = &workflow.BadRequestError{Message: "Unknown shard queue type."}
+	errReplicatorQueueNotEnabled      = &workflow.BadRequestError{Message: "Replication queue is not enabled on the shard."}
+	errShardQueueTaskNotFound         = &workflow.EntityNotExistsError{Message: "Shard queue task not found."}
+	errShardQueueTaskLoaded           = &workflow.BadRequestError{Message: "Shard queue task is already loaded by the queue processor, it can only be refired."}
+	defaultShardQueueTasksPageSize    = 100
+	shardQueueTimerTaskLookupInterval = time.Millisecond
+)
+
+// shardQueueTaskMatches returns whether the task belongs to the domain and workflow requested,
+// an empty domain ID or workflow ID matches any task
+func shardQueueTaskMatches(domainID, workflowID string, taskDomainID, taskWorkflowID string) bool {
+	if domainID != "" && domainID != taskDomainID {
+		return false
+	}
+	return workflowID == "" || workflowID == taskWorkflowID
+}
+
+func toThriftShardQueueTaskInfo(
+	queueType workflow.ShardQueueType,
+	task queueTaskInfo,
+	domainID string,
+	workflowID string,
+	runID string,
+) (*workflow.ShardQueueTaskInfo, error) {
+
+	data, err := json.Marshal(task)
+	if err != nil {
+		return nil, err
+	}
+
+	taskInfo := &workflow.ShardQueueTaskInfo{
+		QueueType:  queueType.Ptr(),
+		TaskId:     common.Int64Ptr(task.GetTaskID()),
+		TaskType:   common.Int32Ptr(int32(task.GetTaskType())),
+		DomainId:   common.StringPtr(domainID),
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr(runID),
+		Version:    common.Int64Ptr(task.GetVersion()),
+		Data:       data,
+	}
+	// replication tasks have no visibility timestamp
+	if visibilityTimestamp := task.GetVisibilityTimestamp(); !visibilityTimestamp.IsZero() {
+		taskInfo.VisibilityTimestamp = common.Int64Ptr(visibilityTimestamp.UnixNano())
+	}
+	return taskInfo, nil
+}
+
+func validateShardQueueTaskRequest(
+	queueType *workflow.ShardQueueType,
+	taskID *int64,
+	visibilityTimestamp *int64,
+) error {
+
+	if queueType == nil {
+		return errShardQueueTypeNotSet
+	}
+	if taskID == nil {
+		return errShardQueueTaskIDNotSet
+	}
+	if *queueType == workflow.ShardQueueTypeTimer && visibilityTimestamp == nil {
+		return errShardQueueTimestampNotSet
+	}
+	return nil
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/mocks"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+type (
+	shardQueueSuite struct {
+		suite.Suite
+
+		mockExecutionMgr    *mocks.ExecutionManager
+		mockClusterMetadata *mocks.ClusterMetadata
+		mockTxProcessor     *MockTransferQueueProcessor
+		mockTimerProcessor  *MockTimerQueueProcessor
+		timerAckLevel       time.Time
+		historyEngine       *historyEngineImpl
+	}
+)
+
+func TestShardQueueSuite(t *testing.T) {
+	s := new(shardQueueSuite)
+	suite.Run(t, s)
+}
+
+func (s *shardQueueSuite) SetupTest() {
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.mockTxProcessor = &MockTransferQueueProcessor{}
+	s.mockTimerProcessor = &MockTimerQueueProcessor{}
+	s.timerAckLevel = time.Unix(0, 1000)
+	s.historyEngine = &historyEngineImpl{
+		currentClusterName: cluster.TestCurrentClusterName,
+		shard: &shardContextImpl{
+			shardID:          5,
+			service:          service.NewTestService(s.mockClusterMetadata, nil, nil, nil),
+			executionManager: s.mockExecutionMgr,
+			shardInfo: &p.ShardInfo{
+				ShardID:             5,
+				TransferAckLevel:    100,
+				TimerAckLevel:       s.timerAckLevel,
+				ReplicationAckLevel: 50,
+			},
+			transferMaxReadLevel: 200,
+			timerMaxReadLevelMap: map[string]time.Time{cluster.TestCurrentClusterName: time.Unix(0, 3000)},
+		},
+		txProcessor:    s.mockTxProcessor,
+		timerProcessor: s.mockTimerProcessor,
+	}
+}
+
+func (s *shardQueueSuite) TearDownTest() {
+	s.mockExecutionMgr.AssertExpectations(s.T())
+	s.mockTxProcessor.AssertExpectations(s.T())
+	s.mockTimerProcessor.AssertExpectations(s.T())
+}
+
+func (s *shardQueueSuite) TestDescribeShardQueues() {
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestSingleDCClusterInfo)
+	s.mockTxProcessor.On("GetReadLevel").Return(int64(150)).Once()
+	s.mockTimerProcessor.On("GetReadLevel").Return(time.Unix(0, 2000)).Once()
+
+	resp, err := s.historyEngine.DescribeShardQueues(context.Background(), &workflow.DescribeShardQueuesRequest{
+		ShardId: common.Int32Ptr(5),
+	})
+	s.NoError(err)
+	s.Equal(int32(5), resp.GetShardId())
+	s.Equal(int64(100), resp.GetTransferAckLevel())
+	s.Equal(int64(150), resp.GetTransferReadLevel())
+	s.Equal(int64(200), resp.GetTransferMaxReadLevel())
+	s.Equal(map[string]int64{cluster.TestCurrentClusterName: 100}, resp.TransferClusterAckLevels)
+	s.Equal(int64(1000), resp.GetTimerAckLevel())
+	s.Equal(int64(2000), resp.GetTimerReadLevel())
+	s.Equal(int64(3000), resp.GetTimerMaxReadLevel())
+	s.Equal(map[string]int64{cluster.TestCurrentClusterName: 1000}, resp.TimerClusterAckLevels)
+	s.Equal(int64(50), resp.GetReplicationAckLevel())
+	s.Nil(resp.ReplicationReadLevel)
+}
+
+func (s *shardQueueSuite) TestListShardQueueTasks_Transfer_Filtered() {
+	s.mockExecutionMgr.On("GetTransferTasks", &p.GetTransferTasksRequest{
+		ReadLevel:     100,
+		MaxReadLevel:  200,
+		BatchSize:     10,
+		NextPageToken: []byte("some random token"),
+	}).Return(&p.GetTransferTasksResponse{
+		Tasks: []*p.TransferTaskInfo{
+			{TaskID: 101, DomainID: "some random domain ID", WorkflowID: "some random workflow ID", RunID: "some random run ID"},
+			{TaskID: 102, DomainID: "some random domain ID", WorkflowID: "some other workflow ID"},
+			{TaskID: 103, DomainID: "some other domain ID", WorkflowID: "some random workflow ID"},
+		},
+		NextPageToken: []byte("some next token"),
+	}, nil).Once()
+
+	resp, err := s.historyEngine.ListShardQueueTasks(context.Background(), &workflow.ListShardQueueTasksRequest{
+		ShardId:         common.Int32Ptr(5),
+		QueueType:       workflow.ShardQueueTypeTransfer.Ptr(),
+		DomainId:        common.StringPtr("some random domain ID"),
+		WorkflowId:      common.StringPtr("some random workflow ID"),
+		MaximumPageSize: common.Int32Ptr(10),
+		NextPageToken:   []byte("some random token"),
+	})
+	s.NoError(err)
+	s.Equal([]byte("some next token"), resp.NextPageToken)
+	s.Equal(1, len(resp.Tasks))
+	task := resp.Tasks[0]
+	s.Equal(workflow.ShardQueueTypeTransfer, task.GetQueueType())
+	s.Equal(int64(101), task.GetTaskId())
+	s.Equal("some random run ID", task.GetRunId())
+
+	decoded := &p.TransferTaskInfo{}
+	s.NoError(json.Unmarshal(task.Data, decoded))
+	s.Equal(int64(101), decoded.TaskID)
+}
+
+func (s *shardQueueSuite) TestListShardQueueTasks_Timer() {
+	visibilityTimestamp := time.Unix(0, 5000)
+	s.mockExecutionMgr.On("GetTimerIndexTasks", &p.GetTimerIndexTasksRequest{
+		MinTimestamp: s.timerAckLevel,
+		MaxTimestamp: maximumTime,
+		BatchSize:    defaultShardQueueTasksPageSize,
+	}).Return(&p.GetTimerIndexTasksResponse{
+		Timers: []*p.TimerTaskInfo{{TaskID: 101, VisibilityTimestamp: visibilityTimestamp}},
+	}, nil).Once()
+
+	resp, err := s.historyEngine.ListShardQueueTasks(context.Background(), &workflow.ListShardQueueTasksRequest{
+		ShardId:   common.Int32Ptr(5),
+		QueueType: workflow.ShardQueueTypeTimer.Ptr(),
+	})
+	s.NoError(err)
+	s.Nil(resp.NextPageToken)
+	s.Equal(1, len(resp.Tasks))
+	s.Equal(visibilityTimestamp.UnixNano(), resp.Tasks[0].GetVisibilityTimestamp())
+}
+
+func (s *shardQueueSuite) TestListShardQueueTasks_Replication() {
+	s.mockExecutionMgr.On("GetReplicationTasks", &p.GetReplicationTasksRequest{
+		ReadLevel:    50,
+		MaxReadLevel: 200,
+		BatchSize:    defaultShardQueueTasksPageSize,
+	}).Return(&p.GetReplicationTasksResponse{
+		Tasks: []*p.ReplicationTaskInfo{{TaskID: 51}},
+	}, nil).Once()
+
+	resp, err := s.historyEngine.ListShardQueueTasks(context.Background(), &workflow.ListShardQueueTasksRequest{
+		ShardId:   common.Int32Ptr(5),
+		QueueType: workflow.ShardQueueTypeReplication.Ptr(),
+	})
+	s.NoError(err)
+	s.Equal(1, len(resp.Tasks))
+	s.Nil(resp.Tasks[0].VisibilityTimestamp)
+}
+
+func (s *shardQueueSuite) TestDeleteShardQueueTask_Timer() {
+	s.mockTimerProcessor.On("IsTaskLoaded", time.Unix(0, 5000), int64(1234)).Return(false).Once()
+	s.mockExecutionMgr.On("CompleteTimerTask", &p.CompleteTimerTaskRequest{
+		VisibilityTimestamp: time.Unix(0, 5000),
+		TaskID:              1234,
+	}).Return(nil).Once()
+
+	err := s.historyEngine.DeleteShardQueueTask(context.Background(), &workflow.DeleteShardQueueTaskRequest{
+		ShardId:             common.Int32Ptr(5),
+		QueueType:           workflow.ShardQueueTypeTimer.Ptr(),
+		TaskId:              common.Int64Ptr(1234),
+		VisibilityTimestamp: common.Int64Ptr(5000),
+	})
+	s.NoError(err)
+}
+
+func (s *shardQueueSuite) TestDeleteShardQueueTask_TimerLoaded() {
+	s.mockTimerProcessor.On("IsTaskLoaded", time.Unix(0, 5000), int64(1234)).Return(true).Once()
+
+	err := s.historyEngine.DeleteShardQueueTask(context.Background(), &workflow.DeleteShardQueueTaskRequest{
+		ShardId:             common.Int32Ptr(5),
+		QueueType:           workflow.ShardQueueTypeTimer.Ptr(),
+		TaskId:              common.Int64Ptr(1234),
+		VisibilityTimestamp: common.Int64Ptr(5000),
+	})
+	s.Equal(errShardQueueTaskLoaded, err)
+}
+
+func (s *shardQueueSuite) TestDeleteShardQueueTask_Transfer() {
+	s.mockTxProcessor.On("IsTaskLoaded", int64(1234)).Return(false).Once()
+	s.mockExecutionMgr.On("CompleteTransferTask", &p.CompleteTransferTaskRequest{
+		TaskID: 1234,
+	}).Return(nil).Once()
+
+	err := s.historyEngine.DeleteShardQueueTask(context.Background(), &workflow.DeleteShardQueueTaskRequest{
+		ShardId:   common.Int32Ptr(5),
+		QueueType: workflow.ShardQueueTypeTransfer.Ptr(),
+		TaskId:    common.Int64Ptr(1234),
+	})
+	s.NoError(err)
+}
+
+func (s *shardQueueSuite) TestDeleteShardQueueTask_TransferLoaded() {
+	s.mockTxProcessor.On("IsTaskLoaded", int64(1234)).Return(true).Once()
+
+	err := s.historyEngine.DeleteShardQueueTask(context.Background(), &workflow.DeleteShardQueueTaskRequest{
+		ShardId:   common.Int32Ptr(5),
+		QueueType: workflow.ShardQueueTypeTransfer.Ptr(),
+		TaskId:    common.Int64Ptr(1234),
+	})
+	s.Equal(errShardQueueTaskLoaded, err)
+}
+
+func (s *shardQueueSuite) TestDeleteShardQueueTask_TimerWithoutTimestamp() {
+	err := s.historyEngine.DeleteShardQueueTask(context.Background(), &workflow.DeleteShardQueueTaskRequest{
+		ShardId:   common.Int32Ptr(5),
+		QueueType: workflow.ShardQueueTypeTimer.Ptr(),
+		TaskId:    common.Int64Ptr(1234),
+	})
+	s.Equal(errShardQueueTimestampNotSet, err)
+}
+
+func (s *shardQueueSuite) TestRefireShardQueueTask_Transfer() {
+	task := &p.TransferTaskInfo{TaskID: 1234}
+	s.mockExecutionMgr.On("GetTransferTasks", &p.GetTransferTasksRequest{
+		ReadLevel:    1233,
+		MaxReadLevel: 1234,
+		BatchSize:    1,
+	}).Return(&p.GetTransferTasksResponse{Tasks: []*p.TransferTaskInfo{task}}, nil).Once()
+	s.mockTxProcessor.On("ProcessTaskOnce", task).Return(nil).Once()
+
+	err := s.historyEngine.RefireShardQueueTask(context.Background(), &workflow.RefireShardQueueTaskRequest{
+		ShardId:   common.Int32Ptr(5),
+		QueueType: workflow.ShardQueueTypeTransfer.Ptr(),
+		TaskId:    common.Int64Ptr(1234),
+	})
+	s.NoError(err)
+}
+
+func (s *shardQueueSuite) TestRefireShardQueueTask_TimerNotFound() {
+	visibilityTimestamp := time.Unix(0, 5000)
+	s.mockExecutionMgr.On("GetTimerIndexTasks", &p.GetTimerIndexTasksRequest{
+		MinTimestamp: visibilityTimestamp,
+		MaxTimestamp: visibilityTimestamp.Add(shardQueueTimerTaskLookupInterval),
+		BatchSize:    defaultShardQueueTasksPageSize,
+	}).Return(&p.GetTimerIndexTasksResponse{
+		Timers: []*p.TimerTaskInfo{{TaskID: 1235, VisibilityTimestamp: visibilityTimestamp}},
+	}, nil).Once()
+
+	err := s.historyEngine.RefireShardQueueTask(context.Background(), &workflow.RefireShardQueueTaskRequest{
+		ShardId:             common.Int32Ptr(5),
+		QueueType:           workflow.ShardQueueTypeTimer.Ptr(),
+		TaskId:              common.Int64Ptr(1234),
+		VisibilityTimestamp: common.Int64Ptr(visibilityTimestamp.UnixNano()),
+	})
+	s.Equal(errShardQueueTaskNotFound, err)
+}
+
+func (s *shardQueueSuite) TestRefireShardQueueTask_ReplicationNotEnabled() {
+	err := s.historyEngine.RefireShardQueueTask(context.Background(), &workflow.RefireShardQueueTaskRequest{
+		ShardId:   common.Int32Ptr(5),
+		QueueType: workflow.ShardQueueTypeReplication.Ptr(),
+		TaskId:    common.Int64Ptr(1234),
+	})
+	s.Equal(errReplicatorQueueNotEnabled, err)
+}
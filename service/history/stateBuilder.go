@@ -26,7 +26,6 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
-	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
@@ -445,6 +444,28 @@ func (b *stateBuilderImpl) applyEvents(domainID, requestID string, execution sha
 				return nil, nil, nil, err
 			}
 
+		case shared.EventTypeWorkflowExecutionUpdateRequested:
+			if err := b.msBuilder.ReplicateWorkflowExecutionUpdateRequestedEvent(event); err != nil {
+				return nil, nil, nil, err
+			}
+
+		case shared.EventTypeWorkflowExecutionUpdateCompleted:
+			if err := b.msBuilder.ReplicateWorkflowExecutionUpdateCompletedEvent(event); err != nil {
+				return nil, nil, nil, err
+			}
+
+		case shared.EventTypeWorkflowExecutionPaused:
+			if err := b.msBuilder.ReplicateWorkflowExecutionPausedEvent(event); err != nil {
+				return nil, nil, nil, err
+			}
+			b.transferTasks = append(b.transferTasks, b.scheduleUpsertSearchAttributesTask())
+
+		case shared.EventTypeWorkflowExecutionResumed:
+			if err := b.msBuilder.ReplicateWorkflowExecutionResumedEvent(event); err != nil {
+				return nil, nil, nil, err
+			}
+			b.transferTasks = append(b.transferTasks, b.scheduleUpsertSearchAttributesTask())
+
 		case shared.EventTypeWorkflowExecutionCancelRequested:
 			if err := b.msBuilder.ReplicateWorkflowExecutionCancelRequestedEvent(event); err != nil {
 				return nil, nil, nil, err
@@ -657,13 +678,16 @@ func (b *stateBuilderImpl) scheduleWorkflowTimerTask(event *shared.HistoryEvent,
 	now := time.Unix(0, event.GetTimestamp())
 	timeout := now.Add(time.Duration(msBuilder.GetExecutionInfo().WorkflowTimeout) * time.Second)
 
-	cronSchedule := b.msBuilder.GetExecutionInfo().CronSchedule
-	cronBackoffDuration := backoff.GetBackoffForNextSchedule(cronSchedule, now)
-	if cronBackoffDuration != backoff.NoBackoff {
-		timeout = timeout.Add(cronBackoffDuration)
+	// the first decision task backoff recorded in the start event covers the start delay and the cron schedule,
+	// or the retry backoff of a workflow continued by its retry policy
+	startAttributes := event.WorkflowExecutionStartedEventAttributes
+	backoffSeconds := startAttributes.GetFirstDecisionTaskBackoffSeconds()
+	if backoffSeconds != 0 {
+		backoffDuration := time.Duration(backoffSeconds) * time.Second
+		timeout = timeout.Add(backoffDuration)
 		timerTasks = append(timerTasks, &persistence.WorkflowBackoffTimerTask{
-			VisibilityTimestamp: now.Add(cronBackoffDuration),
-			TimeoutType:         persistence.WorkflowBackoffTimeoutTypeCron,
+			VisibilityTimestamp: now.Add(backoffDuration),
+			TimeoutType:         getWorkflowBackoffTimeoutType(startAttributes),
 		})
 	}
 
@@ -147,14 +147,26 @@ func (s *stateBuilderSuite) toHistory(events ...*shared.HistoryEvent) []*shared.
 }
 
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionStarted_NoCronSchedule() {
-	s.applyWorkflowExecutionStartedEventTest("")
+	s.applyWorkflowExecutionStartedEventTest("", 0, 0)
 }
 
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionStarted_WithCronSchedule() {
-	s.applyWorkflowExecutionStartedEventTest("* * * * *")
+	s.applyWorkflowExecutionStartedEventTest("* * * * *", 0, 0)
 }
 
-func (s *stateBuilderSuite) applyWorkflowExecutionStartedEventTest(cronSchedule string) {
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionStarted_WithDelayStart() {
+	s.applyWorkflowExecutionStartedEventTest("", 30, 0)
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionStarted_WithDelayStartAndCronSchedule() {
+	s.applyWorkflowExecutionStartedEventTest("* * * * *", 30, 0)
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionStarted_WithRetryBackoff() {
+	s.applyWorkflowExecutionStartedEventTest("", 0, 30)
+}
+
+func (s *stateBuilderSuite) applyWorkflowExecutionStartedEventTest(cronSchedule string, delayStartSeconds int32, retryBackoffSeconds int32) {
 	version := int64(1)
 	requestID := uuid.New()
 	domainID := validDomainID
@@ -171,6 +183,16 @@ func (s *stateBuilderSuite) applyWorkflowExecutionStartedEventTest(cronSchedule
 	}
 
 	now := time.Now()
+	delayedStartTime := now.Add(time.Duration(delayStartSeconds) * time.Second)
+	backoffSeconds := delayStartSeconds + backoff.GetBackoffForNextScheduleInSeconds(cronSchedule, delayedStartTime)
+	var initiator *shared.ContinueAsNewInitiator
+	expectedBackoffTimeoutType := persistence.WorkflowBackoffTimeoutTypeCron
+	if retryBackoffSeconds != 0 {
+		// the workflow is continued by its retry policy
+		backoffSeconds = retryBackoffSeconds
+		initiator = shared.ContinueAsNewInitiatorRetryPolicy.Ptr()
+		expectedBackoffTimeoutType = persistence.WorkflowBackoffTimeoutTypeRetry
+	}
 	evenType := shared.EventTypeWorkflowExecutionStarted
 	event := &shared.HistoryEvent{
 		Version:   common.Int64Ptr(version),
@@ -178,7 +200,9 @@ func (s *stateBuilderSuite) applyWorkflowExecutionStartedEventTest(cronSchedule
 		Timestamp: common.Int64Ptr(now.UnixNano()),
 		EventType: &evenType,
 		WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{
-			ParentWorkflowDomain: common.StringPtr(parentName),
+			ParentWorkflowDomain:            common.StringPtr(parentName),
+			FirstDecisionTaskBackoffSeconds: common.Int32Ptr(backoffSeconds),
+			Initiator:                       initiator,
 		},
 	}
 
@@ -221,8 +245,8 @@ func (s *stateBuilderSuite) applyWorkflowExecutionStartedEventTest(cronSchedule
 
 	expectedTimerTasksLength := 1
 	timeout := now.Add(time.Duration(executionInfo.WorkflowTimeout) * time.Second)
-	backoffDuration := backoff.GetBackoffForNextSchedule(cronSchedule, now)
-	if backoffDuration != backoff.NoBackoff {
+	backoffDuration := time.Duration(backoffSeconds) * time.Second
+	if backoffDuration != 0 {
 		expectedTimerTasksLength = 2
 		timeout = timeout.Add(backoffDuration)
 	}
@@ -233,8 +257,9 @@ func (s *stateBuilderSuite) applyWorkflowExecutionStartedEventTest(cronSchedule
 		case *persistence.WorkflowTimeoutTask:
 			s.True(timerTask.VisibilityTimestamp.Equal(timeout))
 		case *persistence.WorkflowBackoffTimerTask:
-			s.NotEqual(backoff.NoBackoff, backoffDuration)
+			s.NotZero(backoffDuration)
 			s.True(timerTask.VisibilityTimestamp.Equal(now.Add(backoffDuration)))
+			s.Equal(expectedBackoffTimeoutType, timerTask.TimeoutType)
 		default:
 			s.FailNow("Unexpected timer task type.")
 		}
@@ -383,6 +408,70 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionSignaled()
 	s.Empty(s.stateBuilder.newRunTransferTasks)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionUpdateRequested() {
+	version := int64(1)
+	requestID := uuid.New()
+	domainID := validDomainID
+	execution := shared.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	now := time.Now()
+	evenType := shared.EventTypeWorkflowExecutionUpdateRequested
+	event := &shared.HistoryEvent{
+		Version:   common.Int64Ptr(version),
+		EventId:   common.Int64Ptr(130),
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+		EventType: &evenType,
+		WorkflowExecutionUpdateRequestedEventAttributes: &shared.WorkflowExecutionUpdateRequestedEventAttributes{},
+	}
+	s.mockUpdateVersion(event)
+	s.mockMutableState.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{})
+	s.mockMutableState.On("ReplicateWorkflowExecutionUpdateRequestedEvent", event).Return(nil).Once()
+
+	s.mockMutableState.On("ClearStickyness").Once()
+	_, _, _, err := s.stateBuilder.applyEvents(domainID, requestID, execution, s.toHistory(event), nil, 0, 0)
+	s.Nil(err)
+
+	s.Empty(s.stateBuilder.timerTasks)
+	s.Empty(s.stateBuilder.transferTasks)
+	s.Empty(s.stateBuilder.newRunTimerTasks)
+	s.Empty(s.stateBuilder.newRunTransferTasks)
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionUpdateCompleted() {
+	version := int64(1)
+	requestID := uuid.New()
+	domainID := validDomainID
+	execution := shared.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	now := time.Now()
+	evenType := shared.EventTypeWorkflowExecutionUpdateCompleted
+	event := &shared.HistoryEvent{
+		Version:   common.Int64Ptr(version),
+		EventId:   common.Int64Ptr(130),
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+		EventType: &evenType,
+		WorkflowExecutionUpdateCompletedEventAttributes: &shared.WorkflowExecutionUpdateCompletedEventAttributes{},
+	}
+	s.mockUpdateVersion(event)
+	s.mockMutableState.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{})
+	s.mockMutableState.On("ReplicateWorkflowExecutionUpdateCompletedEvent", event).Return(nil).Once()
+
+	s.mockMutableState.On("ClearStickyness").Once()
+	_, _, _, err := s.stateBuilder.applyEvents(domainID, requestID, execution, s.toHistory(event), nil, 0, 0)
+	s.Nil(err)
+
+	s.Empty(s.stateBuilder.timerTasks)
+	s.Empty(s.stateBuilder.transferTasks)
+	s.Empty(s.stateBuilder.newRunTimerTasks)
+	s.Empty(s.stateBuilder.newRunTransferTasks)
+}
+
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionFailed() {
 	version := int64(1)
 	requestID := uuid.New()
@@ -2478,9 +2567,73 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeUpsertWorkflowSearchAttribu
 	s.Empty(s.stateBuilder.newRunTransferTasks)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionPaused() {
+	version := int64(1)
+	requestID := uuid.New()
+	domainID := validDomainID
+	execution := shared.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	now := time.Now()
+	evenType := shared.EventTypeWorkflowExecutionPaused
+	event := &shared.HistoryEvent{
+		Version:                                common.Int64Ptr(version),
+		EventId:                                common.Int64Ptr(130),
+		Timestamp:                              common.Int64Ptr(now.UnixNano()),
+		EventType:                              &evenType,
+		WorkflowExecutionPausedEventAttributes: &shared.WorkflowExecutionPausedEventAttributes{},
+	}
+	s.mockMutableState.On("ReplicateWorkflowExecutionPausedEvent", event).Return(nil).Once()
+	s.mockUpdateVersion(event)
+	s.mockMutableState.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{})
+
+	s.mockMutableState.On("ClearStickyness").Once()
+	_, _, _, err := s.stateBuilder.applyEvents(domainID, requestID, execution, s.toHistory(event), nil, 0, 0)
+	s.Nil(err)
+
+	s.Empty(s.stateBuilder.timerTasks)
+	s.Equal(1, len(s.stateBuilder.transferTasks))
+	s.Empty(s.stateBuilder.newRunTimerTasks)
+	s.Empty(s.stateBuilder.newRunTransferTasks)
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionResumed() {
+	version := int64(1)
+	requestID := uuid.New()
+	domainID := validDomainID
+	execution := shared.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	now := time.Now()
+	evenType := shared.EventTypeWorkflowExecutionResumed
+	event := &shared.HistoryEvent{
+		Version:                                 common.Int64Ptr(version),
+		EventId:                                 common.Int64Ptr(130),
+		Timestamp:                               common.Int64Ptr(now.UnixNano()),
+		EventType:                               &evenType,
+		WorkflowExecutionResumedEventAttributes: &shared.WorkflowExecutionResumedEventAttributes{},
+	}
+	s.mockMutableState.On("ReplicateWorkflowExecutionResumedEvent", event).Return(nil).Once()
+	s.mockUpdateVersion(event)
+	s.mockMutableState.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{})
+
+	s.mockMutableState.On("ClearStickyness").Once()
+	_, _, _, err := s.stateBuilder.applyEvents(domainID, requestID, execution, s.toHistory(event), nil, 0, 0)
+	s.Nil(err)
+
+	s.Empty(s.stateBuilder.timerTasks)
+	s.Equal(1, len(s.stateBuilder.transferTasks))
+	s.Empty(s.stateBuilder.newRunTimerTasks)
+	s.Empty(s.stateBuilder.newRunTransferTasks)
+}
+
 func (s *stateBuilderSuite) TestApplyEventsNewEventsNotHandled() {
 	eventTypes := shared.EventType_Values()
-	s.Equal(42, len(eventTypes), "If you see this error, you are adding new event type. "+
+	s.Equal(46, len(eventTypes), "If you see this error, you are adding new event type. "+
 		"Before updating the number to make this test pass, please make sure you update stateBuilderImpl.applyEvents method "+
 		"to handle the new decision type. Otherwise cross dc will not work on the new event.")
 }
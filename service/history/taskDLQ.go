@@ -0,0 +1,155 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+var (
+	errDLQTaskCategoryNotSet = &workflow.BadRequestError{Message: "DLQ task category not set on request."}
+	errDLQTaskIDNotSet       = &workflow.BadRequestError{Message: "DLQ task ID not set on request."}
+	errUnknownDLQTask        = errors.New("unknown DLQ task")
+)
+
+// canMoveTaskToDLQ returns whether a task which keeps failing with err should be moved to the DLQ,
+// transient errors, which are expected to be resolved without intervention, are excluded
+func canMoveTaskToDLQ(err error) bool {
+	if err == ErrTaskRetry {
+		return false
+	}
+
+	switch err.(type) {
+	case *workflow.DomainNotActiveError,
+		*workflow.ServiceBusyError,
+		*persistence.ShardOwnershipLostError:
+		return false
+	}
+	return true
+}
+
+// moveTaskToDLQ persists the task with its last error to the DLQ of the shard
+func moveTaskToDLQ(shard ShardContext, task queueTaskInfo, taskErr error, attempt int) error {
+	dlqTask, err := newDLQTask(shard.GetShardID(), task, taskErr, attempt, shard.GetTimeSource().Now())
+	if err != nil {
+		return err
+	}
+
+	return shard.GetTaskDLQManager().PutDLQTask(&persistence.PutDLQTaskRequest{
+		Task: dlqTask,
+	})
+}
+
+func newDLQTask(shardID int, task queueTaskInfo, taskErr error, attempt int, now time.Time) (*persistence.DLQTask, error) {
+	dlqTask := &persistence.DLQTask{
+		ShardID:             shardID,
+		TaskID:              task.GetTaskID(),
+		TaskType:            task.GetTaskType(),
+		VisibilityTimestamp: task.GetVisibilityTimestamp(),
+		LastError:           taskErr.Error(),
+		Attempt:             attempt,
+		CreatedTime:         now,
+	}
+
+	switch task := task.(type) {
+	case *persistence.TransferTaskInfo:
+		dlqTask.Category = persistence.DLQTaskCategoryTransfer
+		dlqTask.DomainID = task.DomainID
+		dlqTask.WorkflowID = task.WorkflowID
+		dlqTask.RunID = task.RunID
+	case *persistence.TimerTaskInfo:
+		dlqTask.Category = persistence.DLQTaskCategoryTimer
+		dlqTask.DomainID = task.DomainID
+		dlqTask.WorkflowID = task.WorkflowID
+		dlqTask.RunID = task.RunID
+	default:
+		return nil, errUnexpectedQueueTask
+	}
+
+	data, err := json.Marshal(task)
+	if err != nil {
+		return nil, err
+	}
+	dlqTask.Data = data
+	return dlqTask, nil
+}
+
+// processTaskOnceError converts the error of processing a task outside of the regular queue processing,
+// errors which would let the queue processors ack the task are not errors for the caller either
+func processTaskOnceError(err error) error {
+	if err == ErrTaskDiscarded {
+		return nil
+	}
+	if _, ok := err.(*workflow.EntityNotExistsError); ok {
+		return nil
+	}
+	return err
+}
+
+func toPersistenceDLQTaskCategory(category *workflow.DLQTaskCategory) (int, error) {
+	if category == nil {
+		return 0, errDLQTaskCategoryNotSet
+	}
+
+	switch *category {
+	case workflow.DLQTaskCategoryTransfer:
+		return persistence.DLQTaskCategoryTransfer, nil
+	case workflow.DLQTaskCategoryTimer:
+		return persistence.DLQTaskCategoryTimer, nil
+	default:
+		return 0, &workflow.BadRequestError{Message: "Unknown DLQ task category."}
+	}
+}
+
+func toThriftDLQTaskCategory(category int) *workflow.DLQTaskCategory {
+	switch category {
+	case persistence.DLQTaskCategoryTransfer:
+		return workflow.DLQTaskCategoryTransfer.Ptr()
+	case persistence.DLQTaskCategoryTimer:
+		return workflow.DLQTaskCategoryTimer.Ptr()
+	default:
+		return nil
+	}
+}
+
+func toThriftDLQTaskInfo(task *persistence.DLQTask) *workflow.DLQTaskInfo {
+	return &workflow.DLQTaskInfo{
+		ShardId:             common.Int32Ptr(int32(task.ShardID)),
+		Category:            toThriftDLQTaskCategory(task.Category),
+		TaskId:              common.Int64Ptr(task.TaskID),
+		TaskType:            common.Int32Ptr(int32(task.TaskType)),
+		DomainId:            common.StringPtr(task.DomainID),
+		WorkflowId:          common.StringPtr(task.WorkflowID),
+		RunId:               common.StringPtr(task.RunID),
+		VisibilityTimestamp: common.Int64Ptr(task.VisibilityTimestamp.UnixNano()),
+		Data:                task.Data,
+		LastError:           common.StringPtr(task.LastError),
+		Attempt:             common.Int32Ptr(int32(task.Attempt)),
+		CreatedTimestamp:    common.Int64Ptr(task.CreatedTime.UnixNano()),
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/mocks"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	taskDLQSuite struct {
+		suite.Suite
+
+		mockTaskDLQMgr     *mocks.TaskDLQManager
+		mockTxProcessor    *MockTransferQueueProcessor
+		mockTimerProcessor *MockTimerQueueProcessor
+		timeSource         *clock.EventTimeSource
+		historyEngine      *historyEngineImpl
+	}
+)
+
+func TestTaskDLQSuite(t *testing.T) {
+	s := new(taskDLQSuite)
+	suite.Run(t, s)
+}
+
+func (s *taskDLQSuite) SetupTest() {
+	s.mockTaskDLQMgr = &mocks.TaskDLQManager{}
+	s.mockTxProcessor = &MockTransferQueueProcessor{}
+	s.mockTimerProcessor = &MockTimerQueueProcessor{}
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.historyEngine = &historyEngineImpl{
+		shard: &shardContextImpl{
+			shardID:    5,
+			taskDLQMgr: s.mockTaskDLQMgr,
+			timeSource: s.timeSource,
+		},
+		txProcessor:    s.mockTxProcessor,
+		timerProcessor: s.mockTimerProcessor,
+	}
+}
+
+func (s *taskDLQSuite) TearDownTest() {
+	s.mockTaskDLQMgr.AssertExpectations(s.T())
+	s.mockTxProcessor.AssertExpectations(s.T())
+	s.mockTimerProcessor.AssertExpectations(s.T())
+}
+
+func (s *taskDLQSuite) TestCanMoveTaskToDLQ() {
+	s.True(canMoveTaskToDLQ(errors.New("some random error")))
+	s.True(canMoveTaskToDLQ(&workflow.InternalServiceError{}))
+	s.False(canMoveTaskToDLQ(ErrTaskRetry))
+	s.False(canMoveTaskToDLQ(&workflow.DomainNotActiveError{}))
+	s.False(canMoveTaskToDLQ(&workflow.ServiceBusyError{}))
+	s.False(canMoveTaskToDLQ(&p.ShardOwnershipLostError{}))
+}
+
+func (s *taskDLQSuite) TestMoveTaskToDLQ_TransferTask() {
+	task := &p.TransferTaskInfo{
+		DomainID:            "some random domain ID",
+		WorkflowID:          "some random workflow ID",
+		RunID:               "some random run ID",
+		TaskID:              1234,
+		TaskType:            p.TransferTaskTypeDecisionTask,
+		VisibilityTimestamp: time.Unix(0, 123),
+	}
+
+	var dlqTask *p.DLQTask
+	s.mockTaskDLQMgr.On("PutDLQTask", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		dlqTask = args.Get(0).(*p.PutDLQTaskRequest).Task
+	}).Once()
+
+	err := moveTaskToDLQ(s.historyEngine.shard, task, errors.New("some random error"), 100)
+	s.NoError(err)
+	s.Equal(5, dlqTask.ShardID)
+	s.Equal(p.DLQTaskCategoryTransfer, dlqTask.Category)
+	s.Equal(task.TaskID, dlqTask.TaskID)
+	s.Equal(task.TaskType, dlqTask.TaskType)
+	s.Equal(task.DomainID, dlqTask.DomainID)
+	s.Equal(task.WorkflowID, dlqTask.WorkflowID)
+	s.Equal(task.RunID, dlqTask.RunID)
+	s.Equal("some random error", dlqTask.LastError)
+	s.Equal(100, dlqTask.Attempt)
+	s.Equal(s.timeSource.Now(), dlqTask.CreatedTime)
+
+	decoded := &p.TransferTaskInfo{}
+	s.NoError(json.Unmarshal(dlqTask.Data, decoded))
+	s.Equal(task.TaskID, decoded.TaskID)
+	s.Equal(task.WorkflowID, decoded.WorkflowID)
+}
+
+func (s *taskDLQSuite) TestMoveTaskToDLQ_TimerTask() {
+	task := &p.TimerTaskInfo{
+		DomainID:            "some random domain ID",
+		WorkflowID:          "some random workflow ID",
+		RunID:               "some random run ID",
+		TaskID:              1234,
+		TaskType:            p.TaskTypeUserTimer,
+		VisibilityTimestamp: time.Unix(0, 123),
+	}
+
+	s.mockTaskDLQMgr.On("PutDLQTask", mock.MatchedBy(func(request *p.PutDLQTaskRequest) bool {
+		return request.Task.Category == p.DLQTaskCategoryTimer && request.Task.TaskID == task.TaskID &&
+			request.Task.VisibilityTimestamp.Equal(task.VisibilityTimestamp)
+	})).Return(nil).Once()
+
+	err := moveTaskToDLQ(s.historyEngine.shard, task, errors.New("some random error"), 100)
+	s.NoError(err)
+}
+
+func (s *taskDLQSuite) TestRequeueDLQTask_Success() {
+	task := &p.TransferTaskInfo{TaskID: 1234, WorkflowID: "some random workflow ID"}
+	data, err := json.Marshal(task)
+	s.NoError(err)
+
+	s.mockTaskDLQMgr.On("GetDLQTask", &p.GetDLQTaskRequest{
+		ShardID:  5,
+		Category: p.DLQTaskCategoryTransfer,
+		TaskID:   1234,
+	}).Return(&p.GetDLQTaskResponse{Task: &p.DLQTask{
+		ShardID:  5,
+		Category: p.DLQTaskCategoryTransfer,
+		TaskID:   1234,
+		Data:     data,
+	}}, nil).Once()
+	s.mockTxProcessor.On("ProcessTaskOnce", task).Return(nil).Once()
+	s.mockTaskDLQMgr.On("DeleteDLQTask", &p.DeleteDLQTaskRequest{
+		ShardID:  5,
+		Category: p.DLQTaskCategoryTransfer,
+		TaskID:   1234,
+	}).Return(nil).Once()
+
+	err = s.historyEngine.RequeueDLQTask(nil, &workflow.RequeueDLQTaskRequest{
+		ShardId:  common.Int32Ptr(5),
+		Category: workflow.DLQTaskCategoryTransfer.Ptr(),
+		TaskId:   common.Int64Ptr(1234),
+	})
+	s.NoError(err)
+}
+
+func (s *taskDLQSuite) TestRequeueDLQTask_ProcessingFailed() {
+	task := &p.TimerTaskInfo{TaskID: 1234, WorkflowID: "some random workflow ID"}
+	data, err := json.Marshal(task)
+	s.NoError(err)
+
+	s.mockTaskDLQMgr.On("GetDLQTask", mock.Anything).Return(&p.GetDLQTaskResponse{Task: &p.DLQTask{
+		ShardID:  5,
+		Category: p.DLQTaskCategoryTimer,
+		TaskID:   1234,
+		Data:     data,
+	}}, nil).Once()
+	processErr := errors.New("some random error")
+	s.mockTimerProcessor.On("ProcessTaskOnce", task).Return(processErr).Once()
+
+	err = s.historyEngine.RequeueDLQTask(nil, &workflow.RequeueDLQTaskRequest{
+		ShardId:  common.Int32Ptr(5),
+		Category: workflow.DLQTaskCategoryTimer.Ptr(),
+		TaskId:   common.Int64Ptr(1234),
+	})
+	s.Equal(processErr, err)
+}
+
+func (s *taskDLQSuite) TestRequeueDLQTask_TaskIDNotSet() {
+	err := s.historyEngine.RequeueDLQTask(nil, &workflow.RequeueDLQTaskRequest{
+		ShardId:  common.Int32Ptr(5),
+		Category: workflow.DLQTaskCategoryTimer.Ptr(),
+	})
+	s.Equal(errDLQTaskIDNotSet, err)
+}
+
+func (s *taskDLQSuite) TestPurgeDLQTasks() {
+	s.mockTaskDLQMgr.On("DeleteDLQTask", &p.DeleteDLQTaskRequest{
+		ShardID:  5,
+		Category: p.DLQTaskCategoryTimer,
+		TaskID:   1234,
+	}).Return(nil).Once()
+	err := s.historyEngine.PurgeDLQTasks(nil, &workflow.PurgeDLQTasksRequest{
+		ShardId:  common.Int32Ptr(5),
+		Category: workflow.DLQTaskCategoryTimer.Ptr(),
+		TaskId:   common.Int64Ptr(1234),
+	})
+	s.NoError(err)
+
+	s.mockTaskDLQMgr.On("RangeDeleteDLQTasks", &p.RangeDeleteDLQTasksRequest{
+		ShardID:  5,
+		Category: p.DLQTaskCategoryTransfer,
+	}).Return(nil).Once()
+	err = s.historyEngine.PurgeDLQTasks(nil, &workflow.PurgeDLQTasksRequest{
+		ShardId:  common.Int32Ptr(5),
+		Category: workflow.DLQTaskCategoryTransfer.Ptr(),
+	})
+	s.NoError(err)
+}
+
+func (s *taskDLQSuite) TestListDLQTasks() {
+	now := time.Now()
+	s.mockTaskDLQMgr.On("ListDLQTasks", &p.ListDLQTasksRequest{
+		ShardID:       5,
+		Category:      p.DLQTaskCategoryTransfer,
+		PageSize:      10,
+		NextPageToken: []byte("some random token"),
+	}).Return(&p.ListDLQTasksResponse{
+		Tasks: []*p.DLQTask{{
+			ShardID:             5,
+			Category:            p.DLQTaskCategoryTransfer,
+			TaskID:              1234,
+			VisibilityTimestamp: now,
+			LastError:           "some random error",
+			Attempt:             100,
+			CreatedTime:         now,
+		}},
+		NextPageToken: []byte("some other token"),
+	}, nil).Once()
+
+	resp, err := s.historyEngine.ListDLQTasks(nil, &workflow.ListDLQTasksRequest{
+		ShardId:         common.Int32Ptr(5),
+		Category:        workflow.DLQTaskCategoryTransfer.Ptr(),
+		MaximumPageSize: common.Int32Ptr(10),
+		NextPageToken:   []byte("some random token"),
+	})
+	s.NoError(err)
+	s.Equal([]byte("some other token"), resp.NextPageToken)
+	s.Len(resp.Tasks, 1)
+	s.Equal(workflow.DLQTaskCategoryTransfer, resp.Tasks[0].GetCategory())
+	s.Equal(int64(1234), resp.Tasks[0].GetTaskId())
+	s.Equal(now.UnixNano(), resp.Tasks[0].GetVisibilityTimestamp())
+	s.Equal("some random error", resp.Tasks[0].GetLastError())
+	s.Equal(int32(100), resp.Tasks[0].GetAttempt())
+}
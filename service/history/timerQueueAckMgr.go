@@ -343,6 +343,15 @@ MoveAckLevelLoop:
 	}
 }
 
+// flushAckLevel persists the ack level when the processor stops while the shard is being handed off,
+// failover ack managers are skipped since they shut down on their own once all the failover timers are acked
+func (t *timerQueueAckMgrImpl) flushAckLevel() {
+	if t.isFailover {
+		return
+	}
+	t.updateAckLevel()
+}
+
 // this function does not take cluster name as parameter, due to we only have one timer queue on Cassandra
 // all timer tasks are in this queue and filter will be applied.
 func (t *timerQueueAckMgrImpl) getTimerTasks(minTimestamp time.Time, maxTimestamp time.Time, batchSize int, pageToken []byte) ([]*persistence.TimerTaskInfo, []byte, error) {
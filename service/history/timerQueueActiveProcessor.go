@@ -629,6 +629,10 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(task *persiste
 		} else if !ok {
 			return nil
 		}
+		if msBuilder.IsWorkflowExecutionPaused() {
+			// activity task is dispatched again when the execution is resumed
+			return nil
+		}
 
 		domainID := task.DomainID
 		targetDomainID := domainID
@@ -194,6 +194,50 @@ func (t *timerQueueProcessorImpl) UnlockTaskPrrocessing() {
 	t.taskAllocator.unlock()
 }
 
+// ProcessTaskOnce processes a timer task once outside of the regular queue processing,
+// using the active or standby processor which owns the task
+func (t *timerQueueProcessorImpl) ProcessTaskOnce(task *persistence.TimerTaskInfo) error {
+	processors := []timerProcessor{t.activeTimerProcessor}
+	for _, standbyTimerProcessor := range t.standbyTimerProcessors {
+		processors = append(processors, standbyTimerProcessor)
+	}
+
+	for _, processor := range processors {
+		shouldProcessTask, err := processor.getTaskFilter()(task)
+		if err != nil {
+			return err
+		}
+		if !shouldProcessTask {
+			continue
+		}
+
+		_, err = processor.process(task, true)
+		return processTaskOnceError(err)
+	}
+	return nil
+}
+
+// GetReadLevel returns the read level of the active timer processor
+func (t *timerQueueProcessorImpl) GetReadLevel() time.Time {
+	return t.activeTimerProcessor.timerQueueAckMgr.getReadLevel().VisibilityTimestamp
+}
+
+// IsTaskLoaded returns whether the timer task has already been read by the active or a standby timer processor
+func (t *timerQueueProcessorImpl) IsTaskLoaded(visibilityTimestamp time.Time, taskID int64) bool {
+	timerID := &TimerSequenceID{VisibilityTimestamp: visibilityTimestamp, TaskID: taskID}
+	readLevel := t.activeTimerProcessor.timerQueueAckMgr.getReadLevel()
+	if !compareTimerIDLess(&readLevel, timerID) {
+		return true
+	}
+	for _, standbyTimerProcessor := range t.standbyTimerProcessors {
+		readLevel := standbyTimerProcessor.timerQueueAckMgr.getReadLevel()
+		if !compareTimerIDLess(&readLevel, timerID) {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *timerQueueProcessorImpl) getTimerFiredCount(clusterName string) uint64 {
 	if clusterName == t.currentClusterName {
 		return t.activeTimerProcessor.getTimerFiredCount()
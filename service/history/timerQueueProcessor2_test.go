@@ -34,6 +34,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
@@ -45,6 +46,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/service/worker/archiver"
 )
 
 type (
@@ -419,3 +421,69 @@ func (s *timerQueueProcessor2Suite) TestWorkflowTimeout_Cron() {
 	<-waitCh
 	s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor.Stop()
 }
+
+func (s *timerQueueProcessor2Suite) TestArchiveVisibility_InvalidURI() {
+	mockArchivalClient := &archiver.ClientMock{}
+	s.mockHistoryEngine.archivalClient = mockArchivalClient
+	s.mockClusterMetadata.On("VisibilityArchivalConfig").Return(
+		cluster.NewArchivalConfig(cluster.ArchivalEnabled, true, workflow.ArchivalStatusEnabled, "test:///visibility"),
+	)
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID},
+		&persistence.DomainConfig{VisibilityArchivalStatus: workflow.ArchivalStatusEnabled, VisibilityArchivalURI: ""},
+		"",
+		nil,
+	)
+	msBuilder := &mockMutableState{}
+	task := &persistence.TimerTaskInfo{DomainID: s.domainID, WorkflowID: "wid", RunID: validRunID}
+
+	processor := s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor.timerQueueProcessorBase
+	err := processor.archiveVisibility(task, msBuilder, domainEntry)
+	s.NoError(err)
+	msBuilder.AssertExpectations(s.T())
+	mockArchivalClient.AssertExpectations(s.T())
+}
+
+func (s *timerQueueProcessor2Suite) TestArchiveVisibility_ArchiverConfigNotFound() {
+	s.assertArchiveVisibilitySkipped("file:///visibility", provider.ErrArchiverConfigNotFound)
+}
+
+func (s *timerQueueProcessor2Suite) TestArchiveVisibility_UnknownScheme() {
+	s.assertArchiveVisibilitySkipped("unknown:///visibility", provider.ErrUnknownScheme)
+}
+
+func (s *timerQueueProcessor2Suite) TestArchiveVisibility_BootstrapContainerNotFound() {
+	s.assertArchiveVisibilitySkipped("file:///visibility", provider.ErrBootstrapContainerNotFound)
+}
+
+func (s *timerQueueProcessor2Suite) assertArchiveVisibilitySkipped(URI string, archiveErr error) {
+	mockArchivalClient := &archiver.ClientMock{}
+	s.mockHistoryEngine.archivalClient = mockArchivalClient
+	s.mockClusterMetadata.On("VisibilityArchivalConfig").Return(
+		cluster.NewArchivalConfig(cluster.ArchivalEnabled, true, workflow.ArchivalStatusEnabled, "test:///visibility"),
+	)
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: "domain"},
+		&persistence.DomainConfig{VisibilityArchivalStatus: workflow.ArchivalStatusEnabled, VisibilityArchivalURI: URI},
+		"",
+		nil,
+	)
+	msBuilder := &mockMutableState{}
+	msBuilder.On("GetCompletionEvent").Return(&workflow.HistoryEvent{Timestamp: common.Int64Ptr(time.Now().UnixNano())}, true).Once()
+	msBuilder.On("GetStartEvent").Return(&workflow.HistoryEvent{
+		WorkflowExecutionStartedEventAttributes: &workflow.WorkflowExecutionStartedEventAttributes{},
+	}, true).Once()
+	msBuilder.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{
+		StartTimestamp: time.Now(),
+		CloseStatus:    persistence.WorkflowCloseStatusCompleted,
+	})
+	msBuilder.On("GetNextEventID").Return(int64(10)).Once()
+	mockArchivalClient.On("ArchiveVisibility", mock.Anything, mock.Anything).Return(archiveErr).Once()
+	task := &persistence.TimerTaskInfo{DomainID: s.domainID, WorkflowID: "wid", RunID: validRunID}
+
+	processor := s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor.timerQueueProcessorBase
+	err := processor.archiveVisibility(task, msBuilder, domainEntry)
+	s.NoError(err)
+	msBuilder.AssertExpectations(s.T())
+	mockArchivalClient.AssertExpectations(s.T())
+}
@@ -31,6 +31,8 @@ import (
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	carchiver "github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
@@ -41,6 +43,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/service/worker/archiver"
 )
 
@@ -82,6 +85,10 @@ type (
 		// duplicate numOfWorker from config.TimerTaskWorkerCount for dynamic config works correctly
 		numOfWorker int
 
+		// host level task scheduler, tasks are submitted to it instead of the worker coroutines if set
+		scheduler   task.Scheduler
+		taskTracker *queueTaskTracker
+
 		lastPollTime time.Time
 
 		// timer notification
@@ -89,6 +96,18 @@ type (
 		newTimeLock sync.Mutex
 		newTime     time.Time
 	}
+
+	// timerQueueTaskExecutor makes the processing attempts of a timer task executed by the host level task scheduler
+	timerQueueTaskExecutor struct {
+		processor         *timerQueueProcessorBase
+		task              *persistence.TimerTaskInfo
+		logger            log.Logger
+		startTime         time.Time
+		scope             int
+		attempt           int
+		filtered          bool
+		shouldProcessTask bool
+	}
 )
 
 func newTimerQueueProcessorBase(scope int, shard ShardContext, historyService *historyEngineImpl,
@@ -131,6 +150,11 @@ func newTimerQueueProcessorBase(scope int, shard ShardContext, historyService *h
 		retryPolicy: common.CreatePersistanceRetryPolicy(),
 	}
 
+	if scheduler := shard.GetTaskScheduler(); scheduler != nil {
+		base.scheduler = scheduler
+		base.taskTracker = newQueueTaskTracker(10 * shard.GetConfig().TimerTaskBatchSize())
+	}
+
 	return base
 }
 
@@ -169,7 +193,7 @@ func (t *timerQueueProcessorBase) processorPump() {
 	defer t.shutdownWG.Done()
 
 	var workerWG sync.WaitGroup
-	for i := 0; i < t.numOfWorker; i++ {
+	for i := 0; t.scheduler == nil && i < t.numOfWorker; i++ {
 		workerWG.Add(1)
 		notificationChan := t.workerNotificationChans[i]
 		go t.taskWorker(&workerWG, notificationChan)
@@ -194,6 +218,16 @@ RetryProcessor:
 	if success := common.AwaitWaitGroup(&workerWG, 10*time.Second); !success {
 		t.logger.Warn("Timer queue processor timedout on worker shutdown.")
 	}
+	if t.taskTracker != nil {
+		if success := t.taskTracker.wait(10 * time.Second); !success {
+			t.logger.Warn("Timer queue processor timedout on scheduled tasks shutdown.")
+		}
+	}
+	if t.shard.IsDraining() {
+		// flush the ack level of the timers completed since the last update, so the new owner
+		// of the shard does not process them again
+		t.timerQueueAckMgr.flushAckLevel()
+	}
 	t.logger.Info("Timer processor exiting.")
 }
 
@@ -382,6 +416,12 @@ func (t *timerQueueProcessorBase) readAndFanoutTimerTasks() (*persistence.TimerT
 
 	for _, task := range timerTasks {
 		// We have a timer to fire.
+		if t.scheduler != nil {
+			if !t.submitTask(task) {
+				return nil, nil
+			}
+			continue
+		}
 		select {
 		case t.tasksCh <- task:
 		case <-t.shutdownCh:
@@ -397,6 +437,16 @@ func (t *timerQueueProcessorBase) readAndFanoutTimerTasks() (*persistence.TimerT
 	return nil, nil
 }
 
+func (t *timerQueueProcessorBase) submitTask(task *persistence.TimerTaskInfo) bool {
+	executor := &timerQueueTaskExecutor{
+		processor: t,
+		task:      task,
+		logger:    t.initializeLoggerForTask(task),
+		startTime: t.timeSource.Now(),
+	}
+	return submitQueueTask(t.shard, t.scheduler, t.taskTracker, task.DomainID, executor, t.shutdownCh, t.logger)
+}
+
 func (t *timerQueueProcessorBase) retryTasks() {
 	for _, workerNotificationChan := range t.workerNotificationChans {
 		select {
@@ -416,10 +466,7 @@ func (t *timerQueueProcessorBase) processTaskAndAck(notificationChan <-chan stru
 	attempt := 0
 	incAttempt := func() {
 		attempt++
-		if attempt >= t.config.TimerTaskMaxRetryCount() {
-			t.metricsClient.RecordTimer(scope, metrics.TaskAttemptTimer, time.Duration(attempt))
-			logger.Error("Critical error processing timer task, retrying.", tag.Error(err), tag.OperationCritical)
-		}
+		t.reportTaskAttempt(scope, attempt, err, logger)
 	}
 
 FilterLoop:
@@ -463,10 +510,37 @@ FilterLoop:
 				return
 			}
 			incAttempt()
+			if t.shouldMoveTaskToDLQ(attempt, err) && t.moveTaskToDLQ(task, scope, attempt, err, logger) {
+				t.ackTaskOnce(task, scope, shouldProcessTask, startTime, attempt)
+				return
+			}
 		}
 	}
 }
 
+func (t *timerQueueProcessorBase) reportTaskAttempt(scope int, attempt int, err error, logger log.Logger) {
+	if attempt >= t.config.TimerTaskMaxRetryCount() {
+		t.metricsClient.RecordTimer(scope, metrics.TaskAttemptTimer, time.Duration(attempt))
+		logger.Error("Critical error processing timer task, retrying.", tag.Error(err), tag.OperationCritical)
+	}
+}
+
+// shouldMoveTaskToDLQ returns whether a timer task exhausted its retries with a non transient error
+func (t *timerQueueProcessorBase) shouldMoveTaskToDLQ(attempt int, err error) bool {
+	return attempt >= t.config.TimerTaskMaxRetryCount() && t.config.EnableTaskDLQ() && canMoveTaskToDLQ(err)
+}
+
+// moveTaskToDLQ moves a task to the DLQ, return false if the task is still to be retried
+func (t *timerQueueProcessorBase) moveTaskToDLQ(task *persistence.TimerTaskInfo, scope int, attempt int, err error, logger log.Logger) bool {
+	if dlqErr := moveTaskToDLQ(t.shard, task, err, attempt); dlqErr != nil {
+		logger.Error("Fail to move timer task to DLQ.", tag.Error(dlqErr))
+		return false
+	}
+	t.metricsClient.IncCounter(scope, metrics.TaskMovedToDLQCounter)
+	logger.Error("Timer task moved to DLQ.", tag.Error(err), tag.Attempt(int32(attempt)))
+	return true
+}
+
 func (t *timerQueueProcessorBase) processTaskOnce(notificationChan <-chan struct{}, task *persistence.TimerTaskInfo, shouldProcessTask bool, logger log.Logger) (int, error) {
 	select {
 	case <-notificationChan:
@@ -497,7 +571,10 @@ func (t *timerQueueProcessorBase) handleTaskError(scope int, startTime time.Time
 	// this is a transient error
 	if err == ErrTaskRetry {
 		t.metricsClient.IncCounter(scope, metrics.TaskStandbyRetryCounter)
-		<-notificationChan
+		// tasks executed by the task scheduler have no notification channel, they are retried with backoff
+		if notificationChan != nil {
+			<-notificationChan
+		}
 		return err
 	}
 
@@ -594,6 +671,10 @@ func (t *timerQueueProcessorBase) processDeleteHistoryEvent(task *persistence.Ti
 	if err != nil {
 		return err
 	}
+	if err := t.archiveVisibility(task, msBuilder, domainCacheEntry); err != nil {
+		return err
+	}
+
 	domainArchivalStatus := domainCacheEntry.GetConfig().HistoryArchivalStatus
 	switch clusterArchivalStatus {
 	case cluster.ArchivalDisabled:
@@ -689,6 +770,77 @@ func (t *timerQueueProcessorBase) archiveWorkflow(task *persistence.TimerTaskInf
 	return nil
 }
 
+func (t *timerQueueProcessorBase) archiveVisibility(task *persistence.TimerTaskInfo, msBuilder mutableState, domainCacheEntry *cache.DomainCacheEntry) error {
+	clusterArchivalConfig := t.shard.GetService().GetClusterMetadata().VisibilityArchivalConfig()
+	if !clusterArchivalConfig.ClusterConfiguredForArchival() ||
+		domainCacheEntry.GetConfig().VisibilityArchivalStatus != workflow.ArchivalStatusEnabled {
+		return nil
+	}
+
+	URI := domainCacheEntry.GetConfig().VisibilityArchivalURI
+	if _, err := common.GetArchivalScheme(URI); err != nil {
+		return t.handleNonRetryableArchiveVisibilityError(task, URI, err)
+	}
+
+	completionEvent, ok := msBuilder.GetCompletionEvent()
+	if !ok {
+		return &workflow.InternalServiceError{Message: "Unable to get workflow completion event."}
+	}
+	startEvent, ok := msBuilder.GetStartEvent()
+	if !ok {
+		return &workflow.InternalServiceError{Message: "Unable to get workflow start event."}
+	}
+	executionInfo := msBuilder.GetExecutionInfo()
+
+	ctx, cancel := context.WithTimeout(context.Background(), t.config.TimerProcessorHistoryArchivalTimeLimit())
+	defer cancel()
+	req := &archiver.ClientVisibilityRequest{
+		ArchiveRequest: &carchiver.ArchiveVisibilityRequest{
+			DomainID:           task.DomainID,
+			DomainName:         domainCacheEntry.GetInfo().Name,
+			WorkflowID:         task.WorkflowID,
+			RunID:              task.RunID,
+			WorkflowTypeName:   executionInfo.WorkflowTypeName,
+			StartTimestamp:     executionInfo.StartTimestamp.UnixNano(),
+			ExecutionTimestamp: getWorkflowExecutionTimestamp(msBuilder, startEvent).UnixNano(),
+			CloseTimestamp:     completionEvent.GetTimestamp(),
+			CloseStatus:        getWorkflowExecutionCloseStatus(executionInfo.CloseStatus),
+			HistoryLength:      msBuilder.GetNextEventID() - 1,
+			Memo:               getVisibilityMemo(startEvent),
+			SearchAttributes:   copySearchAttributes(executionInfo.SearchAttributes),
+		},
+		URI:           URI,
+		CallerService: common.HistoryServiceName,
+	}
+	err := t.historyService.archivalClient.ArchiveVisibility(ctx, req)
+	switch err {
+	case carchiver.ErrArchiveNonRetriable,
+		provider.ErrUnknownScheme,
+		provider.ErrBootstrapContainerNotFound,
+		provider.ErrArchiverConfigNotFound:
+		return t.handleNonRetryableArchiveVisibilityError(task, URI, err)
+	}
+	return err
+}
+
+// handleNonRetryableArchiveVisibilityError skips the visibility record of a workflow which can not be archived
+// because of a bad record, URI or archiver config, retrying will not help and must not block the deletion
+func (t *timerQueueProcessorBase) handleNonRetryableArchiveVisibilityError(
+	task *persistence.TimerTaskInfo,
+	URI string,
+	err error,
+) error {
+
+	t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupVisibilityArchiveFailureCount)
+	t.logger.Error("failed to archive workflow visibility record, skipping it",
+		tag.WorkflowDomainID(task.DomainID),
+		tag.WorkflowID(task.WorkflowID),
+		tag.WorkflowRunID(task.RunID),
+		tag.ArchivalURI(URI),
+		tag.Error(err))
+	return nil
+}
+
 func (t *timerQueueProcessorBase) deleteWorkflowExecution(task *persistence.TimerTaskInfo) error {
 	op := func() error {
 		return t.executionManager.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
@@ -759,3 +911,32 @@ func (t *timerQueueProcessorBase) getTimerTaskType(taskType int) string {
 	}
 	return "UnKnown"
 }
+
+func (e *timerQueueTaskExecutor) execute() error {
+	if !e.filtered {
+		shouldProcessTask, err := e.processor.timerProcessor.getTaskFilter()(e.task)
+		if err != nil {
+			return err
+		}
+		e.shouldProcessTask = shouldProcessTask
+		e.filtered = true
+	}
+
+	var err error
+	e.scope, err = e.processor.processTaskOnce(nil, e.task, e.shouldProcessTask, e.logger)
+	return e.processor.handleTaskError(e.scope, e.startTime, nil, err, e.logger)
+}
+
+func (e *timerQueueTaskExecutor) handleErr(err error) error {
+	e.attempt++
+	e.processor.reportTaskAttempt(e.scope, e.attempt, err, e.logger)
+	if e.filtered && e.processor.shouldMoveTaskToDLQ(e.attempt, err) &&
+		e.processor.moveTaskToDLQ(e.task, e.scope, e.attempt, err, e.logger) {
+		return nil
+	}
+	return err
+}
+
+func (e *timerQueueTaskExecutor) ack() {
+	e.processor.ackTaskOnce(e.task, e.scope, e.shouldProcessTask, e.startTime, e.attempt)
+}
@@ -31,15 +31,26 @@ import (
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
 
-const identityHistoryService = "history-service"
+const (
+	identityHistoryService = "history-service"
+
+	parentClosePolicyReason = "by parent close policy"
+)
 
 type (
+	childForParentClosePolicy struct {
+		domainID    string
+		workflowID  string
+		childPolicy workflow.ChildPolicy
+	}
+
 	transferQueueActiveProcessorImpl struct {
 		currentClusterName string
 		shard              ShardContext
@@ -297,6 +308,10 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(task *persistence
 	} else if !ok {
 		return nil
 	}
+	if msBuilder.IsWorkflowExecutionPaused() {
+		// activity task is dispatched again when the execution is resumed
+		return nil
+	}
 
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
 	// release the context lock since we no longer need mutable state builder and
@@ -341,6 +356,10 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 	} else if !ok {
 		return nil
 	}
+	if msBuilder.IsWorkflowExecutionPaused() {
+		// decision task is dispatched again when the execution is resumed
+		return nil
+	}
 
 	executionInfo := msBuilder.GetExecutionInfo()
 	workflowTimeout := executionInfo.WorkflowTimeout
@@ -422,6 +441,11 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 	visibilityMemo := getVisibilityMemo(startEvent)
 	searchAttr := executionInfo.SearchAttributes
 
+	children, err := t.getChildrenForParentClosePolicy(domainID, msBuilder)
+	if err != nil {
+		return err
+	}
+
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
@@ -455,8 +479,139 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 		case *workflow.EntityNotExistsError:
 			err = nil
 		}
+		if err != nil {
+			return err
+		}
 	}
-	return err
+
+	return t.applyParentClosePolicy(task, children)
+}
+
+// getChildrenForParentClosePolicy returns the started children of a closed workflow which are still pending,
+// along with the child policy each of them was initiated with
+func (t *transferQueueActiveProcessorImpl) getChildrenForParentClosePolicy(
+	domainID string,
+	msBuilder mutableState,
+) ([]*childForParentClosePolicy, error) {
+
+	pendingChildren := msBuilder.GetPendingChildExecutionInfos()
+	if len(pendingChildren) == 0 {
+		return nil, nil
+	}
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		return nil, err
+	}
+	if !t.shard.GetConfig().EnableParentClosePolicy(domainEntry.GetInfo().Name) {
+		return nil, nil
+	}
+
+	var children []*childForParentClosePolicy
+	for _, ci := range pendingChildren {
+		if ci.StartedID == common.EmptyEventID {
+			// the child will not be started as its parent is no longer running
+			continue
+		}
+		initiatedEvent, ok := msBuilder.GetChildExecutionInitiatedEvent(ci.InitiatedID)
+		if !ok {
+			return nil, &workflow.InternalServiceError{Message: "Unable to get child execution initiated event."}
+		}
+		childPolicy := initiatedEvent.StartChildWorkflowExecutionInitiatedEventAttributes.GetChildPolicy()
+		if childPolicy == workflow.ChildPolicyAbandon {
+			continue
+		}
+
+		childDomainID := domainID
+		if ci.DomainName != "" && ci.DomainName != domainEntry.GetInfo().Name {
+			childDomainEntry, err := t.shard.GetDomainCache().GetDomain(ci.DomainName)
+			if err != nil {
+				if _, ok := err.(*workflow.EntityNotExistsError); ok {
+					// the domain of the child got deleted, so there is nothing left to apply the policy to
+					continue
+				}
+				return nil, err
+			}
+			childDomainID = childDomainEntry.GetInfo().ID
+		}
+		children = append(children, &childForParentClosePolicy{
+			domainID:    childDomainID,
+			workflowID:  ci.StartedWorkflowID,
+			childPolicy: childPolicy,
+		})
+	}
+	return children, nil
+}
+
+// applyParentClosePolicy terminates or requests cancellation of the children of a closed workflow,
+// children may live on any shard and in any domain, so requests are routed through the history client
+func (t *transferQueueActiveProcessorImpl) applyParentClosePolicy(
+	task *persistence.TransferTaskInfo,
+	children []*childForParentClosePolicy,
+) error {
+
+	for _, child := range children {
+		// a child which continued as new is still pending in its parent, so the request targets
+		// the current run of the child rather than the run which was started by the parent, the
+		// request only applies if the current run of the child is still owned by this parent
+		childExecution := &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(child.workflowID),
+		}
+		parentExecution := &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(task.WorkflowID),
+			RunId:      common.StringPtr(task.RunID),
+		}
+
+		var op func() error
+		switch child.childPolicy {
+		case workflow.ChildPolicyTerminate:
+			op = func() error {
+				return t.historyClient.TerminateWorkflowExecution(nil, &h.TerminateWorkflowExecutionRequest{
+					DomainUUID: common.StringPtr(child.domainID),
+					TerminateRequest: &workflow.TerminateWorkflowExecutionRequest{
+						Domain:            common.StringPtr(child.domainID),
+						WorkflowExecution: childExecution,
+						Reason:            common.StringPtr(parentClosePolicyReason),
+						Identity:          common.StringPtr(identityHistoryService),
+					},
+					ExternalWorkflowExecution: parentExecution,
+					ChildWorkflowOnly:         common.BoolPtr(true),
+				})
+			}
+		case workflow.ChildPolicyRequestCancel:
+			op = func() error {
+				return t.historyClient.RequestCancelWorkflowExecution(nil, &h.RequestCancelWorkflowExecutionRequest{
+					DomainUUID: common.StringPtr(child.domainID),
+					CancelRequest: &workflow.RequestCancelWorkflowExecutionRequest{
+						Domain:            common.StringPtr(child.domainID),
+						WorkflowExecution: childExecution,
+						Identity:          common.StringPtr(identityHistoryService),
+						RequestId:         common.StringPtr(uuid.New()),
+					},
+					ExternalWorkflowExecution: parentExecution,
+					ChildWorkflowOnly:         common.BoolPtr(true),
+				})
+			}
+		default:
+			continue
+		}
+
+		err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+		switch err.(type) {
+		case nil:
+			t.metricsClient.IncCounter(metrics.TransferActiveTaskCloseExecutionScope, metrics.ParentClosePolicyProcessedCounter)
+		case *workflow.EntityNotExistsError, *workflow.CancellationAlreadyRequestedError:
+			// the child already completed or is being cancelled, this could also be a duplicate processing of the task
+		case *workflow.DomainNotActiveError:
+			// the child lives in a domain which is active in another cluster, this cluster cannot act on it
+			t.logger.Warn("Unable to apply parent close policy to child in domain not active in this cluster.",
+				tag.WorkflowDomainID(child.domainID),
+				tag.WorkflowID(child.workflowID),
+				tag.Error(err))
+		default:
+			return err
+		}
+	}
+	return nil
 }
 
 func (t *transferQueueActiveProcessorImpl) processCancelExecution(task *persistence.TransferTaskInfo) (retError error) {
@@ -897,6 +1052,12 @@ func (t *transferQueueActiveProcessorImpl) processRecordWorkflowStartedOrUpsertH
 	executionTimestamp := getWorkflowExecutionTimestamp(msBuilder, startEvent)
 	visibilityMemo := getVisibilityMemo(startEvent)
 	searchAttr := copySearchAttributes(executionInfo.SearchAttributes)
+	if executionInfo.Paused {
+		if searchAttr == nil {
+			searchAttr = make(map[string][]byte)
+		}
+		searchAttr[definition.Paused] = []byte("true")
+	}
 
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
@@ -45,6 +45,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -711,6 +712,149 @@ func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_NoParent()
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_NoParent_HasChildren() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	msBuilder, event := s.createClosedWorkflowWithChildren(execution)
+
+	transferTask := &persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: execution.GetWorkflowId(),
+		RunID:      execution.GetRunId(),
+		TaskID:     int64(59),
+		TaskList:   "some random task list",
+		TaskType:   persistence.TransferTaskTypeCloseExecution,
+		ScheduleID: event.GetEventId(),
+	}
+
+	persistenceMutableState := createMutableState(msBuilder)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything).Return(nil).Once()
+	s.mockHistoryClient.On("TerminateWorkflowExecution", nil, &history.TerminateWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(s.domainID),
+		TerminateRequest: &workflow.TerminateWorkflowExecutionRequest{
+			Domain:            common.StringPtr(s.domainID),
+			WorkflowExecution: &workflow.WorkflowExecution{WorkflowId: common.StringPtr("child to terminate")},
+			Reason:            common.StringPtr(parentClosePolicyReason),
+			Identity:          common.StringPtr(identityHistoryService),
+		},
+		ExternalWorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: execution.WorkflowId,
+			RunId:      execution.RunId,
+		},
+		ChildWorkflowOnly: common.BoolPtr(true),
+	}).Return(nil).Once()
+	s.mockHistoryClient.On("RequestCancelWorkflowExecution", nil, mock.MatchedBy(func(request *history.RequestCancelWorkflowExecutionRequest) bool {
+		return request.GetDomainUUID() == s.domainID &&
+			request.CancelRequest.WorkflowExecution.GetWorkflowId() == "child to cancel" &&
+			request.CancelRequest.WorkflowExecution.RunId == nil &&
+			request.GetChildWorkflowOnly() &&
+			request.ExternalWorkflowExecution.GetWorkflowId() == execution.GetWorkflowId() &&
+			request.ExternalWorkflowExecution.GetRunId() == execution.GetRunId()
+	})).Return(&workflow.CancellationAlreadyRequestedError{}).Once()
+
+	_, err := s.transferQueueActiveProcessor.process(transferTask, true)
+	s.Nil(err)
+	s.mockHistoryClient.AssertExpectations(s.T())
+}
+
+func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_NoParent_HasChildren_PolicyDisabled() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	msBuilder, event := s.createClosedWorkflowWithChildren(execution)
+	s.mockShard.GetConfig().EnableParentClosePolicy = dynamicconfig.GetBoolPropertyFnFilteredByDomain(false)
+
+	transferTask := &persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: execution.GetWorkflowId(),
+		RunID:      execution.GetRunId(),
+		TaskID:     int64(59),
+		TaskList:   "some random task list",
+		TaskType:   persistence.TransferTaskTypeCloseExecution,
+		ScheduleID: event.GetEventId(),
+	}
+
+	persistenceMutableState := createMutableState(msBuilder)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything).Return(nil).Once()
+
+	_, err := s.transferQueueActiveProcessor.process(transferTask, true)
+	s.Nil(err)
+	s.mockHistoryClient.AssertNotCalled(s.T(), "TerminateWorkflowExecution", mock.Anything, mock.Anything)
+	s.mockHistoryClient.AssertNotCalled(s.T(), "RequestCancelWorkflowExecution", mock.Anything, mock.Anything)
+}
+
+// createClosedWorkflowWithChildren creates a completed workflow with a started child for each child policy,
+// and a child which was never started
+func (s *transferQueueActiveProcessorSuite) createClosedWorkflowWithChildren(
+	execution workflow.WorkflowExecution,
+) (mutableState, *workflow.HistoryEvent) {
+
+	workflowType := "some random workflow type"
+	taskListName := "some random task list"
+
+	msBuilder := newMutableStateBuilderWithReplicationStateWithEventV2(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := msBuilder.AddWorkflowExecutionStartedEvent(
+		s.domainEntry,
+		execution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	event := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, taskListName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.StartedID, nil, "some random identity")
+	decisionCompletedID := event.GetEventId()
+
+	children := []struct {
+		workflowID  string
+		childPolicy workflow.ChildPolicy
+		started     bool
+	}{
+		{workflowID: "child to terminate", childPolicy: workflow.ChildPolicyTerminate, started: true},
+		{workflowID: "child to cancel", childPolicy: workflow.ChildPolicyRequestCancel, started: true},
+		{workflowID: "child to abandon", childPolicy: workflow.ChildPolicyAbandon, started: true},
+		{workflowID: "child not started", childPolicy: workflow.ChildPolicyTerminate, started: false},
+	}
+	for _, child := range children {
+		initiatedEvent, _, err := msBuilder.AddStartChildWorkflowExecutionInitiatedEvent(decisionCompletedID, uuid.New(),
+			&workflow.StartChildWorkflowExecutionDecisionAttributes{
+				WorkflowId:                          common.StringPtr(child.workflowID),
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+				ChildPolicy:                         common.ChildPolicyPtr(child.childPolicy),
+			})
+		s.Nil(err)
+		if child.started {
+			addChildWorkflowExecutionStartedEvent(msBuilder, initiatedEvent.GetEventId(), "", child.workflowID, uuid.New(), workflowType)
+		}
+	}
+
+	event = addCompleteWorkflowEvent(msBuilder, decisionCompletedID, nil)
+	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", s.version).Return(s.mockClusterMetadata.GetCurrentClusterName())
+	msBuilder.UpdateReplicationStateLastEventID(s.version, event.GetEventId())
+	return msBuilder, event
+}
+
 func (s *transferQueueActiveProcessorSuite) TestProcessCancelExecution_Success() {
 
 	execution := workflow.WorkflowExecution{
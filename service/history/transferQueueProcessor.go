@@ -206,6 +206,47 @@ func (t *transferQueueProcessorImpl) UnlockTaskPrrocessing() {
 	t.taskAllocator.unlock()
 }
 
+// ProcessTaskOnce processes a transfer task once outside of the regular queue processing,
+// using the active or standby processor which owns the task
+func (t *transferQueueProcessorImpl) ProcessTaskOnce(task *persistence.TransferTaskInfo) error {
+	processors := []processor{t.activeTaskProcessor}
+	for _, standbyTaskProcessor := range t.standbyTaskProcessors {
+		processors = append(processors, standbyTaskProcessor)
+	}
+
+	for _, processor := range processors {
+		shouldProcessTask, err := processor.getTaskFilter()(task)
+		if err != nil {
+			return err
+		}
+		if !shouldProcessTask {
+			continue
+		}
+
+		_, err = processor.process(task, true)
+		return processTaskOnceError(err)
+	}
+	return nil
+}
+
+// GetReadLevel returns the read level of the active transfer task processor
+func (t *transferQueueProcessorImpl) GetReadLevel() int64 {
+	return t.activeTaskProcessor.getQueueReadLevel()
+}
+
+// IsTaskLoaded returns whether the transfer task has already been read by the active or a standby task processor
+func (t *transferQueueProcessorImpl) IsTaskLoaded(taskID int64) bool {
+	if taskID <= t.activeTaskProcessor.getQueueReadLevel() {
+		return true
+	}
+	for _, standbyTaskProcessor := range t.standbyTaskProcessors {
+		if taskID <= standbyTaskProcessor.getQueueReadLevel() {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *transferQueueProcessorImpl) completeTransferLoop() {
 	timer := time.NewTimer(t.config.TransferProcessorCompleteTransferInterval())
 	defer timer.Stop()
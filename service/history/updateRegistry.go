@@ -0,0 +1,160 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	// updateRegistry keeps track of the callers waiting for the result of workflow updates. The updates are
+	// delivered to the worker through the history, and answered together with the next completed decision task.
+	updateRegistry interface {
+		registerUpdate(id string) <-chan struct{}
+		completeUpdate(id string, result *workflow.WorkflowUpdateResult)
+		unblockUpdates()
+		failUpdates(err error)
+		getResult(id string) (*workflow.WorkflowUpdateResult, error)
+		removeUpdate(id string)
+	}
+
+	updateRegistryImpl struct {
+		sync.Mutex
+		updates map[string]*pendingUpdate
+	}
+
+	pendingUpdate struct {
+		termCh     chan struct{}
+		terminated bool
+		// number of callers waiting for the update, the retries of a request wait for the same update
+		waiters int
+		// result is the answer of the worker, if the result is not set when the update is terminated
+		// the workflow is closed before the worker handled the update
+		result *workflow.WorkflowUpdateResult
+		// err is set when the update is failed before the worker handled it
+		err error
+	}
+)
+
+var _ updateRegistry = (*updateRegistryImpl)(nil)
+
+var (
+	errUpdateNotExists = &workflow.InternalServiceError{Message: "update does not exist"}
+	// errUpdateEvicted is retryable, the retried request with the same request ID waits for the same update
+	errUpdateEvicted = &workflow.InternalServiceError{Message: "Workflow execution was unloaded before the update was handled, retry with the same request ID."}
+)
+
+func newUpdateRegistry() updateRegistry {
+	return &updateRegistryImpl{
+		updates: make(map[string]*pendingUpdate),
+	}
+}
+
+// registerUpdate adds a caller waiting for an update to the registry, the returned channel is closed once the
+// update is terminated. Registering the same update again returns the same channel, and each registration
+// must be paired with a removeUpdate.
+func (r *updateRegistryImpl) registerUpdate(id string) <-chan struct{} {
+	r.Lock()
+	defer r.Unlock()
+
+	u, ok := r.updates[id]
+	if !ok {
+		u = &pendingUpdate{
+			termCh: make(chan struct{}),
+		}
+		r.updates[id] = u
+	}
+	u.waiters++
+	return u.termCh
+}
+
+// completeUpdate terminates the update with the result of the worker, unknown updates are ignored since
+// nobody is waiting for them
+func (r *updateRegistryImpl) completeUpdate(id string, result *workflow.WorkflowUpdateResult) {
+	r.Lock()
+	defer r.Unlock()
+
+	if u, ok := r.updates[id]; ok && !u.terminated {
+		u.terminate(result, nil)
+	}
+}
+
+// unblockUpdates terminates all updates without a result, for example when the workflow is closed
+func (r *updateRegistryImpl) unblockUpdates() {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, u := range r.updates {
+		if !u.terminated {
+			u.terminate(nil, nil)
+		}
+	}
+}
+
+// failUpdates terminates all updates with the error, for example when the registry is evicted from the
+// history cache and the result of the worker would not be delivered to it anymore
+func (r *updateRegistryImpl) failUpdates(err error) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, u := range r.updates {
+		if !u.terminated {
+			u.terminate(nil, err)
+		}
+	}
+}
+
+// getResult returns the result of the terminated update
+func (r *updateRegistryImpl) getResult(id string) (*workflow.WorkflowUpdateResult, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	u, ok := r.updates[id]
+	if !ok {
+		return nil, errUpdateNotExists
+	}
+	return u.result, u.err
+}
+
+// removeUpdate removes a caller waiting for the update, the update is removed from the registry
+// once no caller is waiting for it anymore
+func (r *updateRegistryImpl) removeUpdate(id string) {
+	r.Lock()
+	defer r.Unlock()
+
+	u, ok := r.updates[id]
+	if !ok {
+		return
+	}
+	u.waiters--
+	if u.waiters <= 0 {
+		delete(r.updates, id)
+	}
+}
+
+func (u *pendingUpdate) terminate(result *workflow.WorkflowUpdateResult, err error) {
+	u.terminated = true
+	u.result = result
+	u.err = err
+	close(u.termCh)
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	updateRegistrySuite struct {
+		suite.Suite
+		updateRegistry updateRegistry
+	}
+)
+
+func TestUpdateRegistrySuite(t *testing.T) {
+	s := new(updateRegistrySuite)
+	suite.Run(t, s)
+}
+
+func (s *updateRegistrySuite) SetupTest() {
+	s.updateRegistry = newUpdateRegistry()
+}
+
+func (s *updateRegistrySuite) TestRegisterUpdate() {
+	termCh := s.updateRegistry.registerUpdate("update-id")
+	s.assertNotTerminated(termCh)
+
+	// registering the same update again, e.g. when the update request is retried, waits for the same result
+	s.Equal(termCh, s.updateRegistry.registerUpdate("update-id"))
+}
+
+func (s *updateRegistrySuite) TestCompleteUpdate() {
+	completedCh := s.updateRegistry.registerUpdate("completed-id")
+	pendingCh := s.updateRegistry.registerUpdate("pending-id")
+
+	result := &workflow.WorkflowUpdateResult{
+		ResultType: workflow.UpdateResultTypeAccepted.Ptr(),
+		Result:     []byte("result"),
+	}
+	s.updateRegistry.completeUpdate("completed-id", result)
+	// updates nobody is waiting for are ignored
+	s.updateRegistry.completeUpdate("unknown-id", result)
+
+	<-completedCh
+	completedResult, err := s.updateRegistry.getResult("completed-id")
+	s.NoError(err)
+	s.Equal(result, completedResult)
+	s.assertNotTerminated(pendingCh)
+
+	// completed updates are not completed again
+	s.updateRegistry.completeUpdate("completed-id", &workflow.WorkflowUpdateResult{})
+	completedResult, err = s.updateRegistry.getResult("completed-id")
+	s.NoError(err)
+	s.Equal(result, completedResult)
+}
+
+func (s *updateRegistrySuite) TestUnblockUpdates() {
+	completedCh := s.updateRegistry.registerUpdate("completed-id")
+	pendingCh := s.updateRegistry.registerUpdate("pending-id")
+	result := &workflow.WorkflowUpdateResult{ResultType: workflow.UpdateResultTypeRejected.Ptr()}
+	s.updateRegistry.completeUpdate("completed-id", result)
+
+	s.updateRegistry.unblockUpdates()
+	<-completedCh
+	<-pendingCh
+	completedResult, err := s.updateRegistry.getResult("completed-id")
+	s.NoError(err)
+	s.Equal(result, completedResult)
+	pendingResult, err := s.updateRegistry.getResult("pending-id")
+	s.NoError(err)
+	s.Nil(pendingResult)
+
+	// terminated updates are not terminated again
+	s.updateRegistry.unblockUpdates()
+}
+
+func (s *updateRegistrySuite) TestFailUpdates() {
+	completedCh := s.updateRegistry.registerUpdate("completed-id")
+	pendingCh := s.updateRegistry.registerUpdate("pending-id")
+	result := &workflow.WorkflowUpdateResult{ResultType: workflow.UpdateResultTypeAccepted.Ptr()}
+	s.updateRegistry.completeUpdate("completed-id", result)
+
+	s.updateRegistry.failUpdates(errUpdateEvicted)
+	<-completedCh
+	<-pendingCh
+	completedResult, err := s.updateRegistry.getResult("completed-id")
+	s.NoError(err)
+	s.Equal(result, completedResult)
+	pendingResult, err := s.updateRegistry.getResult("pending-id")
+	s.Equal(errUpdateEvicted, err)
+	s.Nil(pendingResult)
+}
+
+func (s *updateRegistrySuite) TestRemoveUpdate() {
+	s.updateRegistry.registerUpdate("update-id")
+	s.updateRegistry.removeUpdate("update-id")
+
+	_, err := s.updateRegistry.getResult("update-id")
+	s.Equal(errUpdateNotExists, err)
+}
+
+func (s *updateRegistrySuite) TestRemoveUpdate_MultipleWaiters() {
+	s.updateRegistry.registerUpdate("update-id")
+	termCh := s.updateRegistry.registerUpdate("update-id")
+	result := &workflow.WorkflowUpdateResult{ResultType: workflow.UpdateResultTypeAccepted.Ptr()}
+	s.updateRegistry.completeUpdate("update-id", result)
+
+	// the update is kept until the last waiting caller got its result
+	<-termCh
+	s.updateRegistry.removeUpdate("update-id")
+	waiterResult, err := s.updateRegistry.getResult("update-id")
+	s.NoError(err)
+	s.Equal(result, waiterResult)
+
+	s.updateRegistry.removeUpdate("update-id")
+	_, err = s.updateRegistry.getResult("update-id")
+	s.Equal(errUpdateNotExists, err)
+}
+
+func (s *updateRegistrySuite) assertNotTerminated(termCh <-chan struct{}) {
+	select {
+	case <-termCh:
+		s.Fail("update should not be terminated")
+	default:
+	}
+}
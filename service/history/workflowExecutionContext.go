@@ -50,6 +50,7 @@ type (
 		loadWorkflowExecution() (mutableState, error)
 		loadExecutionStats() (*persistence.ExecutionStats, error)
 		clear()
+		unload()
 
 		lock(ctx context.Context) error
 		unlock()
@@ -134,6 +135,8 @@ type (
 
 		mutex           locks.Mutex
 		msBuilder       mutableState
+		queryRegistry   queryRegistry
+		updateRegistry  updateRegistry
 		stats           *persistence.ExecutionStats
 		updateCondition int64
 	}
@@ -169,6 +172,8 @@ func newWorkflowExecutionContext(
 		metricsClient:     shard.GetMetricsClient(),
 		timeSource:        shard.GetTimeSource(),
 		mutex:             locks.NewMutex(),
+		queryRegistry:     newQueryRegistry(),
+		updateRegistry:    newUpdateRegistry(),
 		stats: &persistence.ExecutionStats{
 			HistorySize: 0,
 		},
@@ -183,12 +188,21 @@ func (c *workflowExecutionContextImpl) unlock() {
 	c.mutex.Unlock()
 }
 
+// clear drops the cached mutable state, the registries are kept since they belong to the context and are
+// attached to the reloaded mutable state, so the waiting callers are still answered
 func (c *workflowExecutionContextImpl) clear() {
 	c.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.WorkflowContextCleared)
 	c.msBuilder = nil
 	c.stats = nil
 }
 
+// unload is called once the context is removed from the history cache, the results of the worker are
+// delivered to the registries of a new context, so the callers waiting on this context are failed to retry
+func (c *workflowExecutionContextImpl) unload() {
+	c.queryRegistry.failQueries(errQueryEvicted)
+	c.updateRegistry.failUpdates(errUpdateEvicted)
+}
+
 func (c *workflowExecutionContextImpl) getDomainID() string {
 	return c.domainID
 }
@@ -250,12 +264,16 @@ func (c *workflowExecutionContextImpl) loadWorkflowExecutionInternal() error {
 		return err
 	}
 
-	c.msBuilder = newMutableStateBuilder(
+	msBuilder := newMutableStateBuilder(
 		c.shard,
 		c.shard.GetEventsCache(),
 		c.logger,
 	)
-	c.msBuilder.Load(response.State)
+	msBuilder.Load(response.State)
+	// buffered queries and waiting updates are kept in memory only, so they must survive reloading the mutable state
+	msBuilder.queryRegistry = c.queryRegistry
+	msBuilder.updateRegistry = c.updateRegistry
+	c.msBuilder = msBuilder
 	c.stats = response.State.ExecutionStats
 	c.updateCondition = response.State.ExecutionInfo.NextEventID
 
@@ -499,6 +517,13 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNew(
 		c.msBuilder.IsWorkflowExecutionRunning(),
 	))
 
+	// queries waiting for the next decision task can be dispatched directly once the workflow is closed,
+	// while updates not yet handled by the worker will never be answered
+	if !c.msBuilder.IsWorkflowExecutionRunning() {
+		c.queryRegistry.unblockQueries()
+		c.updateRegistry.unblockUpdates()
+	}
+
 	// finally emit session stats
 	domainName := c.getDomainName()
 	emitWorkflowHistoryStats(
@@ -612,6 +637,7 @@ func (c *workflowExecutionContextImpl) persistFirstWorkflowEvents(
 			Info:        historyGarbageCleanupInfo(domainID, workflowID, runID),
 			BranchToken: branchToken,
 			Events:      events,
+			DomainID:    domainID,
 			// TransactionID is set by shard context
 		},
 	)
@@ -654,6 +680,7 @@ func (c *workflowExecutionContextImpl) persistNonFirstWorkflowEvents(
 			IsNewBranch: false,
 			BranchToken: branchToken,
 			Events:      events,
+			DomainID:    domainID,
 			// TransactionID is set by shard context
 		},
 	)
@@ -53,7 +53,8 @@ func scheduleDecision(
 	logger log.Logger,
 ) error {
 
-	if mutableState.HasPendingDecisionTask() {
+	// decisions are held while the execution is paused, they are scheduled once the execution is resumed
+	if mutableState.HasPendingDecisionTask() || mutableState.IsWorkflowExecutionPaused() {
 		return nil
 	}
 
@@ -38,9 +38,12 @@ import (
 const (
 	uploadHistoryActivityFnName = "uploadHistoryActivity"
 	deleteHistoryActivityFnName = "deleteHistoryActivity"
+	verifyHistoryActivityFnName = "verifyHistoryActivity"
 
 	errDeleteHistoryV1 = "failed to delete history from events_v1"
 	errDeleteHistoryV2 = "failed to delete history from events_v2"
+	errHistoryMismatch = "archived history does not match persisted history"
+	errGetArchiver     = "failed to get history archiver"
 
 	errActivityPanic       = "cadenceInternal:Panic"
 	errTimeoutStartToClose = "cadenceInternal:Timeout START_TO_CLOSE"
@@ -50,6 +53,7 @@ const (
 var (
 	uploadHistoryActivityNonRetryableErrors = []string{errActivityPanic, carchiver.ErrArchiveNonRetriable.Error(), errTimeoutStartToClose, errTimeoutHeartbeat}
 	deleteHistoryActivityNonRetryableErrors = []string{errDeleteHistoryV1, errDeleteHistoryV2}
+	verifyHistoryActivityNonRetryableErrors = []string{errActivityPanic, errHistoryMismatch, errGetArchiver, errTimeoutStartToClose}
 	errContextTimeout                       = errors.New("activity aborted because context timed out")
 )
 
@@ -86,6 +90,43 @@ func uploadHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 	}, carchiver.GetHeartbeatArchiveOption())
 }
 
+// verifyHistoryActivity reads archived history back and compares it with the history in persistence.
+// method will retry all errors except a mismatch between the two histories and timeout errors.
+func verifyHistoryActivity(ctx context.Context, request ArchiveRequest) (err error) {
+	container := ctx.Value(bootstrapContainerKey).(*BootstrapContainer)
+	scope := container.MetricsClient.Scope(metrics.ArchiverVerifyHistoryActivityScope, metrics.DomainTag(request.DomainName))
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := tagLoggerWithRequest(tagLoggerWithActivityInfo(container.Logger, activity.GetInfo(ctx)), request)
+	historyArchiver, err := getHistoryArchiver(container, request.URI)
+	if err != nil {
+		return cadence.NewCustomError(errGetArchiver, err.Error())
+	}
+
+	persisted, err := digestPersistedHistory(container, request)
+	if err != nil {
+		logger.Warn("failed to read persisted history for verification", tag.Error(err))
+		return err
+	}
+	archived, err := digestArchivedHistory(ctx, historyArchiver, request.URI, &carchiver.GetHistoryRequest{
+		DomainID:             request.DomainID,
+		WorkflowID:           request.WorkflowID,
+		RunID:                request.RunID,
+		CloseFailoverVersion: common.Int64Ptr(request.CloseFailoverVersion),
+		PageSize:             verifyHistoryPageSize,
+	})
+	if err != nil {
+		logger.Warn("failed to read archived history for verification", tag.Error(err))
+		return err
+	}
+	if err := persisted.compare(archived); err != nil {
+		scope.IncCounter(metrics.ArchiverHistoryMismatchCount)
+		logger.Error(errHistoryMismatch, tag.Error(err))
+		return cadence.NewCustomError(errHistoryMismatch, err.Error())
+	}
+	return nil
+}
+
 // deleteHistoryActivity deletes workflow execution history from persistence.
 // method will retry all retryable operations until context expires.
 // method will always return either: nil, contextTimeoutErr or an error from deleteHistoryActivityNonRetryableErrors.
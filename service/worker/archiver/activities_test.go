@@ -370,3 +370,66 @@ func getCanceledContext() context.Context {
 	cancel()
 	return ctx
 }
+
+func (s *activitiesSuite) TestVerifyHistory_Success() {
+	s.metricsClient.On("Scope", metrics.ArchiverVerifyHistoryActivityScope, []metrics.Tag{metrics.DomainTag(testDomainName)}).Return(s.metricsScope).Once()
+	s.historyArchiver.On("Get", mock.Anything, testArchivalURI, mock.Anything).Return(&carchiver.GetHistoryResponse{
+		HistoryBatches: testHistoryBatches(5),
+	}, nil).Once()
+	s.archiverProvider.On("GetHistoryArchiver", testScheme, common.WorkerServiceName).Return(s.historyArchiver, nil).Once()
+
+	_, err := s.executeVerifyHistoryActivity(testHistoryBatches(5))
+	s.NoError(err)
+}
+
+func (s *activitiesSuite) TestVerifyHistory_Fail_Mismatch() {
+	s.metricsClient.On("Scope", metrics.ArchiverVerifyHistoryActivityScope, []metrics.Tag{metrics.DomainTag(testDomainName)}).Return(s.metricsScope).Once()
+	s.metricsScope.On("IncCounter", metrics.ArchiverHistoryMismatchCount).Once()
+	s.historyArchiver.On("Get", mock.Anything, testArchivalURI, mock.Anything).Return(&carchiver.GetHistoryResponse{
+		HistoryBatches: testHistoryBatches(5)[:2],
+	}, nil).Once()
+	s.archiverProvider.On("GetHistoryArchiver", testScheme, common.WorkerServiceName).Return(s.historyArchiver, nil).Once()
+
+	_, err := s.executeVerifyHistoryActivity(testHistoryBatches(5))
+	s.Equal(errHistoryMismatch, err.Error())
+}
+
+func (s *activitiesSuite) TestVerifyHistory_Fail_ReadArchivedHistory() {
+	s.metricsClient.On("Scope", metrics.ArchiverVerifyHistoryActivityScope, []metrics.Tag{metrics.DomainTag(testDomainName)}).Return(s.metricsScope).Once()
+	s.historyArchiver.On("Get", mock.Anything, testArchivalURI, mock.Anything).Return(nil, errors.New("some random error")).Once()
+	s.archiverProvider.On("GetHistoryArchiver", testScheme, common.WorkerServiceName).Return(s.historyArchiver, nil).Once()
+
+	_, err := s.executeVerifyHistoryActivity(testHistoryBatches(5))
+	s.Error(err)
+	s.NotEqual(errHistoryMismatch, err.Error())
+}
+
+func (s *activitiesSuite) executeVerifyHistoryActivity(persisted []*shared.History) (interface{}, error) {
+	mockHistoryV2Manager := &mocks.HistoryV2Manager{}
+	mockHistoryV2Manager.On("ReadHistoryBranchByBatch", mock.MatchedBy(func(req *persistence.ReadHistoryBranchRequest) bool {
+		return req.MinEventID == common.FirstEventID
+	})).Return(&persistence.ReadHistoryBranchByBatchResponse{History: persisted}, nil)
+	mockHistoryV2Manager.On("ReadHistoryBranchByBatch", mock.Anything).Return(nil, &shared.EntityNotExistsError{})
+	container := &BootstrapContainer{
+		Logger:           s.logger,
+		MetricsClient:    s.metricsClient,
+		HistoryV2Manager: mockHistoryV2Manager,
+		ArchiverProvider: s.archiverProvider,
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	request := ArchiveRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+		EventStoreVersion:    persistence.EventStoreVersionV2,
+		URI:                  testArchivalURI,
+	}
+	return env.ExecuteActivity(verifyHistoryActivity, request)
+}
@@ -42,6 +42,7 @@ type (
 		logger        log.Logger
 		metricsClient metrics.Client
 		concurrency   int
+		verifyHistory bool
 		requestCh     workflow.Channel
 		resultCh      workflow.Channel
 	}
@@ -53,6 +54,7 @@ func NewArchiver(
 	logger log.Logger,
 	metricsClient metrics.Client,
 	concurrency int,
+	verifyHistory bool,
 	requestCh workflow.Channel,
 ) Archiver {
 	return &archiver{
@@ -60,6 +62,7 @@ func NewArchiver(
 		logger:        logger,
 		metricsClient: metricsClient,
 		concurrency:   concurrency,
+		verifyHistory: verifyHistory,
 		requestCh:     requestCh,
 		resultCh:      workflow.NewChannel(ctx),
 	}
@@ -77,7 +80,7 @@ func (a *archiver) Start() {
 				if more := a.requestCh.Receive(ctx, &request); !more {
 					break
 				}
-				handleRequest(ctx, a.logger, a.metricsClient, request)
+				handleRequest(ctx, a.logger, a.metricsClient, request, a.verifyHistory)
 				handledHashes = append(handledHashes, hash(request))
 			}
 			a.resultCh.Send(ctx, handledHashes)
@@ -99,7 +102,7 @@ func (a *archiver) Finished() []uint64 {
 	return handledHashes
 }
 
-func handleRequest(ctx workflow.Context, logger log.Logger, metricsClient metrics.Client, request ArchiveRequest) {
+func handleRequest(ctx workflow.Context, logger log.Logger, metricsClient metrics.Client, request ArchiveRequest, verifyHistory bool) {
 	sw := metricsClient.StartTimer(metrics.ArchiverScope, metrics.ArchiverHandleRequestLatency)
 	logger = tagLoggerWithRequest(logger, request)
 	ao := workflow.ActivityOptions{
@@ -123,6 +126,28 @@ func handleRequest(ctx workflow.Context, logger log.Logger, metricsClient metric
 	}
 	uploadSW.Stop()
 
+	if err == nil && verifyHistory {
+		ao = workflow.ActivityOptions{
+			ScheduleToStartTimeout: 2 * time.Minute,
+			StartToCloseTimeout:    2 * time.Minute,
+			RetryPolicy: &cadence.RetryPolicy{
+				InitialInterval:          time.Second,
+				BackoffCoefficient:       2.0,
+				ExpirationInterval:       4 * time.Minute,
+				NonRetriableErrorReasons: verifyHistoryActivityNonRetryableErrors,
+			},
+		}
+		actCtx = workflow.WithActivityOptions(ctx, ao)
+		if err := workflow.ExecuteActivity(actCtx, verifyHistoryActivityFnName, request).Get(actCtx, nil); err != nil {
+			// history stays in persistence rather than risking losing it, it is left as a zombie history
+			logger.Error("failed to verify archived history, will not delete history", tag.Error(err))
+			metricsClient.IncCounter(metrics.ArchiverScope, metrics.ArchiverVerifyFailedCount)
+			sw.Stop()
+			return
+		}
+		metricsClient.IncCounter(metrics.ArchiverScope, metrics.ArchiverVerifySuccessCount)
+	}
+
 	lao := workflow.LocalActivityOptions{
 		ScheduleToCloseTimeout: 1 * time.Minute,
 		RetryPolicy: &cadence.RetryPolicy{
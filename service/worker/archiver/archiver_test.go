@@ -55,6 +55,7 @@ func TestArchiverSuite(t *testing.T) {
 func (s *archiverSuite) SetupSuite() {
 	workflow.Register(handleRequestWorkflow)
 	workflow.Register(startAndFinishArchiverWorkflow)
+	workflow.Register(handleRequestWithVerificationWorkflow)
 }
 
 func (s *archiverSuite) SetupTest() {
@@ -158,6 +159,52 @@ func (s *archiverSuite) TestHandleRequest_LocalDeleteFailsThenSucceeds() {
 	s.NoError(env.GetWorkflowError())
 }
 
+func (s *archiverSuite) TestHandleRequest_VerifySuccess() {
+	archiverTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverUploadSuccessCount).Once()
+	archiverTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverVerifySuccessCount).Once()
+	archiverTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverDeleteLocalSuccessCount).Once()
+
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(verifyHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.ExecuteWorkflow(handleRequestWithVerificationWorkflow, ArchiveRequest{})
+
+	env.AssertExpectations(s.T())
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *archiverSuite) TestHandleRequest_VerifyFails_HistoryNotDeleted() {
+	archiverTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverUploadSuccessCount).Once()
+	archiverTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverVerifyFailedCount).Once()
+	archiverTestLogger.On("Error", mock.Anything, mock.Anything).Once()
+
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(verifyHistoryActivityFnName, mock.Anything, mock.Anything).Return(cadence.NewCustomError(errHistoryMismatch))
+	env.ExecuteWorkflow(handleRequestWithVerificationWorkflow, ArchiveRequest{})
+
+	env.AssertExpectations(s.T())
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *archiverSuite) TestHandleRequest_UploadFails_NotVerified() {
+	archiverTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverUploadFailedAllRetriesCount).Once()
+	archiverTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverDeleteLocalSuccessCount).Once()
+	archiverTestLogger.On("Error", mock.Anything, mock.Anything).Once()
+
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(errors.New("some random error"))
+	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.ExecuteWorkflow(handleRequestWithVerificationWorkflow, ArchiveRequest{})
+
+	env.AssertExpectations(s.T())
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
 func (s *archiverSuite) TestRunArchiver() {
 	numRequests := 1000
 	concurrency := 10
@@ -179,13 +226,18 @@ func (s *archiverSuite) TestRunArchiver() {
 }
 
 func handleRequestWorkflow(ctx workflow.Context, request ArchiveRequest) error {
-	handleRequest(ctx, archiverTestLogger, archiverTestMetrics, request)
+	handleRequest(ctx, archiverTestLogger, archiverTestMetrics, request, false)
+	return nil
+}
+
+func handleRequestWithVerificationWorkflow(ctx workflow.Context, request ArchiveRequest) error {
+	handleRequest(ctx, archiverTestLogger, archiverTestMetrics, request, true)
 	return nil
 }
 
 func startAndFinishArchiverWorkflow(ctx workflow.Context, concurrency int, numRequests int) error {
 	requestCh := workflow.NewBufferedChannel(ctx, numRequests)
-	archiver := NewArchiver(ctx, archiverTestLogger, archiverTestMetrics, concurrency, requestCh)
+	archiver := NewArchiver(ctx, archiverTestLogger, archiverTestMetrics, concurrency, false, requestCh)
 	archiver.Start()
 	sentHashes := make([]uint64, numRequests, numRequests)
 	workflow.Go(ctx, func(ctx workflow.Context) {
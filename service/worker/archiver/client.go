@@ -60,9 +60,17 @@ type (
 		URI                  string
 	}
 
-	// Client is used to archive workflow histories
+	// ClientVisibilityRequest is the visibility archive request sent to the archiver client
+	ClientVisibilityRequest struct {
+		ArchiveRequest *carchiver.ArchiveVisibilityRequest
+		URI            string
+		CallerService  string
+	}
+
+	// Client is used to archive workflow histories and visibility records
 	Client interface {
 		Archive(context.Context, *ClientRequest) error
+		ArchiveVisibility(context.Context, *ClientVisibilityRequest) error
 	}
 
 	client struct {
@@ -120,6 +128,36 @@ func (c *client) Archive(ctx context.Context, request *ClientRequest) error {
 	return c.sendArchiveSignal(ctx, request.ArchiveRequest, taggedLogger)
 }
 
+// ArchiveVisibility archives a closed workflow visibility record inline.
+// Visibility records are small, so unlike history they are never handed off to the archival system workflow.
+func (c *client) ArchiveVisibility(ctx context.Context, request *ClientVisibilityRequest) (err error) {
+	c.metricsClient.IncCounter(metrics.ArchiverClientScope, metrics.ArchiverClientVisibilityArchiveAttemptCount)
+	defer func() {
+		if err != nil {
+			c.metricsClient.IncCounter(metrics.ArchiverClientScope, metrics.ArchiverClientVisibilityArchiveFailureCount)
+			c.logger.Error("failed to perform workflow visibility archival",
+				tag.ArchivalCallerServiceName(request.CallerService),
+				tag.ArchivalRequestDomainID(request.ArchiveRequest.DomainID),
+				tag.ArchivalRequestWorkflowID(request.ArchiveRequest.WorkflowID),
+				tag.ArchivalRequestRunID(request.ArchiveRequest.RunID),
+				tag.ArchivalURI(request.URI),
+				tag.Error(err))
+		}
+	}()
+
+	scheme, err := common.GetArchivalScheme(request.URI)
+	if err != nil {
+		return err
+	}
+
+	visibilityArchiver, err := c.archiverProvider.GetVisibilityArchiver(scheme, request.CallerService)
+	if err != nil {
+		return err
+	}
+
+	return visibilityArchiver.Archive(ctx, request.URI, request.ArchiveRequest)
+}
+
 func (c *client) archiveInline(ctx context.Context, request *ClientRequest, taggedLogger log.Logger) (err error) {
 	defer func() {
 		if err != nil {
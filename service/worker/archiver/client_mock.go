@@ -46,3 +46,17 @@ func (_m *ClientMock) Archive(_a0 context.Context, _a1 *ClientRequest) error {
 
 	return r0
 }
+
+// ArchiveVisibility provides a mock function with given fields: _a0, _a1
+func (_m *ClientMock) ArchiveVisibility(_a0 context.Context, _a1 *ClientVisibilityRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *ClientVisibilityRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/cadence/activity"
+	cclient "go.uber.org/cadence/client"
 	"go.uber.org/cadence/worker"
 	"go.uber.org/cadence/workflow"
 )
@@ -46,8 +47,11 @@ type (
 	}
 
 	clientWorker struct {
-		worker      worker.Worker
-		domainCache cache.DomainCache
+		worker        worker.Worker
+		domainCache   cache.DomainCache
+		cadenceClient cclient.Client
+		config        *Config
+		logger        log.Logger
 	}
 
 	// BootstrapContainer contains everything need for bootstrapping
@@ -67,6 +71,9 @@ type (
 		ArchiverConcurrency           dynamicconfig.IntPropertyFn
 		ArchivalsPerIteration         dynamicconfig.IntPropertyFn
 		TimeLimitPerArchivalIteration dynamicconfig.DurationPropertyFn
+		EnableArchivalVerification    dynamicconfig.BoolPropertyFn
+		EnableArchivalScanner         dynamicconfig.BoolPropertyFn
+		ArchivalScannerSampleSize     dynamicconfig.IntPropertyFn
 	}
 
 	contextKey int
@@ -94,6 +101,9 @@ func init() {
 	workflow.RegisterWithOptions(archivalWorkflow, workflow.RegisterOptions{Name: archivalWorkflowFnName})
 	activity.RegisterWithOptions(uploadHistoryActivity, activity.RegisterOptions{Name: uploadHistoryActivityFnName})
 	activity.RegisterWithOptions(deleteHistoryActivity, activity.RegisterOptions{Name: deleteHistoryActivityFnName})
+	activity.RegisterWithOptions(verifyHistoryActivity, activity.RegisterOptions{Name: verifyHistoryActivityFnName})
+	workflow.RegisterWithOptions(archivalScannerWorkflow, workflow.RegisterOptions{Name: scannerWorkflowFnName})
+	activity.RegisterWithOptions(archivalScannerActivity, activity.RegisterOptions{Name: scannerActivityFnName})
 }
 
 // NewClientWorker returns a new ClientWorker
@@ -106,8 +116,11 @@ func NewClientWorker(container *BootstrapContainer) ClientWorker {
 		BackgroundActivityContext: actCtx,
 	}
 	return &clientWorker{
-		worker:      worker.New(container.PublicClient, common.SystemLocalDomainName, decisionTaskList, wo),
-		domainCache: container.DomainCache,
+		worker:        worker.New(container.PublicClient, common.SystemLocalDomainName, decisionTaskList, wo),
+		domainCache:   container.DomainCache,
+		cadenceClient: cclient.NewClient(container.PublicClient, common.SystemLocalDomainName, &cclient.Options{}),
+		config:        container.Config,
+		logger:        globalLogger,
 	}
 }
 
@@ -117,6 +130,9 @@ func (w *clientWorker) Start() error {
 		w.worker.Stop()
 		return err
 	}
+	if w.config.EnableArchivalScanner() {
+		go startScannerWorkflowWithRetry(w.cadenceClient, w.logger)
+	}
 	return nil
 }
 
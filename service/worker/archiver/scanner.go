@@ -0,0 +1,272 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	carchiver "github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"go.uber.org/cadence"
+	cshared "go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	cclient "go.uber.org/cadence/client"
+	"go.uber.org/cadence/workflow"
+)
+
+type (
+	// ScannerResult is the result of one run of archival scanner workflow
+	ScannerResult struct {
+		// Number of archived histories checked
+		CheckedCount int
+		// Number of archived histories found to be missing or incomplete
+		CorruptedCount int
+		// Number of archived histories which could not be checked
+		FailureCount int
+	}
+)
+
+const (
+	scannerWorkflowFnName = "archivalScannerWorkflow"
+	scannerActivityFnName = "archivalScannerActivity"
+	scannerWorkflowID     = "cadence-archival-scanner"
+
+	// scannerLookback is the span of close times sampled by each run, it matches the cron schedule
+	scannerLookback = 12 * time.Hour
+	// scannerArchivalDelay leaves time for the archival workflow to finish before its archives get sampled
+	scannerArchivalDelay = time.Hour
+	// scannerSamplePoolFactor is how many more records than the sample size are listed to sample from
+	scannerSamplePoolFactor = 4
+)
+
+var (
+	scannerWorkflowStartOptions = cclient.StartWorkflowOptions{
+		ID:                           scannerWorkflowID,
+		TaskList:                     decisionTaskList,
+		ExecutionStartToCloseTimeout: 6 * time.Hour,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		CronSchedule:                 "0 */12 * * *",
+	}
+
+	scannerActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    5 * time.Hour,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    10 * time.Second,
+			BackoffCoefficient: 1.7,
+			MaximumInterval:    5 * time.Minute,
+			ExpirationInterval: 5 * time.Hour,
+		},
+	}
+)
+
+// archivalScannerWorkflow periodically samples archived histories and checks that they are complete
+func archivalScannerWorkflow(ctx workflow.Context) (ScannerResult, error) {
+	var result ScannerResult
+	actCtx := workflow.WithActivityOptions(ctx, scannerActivityOptions)
+	err := workflow.ExecuteActivity(actCtx, scannerActivityFnName, workflow.Now(ctx)).Get(actCtx, &result)
+	return result, err
+}
+
+// archivalScannerActivity samples the runs of every domain which archives both history and visibility,
+// and checks the archived history of each of them against its archived visibility record.
+func archivalScannerActivity(ctx context.Context, scanTime time.Time) (ScannerResult, error) {
+	container := ctx.Value(bootstrapContainerKey).(*BootstrapContainer)
+	logger := tagLoggerWithActivityInfo(container.Logger, activity.GetInfo(ctx))
+	result := ScannerResult{}
+	if !container.Config.EnableArchivalScanner() {
+		return result, nil
+	}
+	sampleSize := container.Config.ArchivalScannerSampleSize()
+	for _, entry := range container.DomainCache.GetAllDomain() {
+		config := entry.GetConfig()
+		if config.HistoryArchivalStatus != shared.ArchivalStatusEnabled ||
+			config.VisibilityArchivalStatus != shared.ArchivalStatusEnabled {
+			continue
+		}
+		domainLogger := logger.WithTags(tag.WorkflowDomainName(entry.GetInfo().Name))
+		if err := scanDomain(ctx, container, domainLogger, entry, scanTime, sampleSize, &result); err != nil {
+			if contextExpired(ctx) {
+				return ScannerResult{}, err
+			}
+			container.MetricsClient.IncCounter(metrics.ArchiverScannerScope, metrics.ArchiverScannerFailureCount)
+			domainLogger.Error("failed to scan archived histories of domain", tag.Error(err))
+		}
+		activity.RecordHeartbeat(ctx, result)
+	}
+	return result, nil
+}
+
+func scanDomain(
+	ctx context.Context,
+	container *BootstrapContainer,
+	logger log.Logger,
+	entry *cache.DomainCacheEntry,
+	scanTime time.Time,
+	sampleSize int,
+	result *ScannerResult,
+) error {
+	info := entry.GetInfo()
+	config := entry.GetConfig()
+	historyArchiver, err := getHistoryArchiver(container, config.HistoryArchivalURI)
+	if err != nil {
+		return err
+	}
+	scheme, err := common.GetArchivalScheme(config.VisibilityArchivalURI)
+	if err != nil {
+		return err
+	}
+	visibilityArchiver, err := container.ArchiverProvider.GetVisibilityArchiver(scheme, common.WorkerServiceName)
+	if err != nil {
+		return err
+	}
+
+	// history and visibility record of a run are archived once the retention of the domain has passed since it closed
+	latestCloseTime := scanTime.Add(-time.Duration(config.Retention) * 24 * time.Hour).Add(-scannerArchivalDelay)
+	earliestCloseTime := latestCloseTime.Add(-scannerLookback)
+	resp, err := visibilityArchiver.Get(ctx, config.VisibilityArchivalURI, &carchiver.GetVisibilityRequest{
+		DomainID:          info.ID,
+		EarliestCloseTime: common.Int64Ptr(earliestCloseTime.UnixNano()),
+		LatestCloseTime:   common.Int64Ptr(latestCloseTime.UnixNano()),
+		PageSize:          sampleSize * scannerSamplePoolFactor,
+	})
+	if err != nil {
+		return err
+	}
+
+	executions := resp.Executions
+	rand.Shuffle(len(executions), func(i, j int) {
+		executions[i], executions[j] = executions[j], executions[i]
+	})
+	if len(executions) > sampleSize {
+		executions = executions[:sampleSize]
+	}
+	for _, execution := range executions {
+		err := CheckArchivedHistory(ctx, historyArchiver, config.HistoryArchivalURI, info.ID, execution)
+		switch err.(type) {
+		case nil:
+			result.CheckedCount++
+			container.MetricsClient.IncCounter(metrics.ArchiverScannerScope, metrics.ArchiverScannerCheckedCount)
+		case *archivedHistoryCorruptedError:
+			result.CheckedCount++
+			result.CorruptedCount++
+			container.MetricsClient.IncCounter(metrics.ArchiverScannerScope, metrics.ArchiverScannerCheckedCount)
+			container.MetricsClient.IncCounter(metrics.ArchiverScannerScope, metrics.ArchiverScannerCorruptedCount)
+			logger.Error("archived history is corrupted",
+				tag.WorkflowID(execution.Execution.GetWorkflowId()),
+				tag.WorkflowRunID(execution.Execution.GetRunId()),
+				tag.Error(err))
+		default:
+			if contextExpired(ctx) {
+				return err
+			}
+			result.FailureCount++
+			container.MetricsClient.IncCounter(metrics.ArchiverScannerScope, metrics.ArchiverScannerFailureCount)
+			logger.Warn("failed to check archived history",
+				tag.WorkflowID(execution.Execution.GetWorkflowId()),
+				tag.WorkflowRunID(execution.Execution.GetRunId()),
+				tag.Error(err))
+		}
+		activity.RecordHeartbeat(ctx, *result)
+	}
+	return nil
+}
+
+// CheckArchivedHistory returns an error for which IsArchivedHistoryCorruptedError is true if the archived history of execution
+// is missing or does not describe a complete history with the length recorded in its visibility record
+func CheckArchivedHistory(
+	ctx context.Context,
+	historyArchiver carchiver.HistoryArchiver,
+	URI string,
+	domainID string,
+	execution *shared.WorkflowExecutionInfo,
+) error {
+	digest, err := digestArchivedHistory(ctx, historyArchiver, URI, &carchiver.GetHistoryRequest{
+		DomainID:   domainID,
+		WorkflowID: execution.Execution.GetWorkflowId(),
+		RunID:      execution.Execution.GetRunId(),
+		PageSize:   verifyHistoryPageSize,
+	})
+	if err == carchiver.ErrHistoryNotExist {
+		return &archivedHistoryCorruptedError{cause: err}
+	}
+	if err != nil {
+		return err
+	}
+	if err := digest.validate(execution.GetHistoryLength()); err != nil {
+		return &archivedHistoryCorruptedError{cause: err}
+	}
+	return nil
+}
+
+type archivedHistoryCorruptedError struct {
+	cause error
+}
+
+func (e *archivedHistoryCorruptedError) Error() string {
+	return "archived history is corrupted: " + e.cause.Error()
+}
+
+// IsArchivedHistoryCorruptedError returns true if err was returned by CheckArchivedHistory because the archived history
+// is missing or corrupted, as opposed to because the archive could not be read
+func IsArchivedHistoryCorruptedError(err error) bool {
+	_, ok := err.(*archivedHistoryCorruptedError)
+	return ok
+}
+
+func getHistoryArchiver(container *BootstrapContainer, URI string) (carchiver.HistoryArchiver, error) {
+	scheme, err := common.GetArchivalScheme(URI)
+	if err != nil {
+		return nil, err
+	}
+	return container.ArchiverProvider.GetHistoryArchiver(scheme, common.WorkerServiceName)
+}
+
+func startScannerWorkflowWithRetry(publicClient cclient.Client, logger log.Logger) error {
+	policy := backoff.NewExponentialRetryPolicy(time.Second)
+	policy.SetMaximumInterval(time.Minute)
+	policy.SetExpirationInterval(backoff.NoInterval)
+	return backoff.Retry(func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		_, err := publicClient.StartWorkflow(ctx, scannerWorkflowStartOptions, scannerWorkflowFnName)
+		cancel()
+		if err != nil {
+			if _, ok := err.(*cshared.WorkflowExecutionAlreadyStartedError); ok {
+				return nil
+			}
+			logger.Error("error starting archival scanner workflow", tag.Error(err))
+			return err
+		}
+		logger.Info("archival scanner workflow successfully started")
+		return nil
+	}, policy, func(err error) bool {
+		return true
+	})
+}
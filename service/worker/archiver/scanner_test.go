@@ -0,0 +1,174 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	carchiver "github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	mmocks "github.com/uber/cadence/common/metrics/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/zap"
+)
+
+const (
+	testVisibilityScheme      = "testVisibilityScheme"
+	testVisibilityArchivalURI = testVisibilityScheme + "://visibility/archival"
+)
+
+type scannerSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	metricsClient      *mmocks.Client
+	archiverProvider   *provider.ArchiverProviderMock
+	historyArchiver    *carchiver.HistoryArchiverMock
+	visibilityArchiver *carchiver.VisibilityArchiverMock
+	domainCache        *cache.DomainCacheMock
+}
+
+func TestScannerSuite(t *testing.T) {
+	suite.Run(t, new(scannerSuite))
+}
+
+func (s *scannerSuite) SetupTest() {
+	s.metricsClient = &mmocks.Client{}
+	s.archiverProvider = &provider.ArchiverProviderMock{}
+	s.historyArchiver = &carchiver.HistoryArchiverMock{}
+	s.visibilityArchiver = &carchiver.VisibilityArchiverMock{}
+	s.domainCache = &cache.DomainCacheMock{}
+}
+
+func (s *scannerSuite) TearDownTest() {
+	s.metricsClient.AssertExpectations(s.T())
+	s.archiverProvider.AssertExpectations(s.T())
+	s.historyArchiver.AssertExpectations(s.T())
+	s.visibilityArchiver.AssertExpectations(s.T())
+}
+
+func (s *scannerSuite) TestWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(scannerActivityFnName, mock.Anything, mock.Anything).Return(ScannerResult{CheckedCount: 1}, nil)
+	env.ExecuteWorkflow(scannerWorkflowFnName)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *scannerSuite) TestScannerActivity_Disabled() {
+	result, err := s.executeScannerActivity(false)
+	s.NoError(err)
+	s.Equal(ScannerResult{}, result)
+}
+
+func (s *scannerSuite) TestScannerActivity() {
+	s.domainCache.On("GetAllDomain").Return(map[string]*cache.DomainCacheEntry{
+		"enabled-domain":  s.newDomainCacheEntry(testDomainID, shared.ArchivalStatusEnabled),
+		"disabled-domain": s.newDomainCacheEntry("disabled-domain-id", shared.ArchivalStatusDisabled),
+	})
+	s.archiverProvider.On("GetHistoryArchiver", testScheme, common.WorkerServiceName).Return(s.historyArchiver, nil).Once()
+	s.archiverProvider.On("GetVisibilityArchiver", testVisibilityScheme, common.WorkerServiceName).Return(s.visibilityArchiver, nil).Once()
+	s.visibilityArchiver.On("Get", mock.Anything, testVisibilityArchivalURI, mock.MatchedBy(func(req *carchiver.GetVisibilityRequest) bool {
+		return req.DomainID == testDomainID && *req.EarliestCloseTime < *req.LatestCloseTime
+	})).Return(&carchiver.GetVisibilityResponse{
+		Executions: []*shared.WorkflowExecutionInfo{
+			s.newExecutionInfo("complete", 5),
+			s.newExecutionInfo("partial", 5),
+			s.newExecutionInfo("missing", 5),
+		},
+	}, nil).Once()
+	s.historyArchiver.On("Get", mock.Anything, testArchivalURI, mock.MatchedBy(func(req *carchiver.GetHistoryRequest) bool {
+		return req.WorkflowID == "complete"
+	})).Return(&carchiver.GetHistoryResponse{HistoryBatches: testHistoryBatches(5)}, nil).Once()
+	s.historyArchiver.On("Get", mock.Anything, testArchivalURI, mock.MatchedBy(func(req *carchiver.GetHistoryRequest) bool {
+		return req.WorkflowID == "partial"
+	})).Return(&carchiver.GetHistoryResponse{HistoryBatches: testHistoryBatches(5)[:1]}, nil).Once()
+	s.historyArchiver.On("Get", mock.Anything, testArchivalURI, mock.MatchedBy(func(req *carchiver.GetHistoryRequest) bool {
+		return req.WorkflowID == "missing"
+	})).Return(nil, carchiver.ErrHistoryNotExist).Once()
+	s.metricsClient.On("IncCounter", metrics.ArchiverScannerScope, metrics.ArchiverScannerCheckedCount).Times(3)
+	s.metricsClient.On("IncCounter", metrics.ArchiverScannerScope, metrics.ArchiverScannerCorruptedCount).Twice()
+
+	result, err := s.executeScannerActivity(true)
+	s.NoError(err)
+	s.Equal(ScannerResult{CheckedCount: 3, CorruptedCount: 2}, result)
+}
+
+func (s *scannerSuite) executeScannerActivity(enabled bool) (ScannerResult, error) {
+	container := &BootstrapContainer{
+		Logger:           loggerimpl.NewLogger(zap.NewNop()),
+		MetricsClient:    s.metricsClient,
+		DomainCache:      s.domainCache,
+		ArchiverProvider: s.archiverProvider,
+		Config: &Config{
+			EnableArchivalScanner:     dynamicconfig.GetBoolPropertyFn(enabled),
+			ArchivalScannerSampleSize: dynamicconfig.GetIntPropertyFn(10),
+		},
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	val, err := env.ExecuteActivity(archivalScannerActivity, time.Now())
+	if err != nil {
+		return ScannerResult{}, err
+	}
+	var result ScannerResult
+	s.NoError(val.Get(&result))
+	return result, nil
+}
+
+func (s *scannerSuite) newDomainCacheEntry(domainID string, status shared.ArchivalStatus) *cache.DomainCacheEntry {
+	return cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: domainID, Name: domainID},
+		&persistence.DomainConfig{
+			Retention:                1,
+			HistoryArchivalStatus:    status,
+			HistoryArchivalURI:       testArchivalURI,
+			VisibilityArchivalStatus: status,
+			VisibilityArchivalURI:    testVisibilityArchivalURI,
+		},
+		"",
+		nil,
+	)
+}
+
+func (s *scannerSuite) newExecutionInfo(workflowID string, historyLength int64) *shared.WorkflowExecutionInfo {
+	return &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(testRunID),
+		},
+		HistoryLength: common.Int64Ptr(historyLength),
+	}
+}
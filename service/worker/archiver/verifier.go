@@ -0,0 +1,184 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	carchiver "github.com/uber/cadence/common/archiver"
+)
+
+const (
+	verifyHistoryPageSize = 250
+	verifyHistoryBlobSize = 2 * 1024 * 1024
+)
+
+type (
+	// historyDigest summarizes a workflow history so that two copies of it can be compared
+	// without holding either of them in memory
+	historyDigest struct {
+		EventCount    int64
+		FirstEventID  int64
+		LastEventID   int64
+		LastEventType shared.EventType
+		Checksum      uint64
+		// Contiguous is false if event IDs are not increasing by one
+		Contiguous bool
+
+		hasher hash64
+	}
+)
+
+type hash64 interface {
+	Write(p []byte) (int, error)
+	Sum64() uint64
+}
+
+var workflowCloseEventTypes = map[shared.EventType]struct{}{
+	shared.EventTypeWorkflowExecutionCompleted:      {},
+	shared.EventTypeWorkflowExecutionFailed:         {},
+	shared.EventTypeWorkflowExecutionTimedOut:       {},
+	shared.EventTypeWorkflowExecutionCanceled:       {},
+	shared.EventTypeWorkflowExecutionTerminated:     {},
+	shared.EventTypeWorkflowExecutionContinuedAsNew: {},
+}
+
+func newHistoryDigest() *historyDigest {
+	return &historyDigest{
+		Contiguous: true,
+		hasher:     fnv.New64a(),
+	}
+}
+
+// add folds the events of batches into the digest, batches must be added in event ID order
+func (d *historyDigest) add(batches []*shared.History) error {
+	for _, batch := range batches {
+		for _, event := range batch.Events {
+			// events are hashed in their json form, which is the form archivers store them in,
+			// so that the digest is not sensitive to how the copy being digested was decoded
+			data, err := json.Marshal(event)
+			if err != nil {
+				return err
+			}
+			d.hasher.Write(data)
+
+			if d.EventCount == 0 {
+				d.FirstEventID = event.GetEventId()
+			} else if event.GetEventId() != d.LastEventID+1 {
+				d.Contiguous = false
+			}
+			d.EventCount++
+			d.LastEventID = event.GetEventId()
+			d.LastEventType = event.GetEventType()
+		}
+	}
+	d.Checksum = d.hasher.Sum64()
+	return nil
+}
+
+// compare returns a description of the first difference found between two digests, or nil if there is none
+func (d *historyDigest) compare(other *historyDigest) error {
+	switch {
+	case d.EventCount != other.EventCount:
+		return fmt.Errorf("event count %v does not match %v", other.EventCount, d.EventCount)
+	case d.LastEventID != other.LastEventID:
+		return fmt.Errorf("last event ID %v does not match %v", other.LastEventID, d.LastEventID)
+	case d.Checksum != other.Checksum:
+		return fmt.Errorf("checksum %v does not match %v", other.Checksum, d.Checksum)
+	}
+	return nil
+}
+
+// validate returns an error if the digest does not describe a complete history of a closed workflow
+// with the given number of events
+func (d *historyDigest) validate(expectedEventCount int64) error {
+	switch {
+	case d.EventCount == 0:
+		return fmt.Errorf("history is empty")
+	case d.FirstEventID != common.FirstEventID:
+		return fmt.Errorf("first event ID is %v", d.FirstEventID)
+	case !d.Contiguous:
+		return fmt.Errorf("event IDs are not contiguous")
+	case expectedEventCount > 0 && d.EventCount != expectedEventCount:
+		return fmt.Errorf("event count %v does not match history length %v", d.EventCount, expectedEventCount)
+	}
+	if _, ok := workflowCloseEventTypes[d.LastEventType]; !ok {
+		return fmt.Errorf("last event type %v is not a workflow close event", d.LastEventType)
+	}
+	return nil
+}
+
+// digestPersistedHistory reads the history of request from persistence and returns its digest
+func digestPersistedHistory(container *BootstrapContainer, request ArchiveRequest) (*historyDigest, error) {
+	iterator, err := carchiver.NewHistoryIterator(&carchiver.ArchiveHistoryRequest{
+		ShardID:              request.ShardID,
+		DomainID:             request.DomainID,
+		DomainName:           request.DomainName,
+		WorkflowID:           request.WorkflowID,
+		RunID:                request.RunID,
+		EventStoreVersion:    request.EventStoreVersion,
+		BranchToken:          request.BranchToken,
+		NextEventID:          request.NextEventID,
+		CloseFailoverVersion: request.CloseFailoverVersion,
+	}, container.HistoryManager, container.HistoryV2Manager, verifyHistoryBlobSize, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	digest := newHistoryDigest()
+	for iterator.HasNext() {
+		blob, err := iterator.Next()
+		if err != nil {
+			return nil, err
+		}
+		if err := digest.add(blob.Body); err != nil {
+			return nil, err
+		}
+	}
+	return digest, nil
+}
+
+// digestArchivedHistory reads the history of request back from the archive and returns its digest
+func digestArchivedHistory(
+	ctx context.Context,
+	historyArchiver carchiver.HistoryArchiver,
+	URI string,
+	request *carchiver.GetHistoryRequest,
+) (*historyDigest, error) {
+	digest := newHistoryDigest()
+	for {
+		resp, err := historyArchiver.Get(ctx, URI, request)
+		if err != nil {
+			return nil, err
+		}
+		if err := digest.add(resp.HistoryBatches); err != nil {
+			return nil, err
+		}
+		if len(resp.NextPageToken) == 0 {
+			return digest, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type verifierSuite struct {
+	suite.Suite
+}
+
+func TestVerifierSuite(t *testing.T) {
+	suite.Run(t, new(verifierSuite))
+}
+
+func (s *verifierSuite) TestDigest_SameHistory() {
+	a := s.digest(testHistoryBatches(5))
+	b := s.digest(testHistoryBatches(5))
+	s.NoError(a.compare(b))
+	s.NoError(a.validate(5))
+}
+
+func (s *verifierSuite) TestDigest_MissingEvents() {
+	a := s.digest(testHistoryBatches(5))
+	b := s.digest(testHistoryBatches(5)[:1])
+	s.Error(a.compare(b))
+	s.Error(b.validate(5))
+}
+
+func (s *verifierSuite) TestDigest_ModifiedEvent() {
+	batches := testHistoryBatches(5)
+	a := s.digest(batches)
+	batches[0].Events[0].Version = common.Int64Ptr(1000)
+	b := s.digest(batches)
+	s.Error(a.compare(b))
+}
+
+func (s *verifierSuite) TestValidate_NotContiguous() {
+	batches := testHistoryBatches(5)
+	batches[1].Events[0].EventId = common.Int64Ptr(100)
+	s.Error(s.digest(batches).validate(0))
+}
+
+func (s *verifierSuite) TestValidate_NotClosed() {
+	batches := testHistoryBatches(5)
+	s.Error(s.digest(batches[:len(batches)-1]).validate(0))
+}
+
+func (s *verifierSuite) TestValidate_Empty() {
+	s.Error(newHistoryDigest().validate(0))
+}
+
+func (s *verifierSuite) digest(batches []*shared.History) *historyDigest {
+	digest := newHistoryDigest()
+	s.NoError(digest.add(batches))
+	return digest
+}
+
+// testHistoryBatches returns the history of a completed workflow with eventCount events,
+// batched two events at a time
+func testHistoryBatches(eventCount int64) []*shared.History {
+	var batches []*shared.History
+	for eventID := common.FirstEventID; eventID <= eventCount; eventID++ {
+		eventType := shared.EventTypeDecisionTaskScheduled
+		if eventID == eventCount {
+			eventType = shared.EventTypeWorkflowExecutionCompleted
+		}
+		event := &shared.HistoryEvent{
+			EventId:   common.Int64Ptr(eventID),
+			EventType: eventType.Ptr(),
+			Version:   common.Int64Ptr(testCloseFailoverVersion),
+		}
+		if eventID%2 == 1 {
+			batches = append(batches, &shared.History{})
+		}
+		batches[len(batches)-1].Events = append(batches[len(batches)-1].Events, event)
+	}
+	return batches
+}
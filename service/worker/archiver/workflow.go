@@ -34,6 +34,7 @@ type dynamicConfigResult struct {
 	ArchiverConcurrency   int
 	ArchivalsPerIteration int
 	TimelimitPerIteration time.Duration
+	EnableVerification    bool
 }
 
 func archivalWorkflow(ctx workflow.Context, carryover []ArchiveRequest) error {
@@ -74,11 +75,12 @@ func archivalWorkflowHelper(
 				ArchiverConcurrency:   config.ArchiverConcurrency(),
 				ArchivalsPerIteration: config.ArchivalsPerIteration(),
 				TimelimitPerIteration: timeLimit,
+				EnableVerification:    config.EnableArchivalVerification(),
 			}
 		}).Get(&dcResult)
 	requestCh := workflow.NewBufferedChannel(ctx, dcResult.ArchivalsPerIteration)
 	if archiver == nil {
-		archiver = NewArchiver(ctx, logger, metricsClient, dcResult.ArchiverConcurrency, requestCh)
+		archiver = NewArchiver(ctx, logger, metricsClient, dcResult.ArchiverConcurrency, dcResult.EnableVerification, requestCh)
 	}
 	archiverSW := metricsClient.StartTimer(metrics.ArchiverArchivalWorkflowScope, metrics.ArchiverHandleAllRequestsLatency)
 	archiver.Start()
@@ -64,6 +64,7 @@ func (s *workflowSuite) SetupTest() {
 		ArchiverConcurrency:           dynamicconfig.GetIntPropertyFn(0),
 		ArchivalsPerIteration:         dynamicconfig.GetIntPropertyFn(0),
 		TimeLimitPerArchivalIteration: dynamicconfig.GetDurationPropertyFn(MaxArchivalIterationTimeout()),
+		EnableArchivalVerification:    dynamicconfig.GetBoolPropertyFn(false),
 	}
 }
 
@@ -0,0 +1,92 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backfiller
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/worker"
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the visibility backfill sub-system
+	BootstrapParams struct {
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// MetadataManager is used to resolve the domain being backfilled
+		MetadataManager persistence.MetadataManager
+		// VisibilityManager is the database visibility store records are read from
+		VisibilityManager persistence.VisibilityManager
+		// ESVisibilityManager is the elastic search visibility store records are written to
+		ESVisibilityManager persistence.VisibilityManager
+	}
+
+	// Backfiller is the background sub-system that copies visibility records from the database
+	// visibility store into elastic search. It is also the context object that get's passed around
+	// within the backfill workflow / activities
+	Backfiller struct {
+		svcClient     workflowserviceclient.Interface
+		metricsClient metrics.Client
+		tallyScope    tally.Scope
+		logger        log.Logger
+		domainDB      persistence.MetadataManager
+		visibilityDB  persistence.VisibilityManager
+		visibilityES  persistence.VisibilityManager
+	}
+)
+
+// New returns a new instance of the visibility Backfiller
+func New(params *BootstrapParams) *Backfiller {
+	return &Backfiller{
+		svcClient:     params.ServiceClient,
+		metricsClient: params.MetricsClient,
+		tallyScope:    params.TallyScope,
+		logger:        params.Logger.WithTags(tag.ComponentVisibilityBackfiller),
+		domainDB:      params.MetadataManager,
+		visibilityDB:  params.VisibilityManager,
+		visibilityES:  params.ESVisibilityManager,
+	}
+}
+
+// Start starts the worker for the visibility backfill workflow
+func (s *Backfiller) Start() error {
+	workerOpts := worker.Options{
+		MetricsScope:              s.tallyScope,
+		BackgroundActivityContext: context.WithValue(context.Background(), backfillerContextKey, s),
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	worker := worker.New(s.svcClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	return worker.Start()
+}
@@ -0,0 +1,381 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backfiller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"golang.org/x/time/rate"
+)
+
+const (
+	backfillerContextKey = "backfillerContext"
+	// TaskListName is the tasklist name of the visibility backfill workflow
+	TaskListName = "cadence-sys-visibility-backfill-tasklist"
+	// WorkflowTypeName is the workflow type of the visibility backfill workflow
+	WorkflowTypeName = "cadence-sys-visibility-backfill-workflow"
+	// WorkflowIDPrefix is the prefix of the visibility backfill workflow id, it is followed by the domain name
+	WorkflowIDPrefix     = "cadence-sys-visibility-backfill-"
+	backfillActivityName = "cadence-sys-visibility-backfill-activity"
+	verifyActivityName   = "cadence-sys-visibility-backfill-verify-activity"
+	// InfiniteDuration is a long duration(20 yrs) we used for infinite workflow running
+	InfiniteDuration = 20 * 365 * 24 * time.Hour
+	verifyInterval   = 10 * time.Second
+	secondsInDay     = 24 * 60 * 60
+	// backfillVersion is the elastic search document version of backfilled records. It is lower than the
+	// task id of any record written by the history service, so backfilled records never overwrite them
+	backfillVersion = 0
+
+	// ErrReasonDomainNotExists is the error reason when the domain to backfill does not exist
+	ErrReasonDomainNotExists = "cadence-sys-visibility-backfill-domain-not-exists"
+
+	// below are default values for BackfillParams
+	DefaultRPS                      = 100
+	DefaultPageSize                 = 1000
+	DefaultVerifyTimeout            = 10 * time.Minute
+	DefaultActivityHeartBeatTimeout = time.Minute
+)
+
+type (
+	// BackfillParams is the parameters for visibility backfill workflow
+	BackfillParams struct {
+		// Domain whose visibility records are copied into elastic search
+		DomainName string
+
+		// Below are all optional
+		// Only records of workflows started no later than this time are backfilled. Newer records are
+		// expected to be dual-written to elastic search already. Default to the workflow start time
+		LatestStartTime time.Time
+		// Max number of records written to elastic search per second. Default to DefaultRPS
+		RPS int
+		// Number of records read from the database per page. Default to DefaultPageSize
+		PageSize int
+		// How long to wait for elastic search to catch up before giving up on count verification
+		VerifyTimeout time.Duration
+		// timeout for activity heartbeat
+		ActivityHeartBeatTimeout time.Duration
+	}
+
+	// HeartBeatDetails is the struct for heartbeat details, it is also the checkpoint the
+	// backfill activity resumes from after a retry
+	HeartBeatDetails struct {
+		// ClosedPhase is true once all open records are backfilled and closed records are being paged
+		ClosedPhase bool
+		// PageToken of the next page to read in the current phase
+		PageToken []byte
+		// Number of open records backfilled
+		OpenCount int64
+		// Number of closed records backfilled
+		ClosedCount int64
+	}
+
+	// BackfillResult is the result of visibility backfill workflow
+	BackfillResult struct {
+		// Number of open records backfilled
+		OpenCount int64
+		// Number of closed records backfilled
+		ClosedCount int64
+		// Number of records in elastic search started no later than LatestStartTime
+		ESCount int64
+		// Verified is true if elastic search contains at least as many records as were backfilled
+		Verified bool
+	}
+)
+
+var (
+	backfillActivityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:          10 * time.Second,
+		BackoffCoefficient:       1.7,
+		MaximumInterval:          5 * time.Minute,
+		ExpirationInterval:       InfiniteDuration,
+		NonRetriableErrorReasons: []string{ErrReasonDomainNotExists},
+	}
+
+	backfillActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    InfiniteDuration,
+		RetryPolicy:            &backfillActivityRetryPolicy,
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(BackfillWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	activity.RegisterWithOptions(BackfillActivity, activity.RegisterOptions{Name: backfillActivityName})
+	activity.RegisterWithOptions(VerifyActivity, activity.RegisterOptions{Name: verifyActivityName})
+}
+
+// BackfillWorkflow is the workflow that copies the visibility records of a domain from the database into elastic search
+func BackfillWorkflow(ctx workflow.Context, params BackfillParams) (BackfillResult, error) {
+	if err := validateParams(params); err != nil {
+		return BackfillResult{}, err
+	}
+	params = setDefaultParams(params)
+	if params.LatestStartTime.IsZero() {
+		params.LatestStartTime = workflow.Now(ctx)
+	}
+
+	opts := backfillActivityOptions
+	opts.HeartbeatTimeout = params.ActivityHeartBeatTimeout
+	var progress HeartBeatDetails
+	if err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, opts), backfillActivityName, params).Get(ctx, &progress); err != nil {
+		return BackfillResult{}, err
+	}
+
+	opts.StartToCloseTimeout = params.VerifyTimeout + params.ActivityHeartBeatTimeout
+	var result BackfillResult
+	err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, opts), verifyActivityName, params, progress).Get(ctx, &result)
+	return result, err
+}
+
+func validateParams(params BackfillParams) error {
+	if params.DomainName == "" {
+		return fmt.Errorf("must provide required parameter DomainName")
+	}
+	return nil
+}
+
+func setDefaultParams(params BackfillParams) BackfillParams {
+	if params.RPS <= 0 {
+		params.RPS = DefaultRPS
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = DefaultPageSize
+	}
+	if params.VerifyTimeout <= 0 {
+		params.VerifyTimeout = DefaultVerifyTimeout
+	}
+	if params.ActivityHeartBeatTimeout <= 0 {
+		params.ActivityHeartBeatTimeout = DefaultActivityHeartBeatTimeout
+	}
+	return params
+}
+
+// BackfillActivity pages through the open and then the closed visibility records of the domain in the database
+// and writes each of them to elastic search. Progress is checkpointed after every page through heartbeat.
+func BackfillActivity(ctx context.Context, params BackfillParams) (HeartBeatDetails, error) {
+	backfiller := ctx.Value(backfillerContextKey).(*Backfiller)
+	logger := getActivityLogger(ctx)
+
+	domain, err := getDomain(backfiller, params.DomainName)
+	if err != nil {
+		return HeartBeatDetails{}, err
+	}
+
+	hbd := HeartBeatDetails{}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &hbd); err != nil {
+			backfiller.metricsClient.IncCounter(metrics.VisibilityBackfillScope, metrics.VisibilityBackfillFailures)
+			logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+			hbd = HeartBeatDetails{}
+		}
+	}
+
+	rateLimiter := rate.NewLimiter(rate.Limit(params.RPS), params.RPS)
+	for {
+		request := &persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:        domain.Info.ID,
+			Domain:            domain.Info.Name,
+			EarliestStartTime: 0,
+			LatestStartTime:   params.LatestStartTime.UnixNano(),
+			PageSize:          params.PageSize,
+			NextPageToken:     hbd.PageToken,
+		}
+		var resp *persistence.ListWorkflowExecutionsResponse
+		if hbd.ClosedPhase {
+			resp, err = backfiller.visibilityDB.ListClosedWorkflowExecutions(request)
+		} else {
+			resp, err = backfiller.visibilityDB.ListOpenWorkflowExecutions(request)
+		}
+		if err != nil {
+			backfiller.metricsClient.IncCounter(metrics.VisibilityBackfillScope, metrics.VisibilityBackfillFailures)
+			logger.Error("Failed to read visibility records from database", tag.Error(err))
+			return HeartBeatDetails{}, err
+		}
+
+		for _, info := range resp.Executions {
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return HeartBeatDetails{}, err
+			}
+			// the checkpoint only moves once a page is fully written, records of a partially written
+			// page are written again after a retry, which is harmless as writes are idempotent
+			activity.RecordHeartbeat(ctx, hbd)
+			if err := backfillRecord(backfiller, domain, info, hbd.ClosedPhase); err != nil {
+				backfiller.metricsClient.IncCounter(metrics.VisibilityBackfillScope, metrics.VisibilityBackfillFailures)
+				logger.Error("Failed to write visibility record to elastic search",
+					tag.WorkflowID(info.Execution.GetWorkflowId()),
+					tag.WorkflowRunID(info.Execution.GetRunId()),
+					tag.Error(err))
+				return HeartBeatDetails{}, err
+			}
+		}
+
+		count := int64(len(resp.Executions))
+		backfiller.metricsClient.AddCounter(metrics.VisibilityBackfillScope, metrics.VisibilityBackfillRecordCount, count)
+		if hbd.ClosedPhase {
+			hbd.ClosedCount += count
+		} else {
+			hbd.OpenCount += count
+		}
+		hbd.PageToken = resp.NextPageToken
+		if len(hbd.PageToken) == 0 {
+			if hbd.ClosedPhase {
+				break
+			}
+			hbd.ClosedPhase = true
+		}
+		activity.RecordHeartbeat(ctx, hbd)
+	}
+
+	logger.Info("Finished backfilling visibility records",
+		tag.Counter(int(hbd.OpenCount+hbd.ClosedCount)))
+	return hbd, nil
+}
+
+// VerifyActivity waits until elastic search contains at least as many records of the domain
+// as were backfilled, or until the verify timeout expires
+func VerifyActivity(ctx context.Context, params BackfillParams, progress HeartBeatDetails) (BackfillResult, error) {
+	backfiller := ctx.Value(backfillerContextKey).(*Backfiller)
+	logger := getActivityLogger(ctx)
+
+	domain, err := getDomain(backfiller, params.DomainName)
+	if err != nil {
+		return BackfillResult{}, err
+	}
+
+	result := BackfillResult{
+		OpenCount:   progress.OpenCount,
+		ClosedCount: progress.ClosedCount,
+	}
+	expected := progress.OpenCount + progress.ClosedCount
+	// records written to elastic search after the backfill started are not in the database pages that were
+	// read, so only count records which could have been backfilled
+	query := fmt.Sprintf("StartTime <= %v", params.LatestStartTime.UnixNano())
+	deadline := time.Now().Add(params.VerifyTimeout)
+	for {
+		resp, err := backfiller.visibilityES.CountWorkflowExecutions(&persistence.CountWorkflowExecutionsRequest{
+			DomainUUID: domain.Info.ID,
+			Domain:     domain.Info.Name,
+			Query:      query,
+		})
+		if err != nil {
+			return BackfillResult{}, err
+		}
+		result.ESCount = resp.Count
+		if result.ESCount >= expected {
+			result.Verified = true
+			return result, nil
+		}
+		if time.Now().After(deadline) {
+			break
+		}
+		activity.RecordHeartbeat(ctx)
+		select {
+		case <-time.After(verifyInterval):
+		case <-ctx.Done():
+			return BackfillResult{}, ctx.Err()
+		}
+	}
+
+	backfiller.metricsClient.IncCounter(metrics.VisibilityBackfillScope, metrics.VisibilityBackfillCountMismatch)
+	logger.Warn(fmt.Sprintf("Elastic search has %v records while %v were backfilled", result.ESCount, expected))
+	return result, nil
+}
+
+func getDomain(backfiller *Backfiller, domainName string) (*persistence.GetDomainResponse, error) {
+	domain, err := backfiller.domainDB.GetDomain(&persistence.GetDomainRequest{Name: domainName})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			return nil, cadence.NewCustomError(ErrReasonDomainNotExists, domainName)
+		}
+		return nil, err
+	}
+	if domain.Info == nil || domain.Config == nil {
+		return nil, errors.New("domain info or config is missing")
+	}
+	return domain, nil
+}
+
+func backfillRecord(
+	backfiller *Backfiller,
+	domain *persistence.GetDomainResponse,
+	info *shared.WorkflowExecutionInfo,
+	closed bool,
+) error {
+	executionTimestamp := info.GetExecutionTime()
+	if executionTimestamp == 0 {
+		// records written before execution time was tracked
+		executionTimestamp = info.GetStartTime()
+	}
+	var searchAttributes map[string][]byte
+	if info.SearchAttributes != nil {
+		searchAttributes = info.SearchAttributes.IndexedFields
+	}
+
+	if !closed {
+		return backfiller.visibilityES.RecordWorkflowExecutionStarted(&persistence.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:         domain.Info.ID,
+			Domain:             domain.Info.Name,
+			Execution:          *info.Execution,
+			WorkflowTypeName:   info.Type.GetName(),
+			StartTimestamp:     info.GetStartTime(),
+			ExecutionTimestamp: executionTimestamp,
+			TaskID:             backfillVersion,
+			Memo:               info.Memo,
+			SearchAttributes:   searchAttributes,
+		})
+	}
+	return backfiller.visibilityES.RecordWorkflowExecutionClosed(&persistence.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:         domain.Info.ID,
+		Domain:             domain.Info.Name,
+		Execution:          *info.Execution,
+		WorkflowTypeName:   info.Type.GetName(),
+		StartTimestamp:     info.GetStartTime(),
+		ExecutionTimestamp: executionTimestamp,
+		CloseTimestamp:     info.GetCloseTime(),
+		Status:             info.GetCloseStatus(),
+		HistoryLength:      info.GetHistoryLength(),
+		RetentionSeconds:   int64(domain.Config.Retention) * secondsInDay,
+		TaskID:             backfillVersion,
+		Memo:               info.Memo,
+		SearchAttributes:   searchAttributes,
+	})
+}
+
+func getActivityLogger(ctx context.Context) log.Logger {
+	backfiller := ctx.Value(backfillerContextKey).(*Backfiller)
+	wfInfo := activity.GetInfo(ctx)
+	return backfiller.logger.WithTags(
+		tag.WorkflowID(wfInfo.WorkflowExecution.ID),
+		tag.WorkflowRunID(wfInfo.WorkflowExecution.RunID),
+		tag.WorkflowDomainName(wfInfo.WorkflowDomain),
+	)
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backfiller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	p "github.com/uber/cadence/common/persistence"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/zap"
+)
+
+const (
+	testDomainID   = "test-domain-id"
+	testDomainName = "test-domain-name"
+)
+
+type backfillerWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestBackfillerWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(backfillerWorkflowTestSuite))
+}
+
+func (s *backfillerWorkflowTestSuite) TestWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	progress := HeartBeatDetails{ClosedPhase: true, OpenCount: 1, ClosedCount: 2}
+	env.OnActivity(backfillActivityName, mock.Anything, mock.Anything).Return(progress, nil)
+	env.OnActivity(verifyActivityName, mock.Anything, mock.Anything, progress).
+		Return(BackfillResult{OpenCount: 1, ClosedCount: 2, ESCount: 3, Verified: true}, nil)
+	env.ExecuteWorkflow(WorkflowTypeName, BackfillParams{DomainName: testDomainName})
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result BackfillResult
+	s.NoError(env.GetWorkflowResult(&result))
+	s.True(result.Verified)
+	s.Equal(int64(3), result.ESCount)
+}
+
+func (s *backfillerWorkflowTestSuite) TestWorkflow_MissingDomain() {
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(WorkflowTypeName, BackfillParams{})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
+
+func (s *backfillerWorkflowTestSuite) TestBackfillActivity() {
+	domainDB := &mocks.MetadataManager{}
+	domainDB.On("GetDomain", &p.GetDomainRequest{Name: testDomainName}).Return(&p.GetDomainResponse{
+		Info:   &p.DomainInfo{ID: testDomainID, Name: testDomainName},
+		Config: &p.DomainConfig{Retention: 1},
+	}, nil)
+	visibilityDB := &mocks.VisibilityManager{}
+	visibilityDB.On("ListOpenWorkflowExecutions", mock.MatchedBy(func(req *p.ListWorkflowExecutionsRequest) bool {
+		return len(req.NextPageToken) == 0
+	})).Return(&p.ListWorkflowExecutionsResponse{
+		Executions:    []*shared.WorkflowExecutionInfo{s.newExecutionInfo("wid1", false)},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	visibilityDB.On("ListOpenWorkflowExecutions", mock.MatchedBy(func(req *p.ListWorkflowExecutionsRequest) bool {
+		return string(req.NextPageToken) == "token"
+	})).Return(&p.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{s.newExecutionInfo("wid2", false)},
+	}, nil).Once()
+	visibilityDB.On("ListClosedWorkflowExecutions", mock.Anything).Return(&p.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{s.newExecutionInfo("wid3", true)},
+	}, nil).Once()
+	visibilityES := &mocks.VisibilityManager{}
+	visibilityES.On("RecordWorkflowExecutionStarted", mock.MatchedBy(func(req *p.RecordWorkflowExecutionStartedRequest) bool {
+		return req.DomainUUID == testDomainID && req.TaskID == backfillVersion && req.ExecutionTimestamp == req.StartTimestamp
+	})).Return(nil).Twice()
+	visibilityES.On("RecordWorkflowExecutionClosed", mock.MatchedBy(func(req *p.RecordWorkflowExecutionClosedRequest) bool {
+		return req.DomainUUID == testDomainID && req.RetentionSeconds == secondsInDay &&
+			req.Status == shared.WorkflowExecutionCloseStatusCompleted
+	})).Return(nil).Once()
+
+	env := s.newActivityEnvironment(domainDB, visibilityDB, visibilityES)
+	params := setDefaultParams(BackfillParams{DomainName: testDomainName, LatestStartTime: time.Now()})
+	val, err := env.ExecuteActivity(backfillActivityName, params)
+	s.NoError(err)
+	var progress HeartBeatDetails
+	s.NoError(val.Get(&progress))
+	s.Equal(HeartBeatDetails{ClosedPhase: true, OpenCount: 2, ClosedCount: 1}, progress)
+	visibilityDB.AssertExpectations(s.T())
+	visibilityES.AssertExpectations(s.T())
+}
+
+func (s *backfillerWorkflowTestSuite) TestBackfillActivity_DomainNotExists() {
+	domainDB := &mocks.MetadataManager{}
+	domainDB.On("GetDomain", mock.Anything).Return(nil, &shared.EntityNotExistsError{})
+
+	env := s.newActivityEnvironment(domainDB, &mocks.VisibilityManager{}, &mocks.VisibilityManager{})
+	params := setDefaultParams(BackfillParams{DomainName: testDomainName, LatestStartTime: time.Now()})
+	_, err := env.ExecuteActivity(backfillActivityName, params)
+	s.Error(err)
+	s.Contains(err.Error(), ErrReasonDomainNotExists)
+}
+
+func (s *backfillerWorkflowTestSuite) TestVerifyActivity() {
+	domainDB := &mocks.MetadataManager{}
+	domainDB.On("GetDomain", mock.Anything).Return(&p.GetDomainResponse{
+		Info:   &p.DomainInfo{ID: testDomainID, Name: testDomainName},
+		Config: &p.DomainConfig{Retention: 1},
+	}, nil)
+	visibilityES := &mocks.VisibilityManager{}
+	visibilityES.On("CountWorkflowExecutions", mock.Anything).Return(&p.CountWorkflowExecutionsResponse{Count: 3}, nil)
+
+	env := s.newActivityEnvironment(domainDB, &mocks.VisibilityManager{}, visibilityES)
+	params := setDefaultParams(BackfillParams{DomainName: testDomainName, LatestStartTime: time.Now()})
+	val, err := env.ExecuteActivity(verifyActivityName, params, HeartBeatDetails{OpenCount: 1, ClosedCount: 2})
+	s.NoError(err)
+	var result BackfillResult
+	s.NoError(val.Get(&result))
+	s.Equal(BackfillResult{OpenCount: 1, ClosedCount: 2, ESCount: 3, Verified: true}, result)
+}
+
+func (s *backfillerWorkflowTestSuite) newActivityEnvironment(
+	domainDB p.MetadataManager,
+	visibilityDB p.VisibilityManager,
+	visibilityES p.VisibilityManager,
+) *testsuite.TestActivityEnvironment {
+	backfiller := &Backfiller{
+		metricsClient: metrics.NewClient(tally.NoopScope, metrics.Worker),
+		logger:        loggerimpl.NewLogger(zap.NewNop()),
+		domainDB:      domainDB,
+		visibilityDB:  visibilityDB,
+		visibilityES:  visibilityES,
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetTestTimeout(time.Second * 5)
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), backfillerContextKey, backfiller),
+	})
+	return env
+}
+
+func (s *backfillerWorkflowTestSuite) newExecutionInfo(workflowID string, closed bool) *shared.WorkflowExecutionInfo {
+	info := &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(workflowID + "-run"),
+		},
+		Type:      &shared.WorkflowType{Name: common.StringPtr("test-workflow-type")},
+		StartTime: common.Int64Ptr(time.Now().UnixNano()),
+	}
+	if closed {
+		info.CloseTime = common.Int64Ptr(time.Now().UnixNano())
+		info.CloseStatus = shared.WorkflowExecutionCloseStatusCompleted.Ptr()
+		info.HistoryLength = common.Int64Ptr(10)
+	}
+	return info
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/backoff"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+var retryForeverPolicy = newRetryForeverPolicy()
+
+func (s *Scanner) getTimerTasks(shard *shardScan, pageToken []byte) (*p.GetTimerIndexTasksResponse, error) {
+	var err error
+	var resp *p.GetTimerIndexTasksResponse
+	s.retryForever(func() error {
+		resp, err = shard.db.GetTimerIndexTasks(&p.GetTimerIndexTasksRequest{
+			MinTimestamp:  epochStartTime,
+			MaxTimestamp:  maximumTime,
+			BatchSize:     s.cfg.PageSize(),
+			NextPageToken: pageToken,
+		})
+		return err
+	})
+	return resp, err
+}
+
+func (s *Scanner) listConcreteExecutions(shard *shardScan, pageToken []byte) (*p.ListConcreteExecutionsResponse, error) {
+	var err error
+	var resp *p.ListConcreteExecutionsResponse
+	s.retryForever(func() error {
+		resp, err = shard.db.ListConcreteExecutions(&p.ListConcreteExecutionsRequest{
+			PageSize:  s.cfg.PageSize(),
+			PageToken: pageToken,
+		})
+		return err
+	})
+	return resp, err
+}
+
+func (s *Scanner) listCurrentExecutions(shard *shardScan, pageToken []byte) (*p.ListCurrentExecutionsResponse, error) {
+	var err error
+	var resp *p.ListCurrentExecutionsResponse
+	s.retryForever(func() error {
+		resp, err = shard.db.ListCurrentExecutions(&p.ListCurrentExecutionsRequest{
+			PageSize:  s.cfg.PageSize(),
+			PageToken: pageToken,
+		})
+		return err
+	})
+	return resp, err
+}
+
+func (s *Scanner) getWorkflowExecution(shard *shardScan, key *executionKey) (*p.GetWorkflowExecutionResponse, error) {
+	var err error
+	var resp *p.GetWorkflowExecutionResponse
+	s.retryOnServiceBusy(func() error {
+		resp, err = shard.db.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+			DomainID: key.DomainID,
+			Execution: shared.WorkflowExecution{
+				WorkflowId: &key.WorkflowID,
+				RunId:      &key.RunID,
+			},
+		})
+		return err
+	})
+	return resp, err
+}
+
+func (s *Scanner) getCurrentExecution(shard *shardScan, key *executionKey) (*p.GetCurrentExecutionResponse, error) {
+	var err error
+	var resp *p.GetCurrentExecutionResponse
+	s.retryOnServiceBusy(func() error {
+		resp, err = shard.db.GetCurrentExecution(&p.GetCurrentExecutionRequest{
+			DomainID:   key.DomainID,
+			WorkflowID: key.WorkflowID,
+		})
+		return err
+	})
+	return resp, err
+}
+
+// readHistory returns the events in the range [firstEventID, nextEventID) of the given execution
+func (s *Scanner) readHistory(shard *shardScan, info *p.WorkflowExecutionInfo, firstEventID int64, nextEventID int64) ([]*shared.HistoryEvent, error) {
+	var events []*shared.HistoryEvent
+	var pageToken []byte
+	for {
+		var err error
+		var batch []*shared.HistoryEvent
+		s.retryOnServiceBusy(func() error {
+			if info.EventStoreVersion == p.EventStoreVersionV2 {
+				var resp *p.ReadHistoryBranchResponse
+				resp, err = s.historyV2DB.ReadHistoryBranch(&p.ReadHistoryBranchRequest{
+					BranchToken:   info.BranchToken,
+					MinEventID:    firstEventID,
+					MaxEventID:    nextEventID,
+					PageSize:      s.cfg.PageSize(),
+					NextPageToken: pageToken,
+					ShardID:       &shard.shardID,
+				})
+				if err == nil {
+					batch, pageToken = resp.HistoryEvents, resp.NextPageToken
+				}
+				return err
+			}
+			var resp *p.GetWorkflowExecutionHistoryResponse
+			resp, err = s.historyDB.GetWorkflowExecutionHistory(&p.GetWorkflowExecutionHistoryRequest{
+				DomainID: info.DomainID,
+				Execution: shared.WorkflowExecution{
+					WorkflowId: &info.WorkflowID,
+					RunId:      &info.RunID,
+				},
+				FirstEventID:  firstEventID,
+				NextEventID:   nextEventID,
+				PageSize:      s.cfg.PageSize(),
+				NextPageToken: pageToken,
+			})
+			if err == nil {
+				batch, pageToken = resp.History.Events, resp.NextPageToken
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, batch...)
+		if len(pageToken) == 0 {
+			return events, nil
+		}
+	}
+}
+
+func (s *Scanner) deleteExecution(shard *shardScan, key *executionKey) error {
+	return s.retryOnServiceBusy(func() error {
+		return shard.db.DeleteWorkflowExecution(&p.DeleteWorkflowExecutionRequest{
+			DomainID:   key.DomainID,
+			WorkflowID: key.WorkflowID,
+			RunID:      key.RunID,
+		})
+	})
+}
+
+func (s *Scanner) deleteCurrentExecution(shard *shardScan, key *executionKey) error {
+	// the delete is conditional on the run id, so a newer run is never affected
+	return s.retryOnServiceBusy(func() error {
+		return shard.db.DeleteCurrentWorkflowExecution(&p.DeleteCurrentWorkflowExecutionRequest{
+			DomainID:   key.DomainID,
+			WorkflowID: key.WorkflowID,
+			RunID:      key.RunID,
+		})
+	})
+}
+
+func (s *Scanner) deleteHistory(shard *shardScan, info *p.WorkflowExecutionInfo) error {
+	return s.retryOnServiceBusy(func() error {
+		if info.EventStoreVersion == p.EventStoreVersionV2 {
+			return s.historyV2DB.DeleteHistoryBranch(&p.DeleteHistoryBranchRequest{
+				BranchToken: info.BranchToken,
+				ShardID:     &shard.shardID,
+			})
+		}
+		return s.historyDB.DeleteWorkflowExecutionHistory(&p.DeleteWorkflowExecutionHistoryRequest{
+			DomainID: info.DomainID,
+			Execution: shared.WorkflowExecution{
+				WorkflowId: &info.WorkflowID,
+				RunId:      &info.RunID,
+			},
+		})
+	})
+}
+
+func (s *Scanner) retryForever(op func() error) error {
+	return backoff.Retry(op, retryForeverPolicy, s.isRetryable)
+}
+
+// retryOnServiceBusy retries only when persistence is overloaded, any other error
+// is returned to the caller as it could be the result of a corrupted record
+func (s *Scanner) retryOnServiceBusy(op func() error) error {
+	return backoff.Retry(op, retryForeverPolicy, func(err error) bool {
+		_, ok := err.(*shared.ServiceBusyError)
+		return ok && s.Alive()
+	})
+}
+
+func newRetryForeverPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(250 * time.Millisecond)
+	policy.SetExpirationInterval(backoff.NoInterval)
+	policy.SetMaximumInterval(30 * time.Second)
+	return policy
+}
+
+func (s *Scanner) isRetryable(err error) bool {
+	return s.Alive()
+}
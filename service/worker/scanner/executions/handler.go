@@ -0,0 +1,328 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/scanner/executor"
+)
+
+type (
+	handlerStatus = executor.TaskStatus
+
+	historyStatus int
+
+	// shardScan holds the state of a scan over a single history shard
+	shardScan struct {
+		shardID   int
+		db        p.ExecutionManager
+		startTime time.Time
+		// timers is the set of executions which had at least one timer task when the scan started
+		timers map[executionKey]struct{}
+	}
+)
+
+const (
+	handlerStatusDone = executor.TaskStatusDone
+	handlerStatusErr  = executor.TaskStatusErr
+)
+
+const (
+	historyStatusOK historyStatus = iota
+	historyStatusMissing
+	historyStatusTruncated
+)
+
+// scanHandler scans all the executions of a given history shard
+//
+// The handler proceeds as follows
+//   - Load the set of executions which have timer tasks in the shard
+//   - Go through all the open concrete executions of the shard which have been idle for
+//     executionGracePeriod, read the first and the last event batch of their history to
+//     make sure it exists and is not truncated, then load their mutable state to make sure
+//     there is at least one timer task if they have pending activities or timers
+//   - Go through all the current executions of the shard and make sure the run they
+//     point to exists
+func (s *Scanner) scanHandler(shardID int) handlerStatus {
+	db, err := s.executionDB.NewExecutionManager(shardID)
+	if err != nil {
+		s.logger.Error("failed to create execution manager", tag.ShardID(shardID), tag.Error(err))
+		return handlerStatusErr
+	}
+	defer db.Close()
+
+	shard := &shardScan{
+		shardID:   shardID,
+		db:        db,
+		startTime: time.Now(),
+	}
+	if err := s.loadTimerTasks(shard); err != nil {
+		s.logger.Error("executions scanner failed to load timer tasks", tag.ShardID(shardID), tag.Error(err))
+		return handlerStatusErr
+	}
+	if err := s.scanConcreteExecutions(shard); err != nil {
+		s.logger.Error("executions scanner failed to list executions", tag.ShardID(shardID), tag.Error(err))
+		return handlerStatusErr
+	}
+	if err := s.scanCurrentExecutions(shard); err != nil {
+		s.logger.Error("executions scanner failed to list current executions", tag.ShardID(shardID), tag.Error(err))
+		return handlerStatusErr
+	}
+	s.logger.Info("executions scanner processed shard", tag.ShardID(shardID))
+	return handlerStatusDone
+}
+
+func (s *Scanner) loadTimerTasks(shard *shardScan) error {
+	shard.timers = make(map[executionKey]struct{})
+	var pageToken []byte
+	for {
+		resp, err := s.getTimerTasks(shard, pageToken)
+		if err != nil {
+			return err
+		}
+		for _, task := range resp.Timers {
+			shard.timers[executionKey{
+				DomainID:   task.DomainID,
+				WorkflowID: task.WorkflowID,
+				RunID:      task.RunID,
+			}] = struct{}{}
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (s *Scanner) scanConcreteExecutions(shard *shardScan) error {
+	var pageToken []byte
+	for {
+		resp, err := s.listConcreteExecutions(shard, pageToken)
+		if err != nil {
+			return err
+		}
+		for _, info := range resp.ExecutionInfos {
+			atomic.AddInt64(&s.stats.execution.nProcessed, 1)
+			s.checkExecution(shard, info)
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (s *Scanner) scanCurrentExecutions(shard *shardScan) error {
+	var pageToken []byte
+	for {
+		resp, err := s.listCurrentExecutions(shard, pageToken)
+		if err != nil {
+			return err
+		}
+		for _, execution := range resp.Executions {
+			atomic.AddInt64(&s.stats.currentExecution.nProcessed, 1)
+			s.checkCurrentExecution(shard, execution)
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (s *Scanner) checkExecution(shard *shardScan, info *p.WorkflowExecutionInfo) {
+	if info.State == p.WorkflowStateCompleted || !shard.isIdle(info) {
+		return
+	}
+	key := &executionKey{
+		DomainID:   info.DomainID,
+		WorkflowID: info.WorkflowID,
+		RunID:      info.RunID,
+	}
+
+	status, err := s.checkHistory(shard, info)
+	if err != nil {
+		s.checkFailed(shard, key, "failed to read history", err)
+		return
+	}
+	switch status {
+	case historyStatusMissing:
+		atomic.AddInt64(&s.stats.execution.nHistoryMissing, 1)
+		s.logger.Warn("executions scanner found execution with missing history", s.tags(shard, key)...)
+		s.tryDeleteExecution(shard, key, info, false)
+		return
+	case historyStatusTruncated:
+		atomic.AddInt64(&s.stats.execution.nHistoryTruncated, 1)
+		s.logger.Warn("executions scanner found execution with truncated history",
+			append(s.tags(shard, key), tag.WorkflowNextEventID(info.NextEventID))...)
+		s.tryDeleteExecution(shard, key, info, true)
+		return
+	}
+
+	resp, err := s.getWorkflowExecution(shard, key)
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			return // deleted after the page was listed
+		}
+		s.checkFailed(shard, key, "failed to load mutable state", err)
+		return
+	}
+	state := resp.State
+	if state.ExecutionInfo.State == p.WorkflowStateCompleted || !shard.isIdle(state.ExecutionInfo) {
+		return
+	}
+	if len(state.ActivityInfos) == 0 && len(state.TimerInfos) == 0 {
+		return
+	}
+	if _, ok := shard.timers[*key]; !ok {
+		// timer tasks can only be created by the history host owning the shard, since
+		// doing so requires the shard range id, so this is only reported and never fixed
+		atomic.AddInt64(&s.stats.execution.nTimerTasksMissing, 1)
+		s.logger.Warn("executions scanner found execution with pending activities or timers but no timer task", s.tags(shard, key)...)
+	}
+}
+
+// checkHistory reads the first and the last event batch of an execution history
+// An error is returned when the history cannot be read for any other reason than
+// the events not being found, the execution is not considered corrupted in that case
+func (s *Scanner) checkHistory(shard *shardScan, info *p.WorkflowExecutionInfo) (historyStatus, error) {
+	lastEventID := info.NextEventID - 1
+	if lastEventID < common.FirstEventID {
+		return historyStatusOK, nil
+	}
+
+	if _, err := s.readHistory(shard, info, common.FirstEventID, common.FirstEventID+1); err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			return historyStatusMissing, nil
+		}
+		return historyStatusOK, err
+	}
+
+	lastFirstEventID := common.MaxInt64(info.LastFirstEventID, common.FirstEventID)
+	events, err := s.readHistory(shard, info, lastFirstEventID, info.NextEventID)
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			return historyStatusTruncated, nil
+		}
+		return historyStatusOK, err
+	}
+	if len(events) == 0 || events[len(events)-1].GetEventId() != lastEventID {
+		return historyStatusTruncated, nil
+	}
+	return historyStatusOK, nil
+}
+
+func (s *Scanner) checkCurrentExecution(shard *shardScan, execution *p.CurrentWorkflowExecution) {
+	key := &executionKey{
+		DomainID:   execution.DomainID,
+		WorkflowID: execution.WorkflowID,
+		RunID:      execution.RunID,
+	}
+	_, err := s.getWorkflowExecution(shard, key)
+	if err == nil {
+		return
+	}
+	if _, ok := err.(*shared.EntityNotExistsError); !ok {
+		s.checkFailed(shard, key, "failed to load mutable state", err)
+		return
+	}
+
+	// retention deletes the current record before the run itself, make sure
+	// the record still points to the missing run before reporting it
+	resp, err := s.getCurrentExecution(shard, key)
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			return
+		}
+		s.checkFailed(shard, key, "failed to load current execution", err)
+		return
+	}
+	if resp.RunID != key.RunID {
+		return
+	}
+
+	atomic.AddInt64(&s.stats.currentExecution.nOrphaned, 1)
+	s.logger.Warn("executions scanner found current execution pointing to a missing run", s.tags(shard, key)...)
+	if !s.cfg.FixEnabled() {
+		return
+	}
+	if err := s.deleteCurrentExecution(shard, key); err != nil {
+		s.logger.Error("deleteCurrentExecution error", append(s.tags(shard, key), tag.Error(err))...)
+		return
+	}
+	atomic.AddInt64(&s.stats.nFixed, 1)
+	s.logger.Info("executions scanner deleted orphaned current execution", s.tags(shard, key)...)
+}
+
+// tryDeleteExecution deletes an execution whose history is missing or truncated, such
+// an execution cannot make progress since history service is unable to rebuild its state
+//
+// usually, history service is the authoritative owner of the executions of a shard and
+// its incorrect for any other entity to mutate them, the delete here is safe because
+// the execution has been idle for executionGracePeriod and any later update by history
+// service is conditional and will fail once the execution is gone
+func (s *Scanner) tryDeleteExecution(shard *shardScan, key *executionKey, info *p.WorkflowExecutionInfo, deleteHistory bool) {
+	if !s.cfg.FixEnabled() {
+		return
+	}
+	if err := s.deleteCurrentExecution(shard, key); err != nil {
+		s.logger.Error("deleteCurrentExecution error", append(s.tags(shard, key), tag.Error(err))...)
+		return
+	}
+	if err := s.deleteExecution(shard, key); err != nil {
+		s.logger.Error("deleteExecution error", append(s.tags(shard, key), tag.Error(err))...)
+		return
+	}
+	if deleteHistory {
+		if err := s.deleteHistory(shard, info); err != nil {
+			// the execution is gone already, the history left behind is only wasting space
+			s.logger.Warn("deleteHistory error", append(s.tags(shard, key), tag.Error(err))...)
+		}
+	}
+	atomic.AddInt64(&s.stats.nFixed, 1)
+	s.logger.Info("executions scanner deleted corrupted execution", s.tags(shard, key)...)
+}
+
+func (s *Scanner) checkFailed(shard *shardScan, key *executionKey, msg string, err error) {
+	atomic.AddInt64(&s.stats.nFailed, 1)
+	s.logger.Error("executions scanner "+msg, append(s.tags(shard, key), tag.Error(err))...)
+}
+
+func (s *Scanner) tags(shard *shardScan, key *executionKey) []tag.Tag {
+	return []tag.Tag{
+		tag.ShardID(shard.shardID),
+		tag.WorkflowDomainID(key.DomainID),
+		tag.WorkflowID(key.WorkflowID),
+		tag.WorkflowRunID(key.RunID),
+	}
+}
+
+// isIdle returns true if the execution hasn't been updated for executionGracePeriod
+// before the scan of the shard started
+func (shard *shardScan) isIdle(info *p.WorkflowExecutionInfo) bool {
+	return shard.startTime.Sub(info.LastUpdatedTimestamp) >= executionGracePeriod
+}
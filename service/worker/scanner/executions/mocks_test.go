@@ -0,0 +1,228 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	mockExecution struct {
+		info      *p.WorkflowExecutionInfo
+		events    []*shared.HistoryEvent
+		pending   bool
+		hasTimer  bool
+		readError error
+		deleted   bool
+	}
+
+	mockExecutionTable struct {
+		sync.Mutex
+		executions []*mockExecution
+		current    map[string]*p.CurrentWorkflowExecution
+		histories  map[string]bool
+	}
+)
+
+func newMockExecutionTable() *mockExecutionTable {
+	return &mockExecutionTable{
+		current:   make(map[string]*p.CurrentWorkflowExecution),
+		histories: make(map[string]bool),
+	}
+}
+
+// generate adds an open execution with nEvents events in two batches, the last
+// nPersisted of which are actually persisted in history
+func (tbl *mockExecutionTable) generate(nEvents int64, nPersisted int64, eventStoreVersion int32, idle bool) *mockExecution {
+	runID := uuid.New()
+	info := &p.WorkflowExecutionInfo{
+		DomainID:             uuid.New(),
+		WorkflowID:           uuid.New(),
+		RunID:                runID,
+		State:                p.WorkflowStateRunning,
+		NextEventID:          nEvents + 1,
+		LastFirstEventID:     nEvents/2 + 1,
+		LastUpdatedTimestamp: time.Now(),
+		EventStoreVersion:    eventStoreVersion,
+	}
+	if eventStoreVersion == p.EventStoreVersionV2 {
+		info.BranchToken = []byte(runID)
+	}
+	if idle {
+		info.LastUpdatedTimestamp = time.Now().Add(-2 * executionGracePeriod)
+	}
+	execution := &mockExecution{info: info}
+	for id := common.FirstEventID; id < common.FirstEventID+nPersisted; id++ {
+		execution.events = append(execution.events, &shared.HistoryEvent{EventId: common.Int64Ptr(id)})
+	}
+	tbl.executions = append(tbl.executions, execution)
+	tbl.current[info.WorkflowID] = &p.CurrentWorkflowExecution{
+		DomainID:   info.DomainID,
+		WorkflowID: info.WorkflowID,
+		RunID:      info.RunID,
+		State:      info.State,
+	}
+	tbl.histories[runID] = true
+	return execution
+}
+
+// generateOrphan adds a current execution which points to a run that doesn't exist
+func (tbl *mockExecutionTable) generateOrphan() *p.CurrentWorkflowExecution {
+	execution := &p.CurrentWorkflowExecution{
+		DomainID:   uuid.New(),
+		WorkflowID: uuid.New(),
+		RunID:      uuid.New(),
+		State:      p.WorkflowStateRunning,
+	}
+	tbl.current[execution.WorkflowID] = execution
+	return execution
+}
+
+func (tbl *mockExecutionTable) list(token []byte, count int) ([]*p.WorkflowExecutionInfo, []byte) {
+	tbl.Lock()
+	defer tbl.Unlock()
+	var off int
+	if token != nil {
+		off = int(binary.BigEndian.Uint32(token))
+	}
+	var result []*p.WorkflowExecutionInfo
+	for ; off < len(tbl.executions) && len(result) < count; off++ {
+		if !tbl.executions[off].deleted {
+			result = append(result, tbl.executions[off].info)
+		}
+	}
+	if off >= len(tbl.executions) {
+		return result, nil
+	}
+	next := make([]byte, 4)
+	binary.BigEndian.PutUint32(next, uint32(off))
+	return result, next
+}
+
+func (tbl *mockExecutionTable) listCurrent() []*p.CurrentWorkflowExecution {
+	tbl.Lock()
+	defer tbl.Unlock()
+	var result []*p.CurrentWorkflowExecution
+	for _, execution := range tbl.current {
+		result = append(result, execution)
+	}
+	return result
+}
+
+func (tbl *mockExecutionTable) timers() []*p.TimerTaskInfo {
+	tbl.Lock()
+	defer tbl.Unlock()
+	var result []*p.TimerTaskInfo
+	for _, execution := range tbl.executions {
+		if execution.hasTimer && !execution.deleted {
+			result = append(result, &p.TimerTaskInfo{
+				DomainID:   execution.info.DomainID,
+				WorkflowID: execution.info.WorkflowID,
+				RunID:      execution.info.RunID,
+			})
+		}
+	}
+	return result
+}
+
+func (tbl *mockExecutionTable) get(runID string) *mockExecution {
+	tbl.Lock()
+	defer tbl.Unlock()
+	for _, execution := range tbl.executions {
+		if execution.info.RunID == runID && !execution.deleted {
+			return execution
+		}
+	}
+	return nil
+}
+
+func (tbl *mockExecutionTable) getCurrent(workflowID string) *p.CurrentWorkflowExecution {
+	tbl.Lock()
+	defer tbl.Unlock()
+	return tbl.current[workflowID]
+}
+
+func (tbl *mockExecutionTable) mutableState(runID string) (*p.GetWorkflowExecutionResponse, error) {
+	execution := tbl.get(runID)
+	if execution == nil {
+		return nil, &shared.EntityNotExistsError{}
+	}
+	state := &p.WorkflowMutableState{
+		ExecutionInfo: execution.info,
+		ActivityInfos: make(map[int64]*p.ActivityInfo),
+		TimerInfos:    make(map[string]*p.TimerInfo),
+	}
+	if execution.pending {
+		state.ActivityInfos[2] = &p.ActivityInfo{ScheduleID: 2}
+	}
+	return &p.GetWorkflowExecutionResponse{State: state}, nil
+}
+
+func (tbl *mockExecutionTable) readHistory(runID string, firstEventID int64, nextEventID int64) ([]*shared.HistoryEvent, error) {
+	execution := tbl.get(runID)
+	if execution == nil {
+		return nil, &shared.EntityNotExistsError{}
+	}
+	if execution.readError != nil {
+		return nil, execution.readError
+	}
+	var result []*shared.HistoryEvent
+	for _, event := range execution.events {
+		if event.GetEventId() >= firstEventID && event.GetEventId() < nextEventID {
+			result = append(result, event)
+		}
+	}
+	if len(result) == 0 {
+		return nil, &shared.EntityNotExistsError{}
+	}
+	return result, nil
+}
+
+func (tbl *mockExecutionTable) delete(runID string) {
+	tbl.Lock()
+	defer tbl.Unlock()
+	for _, execution := range tbl.executions {
+		if execution.info.RunID == runID {
+			execution.deleted = true
+		}
+	}
+}
+
+func (tbl *mockExecutionTable) deleteCurrent(workflowID string, runID string) {
+	tbl.Lock()
+	defer tbl.Unlock()
+	if execution, ok := tbl.current[workflowID]; ok && execution.RunID == runID {
+		delete(tbl.current, workflowID)
+	}
+}
+
+func (tbl *mockExecutionTable) deleteHistory(runID string) {
+	tbl.Lock()
+	defer tbl.Unlock()
+	delete(tbl.histories, runID)
+}
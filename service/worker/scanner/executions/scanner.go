@@ -0,0 +1,229 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/service/worker/scanner/executor"
+)
+
+type (
+	// Config defines the configuration for the executions scanner
+	Config struct {
+		// NumHistoryShards is the total number of history shards to scan
+		NumHistoryShards int
+		// Concurrency is the number of history shards scanned concurrently
+		Concurrency dynamicconfig.IntPropertyFn
+		// PageSize is the number of records read from persistence in one call
+		PageSize dynamicconfig.IntPropertyFn
+		// FixEnabled indicates if corrupted and orphaned executions should be deleted
+		FixEnabled dynamicconfig.BoolPropertyFn
+	}
+
+	// Scanner is the type that holds the state for executions scanner daemon
+	Scanner struct {
+		cfg         *Config
+		executionDB p.ExecutionManagerFactory
+		historyDB   p.HistoryManager
+		historyV2DB p.HistoryV2Manager
+		executor    executor.Executor
+		metrics     metrics.Client
+		logger      log.Logger
+		stats       stats
+		status      int32
+		stopC       chan struct{}
+		stopWG      sync.WaitGroup
+	}
+
+	executionKey struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
+	stats struct {
+		execution struct {
+			nProcessed         int64
+			nHistoryMissing    int64
+			nHistoryTruncated  int64
+			nTimerTasksMissing int64
+		}
+		currentExecution struct {
+			nProcessed int64
+			nOrphaned  int64
+		}
+		nFixed  int64
+		nFailed int64
+	}
+
+	// executorTask is a runnable task that adheres to the executor.Task interface
+	// for the scanner, each of this task processes a single history shard
+	executorTask struct {
+		shardID int
+		scanner *Scanner
+	}
+)
+
+var (
+	executionGracePeriod     = time.Hour // amount of time an execution has to be idle before it is checked
+	epochStartTime           = time.Unix(0, 0)
+	maximumTime              = time.Unix(0, math.MaxInt64)
+	executorPollInterval     = time.Minute
+	executorMaxDeferredTasks = 10000
+)
+
+// NewScanner returns an instance of executions scanner daemon
+// The Scanner can be started by calling the Start() method on the
+// returned object. Calling the Start() method will result in one
+// complete iteration over all of the executions in the system, one
+// history shard at a time. For each shard, the scanner will look for
+//   - open executions whose history is missing or truncated
+//   - current execution records pointing to a run that no longer exists
+//   - open executions with pending activities or timers but without any timer task
+//
+// Corrupted and orphaned records are reported through metrics and, when
+// FixEnabled is set, deleted. Executions missing timer tasks are only
+// reported since timer tasks can only be created by the owner of the shard.
+//
+// The scanner will retry on persistence errors while listing records infinitely
+// and will only stop under two conditions
+//   - either all shards are processed (or)
+//   - Stop() method is called to stop the scanner
+func NewScanner(
+	cfg *Config,
+	executionDB p.ExecutionManagerFactory,
+	historyDB p.HistoryManager,
+	historyV2DB p.HistoryV2Manager,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Scanner {
+	taskExecutor := executor.NewFixedSizePoolExecutor(
+		cfg.Concurrency(), executorMaxDeferredTasks, metricsClient, metrics.ExecutionsScannerScope)
+	return &Scanner{
+		cfg:         cfg,
+		executionDB: executionDB,
+		historyDB:   historyDB,
+		historyV2DB: historyV2DB,
+		metrics:     metricsClient,
+		logger:      logger,
+		stopC:       make(chan struct{}),
+		executor:    taskExecutor,
+	}
+}
+
+// Start starts the scanner
+func (s *Scanner) Start() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	s.logger.Info("Executions scanner starting")
+	s.stopWG.Add(1)
+	s.executor.Start()
+	go s.run()
+	s.metrics.IncCounter(metrics.ExecutionsScannerScope, metrics.StartedCount)
+	s.logger.Info("Executions scanner started")
+}
+
+// Stop stops the scanner
+func (s *Scanner) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	s.metrics.IncCounter(metrics.ExecutionsScannerScope, metrics.StoppedCount)
+	s.logger.Info("Executions scanner stopping")
+	close(s.stopC)
+	s.executor.Stop()
+	s.stopWG.Wait()
+	s.logger.Info("Executions scanner stopped")
+}
+
+// Alive returns true if the scanner is still running
+func (s *Scanner) Alive() bool {
+	return atomic.LoadInt32(&s.status) == common.DaemonStatusStarted
+}
+
+// run does a single run over all history shards
+func (s *Scanner) run() {
+	defer func() {
+		s.emitStats()
+		go s.Stop()
+		s.stopWG.Done()
+	}()
+
+	for shardID := 0; shardID < s.cfg.NumHistoryShards; shardID++ {
+		if !s.executor.Submit(s.newTask(shardID)) {
+			return
+		}
+	}
+
+	s.awaitExecutor()
+}
+
+// process is a callback function that gets invoked from within the executor.Run() method
+func (s *Scanner) process(shardID int) executor.TaskStatus {
+	return s.scanHandler(shardID)
+}
+
+func (s *Scanner) awaitExecutor() {
+	outstanding := s.executor.TaskCount()
+	for outstanding > 0 {
+		select {
+		case <-time.After(executorPollInterval):
+			outstanding = s.executor.TaskCount()
+			s.metrics.UpdateGauge(metrics.ExecutionsScannerScope, metrics.ShardOutstandingCount, float64(outstanding))
+		case <-s.stopC:
+			return
+		}
+	}
+}
+
+func (s *Scanner) emitStats() {
+	s.metrics.UpdateGauge(metrics.ExecutionsScannerScope, metrics.ExecutionProcessedCount, float64(s.stats.execution.nProcessed))
+	s.metrics.UpdateGauge(metrics.ExecutionsScannerScope, metrics.ExecutionHistoryMissingCount, float64(s.stats.execution.nHistoryMissing))
+	s.metrics.UpdateGauge(metrics.ExecutionsScannerScope, metrics.ExecutionHistoryTruncatedCount, float64(s.stats.execution.nHistoryTruncated))
+	s.metrics.UpdateGauge(metrics.ExecutionsScannerScope, metrics.ExecutionTimerTasksMissingCount, float64(s.stats.execution.nTimerTasksMissing))
+	s.metrics.UpdateGauge(metrics.ExecutionsScannerScope, metrics.CurrentExecutionProcessedCount, float64(s.stats.currentExecution.nProcessed))
+	s.metrics.UpdateGauge(metrics.ExecutionsScannerScope, metrics.CurrentExecutionOrphanedCount, float64(s.stats.currentExecution.nOrphaned))
+	s.metrics.UpdateGauge(metrics.ExecutionsScannerScope, metrics.ExecutionFixedCount, float64(s.stats.nFixed))
+	s.metrics.UpdateGauge(metrics.ExecutionsScannerScope, metrics.ExecutionScanFailedCount, float64(s.stats.nFailed))
+}
+
+// newTask returns a new instance of an executable task which will process a single history shard
+func (s *Scanner) newTask(shardID int) executor.Task {
+	return &executorTask{
+		shardID: shardID,
+		scanner: s,
+	}
+}
+
+// Run runs the task
+func (t *executorTask) Run() executor.TaskStatus {
+	return t.scanner.process(t.shardID)
+}
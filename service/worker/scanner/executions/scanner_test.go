@@ -0,0 +1,235 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/zap"
+)
+
+type (
+	ScannerTestSuite struct {
+		suite.Suite
+		table          *mockExecutionTable
+		executionMgr   *mocks.ExecutionManager
+		historyMgr     *mocks.HistoryManager
+		historyV2Mgr   *mocks.HistoryV2Manager
+		executionMgrs  *mocks.ExecutionManagerFactory
+		fixEnabled     bool
+		scanner        *Scanner
+		healthy        *mockExecution
+		historyMissing *mockExecution
+		truncated      *mockExecution
+		timerMissing   *mockExecution
+		corrupted      *mockExecution
+		recent         *mockExecution
+		closed         *mockExecution
+		orphan         *p.CurrentWorkflowExecution
+	}
+)
+
+var errTest = errors.New("transient error")
+
+func TestScannerTestSuite(t *testing.T) {
+	suite.Run(t, new(ScannerTestSuite))
+}
+
+func (s *ScannerTestSuite) SetupTest() {
+	s.table = newMockExecutionTable()
+	s.executionMgr = &mocks.ExecutionManager{}
+	s.historyMgr = &mocks.HistoryManager{}
+	s.historyV2Mgr = &mocks.HistoryV2Manager{}
+	s.executionMgrs = &mocks.ExecutionManagerFactory{}
+	s.fixEnabled = false
+	zapLogger, err := zap.NewDevelopment()
+	if err != nil {
+		s.Require().NoError(err)
+	}
+	logger := loggerimpl.NewLogger(zapLogger)
+	cfg := &Config{
+		NumHistoryShards: 1,
+		Concurrency:      dynamicconfig.GetIntPropertyFn(4),
+		PageSize:         dynamicconfig.GetIntPropertyFn(2),
+		FixEnabled:       func(opts ...dynamicconfig.FilterOption) bool { return s.fixEnabled },
+	}
+	s.scanner = NewScanner(cfg, s.executionMgrs, s.historyMgr, s.historyV2Mgr, metrics.NewClient(tally.NoopScope, metrics.Worker), logger)
+	executorPollInterval = time.Millisecond * 50
+
+	s.healthy = s.table.generate(4, 4, p.EventStoreVersionV2, true)
+	s.healthy.pending = true
+	s.healthy.hasTimer = true
+	s.historyMissing = s.table.generate(4, 0, p.EventStoreVersionV2, true)
+	s.truncated = s.table.generate(6, 3, 0, true)
+	s.timerMissing = s.table.generate(4, 4, 0, true)
+	s.timerMissing.pending = true
+	s.corrupted = s.table.generate(4, 4, p.EventStoreVersionV2, true)
+	s.corrupted.readError = &shared.InternalServiceError{Message: "corrupted history event batch"}
+	s.recent = s.table.generate(4, 0, p.EventStoreVersionV2, false)
+	s.closed = s.table.generate(4, 0, p.EventStoreVersionV2, true)
+	s.closed.info.State = p.WorkflowStateCompleted
+	s.orphan = s.table.generateOrphan()
+}
+
+func (s *ScannerTestSuite) TestScanWithoutFix() {
+	s.setupMocks()
+	s.runScanner()
+
+	s.Equal(int64(7), s.scanner.stats.execution.nProcessed)
+	s.Equal(int64(1), s.scanner.stats.execution.nHistoryMissing)
+	s.Equal(int64(1), s.scanner.stats.execution.nHistoryTruncated)
+	s.Equal(int64(1), s.scanner.stats.execution.nTimerTasksMissing)
+	s.Equal(int64(8), s.scanner.stats.currentExecution.nProcessed)
+	s.Equal(int64(1), s.scanner.stats.currentExecution.nOrphaned)
+	s.Equal(int64(1), s.scanner.stats.nFailed)
+	s.Equal(int64(0), s.scanner.stats.nFixed)
+	s.executionMgr.AssertNotCalled(s.T(), "DeleteWorkflowExecution", mock.Anything)
+	s.executionMgr.AssertNotCalled(s.T(), "DeleteCurrentWorkflowExecution", mock.Anything)
+}
+
+func (s *ScannerTestSuite) TestScanWithFix() {
+	s.fixEnabled = true
+	s.setupMocks()
+	s.runScanner()
+
+	s.Equal(int64(3), s.scanner.stats.nFixed)
+	s.Nil(s.table.get(s.historyMissing.info.RunID), "failed to delete execution with missing history")
+	s.Nil(s.table.getCurrent(s.historyMissing.info.WorkflowID), "failed to delete current execution with missing history")
+	s.Nil(s.table.get(s.truncated.info.RunID), "failed to delete execution with truncated history")
+	s.Nil(s.table.getCurrent(s.truncated.info.WorkflowID), "failed to delete current execution with truncated history")
+	s.False(s.table.histories[s.truncated.info.RunID], "failed to delete truncated history")
+	s.Nil(s.table.getCurrent(s.orphan.WorkflowID), "failed to delete orphaned current execution")
+	for _, execution := range []*mockExecution{s.healthy, s.timerMissing, s.corrupted, s.recent, s.closed} {
+		s.NotNil(s.table.get(execution.info.RunID), "scanner deleted a valid execution")
+		s.NotNil(s.table.getCurrent(execution.info.WorkflowID), "scanner deleted a valid current execution")
+		s.True(s.table.histories[execution.info.RunID], "scanner deleted a valid history")
+	}
+}
+
+func (s *ScannerTestSuite) TestScanWithErrors() {
+	s.executionMgr.On("ListConcreteExecutions", mock.Anything).Return(nil, errTest).Once()
+	s.executionMgr.On("ListCurrentExecutions", mock.Anything).Return(nil, &shared.ServiceBusyError{}).Once()
+	s.setupMocks()
+	s.runScanner()
+
+	s.Equal(int64(7), s.scanner.stats.execution.nProcessed)
+	s.Equal(int64(8), s.scanner.stats.currentExecution.nProcessed)
+	s.Equal(int64(1), s.scanner.stats.currentExecution.nOrphaned)
+}
+
+func (s *ScannerTestSuite) runScanner() {
+	s.scanner.Start()
+	timer := time.NewTimer(10 * time.Second)
+	select {
+	case <-s.scanner.stopC:
+		timer.Stop()
+		return
+	case <-timer.C:
+		s.Fail("timed out waiting for scanner to finish")
+	}
+}
+
+func (s *ScannerTestSuite) setupMocks() {
+	s.executionMgrs.On("NewExecutionManager", 0).Return(s.executionMgr, nil)
+	s.executionMgr.On("Close").Return()
+	s.executionMgr.On("GetTimerIndexTasks", mock.Anything).Return(
+		&p.GetTimerIndexTasksResponse{Timers: s.table.timers()}, nil)
+	s.executionMgr.On("ListConcreteExecutions", mock.Anything).Return(
+		func(req *p.ListConcreteExecutionsRequest) *p.ListConcreteExecutionsResponse {
+			infos, next := s.table.list(req.PageToken, req.PageSize)
+			return &p.ListConcreteExecutionsResponse{ExecutionInfos: infos, NextPageToken: next}
+		}, nil)
+	s.executionMgr.On("ListCurrentExecutions", mock.Anything).Return(
+		func(req *p.ListCurrentExecutionsRequest) *p.ListCurrentExecutionsResponse {
+			return &p.ListCurrentExecutionsResponse{Executions: s.table.listCurrent()}
+		}, nil)
+	s.executionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		func(req *p.GetWorkflowExecutionRequest) *p.GetWorkflowExecutionResponse {
+			resp, _ := s.table.mutableState(req.Execution.GetRunId())
+			return resp
+		},
+		func(req *p.GetWorkflowExecutionRequest) error {
+			_, err := s.table.mutableState(req.Execution.GetRunId())
+			return err
+		})
+	s.executionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		func(req *p.GetCurrentExecutionRequest) *p.GetCurrentExecutionResponse {
+			if execution := s.table.getCurrent(req.WorkflowID); execution != nil {
+				return &p.GetCurrentExecutionResponse{RunID: execution.RunID}
+			}
+			return nil
+		},
+		func(req *p.GetCurrentExecutionRequest) error {
+			if s.table.getCurrent(req.WorkflowID) == nil {
+				return &shared.EntityNotExistsError{}
+			}
+			return nil
+		})
+	s.executionMgr.On("DeleteWorkflowExecution", mock.Anything).Return(
+		func(req *p.DeleteWorkflowExecutionRequest) error {
+			s.table.delete(req.RunID)
+			return nil
+		})
+	s.executionMgr.On("DeleteCurrentWorkflowExecution", mock.Anything).Return(
+		func(req *p.DeleteCurrentWorkflowExecutionRequest) error {
+			s.table.deleteCurrent(req.WorkflowID, req.RunID)
+			return nil
+		})
+	s.historyV2Mgr.On("ReadHistoryBranch", mock.Anything).Return(
+		func(req *p.ReadHistoryBranchRequest) *p.ReadHistoryBranchResponse {
+			events, _ := s.table.readHistory(string(req.BranchToken), req.MinEventID, req.MaxEventID)
+			return &p.ReadHistoryBranchResponse{HistoryEvents: events}
+		},
+		func(req *p.ReadHistoryBranchRequest) error {
+			_, err := s.table.readHistory(string(req.BranchToken), req.MinEventID, req.MaxEventID)
+			return err
+		})
+	s.historyMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		func(req *p.GetWorkflowExecutionHistoryRequest) *p.GetWorkflowExecutionHistoryResponse {
+			events, _ := s.table.readHistory(req.Execution.GetRunId(), req.FirstEventID, req.NextEventID)
+			return &p.GetWorkflowExecutionHistoryResponse{History: &shared.History{Events: events}}
+		},
+		func(req *p.GetWorkflowExecutionHistoryRequest) error {
+			_, err := s.table.readHistory(req.Execution.GetRunId(), req.FirstEventID, req.NextEventID)
+			return err
+		})
+	s.historyV2Mgr.On("DeleteHistoryBranch", mock.Anything).Return(
+		func(req *p.DeleteHistoryBranchRequest) error {
+			s.table.deleteHistory(string(req.BranchToken))
+			return nil
+		})
+	s.historyMgr.On("DeleteWorkflowExecutionHistory", mock.Anything).Return(
+		func(req *p.DeleteWorkflowExecutionHistoryRequest) error {
+			s.table.deleteHistory(req.Execution.GetRunId())
+			return nil
+		})
+}
@@ -35,6 +35,7 @@ import (
 	pfactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/service/worker/scanner/executions"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/cadence/.gen/go/shared"
 	cclient "go.uber.org/cadence/client"
@@ -51,6 +52,10 @@ type (
 		Persistence *config.Persistence
 		// ClusterMetadata contains the metadata for this cluster
 		ClusterMetadata cluster.Metadata
+		// ExecutionsScannerEnabled indicates if executions scanner should be started
+		ExecutionsScannerEnabled dynamicconfig.BoolPropertyFn
+		// ExecutionsScannerCfg contains the configuration for executions scanner
+		ExecutionsScannerCfg *executions.Config
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap
@@ -72,6 +77,9 @@ type (
 	scannerContext struct {
 		taskDB        p.TaskManager
 		domainDB      p.MetadataManager
+		executionDB   p.ExecutionManagerFactory
+		historyDB     p.HistoryManager
+		historyV2DB   p.HistoryV2Manager
 		cfg           Config
 		sdkClient     workflowserviceclient.Interface
 		metricsClient metrics.Client
@@ -124,35 +132,47 @@ func (s *Scanner) Start() error {
 		MaxConcurrentDecisionTaskExecutionSize: maxConcurrentDecisionTaskExecutionSize,
 		BackgroundActivityContext:              context.WithValue(context.Background(), scannerContextKey, s.context),
 	}
-	go s.startWorkflowWithRetry()
-	worker := worker.New(s.context.sdkClient, common.SystemLocalDomainName, tlScannerTaskListName, workerOpts)
-	return worker.Start()
+	if s.context.cfg.Persistence.DefaultStoreType() == config.StoreTypeSQL {
+		go s.startWorkflowWithRetry(tlScannerWFStartOptions, tlScannerWFTypeName)
+		worker := worker.New(s.context.sdkClient, common.SystemLocalDomainName, tlScannerTaskListName, workerOpts)
+		if err := worker.Start(); err != nil {
+			return err
+		}
+	}
+	if s.context.cfg.ExecutionsScannerEnabled() {
+		go s.startWorkflowWithRetry(executionsScannerWFStartOptions, executionsScannerWFTypeName)
+		worker := worker.New(s.context.sdkClient, common.SystemLocalDomainName, executionsScannerTaskListName, workerOpts)
+		if err := worker.Start(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (s *Scanner) startWorkflowWithRetry() error {
+func (s *Scanner) startWorkflowWithRetry(options cclient.StartWorkflowOptions, workflowType string) error {
 	client := cclient.NewClient(s.context.sdkClient, common.SystemLocalDomainName, &cclient.Options{})
 	policy := backoff.NewExponentialRetryPolicy(time.Second)
 	policy.SetMaximumInterval(time.Minute)
 	policy.SetExpirationInterval(backoff.NoInterval)
 	return backoff.Retry(func() error {
-		return s.startWorkflow(client)
+		return s.startWorkflow(client, options, workflowType)
 	}, policy, func(err error) bool {
 		return true
 	})
 }
 
-func (s *Scanner) startWorkflow(client cclient.Client) error {
+func (s *Scanner) startWorkflow(client cclient.Client, options cclient.StartWorkflowOptions, workflowType string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
-	_, err := client.StartWorkflow(ctx, tlScannerWFStartOptions, tlScannerWFTypeName)
+	_, err := client.StartWorkflow(ctx, options, workflowType)
 	cancel()
 	if err != nil {
 		if _, ok := err.(*shared.WorkflowExecutionAlreadyStartedError); ok {
 			return nil
 		}
-		s.context.logger.Error("error starting scanner workflow", tag.Error(err))
+		s.context.logger.Error("error starting scanner workflow", tag.WorkflowType(workflowType), tag.Error(err))
 		return err
 	}
-	s.context.logger.Info("Scanner workflow successfully started")
+	s.context.logger.Info("Scanner workflow successfully started", tag.WorkflowType(workflowType))
 	return nil
 }
 
@@ -169,5 +189,18 @@ func (s *Scanner) buildContext() error {
 	}
 	s.context.taskDB = taskDB
 	s.context.domainDB = domainDB
+	if cfg.ExecutionsScannerEnabled() {
+		historyDB, err := pFactory.NewHistoryManager()
+		if err != nil {
+			return err
+		}
+		historyV2DB, err := pFactory.NewHistoryV2Manager()
+		if err != nil {
+			return err
+		}
+		s.context.executionDB = pFactory
+		s.context.historyDB = historyDB
+		s.context.historyV2DB = historyV2DB
+	}
 	return nil
 }
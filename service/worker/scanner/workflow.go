@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"go.uber.org/cadence"
 	"go.uber.org/cadence/activity"
@@ -45,12 +46,18 @@ const (
 	tlScannerWFTypeName           = "cadence-sys-tl-scanner-workflow"
 	tlScannerTaskListName         = "cadence-sys-tl-scanner-tasklist-0"
 	taskListScavengerActivityName = "cadence-sys-tl-scanner-scvg-activity"
+
+	executionsScannerWFID         = "cadence-sys-executions-scanner"
+	executionsScannerWFTypeName   = "cadence-sys-executions-scanner-workflow"
+	executionsScannerTaskListName = "cadence-sys-executions-scanner-tasklist-0"
+	executionsScannerActivityName = "cadence-sys-executions-scanner-activity"
 )
 
 var (
-	tlScavengerHBInterval = 10 * time.Second
+	tlScavengerHBInterval       = 10 * time.Second
+	executionsScannerHBInterval = 10 * time.Second
 
-	tlScavengerActivityRetryPolicy = cadence.RetryPolicy{
+	activityRetryPolicy = cadence.RetryPolicy{
 		InitialInterval:    10 * time.Second,
 		BackoffCoefficient: 1.7,
 		MaximumInterval:    5 * time.Minute,
@@ -63,11 +70,20 @@ var (
 		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
 		CronSchedule:                 "0 */12 * * *",
 	}
+	executionsScannerWFStartOptions = cclient.StartWorkflowOptions{
+		ID:                           executionsScannerWFID,
+		TaskList:                     executionsScannerTaskListName,
+		ExecutionStartToCloseTimeout: 5 * 24 * time.Hour,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		CronSchedule:                 "0 0 * * *",
+	}
 )
 
 func init() {
 	workflow.RegisterWithOptions(TaskListScannerWorkflow, workflow.RegisterOptions{Name: tlScannerWFTypeName})
 	activity.RegisterWithOptions(TaskListScavengerActivity, activity.RegisterOptions{Name: taskListScavengerActivityName})
+	workflow.RegisterWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
+	activity.RegisterWithOptions(ExecutionsScannerActivity, activity.RegisterOptions{Name: executionsScannerActivityName})
 }
 
 // TaskListScannerWorkflow is the workflow that runs the task-list scanner background daemon
@@ -76,7 +92,7 @@ func TaskListScannerWorkflow(ctx workflow.Context) error {
 		ScheduleToStartTimeout: 5 * time.Minute,
 		StartToCloseTimeout:    infiniteDuration,
 		HeartbeatTimeout:       5 * time.Minute,
-		RetryPolicy:            &tlScavengerActivityRetryPolicy,
+		RetryPolicy:            &activityRetryPolicy,
 	}
 	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, opts), taskListScavengerActivityName)
 	return future.Get(ctx, nil)
@@ -99,3 +115,34 @@ func TaskListScavengerActivity(aCtx context.Context) error {
 	}
 	return nil
 }
+
+// ExecutionsScannerWorkflow is the workflow that runs the executions scanner background daemon
+func ExecutionsScannerWorkflow(ctx workflow.Context) error {
+	opts := workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    infiniteDuration,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, opts), executionsScannerActivityName)
+	return future.Get(ctx, nil)
+}
+
+// ExecutionsScannerActivity is the activity that runs executions scanner
+func ExecutionsScannerActivity(aCtx context.Context) error {
+	ctx := aCtx.Value(scannerContextKey).(scannerContext)
+	scanner := executions.NewScanner(
+		ctx.cfg.ExecutionsScannerCfg, ctx.executionDB, ctx.historyDB, ctx.historyV2DB, ctx.metricsClient, ctx.logger)
+	ctx.logger.Info("Starting executions scanner")
+	scanner.Start()
+	for scanner.Alive() {
+		activity.RecordHeartbeat(aCtx)
+		if aCtx.Err() != nil {
+			ctx.logger.Info("activity context error, stopping executions scanner", tag.Error(aCtx.Err()))
+			scanner.Stop()
+			return aCtx.Err()
+		}
+		time.Sleep(executionsScannerHBInterval)
+	}
+	return nil
+}
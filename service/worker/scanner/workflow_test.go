@@ -32,6 +32,8 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/service/worker/scanner/executions"
 	"go.uber.org/cadence/testsuite"
 	"go.uber.org/cadence/worker"
 	"go.uber.org/zap"
@@ -72,3 +74,37 @@ func (s *scannerWorkflowTestSuite) TestScavengerActivity() {
 	_, err := env.ExecuteActivity(taskListScavengerActivityName)
 	s.NoError(err)
 }
+
+func (s *scannerWorkflowTestSuite) TestExecutionsScannerWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(executionsScannerActivityName, mock.Anything).Return(nil)
+	env.ExecuteWorkflow(executionsScannerWFTypeName)
+	s.True(env.IsWorkflowCompleted())
+}
+
+func (s *scannerWorkflowTestSuite) TestExecutionsScannerActivity() {
+	env := s.NewTestActivityEnvironment()
+	ctx := scannerContext{
+		cfg: Config{
+			ExecutionsScannerCfg: &executions.Config{
+				NumHistoryShards: 0,
+				Concurrency:      dynamicconfig.GetIntPropertyFn(1),
+				PageSize:         dynamicconfig.GetIntPropertyFn(10),
+				FixEnabled:       dynamicconfig.GetBoolPropertyFn(false),
+			},
+		},
+		executionDB:   &mocks.ExecutionManagerFactory{},
+		historyDB:     &mocks.HistoryManager{},
+		historyV2DB:   &mocks.HistoryV2Manager{},
+		metricsClient: metrics.NewClient(tally.NoopScope, metrics.Worker),
+		zapLogger:     zap.NewNop(),
+		logger:        loggerimpl.NewLogger(zap.NewNop()),
+	}
+	env.SetTestTimeout(time.Second * 5)
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), scannerContextKey, ctx),
+	})
+	executionsScannerHBInterval = time.Millisecond * 10
+	_, err := env.ExecuteActivity(executionsScannerActivityName)
+	s.NoError(err)
+}
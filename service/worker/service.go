@@ -35,15 +35,18 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	espersistence "github.com/uber/cadence/common/persistence/elasticsearch"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
+	"github.com/uber/cadence/service/worker/backfiller"
 	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scanner"
+	"github.com/uber/cadence/service/worker/scanner/executions"
 )
 
 type (
@@ -51,6 +54,7 @@ type (
 	// 1. Replicator: Handles applying replication tasks generated by remote clusters.
 	// 2. Indexer: Handles uploading of visibility records to elastic search.
 	// 3. Archiver: Handles archival of workflow histories.
+	// 4. Backfiller: Handles copying of visibility records from the database into elastic search.
 	Service struct {
 		stopC         chan struct{}
 		isStopped     int32
@@ -104,6 +108,9 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			ArchiverConcurrency:           dc.GetIntProperty(dynamicconfig.WorkerArchiverConcurrency, 50),
 			ArchivalsPerIteration:         dc.GetIntProperty(dynamicconfig.WorkerArchivalsPerIteration, 1000),
 			TimeLimitPerArchivalIteration: dc.GetDurationProperty(dynamicconfig.WorkerTimeLimitPerArchivalIteration, archiver.MaxArchivalIterationTimeout()),
+			EnableArchivalVerification:    dc.GetBoolProperty(dynamicconfig.WorkerEnableArchivalVerification, false),
+			EnableArchivalScanner:         dc.GetBoolProperty(dynamicconfig.WorkerEnableArchivalScanner, false),
+			ArchivalScannerSampleSize:     dc.GetIntProperty(dynamicconfig.WorkerArchivalScannerSampleSize, 10),
 		},
 		IndexerCfg: &indexer.Config{
 			IndexerConcurrency:       dc.GetIntProperty(dynamicconfig.WorkerIndexerConcurrency, 1000),
@@ -114,9 +121,16 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			ValidSearchAttributes:    dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
 		},
 		ScannerCfg: &scanner.Config{
-			PersistenceMaxQPS: dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
-			Persistence:       &params.PersistenceConfig,
-			ClusterMetadata:   params.ClusterMetadata,
+			PersistenceMaxQPS:        dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
+			Persistence:              &params.PersistenceConfig,
+			ClusterMetadata:          params.ClusterMetadata,
+			ExecutionsScannerEnabled: dc.GetBoolProperty(dynamicconfig.ExecutionsScannerEnabled, false),
+			ExecutionsScannerCfg: &executions.Config{
+				NumHistoryShards: params.PersistenceConfig.NumHistoryShards,
+				Concurrency:      dc.GetIntProperty(dynamicconfig.ExecutionsScannerConcurrency, 25),
+				PageSize:         dc.GetIntProperty(dynamicconfig.ExecutionsScannerPersistencePageSize, 100),
+				FixEnabled:       dc.GetBoolProperty(dynamicconfig.ExecutionsScannerFixEnabled, false),
+			},
 		},
 		BatcherCfg: &batcher.Config{
 			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
@@ -141,15 +155,17 @@ func (s *Service) Start() {
 
 	replicatorEnabled := base.GetClusterMetadata().IsGlobalDomainEnabled()
 	archiverEnabled := base.GetClusterMetadata().HistoryArchivalConfig().ClusterConfiguredForArchival()
-	scannerEnabled := s.config.ScannerCfg.Persistence.DefaultStoreType() == config.StoreTypeSQL
+	scannerEnabled := s.config.ScannerCfg.Persistence.DefaultStoreType() == config.StoreTypeSQL ||
+		s.config.ScannerCfg.ExecutionsScannerEnabled()
 	batcherEnabled := s.config.EnableBatcher()
+	backfillerEnabled := s.params.ESConfig.Enable
 
-	if replicatorEnabled || archiverEnabled || scannerEnabled || batcherEnabled {
+	if replicatorEnabled || archiverEnabled || scannerEnabled || batcherEnabled || backfillerEnabled {
 		pConfig := s.params.PersistenceConfig
 		pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.ReplicationCfg.PersistenceMaxQPS())
 		pFactory := persistencefactory.New(&pConfig, s.params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, s.logger)
 
-		if archiverEnabled || scannerEnabled {
+		if archiverEnabled || scannerEnabled || backfillerEnabled {
 			s.ensureSystemDomainExists(pFactory, base.GetClusterMetadata().GetCurrentClusterName())
 		}
 		if replicatorEnabled {
@@ -164,6 +180,9 @@ func (s *Service) Start() {
 		if batcherEnabled {
 			s.startBatcher(base)
 		}
+		if backfillerEnabled {
+			s.startBackfiller(base, pFactory)
+		}
 	}
 
 	s.logger.Info("service started", tag.ComponentWorker)
@@ -194,6 +213,43 @@ func (s *Service) startBatcher(base service.Service) {
 	}
 }
 
+func (s *Service) startBackfiller(base service.Service, pFactory persistencefactory.Factory) {
+	metadataMgr, err := pFactory.NewMetadataManager(persistencefactory.MetadataV1V2)
+	if err != nil {
+		s.logger.Fatal("failed to start backfiller, could not create MetadataManager", tag.Error(err))
+	}
+	visibilityMgr, err := pFactory.NewVisibilityManager()
+	if err != nil {
+		s.logger.Fatal("failed to start backfiller, could not create VisibilityManager", tag.Error(err))
+	}
+	visibilityProducer, err := base.GetMessagingClient().NewProducer(common.VisibilityAppName)
+	if err != nil {
+		s.logger.Fatal("failed to start backfiller, could not create visibility producer", tag.Error(err))
+	}
+	esVisibilityMgr := espersistence.NewESVisibilityManager(
+		s.params.ESConfig.GetVisibilityIndex(),
+		s.params.ESClient,
+		nil,
+		visibilityProducer,
+		persistencefactory.NewPayloadEncryption(&s.params.PersistenceConfig, s.logger),
+		s.metricsClient,
+		s.logger)
+
+	params := &backfiller.BootstrapParams{
+		ServiceClient:       s.params.PublicClient,
+		MetricsClient:       s.metricsClient,
+		Logger:              s.logger,
+		TallyScope:          s.params.MetricScope,
+		MetadataManager:     metadataMgr,
+		VisibilityManager:   visibilityMgr,
+		ESVisibilityManager: esVisibilityMgr,
+	}
+	backfiller := backfiller.New(params)
+	if err := backfiller.Start(); err != nil {
+		s.logger.Fatal("error starting backfiller", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner(base service.Service) {
 	params := &scanner.BootstrapParams{
 		Config:        *s.config.ScannerCfg,
@@ -270,7 +326,13 @@ func (s *Service) startArchiver(base service.Service, pFactory persistencefactor
 		ClusterMetadata:  base.GetClusterMetadata(),
 		DomainCache:      domainCache,
 	}
-	archiverProvider.RegisterBootstrapContainer(common.WorkerServiceName, historyArchiverBootstrapContainer, &carchiver.VisibilityBootstrapContainer{})
+	visibilityArchiverBootstrapContainer := &carchiver.VisibilityBootstrapContainer{
+		Logger:          s.logger,
+		MetricsClient:   s.metricsClient,
+		ClusterMetadata: base.GetClusterMetadata(),
+		DomainCache:     domainCache,
+	}
+	archiverProvider.RegisterBootstrapContainer(common.WorkerServiceName, historyArchiverBootstrapContainer, visibilityArchiverBootstrapContainer)
 
 	bc := &archiver.BootstrapContainer{
 		PublicClient:     publicClient,
@@ -20,7 +20,10 @@
 
 package cli
 
-import "github.com/urfave/cli"
+import (
+	"github.com/uber/cadence/service/worker/backfiller"
+	"github.com/urfave/cli"
+)
 
 func newAdminWorkflowCommands() []cli.Command {
 	return []cli.Command{
@@ -520,6 +523,283 @@ func newAdminElasticSearchCommands() []cli.Command {
 				AdminIndex(c)
 			},
 		},
+		{
+			Name:    "backfill",
+			Aliases: []string{"bf"},
+			Usage:   "Start a workflow to copy visibility records of a domain from database to ElasticSearch",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "Optional latest start time of workflows to backfill, supported formats are '2006-01-02T15:04:05+07:00' and raw UnixNano. Default to now",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Usage: "Optional max number of records written to ElasticSearch per second",
+					Value: backfiller.DefaultRPS,
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Usage: "Optional number of records read from database per page",
+					Value: backfiller.DefaultPageSize,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminStartVisibilityBackfill(c)
+			},
+		},
+		{
+			Name:    "backfillStatus",
+			Aliases: []string{"bfs"},
+			Usage:   "Describe the progress of visibility backfill of a domain",
+			Action: func(c *cli.Context) {
+				AdminDescribeVisibilityBackfill(c)
+			},
+		},
+	}
+}
+
+func newAdminArchiveCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "ls",
+			Usage: "List archived runs of a domain from its visibility archive, without a running cluster",
+			Flags: append(getFlagsForArchive(),
+				cli.StringFlag{
+					Name:  FlagVisibilityArchivalURIWithAlias,
+					Usage: "URI of the visibility archive",
+				},
+				cli.StringFlag{
+					Name:  FlagEarliestTimeWithAlias,
+					Usage: "Optional earliest close time of runs to list, supported formats are '2006-01-02T15:04:05+07:00' and raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "Optional latest close time of runs to list, supported formats are '2006-01-02T15:04:05+07:00' and raw UnixNano",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 1000,
+					Usage: "Optional number of records read from the archive per page",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminListArchivedRuns(c)
+			},
+		},
+		{
+			Name:  "download",
+			Usage: "Download the archived history of a run as a json history file which can be replayed, without a running cluster",
+			Flags: append(getFlagsForArchive(),
+				cli.StringFlag{
+					Name:  FlagHistoryArchivalURIWithAlias,
+					Usage: "URI of the history archive",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.Int64Flag{
+					Name:  FlagCloseFailoverVersion,
+					Usage: "Optional failover version of the run when it closed, defaults to the highest version archived",
+				},
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Output file of the history",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminDownloadArchivedHistory(c)
+			},
+		},
+		{
+			Name: "verify",
+			Usage: "Verify that archived histories are complete, without a running cluster. " +
+				"Verifies a single run if workflow_id and run_id are given, otherwise every run listed in the visibility archive",
+			Flags: append(getFlagsForArchive(),
+				cli.StringFlag{
+					Name:  FlagHistoryArchivalURIWithAlias,
+					Usage: "URI of the history archive",
+				},
+				cli.StringFlag{
+					Name:  FlagVisibilityArchivalURIWithAlias,
+					Usage: "URI of the visibility archive, required unless a single run is verified",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "Optional WorkflowID of the run to verify",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional RunID of the run to verify",
+				},
+				cli.StringFlag{
+					Name:  FlagEarliestTimeWithAlias,
+					Usage: "Optional earliest close time of runs to verify, supported formats are '2006-01-02T15:04:05+07:00' and raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "Optional latest close time of runs to verify, supported formats are '2006-01-02T15:04:05+07:00' and raw UnixNano",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 1000,
+					Usage: "Optional number of records read from the visibility archive per page",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminVerifyArchivedHistory(c)
+			},
+		},
+	}
+}
+
+func newAdminAuditCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the audit records of a domain newest first, or the cluster level records when no domain is given",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagEarliestTimeWithAlias,
+					Usage: "Optional earliest time of records to list, supported formats are '2006-01-02T15:04:05+07:00' and raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "Optional latest time of records to list, supported formats are '2006-01-02T15:04:05+07:00' and raw UnixNano",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Optional number of records read per request",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print the records in json format, including the request summary",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListAuditRecords(c)
+			},
+		},
+	}
+}
+
+func newAdminAuthorizationCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "token",
+			Usage: "Create the token which authenticates an actor to frontend, signed with the token key of the cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagActor,
+					Usage: "Identity of the caller, as configured in the authorization actors of the cluster",
+				},
+				cli.StringFlag{
+					Name:  FlagKeyFile,
+					Usage: "Token key file, the same file as used by the cluster",
+				},
+				cli.IntFlag{
+					Name:  FlagTokenTTL,
+					Value: 24,
+					Usage: "Number of hours the token authenticates the actor for",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminCreateAuthToken(c)
+			},
+		},
+	}
+}
+
+func newAdminEncryptionCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "reencrypt",
+			Aliases: []string{"re"},
+			Usage: "Re-encrypt the history v2 events of all workflows with the active data key of their domain, reading and writing the database directly. " +
+				"Mutable states, history v1 events and visibility memos are not re-encrypted by this command: each part of a mutable state is re-encrypted when it is next updated, " +
+				"and everything else keeps its key until the workflow is past the retention of its domain. " +
+				"Retired keys must stay in the key file until then",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  FlagKeyFile,
+					Usage: "Key file with the active and all retired data keys, the same file as used by the cluster",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Optional number of history branches read from the database per page",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: 100,
+					Usage: "Optional maximum number of database requests per second",
+				},
+			}, getDBFlags()...),
+			Action: func(c *cli.Context) {
+				AdminReencryptHistory(c)
+			},
+		},
+	}
+}
+
+func getDBFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagDBEngine,
+			Value: "cassandra",
+			Usage: "Type of the database, one of cassandra, mysql, postgres",
+		},
+		cli.StringFlag{
+			Name:  FlagAddress,
+			Usage: "database host address",
+		},
+		cli.IntFlag{
+			Name:  FlagPort,
+			Usage: "database port for the host",
+		},
+		cli.StringFlag{
+			Name:  FlagUsername,
+			Usage: "database username",
+		},
+		cli.StringFlag{
+			Name:  FlagPassword,
+			Usage: "database password",
+		},
+		cli.StringFlag{
+			Name:  FlagKeyspace,
+			Usage: "cassandra keyspace",
+		},
+		cli.StringFlag{
+			Name:  FlagDatabaseName,
+			Usage: "sql database name",
+		},
+	}
+}
+
+func getFlagsForArchive() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagDomainID,
+			Usage: "DomainID",
+		},
+		cli.StringFlag{
+			Name:  FlagS3Region,
+			Usage: "Optional region of the bucket, required for s3 URIs",
+		},
+		cli.StringFlag{
+			Name:  FlagS3Endpoint,
+			Usage: "Optional endpoint of the s3 compatible service, defaults to AWS S3 for the region",
+		},
+		cli.BoolFlag{
+			Name:  FlagS3ForcePathStyle,
+			Usage: "Optional, address buckets as endpoint/bucket instead of bucket.endpoint",
+		},
 	}
 }
 
@@ -570,3 +850,191 @@ func newAdminClusterCommands() []cli.Command {
 		},
 	}
 }
+
+func newAdminDLQCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the tasks of a shard which were moved to the dead letter queue",
+			Flags: append(getDLQFlags(),
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Optional number of tasks read per page",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print the tasks in json format",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminListDLQTasks(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe a task of the dead letter queue, including the encoded task and its last error",
+			Flags: append(getDLQFlags(),
+				cli.IntFlag{
+					Name:  FlagTaskIDWithAlias,
+					Usage: "ID of the task",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminDescribeDLQTask(c)
+			},
+		},
+		{
+			Name:    "requeue",
+			Aliases: []string{"rq"},
+			Usage:   "Process a task of the dead letter queue again, the task is removed from the queue if it succeeds",
+			Flags: append(getDLQFlags(),
+				cli.IntFlag{
+					Name:  FlagTaskIDWithAlias,
+					Usage: "ID of the task",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminRequeueDLQTask(c)
+			},
+		},
+		{
+			Name:    "purge",
+			Aliases: []string{"p"},
+			Usage:   "Remove a task, or all the tasks of the category, from the dead letter queue without processing them",
+			Flags: append(getDLQFlags(),
+				cli.IntFlag{
+					Name:  FlagTaskIDWithAlias,
+					Usage: "Optional ID of the task, all the tasks of the category are removed if not set",
+				},
+				cli.BoolFlag{
+					Name:  FlagYes,
+					Usage: "Optional flag to disable confirmation prompt",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminPurgeDLQTasks(c)
+			},
+		},
+	}
+}
+
+func getDLQFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.IntFlag{
+			Name:  FlagShardIDWithAlias,
+			Usage: "ID of the shard",
+		},
+		cli.StringFlag{
+			Name:  FlagDLQCategoryWithAlias,
+			Value: "transfer",
+			Usage: "Optional category of the tasks [transfer|timer]",
+		},
+	}
+}
+
+func newAdminShardCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "describe-queues",
+			Aliases: []string{"dq"},
+			Usage:   "Describe the ack levels and read levels of the transfer, timer and replication queues of a shard",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ID of the shard",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print the raw response in json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeShardQueues(c)
+			},
+		},
+		{
+			Name:    "list-tasks",
+			Aliases: []string{"lt"},
+			Usage:   "List the pending tasks of a queue of a shard",
+			Flags: append(getShardQueueFlags(),
+				cli.StringFlag{
+					Name:  FlagDomainID,
+					Usage: "Optional domain ID to filter the tasks",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "Optional workflow ID to filter the tasks",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Optional number of tasks read per page",
+				},
+				cli.BoolFlag{
+					Name:  FlagAllWithAlias,
+					Usage: "List all the pending tasks instead of the first page",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print the tasks in json format",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminListShardQueueTasks(c)
+			},
+		},
+		{
+			Name:    "delete-task",
+			Aliases: []string{"dt"},
+			Usage:   "Delete a task not yet loaded by the queue processor from a queue of a shard without processing it",
+			Flags: append(getShardQueueTaskFlags(),
+				cli.BoolFlag{
+					Name:  FlagYes,
+					Usage: "Optional flag to disable confirmation prompt",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminDeleteShardQueueTask(c)
+			},
+		},
+		{
+			Name:    "refire-task",
+			Aliases: []string{"rt"},
+			Usage:   "Process a pending task of a queue of a shard once, regardless of the read level of the queue",
+			Flags:   getShardQueueTaskFlags(),
+			Action: func(c *cli.Context) {
+				AdminRefireShardQueueTask(c)
+			},
+		},
+	}
+}
+
+func getShardQueueFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.IntFlag{
+			Name:  FlagShardIDWithAlias,
+			Usage: "ID of the shard",
+		},
+		cli.StringFlag{
+			Name:  FlagQueueTypeWithAlias,
+			Value: "transfer",
+			Usage: "Optional type of the queue [transfer|timer|replication]",
+		},
+	}
+}
+
+func getShardQueueTaskFlags() []cli.Flag {
+	return append(getShardQueueFlags(),
+		cli.IntFlag{
+			Name:  FlagTaskIDWithAlias,
+			Usage: "ID of the task",
+		},
+		cli.StringFlag{
+			Name:  FlagVisibilityTimestampWithAlias,
+			Usage: "Visibility timestamp of the task, required for timer tasks, use UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+	)
+}
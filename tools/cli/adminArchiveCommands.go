@@ -0,0 +1,275 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	carchiver "github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/service/worker/archiver"
+	"github.com/urfave/cli"
+)
+
+const (
+	// archiveServiceName is the service name archivers are registered under when the cli reads archives directly
+	archiveServiceName = "cadence-cli"
+
+	archiveHistoryPageSize = 250
+
+	// archives are only read by the cli, so the modes are never used to create files
+	archiveFileMode = "0666"
+	archiveDirMode  = "0766"
+)
+
+// AdminListArchivedRuns lists the runs of a domain recorded in its visibility archive
+func AdminListArchivedRuns(c *cli.Context) {
+	domainID := getRequiredOption(c, FlagDomainID)
+	URI := getRequiredOption(c, FlagVisibilityArchivalURI)
+	visibilityArchiver := getVisibilityArchiver(c, URI)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Workflow Type", "Workflow ID", "Run ID", "Start Time", "Close Time", "Close Status", "History Length"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	listArchivedRuns(c, visibilityArchiver, URI, domainID, func(execution *shared.WorkflowExecutionInfo) {
+		table.Append([]string{
+			execution.Type.GetName(),
+			execution.Execution.GetWorkflowId(),
+			execution.Execution.GetRunId(),
+			convertTime(execution.GetStartTime(), false),
+			convertTime(execution.GetCloseTime(), false),
+			execution.GetCloseStatus().String(),
+			strconv.FormatInt(execution.GetHistoryLength(), 10),
+		})
+	})
+	table.Render()
+}
+
+// AdminDownloadArchivedHistory writes the archived history of a run to a json history file,
+// in the same format as the one written by workflow show, so that it can be replayed
+func AdminDownloadArchivedHistory(c *cli.Context) {
+	domainID := getRequiredOption(c, FlagDomainID)
+	URI := getRequiredOption(c, FlagHistoryArchivalURI)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := getRequiredOption(c, FlagRunID)
+	outputFileName := getRequiredOption(c, FlagOutputFilename)
+	historyArchiver := getHistoryArchiver(c, URI)
+
+	request := &carchiver.GetHistoryRequest{
+		DomainID:   domainID,
+		WorkflowID: wid,
+		RunID:      rid,
+		PageSize:   archiveHistoryPageSize,
+	}
+	if c.IsSet(FlagCloseFailoverVersion) {
+		request.CloseFailoverVersion = common.Int64Ptr(c.Int64(FlagCloseFailoverVersion))
+	}
+	var events []*shared.HistoryEvent
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := historyArchiver.Get(ctx, URI, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to get archived history on workflow id: %s, run id: %s.", wid, rid), err)
+		}
+		for _, batch := range resp.HistoryBatches {
+			events = append(events, batch.Events...)
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+
+	data, err := json.Marshal(events)
+	if err != nil {
+		ErrorAndExit("Failed to serialize history data.", err)
+	}
+	if err := ioutil.WriteFile(outputFileName, data, 0666); err != nil {
+		ErrorAndExit("Failed to export history data file.", err)
+	}
+	fmt.Printf("Downloaded %v events to %v\n", len(events), outputFileName)
+}
+
+// AdminVerifyArchivedHistory checks that archived histories are complete histories of closed workflows,
+// either of a single run or of every run of a domain recorded in its visibility archive
+func AdminVerifyArchivedHistory(c *cli.Context) {
+	domainID := getRequiredOption(c, FlagDomainID)
+	historyURI := getRequiredOption(c, FlagHistoryArchivalURI)
+	historyArchiver := getHistoryArchiver(c, historyURI)
+
+	if c.IsSet(FlagWorkflowID) || c.IsSet(FlagRunID) {
+		execution := &shared.WorkflowExecutionInfo{
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(getRequiredOption(c, FlagWorkflowID)),
+				RunId:      common.StringPtr(getRequiredOption(c, FlagRunID)),
+			},
+		}
+		ctx, cancel := newContext(c)
+		defer cancel()
+		if err := archiver.CheckArchivedHistory(ctx, historyArchiver, historyURI, domainID, execution); err != nil {
+			ErrorAndExit("Failed to verify archived history.", err)
+		}
+		fmt.Println("Archived history is complete.")
+		return
+	}
+
+	visibilityURI := getRequiredOption(c, FlagVisibilityArchivalURI)
+	visibilityArchiver := getVisibilityArchiver(c, visibilityURI)
+	checkedCount, corruptedCount, failureCount := 0, 0, 0
+	listArchivedRuns(c, visibilityArchiver, visibilityURI, domainID, func(execution *shared.WorkflowExecutionInfo) {
+		ctx, cancel := newContext(c)
+		defer cancel()
+		err := archiver.CheckArchivedHistory(ctx, historyArchiver, historyURI, domainID, execution)
+		switch {
+		case err == nil:
+			checkedCount++
+			return
+		case archiver.IsArchivedHistoryCorruptedError(err):
+			checkedCount++
+			corruptedCount++
+		default:
+			failureCount++
+		}
+		fmt.Printf("workflow id: %s, run id: %s: %v\n",
+			execution.Execution.GetWorkflowId(), execution.Execution.GetRunId(), err)
+	})
+	fmt.Printf("Checked %v archived histories, %v corrupted, %v could not be checked\n", checkedCount, corruptedCount, failureCount)
+	if corruptedCount > 0 || failureCount > 0 {
+		ErrorAndExit("Archive failed verification.", nil)
+	}
+}
+
+func listArchivedRuns(
+	c *cli.Context,
+	visibilityArchiver carchiver.VisibilityArchiver,
+	URI string,
+	domainID string,
+	fn func(*shared.WorkflowExecutionInfo),
+) {
+	request := &carchiver.GetVisibilityRequest{
+		DomainID: domainID,
+		PageSize: c.Int(FlagPageSize),
+	}
+	if c.IsSet(FlagEarliestTime) {
+		request.EarliestCloseTime = common.Int64Ptr(parseTime(c.String(FlagEarliestTime), 0))
+	}
+	if c.IsSet(FlagLatestTime) {
+		request.LatestCloseTime = common.Int64Ptr(parseTime(c.String(FlagLatestTime), 0))
+	}
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := visibilityArchiver.Get(ctx, URI, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list archived runs.", err)
+		}
+		for _, execution := range resp.Executions {
+			fn(execution)
+		}
+		if len(resp.NextPageToken) == 0 {
+			return
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+func getHistoryArchiver(c *cli.Context, URI string) carchiver.HistoryArchiver {
+	scheme, err := common.GetArchivalScheme(URI)
+	if err != nil {
+		ErrorAndExit("Invalid history archive URI.", err)
+	}
+	historyArchiver, err := newArchiverProvider(c).GetHistoryArchiver(scheme, archiveServiceName)
+	if err != nil {
+		ErrorAndExit("Failed to create history archiver.", err)
+	}
+	if err := historyArchiver.ValidateURI(URI); err != nil {
+		ErrorAndExit("Invalid history archive URI.", err)
+	}
+	return historyArchiver
+}
+
+func getVisibilityArchiver(c *cli.Context, URI string) carchiver.VisibilityArchiver {
+	scheme, err := common.GetArchivalScheme(URI)
+	if err != nil {
+		ErrorAndExit("Invalid visibility archive URI.", err)
+	}
+	visibilityArchiver, err := newArchiverProvider(c).GetVisibilityArchiver(scheme, archiveServiceName)
+	if err != nil {
+		ErrorAndExit("Failed to create visibility archiver.", err)
+	}
+	if err := visibilityArchiver.ValidateURI(URI); err != nil {
+		ErrorAndExit("Invalid visibility archive URI.", err)
+	}
+	return visibilityArchiver
+}
+
+// newArchiverProvider returns a provider which creates archivers from flags rather than from a service config,
+// reading archives does not need persistence so the archivers work without a running cluster
+func newArchiverProvider(c *cli.Context) provider.ArchiverProvider {
+	archiverProvider := provider.NewArchiverProvider(
+		&config.HistoryArchiverProvider{
+			Filestore: &config.FilestoreHistoryArchiver{
+				FileMode: archiveFileMode,
+				DirMode:  archiveDirMode,
+			},
+			S3store: &config.S3HistoryArchiver{
+				Region:           c.String(FlagS3Region),
+				Endpoint:         c.String(FlagS3Endpoint),
+				S3ForcePathStyle: c.Bool(FlagS3ForcePathStyle),
+			},
+		},
+		&config.VisibilityArchiverProvider{
+			Filestore: &config.FilestoreVisibilityArchiver{
+				FileMode: archiveFileMode,
+				DirMode:  archiveDirMode,
+			},
+		},
+	)
+	logger := loggerimpl.NewNopLogger()
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Common)
+	archiverProvider.RegisterBootstrapContainer(
+		archiveServiceName,
+		&carchiver.HistoryBootstrapContainer{
+			Logger:        logger,
+			MetricsClient: metricsClient,
+		},
+		&carchiver.VisibilityBootstrapContainer{
+			Logger:        logger,
+			MetricsClient: metricsClient,
+		},
+	)
+	return archiverProvider
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	carchiver "github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/filestore"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/urfave/cli"
+)
+
+const (
+	testArchiveDomainID   = "test-domain-id"
+	testArchiveDomainName = "test-domain-name"
+	testArchiveWorkflowID = "test-workflow-id"
+	testArchiveRunID      = "test-run-id"
+)
+
+type adminArchiveCommandsSuite struct {
+	suite.Suite
+	app           *cli.App
+	dir           string
+	historyURI    string
+	visibilityURI string
+}
+
+func TestAdminArchiveCommandsSuite(t *testing.T) {
+	suite.Run(t, new(adminArchiveCommandsSuite))
+}
+
+func (s *adminArchiveCommandsSuite) SetupTest() {
+	s.app = NewCliApp()
+	dir, err := ioutil.TempDir("", "adminArchiveCommandsSuite")
+	s.Require().NoError(err)
+	s.dir = dir
+	s.historyURI = "file://" + filepath.Join(dir, "history")
+	s.visibilityURI = "file://" + filepath.Join(dir, "visibility")
+}
+
+func (s *adminArchiveCommandsSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *adminArchiveCommandsSuite) TestList() {
+	s.archiveRun(3, 3)
+	s.Equal(0, s.runErrorExitCode([]string{"", "admin", "archive", "ls",
+		"--domain_id", testArchiveDomainID, "--visibility_uri", s.visibilityURI}))
+}
+
+func (s *adminArchiveCommandsSuite) TestList_MissingURI() {
+	s.Equal(1, s.runErrorExitCode([]string{"", "admin", "archive", "ls", "--domain_id", testArchiveDomainID}))
+}
+
+func (s *adminArchiveCommandsSuite) TestDownload() {
+	s.archiveRun(3, 3)
+	outputFileName := filepath.Join(s.dir, "history.json")
+	s.Equal(0, s.runErrorExitCode([]string{"", "admin", "archive", "download",
+		"--domain_id", testArchiveDomainID, "--history_uri", s.historyURI,
+		"--workflow_id", testArchiveWorkflowID, "--run_id", testArchiveRunID, "--output_filename", outputFileName}))
+
+	data, err := ioutil.ReadFile(outputFileName)
+	s.NoError(err)
+	history, err := (&JSONHistorySerializer{}).Deserialize(data)
+	s.NoError(err)
+	s.Len(history.Events, 3)
+	for i, event := range history.Events {
+		s.Equal(int64(i+1), event.GetEventId())
+	}
+}
+
+func (s *adminArchiveCommandsSuite) TestDownload_NotExist() {
+	s.Equal(1, s.runErrorExitCode([]string{"", "admin", "archive", "download",
+		"--domain_id", testArchiveDomainID, "--history_uri", s.historyURI,
+		"--workflow_id", testArchiveWorkflowID, "--run_id", testArchiveRunID,
+		"--output_filename", filepath.Join(s.dir, "history.json")}))
+}
+
+func (s *adminArchiveCommandsSuite) TestVerify_SingleRun() {
+	s.archiveRun(3, 3)
+	s.Equal(0, s.runErrorExitCode([]string{"", "admin", "archive", "verify",
+		"--domain_id", testArchiveDomainID, "--history_uri", s.historyURI,
+		"--workflow_id", testArchiveWorkflowID, "--run_id", testArchiveRunID}))
+}
+
+func (s *adminArchiveCommandsSuite) TestVerify_AllRuns() {
+	s.archiveRun(3, 3)
+	s.Equal(0, s.runErrorExitCode([]string{"", "admin", "archive", "verify",
+		"--domain_id", testArchiveDomainID, "--history_uri", s.historyURI, "--visibility_uri", s.visibilityURI}))
+}
+
+func (s *adminArchiveCommandsSuite) TestVerify_AllRuns_HistoryLengthMismatch() {
+	s.archiveRun(3, 5)
+	s.Equal(1, s.runErrorExitCode([]string{"", "admin", "archive", "verify",
+		"--domain_id", testArchiveDomainID, "--history_uri", s.historyURI, "--visibility_uri", s.visibilityURI}))
+}
+
+func (s *adminArchiveCommandsSuite) TestVerify_SingleRun_NotExist() {
+	s.Equal(1, s.runErrorExitCode([]string{"", "admin", "archive", "verify",
+		"--domain_id", testArchiveDomainID, "--history_uri", s.historyURI,
+		"--workflow_id", testArchiveWorkflowID, "--run_id", testArchiveRunID}))
+}
+
+func (s *adminArchiveCommandsSuite) runErrorExitCode(arguments []string) int {
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+	var errorCode int
+	osExit = func(code int) {
+		errorCode = code
+		panic(code)
+	}
+	func() {
+		defer func() { recover() }()
+		s.app.Run(arguments)
+	}()
+	return errorCode
+}
+
+// archiveRun archives a history of eventCount events and a visibility record claiming historyLength events
+func (s *adminArchiveCommandsSuite) archiveRun(eventCount int, historyLength int64) {
+	var events []*shared.HistoryEvent
+	for i := 1; i <= eventCount; i++ {
+		events = append(events, &shared.HistoryEvent{
+			EventId:   common.Int64Ptr(int64(i)),
+			EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+			Version:   common.Int64Ptr(1),
+		})
+	}
+	events[eventCount-1].EventType = shared.EventTypeWorkflowExecutionCompleted.Ptr()
+
+	historyManager := &mocks.HistoryManager{}
+	historyManager.On("GetWorkflowExecutionHistoryByBatch", mock.Anything).Return(&persistence.GetWorkflowExecutionHistoryByBatchResponse{
+		History: []*shared.History{{Events: events}},
+	}, nil).Once()
+	historyManager.On("GetWorkflowExecutionHistoryByBatch", mock.Anything).Return(nil, &shared.EntityNotExistsError{})
+	logger := loggerimpl.NewNopLogger()
+	historyArchiver, err := filestore.NewHistoryArchiver(carchiver.HistoryBootstrapContainer{
+		HistoryManager: historyManager,
+		Logger:         logger,
+	}, &config.FilestoreHistoryArchiver{FileMode: archiveFileMode, DirMode: archiveDirMode})
+	s.Require().NoError(err)
+	s.Require().NoError(historyArchiver.Archive(context.Background(), s.historyURI, &carchiver.ArchiveHistoryRequest{
+		DomainID:             testArchiveDomainID,
+		DomainName:           testArchiveDomainName,
+		WorkflowID:           testArchiveWorkflowID,
+		RunID:                testArchiveRunID,
+		NextEventID:          int64(eventCount + 1),
+		CloseFailoverVersion: 1,
+	}))
+
+	visibilityArchiver, err := filestore.NewVisibilityArchiver(carchiver.VisibilityBootstrapContainer{
+		Logger: logger,
+	}, &config.FilestoreVisibilityArchiver{FileMode: archiveFileMode, DirMode: archiveDirMode})
+	s.Require().NoError(err)
+	s.Require().NoError(visibilityArchiver.Archive(context.Background(), s.visibilityURI, &carchiver.ArchiveVisibilityRequest{
+		DomainID:         testArchiveDomainID,
+		DomainName:       testArchiveDomainName,
+		WorkflowID:       testArchiveWorkflowID,
+		RunID:            testArchiveRunID,
+		WorkflowTypeName: "test-workflow-type",
+		StartTimestamp:   1,
+		CloseTimestamp:   2,
+		CloseStatus:      shared.WorkflowExecutionCloseStatusCompleted,
+		HistoryLength:    historyLength,
+	}))
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"os"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// AdminListAuditRecords lists the audit records of a domain, or the cluster level
+// records when no domain is given
+func AdminListAuditRecords(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	request := &admin.ListAuditRecordsRequest{
+		EarliestTime:    common.Int64Ptr(parseTime(c.String(FlagEarliestTime), 0)),
+		LatestTime:      common.Int64Ptr(parseTime(c.String(FlagLatestTime), time.Now().UnixNano())),
+		MaximumPageSize: common.Int32Ptr(int32(c.Int(FlagPageSize))),
+	}
+	if domain := c.GlobalString(FlagDomain); domain != "" {
+		request.Domain = common.StringPtr(domain)
+	}
+	var records []*admin.AuditRecord
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ListAuditRecords(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list audit records.", err)
+		}
+		records = append(records, resp.Records...)
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(records)
+		return
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Time", "Operation", "Workflow ID", "Run ID", "Identity", "Reason", "Result"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, record := range records {
+		table.Append([]string{
+			convertTime(record.GetTimestamp(), false),
+			record.GetOperation(),
+			record.GetWorkflowId(),
+			record.GetRunId(),
+			record.GetIdentity(),
+			record.GetReason(),
+			record.GetResult(),
+		})
+	}
+	table.Render()
+}
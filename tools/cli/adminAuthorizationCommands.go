@@ -0,0 +1,44 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/authorization"
+	"github.com/urfave/cli"
+)
+
+// AdminCreateAuthToken prints the token which authenticates an actor to frontend until it expires
+func AdminCreateAuthToken(c *cli.Context) {
+	actor := getRequiredOption(c, FlagActor)
+	keyFile := getRequiredOption(c, FlagKeyFile)
+	ttlHours := c.Int(FlagTokenTTL)
+	if ttlHours <= 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s must be positive.", FlagTokenTTL), nil)
+	}
+	key, err := authorization.LoadTokenKey(keyFile)
+	if err != nil {
+		ErrorAndExit("Failed to load the token key file.", err)
+	}
+	fmt.Println(authorization.NewToken(key, actor, time.Now().Add(time.Duration(ttlHours)*time.Hour)))
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+type dlqTaskDescription struct {
+	*shared.DLQTaskInfo
+	// Data shadows the encoded task of DLQTaskInfo so that it is printed as json instead of base64
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// AdminListDLQTasks lists the tasks of a shard which were moved to the dead letter queue
+func AdminListDLQTasks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &shared.ListDLQTasksRequest{
+		ShardId:         common.Int32Ptr(int32(getRequiredIntOption(c, FlagShardID))),
+		Category:        getDLQTaskCategory(c),
+		MaximumPageSize: common.Int32Ptr(int32(c.Int(FlagPageSize))),
+	}
+
+	var tasks []*shared.DLQTaskInfo
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ListDLQTasks(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("List DLQ tasks failed.", err)
+			return
+		}
+		tasks = append(tasks, resp.Tasks...)
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(tasks)
+		return
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Task ID", "Task Type", "Workflow ID", "Run ID", "Attempt", "Moved Time", "Last Error"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, task := range tasks {
+		table.Append([]string{
+			strconv.FormatInt(task.GetTaskId(), 10),
+			strconv.Itoa(int(task.GetTaskType())),
+			task.GetWorkflowId(),
+			task.GetRunId(),
+			strconv.Itoa(int(task.GetAttempt())),
+			convertTime(task.GetCreatedTimestamp(), false),
+			task.GetLastError(),
+		})
+	}
+	table.Render()
+}
+
+// AdminDescribeDLQTask describes a task of the dead letter queue of a shard
+func AdminDescribeDLQTask(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &shared.DescribeDLQTaskRequest{
+		ShardId:  common.Int32Ptr(int32(getRequiredIntOption(c, FlagShardID))),
+		Category: getDLQTaskCategory(c),
+		TaskId:   common.Int64Ptr(int64(getRequiredIntOption(c, FlagTaskID))),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeDLQTask(ctx, request)
+	if err != nil {
+		ErrorAndExit("Describe DLQ task failed.", err)
+		return
+	}
+	prettyPrintJSONObject(&dlqTaskDescription{
+		DLQTaskInfo: resp.GetTask(),
+		Data:        resp.GetTask().GetData(),
+	})
+}
+
+// AdminRequeueDLQTask processes a task of the dead letter queue of a shard again,
+// the task is removed from the dead letter queue only if it is processed successfully
+func AdminRequeueDLQTask(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &shared.RequeueDLQTaskRequest{
+		ShardId:  common.Int32Ptr(int32(getRequiredIntOption(c, FlagShardID))),
+		Category: getDLQTaskCategory(c),
+		TaskId:   common.Int64Ptr(int64(getRequiredIntOption(c, FlagTaskID))),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.RequeueDLQTask(ctx, request)
+	if err != nil {
+		ErrorAndExit("Requeue DLQ task failed.", err)
+		return
+	}
+	fmt.Println("Success")
+}
+
+// AdminPurgeDLQTasks removes a task, or all the tasks of the category, from the dead letter queue of a shard
+func AdminPurgeDLQTasks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &shared.PurgeDLQTasksRequest{
+		ShardId:  common.Int32Ptr(int32(getRequiredIntOption(c, FlagShardID))),
+		Category: getDLQTaskCategory(c),
+	}
+	target := fmt.Sprintf("all %v tasks", request.GetCategory())
+	if c.IsSet(FlagTaskID) {
+		request.TaskId = common.Int64Ptr(int64(c.Int(FlagTaskID)))
+		target = fmt.Sprintf("%v task %v", request.GetCategory(), request.GetTaskId())
+	}
+
+	if !c.Bool(FlagYes) {
+		reader := bufio.NewReader(os.Stdin)
+		fmt.Printf("Are you trying to purge %v from the DLQ of shard %v? Y/N\n", target, request.GetShardId())
+		text, _ := reader.ReadString('\n')
+		textLower := strings.ToLower(strings.TrimSpace(text))
+		if textLower != "y" && textLower != "yes" {
+			return
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.PurgeDLQTasks(ctx, request)
+	if err != nil {
+		ErrorAndExit("Purge DLQ tasks failed.", err)
+		return
+	}
+	fmt.Println("Success")
+}
+
+func getDLQTaskCategory(c *cli.Context) *shared.DLQTaskCategory {
+	switch strings.ToLower(c.String(FlagDLQCategory)) {
+	case "transfer":
+		return shared.DLQTaskCategoryTransfer.Ptr()
+	case "timer":
+		return shared.DLQTaskCategoryTimer.Ptr()
+	default:
+		ErrorAndExit("Unknown DLQ category, supported: transfer, timer", nil)
+		return nil
+	}
+}
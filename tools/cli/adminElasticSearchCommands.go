@@ -28,8 +28,12 @@ import (
 	"github.com/olekukonko/tablewriter"
 	"github.com/olivere/elastic"
 	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
 	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/service/worker/backfiller"
 	"github.com/urfave/cli"
+	"go.uber.org/cadence/.gen/go/shared"
+	cclient "go.uber.org/cadence/client"
 	"net/http"
 	"os"
 	"strconv"
@@ -177,6 +181,78 @@ func AdminIndex(c *cli.Context) {
 	}
 }
 
+// AdminStartVisibilityBackfill starts a workflow to copy visibility records of a domain from database to ElasticSearch
+func AdminStartVisibilityBackfill(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	params := backfiller.BackfillParams{
+		DomainName: domain,
+		RPS:        c.Int(FlagRPS),
+		PageSize:   c.Int(FlagPageSize),
+	}
+	if c.IsSet(FlagLatestTime) {
+		params.LatestStartTime = time.Unix(0, parseTime(c.String(FlagLatestTime), 0))
+	}
+
+	svcClient := cFactory.ClientFrontendClient(c)
+	client := cclient.NewClient(svcClient, common.SystemLocalDomainName, &cclient.Options{})
+	ctx, cancel := newContext(c)
+	defer cancel()
+	options := cclient.StartWorkflowOptions{
+		ID:                           backfiller.WorkflowIDPrefix + domain,
+		TaskList:                     backfiller.TaskListName,
+		ExecutionStartToCloseTimeout: backfiller.InfiniteDuration,
+	}
+	wf, err := client.StartWorkflow(ctx, options, backfiller.WorkflowTypeName, params)
+	if err != nil {
+		ErrorAndExit("Failed to start visibility backfill", err)
+	}
+	output := map[string]interface{}{
+		"msg":   "visibility backfill is started",
+		"wid":   wf.ID,
+		"runid": wf.RunID,
+	}
+	prettyPrintJSONObject(output)
+}
+
+// AdminDescribeVisibilityBackfill describes the progress of visibility backfill of a domain
+func AdminDescribeVisibilityBackfill(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	svcClient := cFactory.ClientFrontendClient(c)
+	client := cclient.NewClient(svcClient, common.SystemLocalDomainName, &cclient.Options{})
+	ctx, cancel := newContext(c)
+	defer cancel()
+	workflowID := backfiller.WorkflowIDPrefix + domain
+	wf, err := client.DescribeWorkflowExecution(ctx, workflowID, "")
+	if err != nil {
+		ErrorAndExit("Failed to describe visibility backfill", err)
+	}
+
+	output := map[string]interface{}{}
+	if wf.WorkflowExecutionInfo.CloseStatus == nil {
+		output["msg"] = "visibility backfill is running"
+		if len(wf.PendingActivities) > 0 && len(wf.PendingActivities[0].HeartbeatDetails) > 0 {
+			hbd := backfiller.HeartBeatDetails{}
+			if err := json.Unmarshal(wf.PendingActivities[0].HeartbeatDetails, &hbd); err != nil {
+				ErrorAndExit("Failed to describe visibility backfill", err)
+			}
+			output["progress"] = hbd
+		}
+	} else if wf.WorkflowExecutionInfo.GetCloseStatus() != shared.WorkflowExecutionCloseStatusCompleted {
+		output["msg"] = "visibility backfill stopped status: " + wf.WorkflowExecutionInfo.GetCloseStatus().String()
+	} else {
+		output["msg"] = "visibility backfill is finished successfully"
+		ctx, cancel := newContext(c)
+		defer cancel()
+		var result backfiller.BackfillResult
+		if err := client.GetWorkflow(ctx, workflowID, wf.WorkflowExecutionInfo.Execution.GetRunId()).Get(ctx, &result); err != nil {
+			ErrorAndExit("Failed to get visibility backfill result", err)
+		}
+		output["result"] = result
+	}
+	prettyPrintJSONObject(output)
+}
+
 func parseIndexerMessage(fileName string) (messages []*indexer.Message, err error) {
 	file, err := os.Open(fileName)
 	if err != nil {
@@ -0,0 +1,162 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/uber/cadence/common/encryption"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	p "github.com/uber/cadence/common/persistence"
+	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
+	"github.com/uber/cadence/common/persistence/sql/storage/mysql"
+	"github.com/uber/cadence/common/persistence/sql/storage/postgres"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/urfave/cli"
+)
+
+const (
+	encryptionDataStoreName = "default"
+	dbEngineCassandra       = "cassandra"
+)
+
+// AdminReencryptHistory rewrites all history v2 nodes which are not encrypted with the active
+// data key of their domain. It does not rewrite mutable states, history v1 events or visibility
+// memos, so a retired key can only be removed from the key file once those have been updated
+// or deleted as well
+func AdminReencryptHistory(c *cli.Context) {
+	keyFile := getRequiredOption(c, FlagKeyFile)
+	if _, err := encryption.NewLocalKeyProvider(keyFile); err != nil {
+		ErrorAndExit("Failed to load the key file.", err)
+	}
+
+	dataStore := getDataStoreConfig(c)
+	if dataStore.Cassandra != nil {
+		dataStore.Cassandra.MaxQPS = c.Int(FlagRPS)
+	} else {
+		dataStore.SQL.MaxQPS = c.Int(FlagRPS)
+	}
+	pFactory := persistencefactory.New(&config.Persistence{
+		DefaultStore:    encryptionDataStoreName,
+		VisibilityStore: encryptionDataStoreName,
+		DataStores:      map[string]config.DataStore{encryptionDataStoreName: dataStore},
+		Encryption: &config.Encryption{
+			Enabled: true,
+			KeyFile: keyFile,
+		},
+	}, "", nil, loggerimpl.NewNopLogger())
+	defer pFactory.Close()
+	historyV2Mgr, err := pFactory.NewHistoryV2Manager()
+	if err != nil {
+		ErrorAndExit("Failed to connect to the history store.", err)
+	}
+
+	var branchCount, nodeCount, failureCount int
+	request := &p.GetAllHistoryTreeBranchesRequest{
+		PageSize: c.Int(FlagPageSize),
+	}
+	for {
+		resp, err := historyV2Mgr.GetAllHistoryTreeBranches(request)
+		if err != nil {
+			ErrorAndExit("Failed to list history branches.", err)
+		}
+		for _, branch := range resp.Branches {
+			branchCount++
+			domainID := getDomainIDFromBranchInfo(branch.Info)
+			if domainID == "" {
+				fmt.Printf("branch %v of tree %v has no domain in its info %q, skipped\n", branch.BranchID, branch.TreeID, branch.Info)
+				failureCount++
+				continue
+			}
+			shardID := branch.ShardID
+			reencryptResp, err := historyV2Mgr.ReencryptHistoryBranch(&p.ReencryptHistoryBranchRequest{
+				TreeID:   branch.TreeID,
+				BranchID: branch.BranchID,
+				DomainID: domainID,
+				ShardID:  &shardID,
+			})
+			if err != nil {
+				fmt.Printf("failed to re-encrypt branch %v of tree %v: %v\n", branch.BranchID, branch.TreeID, err)
+				failureCount++
+				continue
+			}
+			nodeCount += reencryptResp.ReencryptedNodeCount
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+
+	fmt.Printf("Scanned %v history branches, re-encrypted %v history nodes, %v branches failed.\n",
+		branchCount, nodeCount, failureCount)
+	fmt.Println("Mutable states, history v1 events and visibility memos were not re-encrypted, " +
+		"keep the retired keys until the workflows written with them are updated or past their retention.")
+	if failureCount > 0 {
+		ErrorAndExit("Some history branches were not re-encrypted, run the command again once the failures are resolved.", nil)
+	}
+}
+
+// getDomainIDFromBranchInfo returns the domain ID from the branch info written by history,
+// which is in the format of domainID:workflowID:runID
+func getDomainIDFromBranchInfo(info string) string {
+	parts := strings.SplitN(info, ":", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	return parts[0]
+}
+
+// getDataStoreConfig returns the datastore config given by the database connection flags
+func getDataStoreConfig(c *cli.Context) config.DataStore {
+	host := getRequiredOption(c, FlagAddress)
+	if !c.IsSet(FlagPort) {
+		ErrorAndExit("port is required", nil)
+	}
+	port := c.Int(FlagPort)
+
+	var dataStore config.DataStore
+	switch engine := c.String(FlagDBEngine); engine {
+	case dbEngineCassandra:
+		dataStore.Cassandra = &config.Cassandra{
+			Hosts:    host,
+			Port:     port,
+			User:     c.String(FlagUsername),
+			Password: c.String(FlagPassword),
+			Keyspace: getRequiredOption(c, FlagKeyspace),
+		}
+	case mysql.DriverName, postgres.DriverName:
+		dataStore.SQL = &config.SQL{
+			User:            c.String(FlagUsername),
+			Password:        c.String(FlagPassword),
+			DriverName:      engine,
+			DatabaseName:    getRequiredOption(c, FlagDatabaseName),
+			ConnectAddr:     net.JoinHostPort(host, strconv.Itoa(port)),
+			ConnectProtocol: "tcp",
+		}
+	default:
+		ErrorAndExit("db_engine is not valid, supported: "+dbEngineCassandra+", "+mysql.DriverName+", "+postgres.DriverName, nil)
+	}
+	return dataStore
+}
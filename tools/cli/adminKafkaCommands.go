@@ -471,13 +471,13 @@ func doRereplicate(shardID int, domainID, wid, rid string, minID, maxID int64, t
 	}
 
 	histV1 := cassandra.NewHistoryPersistenceFromSession(session, loggerimpl.NewNopLogger())
-	historyMgr := persistence.NewHistoryManagerImpl(histV1, loggerimpl.NewNopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit))
+	historyMgr := persistence.NewHistoryManagerImpl(histV1, persistence.NewNoopPayloadEncryption(), loggerimpl.NewNopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit))
 
 	histV2 := cassandra.NewHistoryV2PersistenceFromSession(session, loggerimpl.NewNopLogger())
-	historyV2Mgr := persistence.NewHistoryV2ManagerImpl(histV2, loggerimpl.NewNopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit))
+	historyV2Mgr := persistence.NewHistoryV2ManagerImpl(histV2, persistence.NewNoopPayloadEncryption(), loggerimpl.NewNopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit))
 
 	exeM, _ := cassandra.NewWorkflowExecutionPersistence(shardID, session, loggerimpl.NewNopLogger())
-	exeMgr := persistence.NewExecutionManagerImpl(exeM, loggerimpl.NewNopLogger())
+	exeMgr := persistence.NewExecutionManagerImpl(exeM, persistence.NewNoopPayloadEncryption(), loggerimpl.NewNopLogger())
 
 	for {
 		fmt.Printf("Start rereplicate for wid: %v, rid:%v \n", wid, rid)
@@ -0,0 +1,213 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+type shardQueueLevels struct {
+	ShardID                  int32
+	TransferAckLevel         int64
+	TransferReadLevel        int64
+	TransferMaxReadLevel     int64
+	TransferClusterAckLevels map[string]int64
+	TimerAckLevel            string
+	TimerReadLevel           string
+	TimerMaxReadLevel        string
+	TimerClusterAckLevels    map[string]string
+	ReplicationAckLevel      int64
+	ReplicationReadLevel     *int64 `json:",omitempty"`
+}
+
+// AdminDescribeShardQueues describes the ack levels and read levels of the transfer, timer and replication queues of a shard
+func AdminDescribeShardQueues(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &shared.DescribeShardQueuesRequest{
+		ShardId: common.Int32Ptr(int32(getRequiredIntOption(c, FlagShardID))),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeShardQueues(ctx, request)
+	if err != nil {
+		ErrorAndExit("Describe shard queues failed.", err)
+		return
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(resp)
+		return
+	}
+	timerClusterAckLevels := make(map[string]string, len(resp.TimerClusterAckLevels))
+	for clusterName, ackLevel := range resp.TimerClusterAckLevels {
+		timerClusterAckLevels[clusterName] = convertTime(ackLevel, false)
+	}
+	prettyPrintJSONObject(&shardQueueLevels{
+		ShardID:                  resp.GetShardId(),
+		TransferAckLevel:         resp.GetTransferAckLevel(),
+		TransferReadLevel:        resp.GetTransferReadLevel(),
+		TransferMaxReadLevel:     resp.GetTransferMaxReadLevel(),
+		TransferClusterAckLevels: resp.TransferClusterAckLevels,
+		TimerAckLevel:            convertTime(resp.GetTimerAckLevel(), false),
+		TimerReadLevel:           convertTime(resp.GetTimerReadLevel(), false),
+		TimerMaxReadLevel:        convertTime(resp.GetTimerMaxReadLevel(), false),
+		TimerClusterAckLevels:    timerClusterAckLevels,
+		ReplicationAckLevel:      resp.GetReplicationAckLevel(),
+		ReplicationReadLevel:     resp.ReplicationReadLevel,
+	})
+}
+
+// AdminListShardQueueTasks lists the pending tasks of a transfer, timer or replication queue of a shard
+func AdminListShardQueueTasks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &shared.ListShardQueueTasksRequest{
+		ShardId:         common.Int32Ptr(int32(getRequiredIntOption(c, FlagShardID))),
+		QueueType:       getShardQueueType(c),
+		DomainId:        getPtrOrNilIfEmpty(c.String(FlagDomainID)),
+		WorkflowId:      getPtrOrNilIfEmpty(c.String(FlagWorkflowID)),
+		MaximumPageSize: common.Int32Ptr(int32(c.Int(FlagPageSize))),
+	}
+
+	var tasks []*shared.ShardQueueTaskInfo
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ListShardQueueTasks(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("List shard queue tasks failed.", err)
+			return
+		}
+		tasks = append(tasks, resp.Tasks...)
+		if len(resp.NextPageToken) == 0 || (!c.Bool(FlagAll) && len(tasks) >= c.Int(FlagPageSize)) {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(tasks)
+		return
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Task ID", "Task Type", "Domain ID", "Workflow ID", "Run ID", "Visibility Timestamp", "Version"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, task := range tasks {
+		visibilityTimestamp := ""
+		if task.VisibilityTimestamp != nil {
+			visibilityTimestamp = strconv.FormatInt(task.GetVisibilityTimestamp(), 10)
+		}
+		table.Append([]string{
+			strconv.FormatInt(task.GetTaskId(), 10),
+			strconv.Itoa(int(task.GetTaskType())),
+			task.GetDomainId(),
+			task.GetWorkflowId(),
+			task.GetRunId(),
+			visibilityTimestamp,
+			strconv.FormatInt(task.GetVersion(), 10),
+		})
+	}
+	table.Render()
+}
+
+// AdminDeleteShardQueueTask removes a task from a transfer, timer or replication queue of a shard without processing it
+func AdminDeleteShardQueueTask(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &shared.DeleteShardQueueTaskRequest{
+		ShardId:             common.Int32Ptr(int32(getRequiredIntOption(c, FlagShardID))),
+		QueueType:           getShardQueueType(c),
+		TaskId:              common.Int64Ptr(int64(getRequiredIntOption(c, FlagTaskID))),
+		VisibilityTimestamp: getShardQueueVisibilityTimestamp(c),
+	}
+
+	if !c.Bool(FlagYes) {
+		reader := bufio.NewReader(os.Stdin)
+		fmt.Printf("Are you trying to delete %v task %v from shard %v without processing it? Y/N\n",
+			request.GetQueueType(), request.GetTaskId(), request.GetShardId())
+		text, _ := reader.ReadString('\n')
+		textLower := strings.ToLower(strings.TrimSpace(text))
+		if textLower != "y" && textLower != "yes" {
+			return
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.DeleteShardQueueTask(ctx, request)
+	if err != nil {
+		ErrorAndExit("Delete shard queue task failed.", err)
+		return
+	}
+	fmt.Println("Success")
+}
+
+// AdminRefireShardQueueTask processes a pending task of a transfer, timer or replication queue of a shard once
+func AdminRefireShardQueueTask(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &shared.RefireShardQueueTaskRequest{
+		ShardId:             common.Int32Ptr(int32(getRequiredIntOption(c, FlagShardID))),
+		QueueType:           getShardQueueType(c),
+		TaskId:              common.Int64Ptr(int64(getRequiredIntOption(c, FlagTaskID))),
+		VisibilityTimestamp: getShardQueueVisibilityTimestamp(c),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.RefireShardQueueTask(ctx, request)
+	if err != nil {
+		ErrorAndExit("Refire shard queue task failed.", err)
+		return
+	}
+	fmt.Println("Success")
+}
+
+func getShardQueueType(c *cli.Context) *shared.ShardQueueType {
+	switch strings.ToLower(c.String(FlagQueueType)) {
+	case "transfer":
+		return shared.ShardQueueTypeTransfer.Ptr()
+	case "timer":
+		return shared.ShardQueueTypeTimer.Ptr()
+	case "replication":
+		return shared.ShardQueueTypeReplication.Ptr()
+	default:
+		ErrorAndExit("Unknown queue type, supported: transfer, timer, replication", nil)
+		return nil
+	}
+}
+
+func getShardQueueVisibilityTimestamp(c *cli.Context) *int64 {
+	if !c.IsSet(FlagVisibilityTimestamp) {
+		return nil
+	}
+	return common.Int64Ptr(parseTime(c.String(FlagVisibilityTimestamp), 0))
+}
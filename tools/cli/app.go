@@ -59,6 +59,11 @@ func NewCliApp() *cli.App {
 			Usage:  "optional timeout for context of RPC call in seconds",
 			EnvVar: "CADENCE_CONTEXT_TIMEOUT",
 		},
+		cli.StringFlag{
+			Name:   FlagAuthToken,
+			Usage:  "optional token authenticating the caller to cadence frontend service",
+			EnvVar: "CADENCE_CLI_AUTH_TOKEN",
+		},
 	}
 	app.Commands = []cli.Command{
 		{
@@ -126,6 +131,41 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on cluster",
 					Subcommands: newAdminClusterCommands(),
 				},
+				{
+					Name:        "archive",
+					Aliases:     []string{"arc"},
+					Usage:       "Run admin operation directly on archives, without a running cluster",
+					Subcommands: newAdminArchiveCommands(),
+				},
+				{
+					Name:        "audit",
+					Aliases:     []string{"au"},
+					Usage:       "Run admin operation on the audit log of control plane operations",
+					Subcommands: newAdminAuditCommands(),
+				},
+				{
+					Name:        "authorization",
+					Aliases:     []string{"auth"},
+					Usage:       "Run admin operation on the authorization of calls to frontend",
+					Subcommands: newAdminAuthorizationCommands(),
+				},
+				{
+					Name:        "encryption",
+					Aliases:     []string{"enc"},
+					Usage:       "Run admin operation on the encryption at rest of persisted data",
+					Subcommands: newAdminEncryptionCommands(),
+				},
+				{
+					Name:        "dlq",
+					Usage:       "Run admin operation on the dead letter queue of transfer and timer tasks which kept failing",
+					Subcommands: newAdminDLQCommands(),
+				},
+				{
+					Name:        "shard",
+					Aliases:     []string{"sh"},
+					Usage:       "Run admin operation on the transfer, timer and replication queues of a history shard",
+					Subcommands: newAdminShardCommands(),
+				},
 			},
 		},
 		{
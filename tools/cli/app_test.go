@@ -328,6 +328,62 @@ func (s *cliAppSuite) TestTerminateWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestPauseWorkflow() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(&shared.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestResumeWorkflow() {
+	s.serverFrontendClient.EXPECT().ResumeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "resume", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestResumeWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().ResumeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&shared.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "resume", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestUpdateWorkflow() {
+	resp := &serverShared.UpdateWorkflowExecutionResponse{
+		UpdateId: common.StringPtr("update-id"),
+		Result: &serverShared.WorkflowUpdateResult{
+			ResultType: serverShared.UpdateResultTypeAccepted.Ptr(),
+			Result:     []byte("result"),
+		},
+	}
+	s.serverFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "update", "-w", "wid", "-n", "update-name"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateWorkflow_Rejected() {
+	resp := &serverShared.UpdateWorkflowExecutionResponse{
+		UpdateId: common.StringPtr("update-id"),
+		Result: &serverShared.WorkflowUpdateResult{
+			ResultType:   serverShared.UpdateResultTypeRejected.Ptr(),
+			ErrorMessage: common.StringPtr("rejected"),
+		},
+	}
+	s.serverFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil)
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "update", "-w", "wid", "-n", "update-name"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestUpdateWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &shared.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "update", "-w", "wid", "-n", "update-name"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestCancelWorkflow() {
 	s.clientFrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any(), callOptions...).Return(nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "cancel", "-w", "wid"})
@@ -474,6 +530,36 @@ func (s *cliAppSuite) TestListWorkflow_Open_WithWorkflowType() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestListArchivedWorkflow() {
+	resp := &serverShared.ListArchivedWorkflowExecutionsResponse{}
+	s.serverFrontendClient.EXPECT().ListArchivedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "listarchived", "-wid", "some-prefix", "-s", "completed"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestListArchivedWorkflow_All() {
+	resp := &serverShared.ListArchivedWorkflowExecutionsResponse{
+		Executions: []*serverShared.WorkflowExecutionInfo{
+			{
+				Execution: &serverShared.WorkflowExecution{
+					WorkflowId: common.StringPtr("test-list-archived-workflow-id"),
+					RunId:      common.StringPtr(uuid.New()),
+				},
+				Type: &serverShared.WorkflowType{
+					Name: common.StringPtr("test-list-archived-workflow-type"),
+				},
+				StartTime: common.Int64Ptr(time.Now().UnixNano()),
+				CloseTime: common.Int64Ptr(time.Now().Add(time.Hour).UnixNano()),
+			},
+		},
+		NextPageToken: []byte{'1'},
+	}
+	s.serverFrontendClient.EXPECT().ListArchivedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
+	s.serverFrontendClient.EXPECT().ListArchivedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&serverShared.ListArchivedWorkflowExecutionsResponse{}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "listarchived", "--all"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestCountWorkflow() {
 	resp := &shared.CountWorkflowExecutionsResponse{}
 	s.clientFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
@@ -517,6 +603,117 @@ func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminListDLQTasks() {
+	resp := &serverShared.ListDLQTasksResponse{
+		Tasks: []*serverShared.DLQTaskInfo{{
+			ShardId:    common.Int32Ptr(1),
+			Category:   serverShared.DLQTaskCategoryTimer.Ptr(),
+			TaskId:     common.Int64Ptr(1234),
+			WorkflowId: common.StringPtr("test-wf-id"),
+			LastError:  common.StringPtr("faked error"),
+		}},
+	}
+	s.serverAdminClient.EXPECT().ListDLQTasks(gomock.Any(), &serverShared.ListDLQTasksRequest{
+		ShardId:         common.Int32Ptr(1),
+		Category:        serverShared.DLQTaskCategoryTimer.Ptr(),
+		MaximumPageSize: common.Int32Ptr(100),
+	}).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "dlq", "list", "--sid", "1", "--cat", "timer"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeDLQTask() {
+	resp := &serverShared.DescribeDLQTaskResponse{
+		Task: &serverShared.DLQTaskInfo{
+			ShardId:  common.Int32Ptr(1),
+			Category: serverShared.DLQTaskCategoryTransfer.Ptr(),
+			TaskId:   common.Int64Ptr(1234),
+			Data:     []byte(`{"TaskID":1234}`),
+		},
+	}
+	s.serverAdminClient.EXPECT().DescribeDLQTask(gomock.Any(), &serverShared.DescribeDLQTaskRequest{
+		ShardId:  common.Int32Ptr(1),
+		Category: serverShared.DLQTaskCategoryTransfer.Ptr(),
+		TaskId:   common.Int64Ptr(1234),
+	}).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "dlq", "describe", "--sid", "1", "--tid", "1234"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminRequeueDLQTask_Failed() {
+	s.serverAdminClient.EXPECT().RequeueDLQTask(gomock.Any(), gomock.Any()).Return(&serverShared.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "dlq", "requeue", "--sid", "1", "--tid", "1234"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminPurgeDLQTasks() {
+	s.serverAdminClient.EXPECT().PurgeDLQTasks(gomock.Any(), &serverShared.PurgeDLQTasksRequest{
+		ShardId:  common.Int32Ptr(1),
+		Category: serverShared.DLQTaskCategoryTimer.Ptr(),
+	}).Return(nil)
+	err := s.app.Run([]string{"", "admin", "dlq", "purge", "--sid", "1", "--cat", "timer", "--yes"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeShardQueues() {
+	resp := &serverShared.DescribeShardQueuesResponse{
+		ShardId:                  common.Int32Ptr(1),
+		TransferAckLevel:         common.Int64Ptr(1000),
+		TransferReadLevel:        common.Int64Ptr(1010),
+		TransferMaxReadLevel:     common.Int64Ptr(1020),
+		TransferClusterAckLevels: map[string]int64{"active": 1000},
+		TimerAckLevel:            common.Int64Ptr(time.Now().UnixNano()),
+		TimerClusterAckLevels:    map[string]int64{"active": time.Now().UnixNano()},
+		ReplicationAckLevel:      common.Int64Ptr(900),
+	}
+	s.serverAdminClient.EXPECT().DescribeShardQueues(gomock.Any(), &serverShared.DescribeShardQueuesRequest{
+		ShardId: common.Int32Ptr(1),
+	}).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "shard", "describe-queues", "--sid", "1"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListShardQueueTasks() {
+	resp := &serverShared.ListShardQueueTasksResponse{
+		Tasks: []*serverShared.ShardQueueTaskInfo{{
+			QueueType:           serverShared.ShardQueueTypeTimer.Ptr(),
+			TaskId:              common.Int64Ptr(1234),
+			DomainId:            common.StringPtr("test-domain-id"),
+			WorkflowId:          common.StringPtr("test-wf-id"),
+			VisibilityTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+		}},
+	}
+	s.serverAdminClient.EXPECT().ListShardQueueTasks(gomock.Any(), &serverShared.ListShardQueueTasksRequest{
+		ShardId:         common.Int32Ptr(1),
+		QueueType:       serverShared.ShardQueueTypeTimer.Ptr(),
+		WorkflowId:      common.StringPtr("test-wf-id"),
+		MaximumPageSize: common.Int32Ptr(100),
+	}).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "shard", "list-tasks", "--sid", "1", "--qt", "timer", "--wid", "test-wf-id"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDeleteShardQueueTask() {
+	s.serverAdminClient.EXPECT().DeleteShardQueueTask(gomock.Any(), &serverShared.DeleteShardQueueTaskRequest{
+		ShardId:             common.Int32Ptr(1),
+		QueueType:           serverShared.ShardQueueTypeTimer.Ptr(),
+		TaskId:              common.Int64Ptr(1234),
+		VisibilityTimestamp: common.Int64Ptr(1560000000000000000),
+	}).Return(nil)
+	err := s.app.Run([]string{"", "admin", "shard", "delete-task", "--sid", "1", "--qt", "timer", "--tid", "1234", "--vts", "1560000000000000000", "--yes"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminRefireShardQueueTask_Failed() {
+	s.serverAdminClient.EXPECT().RefireShardQueueTask(gomock.Any(), &serverShared.RefireShardQueueTaskRequest{
+		ShardId:   common.Int32Ptr(1),
+		QueueType: serverShared.ShardQueueTypeTransfer.Ptr(),
+		TaskId:    common.Int64Ptr(1234),
+	}).Return(&serverShared.EntityNotExistsError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "shard", "refire-task", "--sid", "1", "--tid", "1234"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestDescribeTaskList() {
 	resp := describeTaskListResponse
 	s.clientFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
@@ -46,6 +46,7 @@ const (
 
 	defaultDecisionTimeoutInSeconds = 10
 	defaultPageSizeForList          = 500
+	defaultPageSizeForListArchived  = 100
 	defaultWorkflowIDReusePolicy    = s.WorkflowIdReusePolicyAllowDuplicateFailedOnly
 
 	workflowStatusNotSet = -1
@@ -26,9 +26,11 @@ import (
 	serverAdmin "github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/urfave/cli"
 	clientFrontend "go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/middleware"
 	"go.uber.org/yarpc/api/transport"
 	"go.uber.org/yarpc/transport/tchannel"
 	"go.uber.org/zap"
@@ -103,7 +105,10 @@ func (b *clientFactory) ensureDispatcher(c *cli.Context) {
 			cadenceFrontendService: {Unary: ch.NewSingleOutbound(b.hostPort)},
 		},
 		OutboundMiddleware: yarpc.OutboundMiddleware{
-			Unary: &versionMiddleware{},
+			Unary: &authTokenMiddleware{
+				token: c.GlobalString(FlagAuthToken),
+				next:  &versionMiddleware{},
+			},
 		},
 	})
 
@@ -116,7 +121,19 @@ func (b *clientFactory) ensureDispatcher(c *cli.Context) {
 type versionMiddleware struct {
 }
 
+type authTokenMiddleware struct {
+	token string
+	next  middleware.UnaryOutbound
+}
+
 func (vm *versionMiddleware) Call(ctx context.Context, request *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
 	request.Headers = request.Headers.With(common.LibraryVersionHeaderName, "1.0.0").With(common.FeatureVersionHeaderName, "1.0.0").With(common.ClientImplHeaderName, "cli")
 	return out.Call(ctx, request)
 }
+
+func (am *authTokenMiddleware) Call(ctx context.Context, request *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
+	if am.token != "" {
+		request.Headers = request.Headers.With(authorization.TokenHeaderName, am.token)
+	}
+	return am.next.Call(ctx, request, out)
+}
@@ -89,6 +89,8 @@ const (
 	FlagOpenWithAlias                     = FlagOpen + ", op"
 	FlagMore                              = "more"
 	FlagMoreWithAlias                     = FlagMore + ", m"
+	FlagAll                               = "all"
+	FlagAllWithAlias                      = FlagAll + ", a"
 	FlagPageSize                          = "pagesize"
 	FlagPageSizeWithAlias                 = FlagPageSize + ", ps"
 	FlagEarliestTime                      = "earliest_time"
@@ -184,6 +186,24 @@ const (
 	FlagJobID                             = "job_id"
 	FlagJobIDWithAlias                    = FlagJobID + ", jid"
 	FlagYes                               = "yes"
+	FlagCloseFailoverVersion              = "close_failover_version"
+	FlagS3Region                          = "s3_region"
+	FlagS3Endpoint                        = "s3_endpoint"
+	FlagS3ForcePathStyle                  = "s3_force_path_style"
+	FlagDBEngine                          = "db_engine"
+	FlagDatabaseName                      = "db_name"
+	FlagKeyFile                           = "key_file"
+	FlagDLQCategory                       = "category"
+	FlagDLQCategoryWithAlias              = FlagDLQCategory + ", cat"
+	FlagTaskID                            = "task_id"
+	FlagTaskIDWithAlias                   = FlagTaskID + ", tid"
+	FlagQueueType                         = "queue_type"
+	FlagQueueTypeWithAlias                = FlagQueueType + ", qt"
+	FlagVisibilityTimestamp               = "visibility_timestamp"
+	FlagVisibilityTimestampWithAlias      = FlagVisibilityTimestamp + ", vts"
+	FlagAuthToken                         = "auth_token"
+	FlagActor                             = "actor"
+	FlagTokenTTL                          = "ttl"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -403,6 +423,56 @@ func getFlagsForListAll() []cli.Flag {
 	}
 }
 
+func getFlagsForListArchived() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagEarliestTimeWithAlias,
+			Usage: "EarliestTime of close time, supported formats are '2006-01-02T15:04:05Z07:00' and raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagLatestTimeWithAlias,
+			Usage: "LatestTime of close time, supported formats are '2006-01-02T15:04:05Z07:00' and raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowIDWithAlias,
+			Usage: "WorkflowID prefix",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowTypeWithAlias,
+			Usage: "WorkflowTypeName",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowStatusWithAlias,
+			Usage: "Closed workflow status [completed, failed, canceled, terminated, continuedasnew, timedout]",
+		},
+		cli.IntFlag{
+			Name:  FlagPageSizeWithAlias,
+			Value: 100,
+			Usage: "Result page size",
+		},
+		cli.BoolFlag{
+			Name:  FlagAllWithAlias,
+			Usage: "List all pages",
+		},
+		cli.BoolFlag{
+			Name:  FlagPrintRawTimeWithAlias,
+			Usage: "Print raw time stamp",
+		},
+		cli.BoolFlag{
+			Name:  FlagPrintDateTimeWithAlias,
+			Usage: "Print full date time in '2006-01-02T15:04:05Z07:00' format",
+		},
+		cli.BoolFlag{
+			Name:  FlagPrintFullyDetailWithAlias,
+			Usage: "Print full message without table format",
+		},
+		cli.BoolFlag{
+			Name:  FlagPrintJSONWithAlias,
+			Usage: "Print in raw json format",
+		},
+	}
+}
+
 func getFlagsForCount() []cli.Flag {
 	return []cli.Flag{
 		cli.StringFlag{
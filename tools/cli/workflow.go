@@ -107,6 +107,35 @@ func newWorkflowCommands() []cli.Command {
 				SignalWorkflow(c)
 			},
 		},
+		{
+			Name:  "update",
+			Usage: "update a workflow execution and wait for the workflow to accept or reject it",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "UpdateName",
+				},
+				cli.StringFlag{
+					Name:  FlagInputWithAlias,
+					Usage: "Input for the update, in JSON format.",
+				},
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Input for the update from JSON file.",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateWorkflow(c)
+			},
+		},
 		{
 			Name:    "terminate",
 			Aliases: []string{"term"},
@@ -129,6 +158,48 @@ func newWorkflowCommands() []cli.Command {
 				TerminateWorkflow(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "pause a workflow execution, holding its decision and activity tasks until resumed",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to pause the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "resume",
+			Usage: "resume a paused workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to resume the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ResumeWorkflow(c)
+			},
+		},
 		{
 			Name:        "list",
 			Aliases:     []string{"l"},
@@ -148,6 +219,15 @@ func newWorkflowCommands() []cli.Command {
 				ListAllWorkflow(c)
 			},
 		},
+		{
+			Name:        "listarchived",
+			Usage:       "list archived workflow executions",
+			Description: "list one page (default size 100 items) by default, use flag --all to list all pages",
+			Flags:       getFlagsForListArchived(),
+			Action: func(c *cli.Context) {
+				ListArchivedWorkflow(c)
+			},
+		},
 		{
 			Name:    "count",
 			Aliases: []string{"cnt"},
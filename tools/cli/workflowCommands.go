@@ -422,6 +422,58 @@ func TerminateWorkflow(c *cli.Context) {
 	}
 }
 
+// PauseWorkflow pauses a workflow execution
+func PauseWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.PauseWorkflowExecution(ctx, &shared.PauseWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		Reason:   common.StringPtr(c.String(FlagReason)),
+		Identity: common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Pause workflow failed.", err)
+	} else {
+		fmt.Println("Pause workflow succeeded.")
+	}
+}
+
+// ResumeWorkflow resumes a paused workflow execution
+func ResumeWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.ResumeWorkflowExecution(ctx, &shared.ResumeWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		Reason:   common.StringPtr(c.String(FlagReason)),
+		Identity: common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Resume workflow failed.", err)
+	} else {
+		fmt.Println("Resume workflow succeeded.")
+	}
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) {
 	wfClient := getWorkflowClient(c)
@@ -470,6 +522,38 @@ func SignalWorkflow(c *cli.Context) {
 	}
 }
 
+// UpdateWorkflow updates a workflow execution and waits for the update result
+func UpdateWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	name := getRequiredOption(c, FlagName)
+	input := processJSONInput(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := frontendClient.UpdateWorkflowExecution(ctx, &shared.UpdateWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		UpdateName: common.StringPtr(name),
+		Input:      []byte(input),
+		Identity:   common.StringPtr(getCliIdentity()),
+		RequestId:  common.StringPtr(uuid.New()),
+	})
+	if err != nil {
+		ErrorAndExit("Update workflow failed.", err)
+	} else if resp.GetResult().GetResultType() == shared.UpdateResultTypeRejected {
+		ErrorAndExit(fmt.Sprintf("Update %v was rejected: %v", resp.GetUpdateId(), resp.GetResult().GetErrorMessage()), nil)
+	} else {
+		fmt.Printf("Update %v accepted, result as JSON:\n%v\n", resp.GetUpdateId(), string(resp.GetResult().GetResult()))
+	}
+}
+
 // QueryWorkflow query workflow execution
 func QueryWorkflow(c *cli.Context) {
 	getRequiredGlobalOption(c, FlagDomain) // for pre-check and alert if not provided
@@ -601,6 +685,86 @@ func ListAllWorkflow(c *cli.Context) {
 	table.Render()
 }
 
+// ListArchivedWorkflow lists archived workflow executions based on filters
+func ListArchivedWorkflow(c *cli.Context) {
+	printJSON := c.Bool(FlagPrintJSON)
+	printDecodedRaw := c.Bool(FlagPrintFullyDetail)
+	printRawTime := c.Bool(FlagPrintRawTime)
+	printDateTime := c.Bool(FlagPrintDateTime)
+	listAll := c.Bool(FlagAll)
+
+	request := getListArchivedWorkflowExecutionsRequest(c)
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	var results []*shared.WorkflowExecutionInfo
+	for {
+		ctx, cancel := newContextForLongPoll(c)
+		resp, err := frontendClient.ListArchivedWorkflowExecutions(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list archived workflow.", err)
+		}
+		results = append(results, resp.Executions...)
+		if !listAll || len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+
+	if printJSON || printDecodedRaw {
+		fmt.Println("[")
+		for i, execution := range results {
+			printListResult(execution, printJSON, i < len(results)-1)
+		}
+		fmt.Println("]")
+		return
+	}
+
+	table := createTableForListWorkflow(c, listAll, false)
+	for _, e := range results {
+		var startTime, executionTime, closeTime string
+		if printRawTime {
+			startTime = fmt.Sprintf("%d", e.GetStartTime())
+			executionTime = fmt.Sprintf("%d", e.GetExecutionTime())
+			closeTime = fmt.Sprintf("%d", e.GetCloseTime())
+		} else {
+			startTime = convertTime(e.GetStartTime(), !printDateTime)
+			executionTime = convertTime(e.GetExecutionTime(), !printDateTime)
+			closeTime = convertTime(e.GetCloseTime(), !printDateTime)
+		}
+		table.Append([]string{trimWorkflowType(e.Type.GetName()), e.Execution.GetWorkflowId(), e.Execution.GetRunId(), startTime, executionTime, closeTime})
+	}
+	table.Render()
+}
+
+func getListArchivedWorkflowExecutionsRequest(c *cli.Context) *shared.ListArchivedWorkflowExecutionsRequest {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSizeForListArchived
+	}
+
+	request := &shared.ListArchivedWorkflowExecutionsRequest{
+		Domain:          common.StringPtr(domain),
+		MaximumPageSize: common.Int32Ptr(int32(pageSize)),
+		CloseTimeFilter: &shared.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(parseTime(c.String(FlagEarliestTime), 0)),
+			LatestTime:   common.Int64Ptr(parseTime(c.String(FlagLatestTime), time.Now().UnixNano())),
+		},
+	}
+	if workflowID := c.String(FlagWorkflowID); len(workflowID) > 0 {
+		request.WorkflowIDPrefix = common.StringPtr(workflowID)
+	}
+	if workflowType := c.String(FlagWorkflowType); len(workflowType) > 0 {
+		request.TypeFilter = &shared.WorkflowTypeFilter{Name: common.StringPtr(workflowType)}
+	}
+	if c.IsSet(FlagWorkflowStatus) {
+		workflowStatus := shared.WorkflowExecutionCloseStatus(getWorkflowStatus(c.String(FlagWorkflowStatus)))
+		request.StatusFilter = &workflowStatus
+	}
+	return request
+}
+
 // CountWorkflow count number of workflows
 func CountWorkflow(c *cli.Context) {
 	wfClient := getWorkflowClient(c)
@@ -1095,21 +1259,22 @@ func getWorkflowIDReusePolicy(value int) *s.WorkflowIdReusePolicy {
 // default will print decoded raw
 func printListResults(executions []*s.WorkflowExecutionInfo, inJSON bool) {
 	for i, execution := range executions {
-		if inJSON {
-			j, _ := json.Marshal(execution)
-			if i < len(executions)-1 {
-				fmt.Println(string(j) + ",")
-			} else {
-				fmt.Println(string(j))
-			}
-		} else {
-			if i < len(executions)-1 {
-				fmt.Println(anyToString(execution, true, 0) + ",")
-			} else {
-				fmt.Println(anyToString(execution, true, 0))
-			}
-		}
+		printListResult(execution, inJSON, i < len(executions)-1)
+	}
+}
+
+func printListResult(execution interface{}, inJSON bool, more bool) {
+	var str string
+	if inJSON {
+		j, _ := json.Marshal(execution)
+		str = string(j)
+	} else {
+		str = anyToString(execution, true, 0)
+	}
+	if more {
+		str += ","
 	}
+	fmt.Println(str)
 }
 
 // ObserveHistory show the process of running workflow
@@ -27,6 +27,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/uber/cadence/tools/common/schema"
 	"github.com/uber/cadence/tools/sql/mysql"
+	"github.com/uber/cadence/tools/sql/postgres"
 )
 
 type (
@@ -41,6 +42,15 @@ type (
 	sqlConn struct {
 		database string
 		db       *sqlx.DB
+		dialect  *sqlDialect
+	}
+	// sqlDialect holds the statements whose syntax differs between the supported sql drivers
+	sqlDialect struct {
+		writeSchemaVersionSQL             string
+		createSchemaVersionTableSQL       string
+		createSchemaUpdateHistoryTableSQL string
+		listTablesSQL                     string
+		createDatabaseSQL                 string
 	}
 )
 
@@ -64,46 +74,98 @@ const (
 		`new_version VARCHAR(64), ` +
 		`old_version VARCHAR(64), ` +
 		`PRIMARY KEY (year, month, update_time));`
+
+	listTablesSQL     = `SHOW TABLES FROM %v`
+	createDatabaseSQL = `CREATE database %v CHARACTER SET UTF8`
+
+	postgresWriteSchemaVersionSQL = `INSERT into schema_version(db_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?) ` +
+		`ON CONFLICT (db_name) DO UPDATE SET creation_time = excluded.creation_time, ` +
+		`curr_version = excluded.curr_version, min_compatible_version = excluded.min_compatible_version`
+
+	postgresCreateSchemaVersionTableSQL = `CREATE TABLE schema_version(db_name VARCHAR(255) not null PRIMARY KEY, ` +
+		`creation_time TIMESTAMP, ` +
+		`curr_version VARCHAR(64), ` +
+		`min_compatible_version VARCHAR(64));`
+
+	postgresCreateSchemaUpdateHistoryTableSQL = `CREATE TABLE schema_update_history(` +
+		`year int not null, ` +
+		`month int not null, ` +
+		`update_time TIMESTAMP not null, ` +
+		`description VARCHAR(255), ` +
+		`manifest_md5 VARCHAR(64), ` +
+		`new_version VARCHAR(64), ` +
+		`old_version VARCHAR(64), ` +
+		`PRIMARY KEY (year, month, update_time));`
+
+	postgresListTablesSQL     = `SELECT table_name FROM information_schema.tables WHERE table_catalog = '%v' AND table_schema = 'public'`
+	postgresCreateDatabaseSQL = `CREATE DATABASE %v ENCODING 'UTF8'`
+)
+
+var (
+	mysqlDialect = &sqlDialect{
+		writeSchemaVersionSQL:             writeSchemaVersionSQL,
+		createSchemaVersionTableSQL:       createSchemaVersionTableSQL,
+		createSchemaUpdateHistoryTableSQL: createSchemaUpdateHistoryTableSQL,
+		listTablesSQL:                     listTablesSQL,
+		createDatabaseSQL:                 createDatabaseSQL,
+	}
+
+	postgresDialect = &sqlDialect{
+		writeSchemaVersionSQL:             postgresWriteSchemaVersionSQL,
+		createSchemaVersionTableSQL:       postgresCreateSchemaVersionTableSQL,
+		createSchemaUpdateHistoryTableSQL: postgresCreateSchemaUpdateHistoryTableSQL,
+		listTablesSQL:                     postgresListTablesSQL,
+		createDatabaseSQL:                 postgresCreateDatabaseSQL,
+	}
 )
 
 var _ schema.DB = (*sqlConn)(nil)
 
 func newConn(params *sqlConnectParams) (*sqlConn, error) {
-	if params.driverName != mysql.DriverName {
+	var db *sqlx.DB
+	var dialect *sqlDialect
+	var err error
+	switch params.driverName {
+	case mysql.DriverName:
+		db, err = mysql.NewConnection(params.host, params.port, params.user, params.password, params.database)
+		dialect = mysqlDialect
+	case postgres.DriverName:
+		db, err = postgres.NewConnection(params.host, params.port, params.user, params.password, params.database)
+		dialect = postgresDialect
+	default:
 		return nil, fmt.Errorf("unsupported database driver: %v", params.driverName)
 	}
-	db, err := mysql.NewConnection(params.host, params.port, params.user, params.password, params.database)
 	if err != nil {
 		return nil, err
 	}
-	return &sqlConn{db: db, database: params.database}, nil
+	return &sqlConn{db: db, database: params.database, dialect: dialect}, nil
 }
 
 // CreateSchemaVersionTables sets up the schema version tables
 func (c *sqlConn) CreateSchemaVersionTables() error {
-	if err := c.Exec(createSchemaVersionTableSQL); err != nil {
+	if err := c.Exec(c.dialect.createSchemaVersionTableSQL); err != nil {
 		return err
 	}
-	return c.Exec(createSchemaUpdateHistoryTableSQL)
+	return c.Exec(c.dialect.createSchemaUpdateHistoryTableSQL)
 }
 
 // ReadSchemaVersion returns the current schema version for the keyspace
 func (c *sqlConn) ReadSchemaVersion() (string, error) {
 	var version string
-	err := c.db.Get(&version, readSchemaVersionSQL, c.database)
+	err := c.db.Get(&version, c.db.Rebind(readSchemaVersionSQL), c.database)
 	return version, err
 }
 
 // UpdateShemaVersion updates the schema version for the keyspace
 func (c *sqlConn) UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error {
-	_, err := c.db.Exec(writeSchemaVersionSQL, c.database, time.Now(), newVersion, minCompatibleVersion)
+	_, err := c.db.Exec(c.db.Rebind(c.dialect.writeSchemaVersionSQL), c.database, time.Now(), newVersion, minCompatibleVersion)
 	return err
 }
 
 // WriteSchemaUpdateLog adds an entry to the schema update history table
 func (c *sqlConn) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
 	now := time.Now().UTC()
-	_, err := c.db.Exec(writeSchemaUpdateHistorySQL, now.Year(), int(now.Month()), now, oldVersion, newVersion, manifestMD5, desc)
+	_, err := c.db.Exec(c.db.Rebind(writeSchemaUpdateHistorySQL), now.Year(), int(now.Month()), now, oldVersion, newVersion, manifestMD5, desc)
 	return err
 }
 
@@ -116,7 +178,7 @@ func (c *sqlConn) Exec(stmt string) error {
 // ListTables returns a list of tables in this database
 func (c *sqlConn) ListTables() ([]string, error) {
 	var tables []string
-	err := c.db.Select(&tables, fmt.Sprintf("SHOW TABLES FROM %v", c.database))
+	err := c.db.Select(&tables, fmt.Sprintf(c.dialect.listTablesSQL, c.database))
 	return tables, err
 }
 
@@ -141,7 +203,7 @@ func (c *sqlConn) DropAllTables() error {
 
 // CreateDatabase creates a database if it doesn't exist
 func (c *sqlConn) CreateDatabase(name string) error {
-	return c.Exec(fmt.Sprintf("CREATE database %v CHARACTER SET UTF8", name))
+	return c.Exec(fmt.Sprintf(c.dialect.createDatabaseSQL, name))
 }
 
 // DropDatabase drops a database
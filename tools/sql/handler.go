@@ -25,6 +25,7 @@ import (
 	"log"
 
 	"github.com/uber/cadence/tools/common/schema"
+	"github.com/uber/cadence/tools/sql/postgres"
 	"github.com/urfave/cli"
 )
 
@@ -118,6 +119,9 @@ func parseConnectParams(cli *cli.Context) (*sqlConnectParams, error) {
 	params.password = cli.GlobalString(schema.CLIOptPassword)
 	params.database = cli.GlobalString(schema.CLIOptDatabase)
 	params.driverName = cli.GlobalString(schema.CLIOptDriverName)
+	if params.driverName == postgres.DriverName && !cli.GlobalIsSet(schema.CLIOptPort) {
+		params.port = postgres.DefaultPort
+	}
 	isDryRun := cli.Bool(schema.CLIOptDryrun)
 	if err := validateConnectParams(params, isDryRun); err != nil {
 		return nil, err
@@ -24,6 +24,7 @@ import (
 	"os"
 
 	_ "github.com/go-sql-driver/mysql" // needed to load the mysql driver
+	_ "github.com/lib/pq"              // needed to load the postgres driver
 	"github.com/uber/cadence/tools/common/schema"
 	"github.com/urfave/cli"
 )
@@ -0,0 +1,59 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	dataSourceName = "postgres://%s@%v/%s?sslmode=disable"
+
+	// defaultDatabase is the database to connect to when none is given, it's needed
+	// because postgres doesn't allow a connection without a database
+	defaultDatabase = "postgres"
+)
+
+// DriverName refers to the name of the postgres driver
+const DriverName = "postgres"
+
+// DefaultPort is the default port of the postgres server
+const DefaultPort = 5432
+
+// NewConnection returns a new connection to postgres database
+func NewConnection(host string, port int, user string, passwd string, database string) (*sqlx.DB, error) {
+	if database == "" {
+		database = defaultDatabase
+	}
+	addr := fmt.Sprintf("%v:%v", host, port)
+	userInfo := url.UserPassword(user, passwd).String()
+	db, err := sqlx.Connect(DriverName, fmt.Sprintf(dataSourceName, userInfo, addr, database))
+	if err != nil {
+		return nil, err
+	}
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	db.MapperFunc(strcase.ToSnake)
+	return db, nil
+}